@@ -548,6 +548,7 @@ func (a *Agent) setupTask(agentCtx, setupCtx context.Context, initialTC *taskCon
 	}
 	tc.taskConfig.WorkDir = taskDirectory
 	tc.taskConfig.NewExpansions.Put("workdir", tc.taskConfig.WorkDir)
+	tc.resourceMonitor.setWorkDir(tc.taskConfig.WorkDir)
 
 	traceClient := otlptracegrpc.NewClient(otlptracegrpc.WithGRPCConn(a.otelGrpcConn))
 	// Set up a new task output directory regardless if the task is part of
@@ -561,6 +562,7 @@ func (a *Agent) setupTask(agentCtx, setupCtx context.Context, initialTC *taskCon
 			Expansions:         tc.taskConfig.NewExpansions,
 			Redacted:           tc.taskConfig.Redacted,
 			InternalRedactions: tc.taskConfig.InternalRedactions,
+			SecretDetector:     tc.taskConfig.SecretDetector,
 		},
 		S3Usage: tc.taskConfig.S3Usage,
 	}
@@ -1295,6 +1297,10 @@ func (a *Agent) finishTask(ctx context.Context, tc *taskContext, detail *apimode
 
 	_ = a.killProcs(ctx, tc, false, "task is ending")
 
+	if tc.resourceMonitor != nil {
+		grip.Error(ctx, errors.Wrap(a.comm.SendResourceUsage(ctx, tc.task, tc.resourceMonitor.usage()), "sending resource usage"))
+	}
+
 	if tc.logger != nil {
 		tc.logger.Execution().Infof(ctx, "Sending final task status: '%s'.", detail.Status)
 		flushCtx, cancel := context.WithTimeout(ctx, time.Minute)
@@ -1302,6 +1308,12 @@ func (a *Agent) finishTask(ctx context.Context, tc *taskContext, detail *apimode
 		grip.Error(ctx, errors.Wrap(tc.logger.Flush(flushCtx), "flushing logs"))
 	}
 
+	// Secrets are counted as log lines are redacted, so the summary is only
+	// complete once the logs have been flushed.
+	if tc.taskConfig != nil {
+		detail.RedactionSummary = tc.taskConfig.SecretDetector.Summary()
+	}
+
 	grip.Infof(ctx, "Sending final task status: '%s'.", detail.Status)
 	resp, err := a.comm.EndTask(ctx, detail, tc.task)
 	if err != nil {
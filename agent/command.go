@@ -230,6 +230,11 @@ func (a *Agent) runCommand(ctx context.Context, tc *taskContext, commandInfo mod
 		}
 	}()
 
+	if tc.resourceMonitor != nil {
+		tc.resourceMonitor.startCommand(ctx, cmd.FullDisplayName())
+		defer tc.resourceMonitor.endCommand(ctx)
+	}
+
 	start := time.Now()
 	defer func() {
 		tc.logger.Task().Infof(ctx, "Finished command %s in %s.", cmd.FullDisplayName(), time.Since(start).String())
@@ -1,11 +1,17 @@
 package command
 
 import (
+	"compress/flate"
 	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
 	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
 	"github.com/mholt/archiver/v3"
 	"github.com/mitchellh/mapstructure"
 	"github.com/mongodb/grip"
@@ -33,6 +39,15 @@ type autoArchiveCreate struct {
 	// Verbose enables per-file logging during archive creation.
 	Verbose bool `mapstructure:"verbose"`
 
+	// CompressionLevel is the format-specific compression level for
+	// compressed tarballs and zip files. If unset, the format's default level
+	// is used.
+	CompressionLevel int `mapstructure:"compression_level"`
+
+	// CompressionThreads is the number of threads used to compress tarballs.
+	// If unset, one thread per CPU is used.
+	CompressionThreads int `mapstructure:"compression_threads"`
+
 	base
 }
 
@@ -49,6 +64,8 @@ func (c *autoArchiveCreate) ParseParams(params map[string]any) error {
 	catcher.NewWhen(c.Target == "", "target cannot be blank")
 	catcher.NewWhen(c.SourceDir == "", "source directory cannot be blank")
 	catcher.NewWhen(len(c.ExcludeFiles) > 0 && len(c.Include) == 0, "if specifying files to exclude, must also specify files to include")
+	catcher.NewWhen(c.CompressionLevel < 0, "compression level cannot be negative")
+	catcher.NewWhen(c.CompressionThreads < 0, "compression threads cannot be negative")
 
 	return catcher.Resolve()
 }
@@ -90,7 +107,7 @@ func (c *autoArchiveCreate) Execute(ctx context.Context,
 		}
 	}
 
-	if err := archiver.Archive(filenames, c.Target); err != nil {
+	if err := c.archive(filenames); err != nil {
 		return errors.Wrapf(err, "constructing auto archive '%s'", c.Target)
 	}
 
@@ -105,3 +122,154 @@ func (c *autoArchiveCreate) Execute(ctx context.Context,
 
 	return nil
 }
+
+// autoPackTarCompressions maps the extensions of the compressed tarballs that
+// archive.auto_pack compresses itself to their compression formats. Every
+// other format is delegated entirely to archiver.
+var autoPackTarCompressions = []struct {
+	extension string
+	format    string
+}{
+	{extension: ".tar.gz", format: archiveCompressionGzip},
+	{extension: ".tgz", format: archiveCompressionGzip},
+	{extension: ".tar.zst", format: archiveCompressionZstd},
+	{extension: ".tar.xz", format: archiveCompressionXz},
+	{extension: ".txz", format: archiveCompressionXz},
+}
+
+// archive creates the target archive from the given files using the format
+// implied by the target's extension.
+func (c *autoArchiveCreate) archive(filenames []string) error {
+	for _, tc := range autoPackTarCompressions {
+		if strings.HasSuffix(c.Target, tc.extension) {
+			return writeCompressedTarball(filenames, c.Target, archiveCompressionOptions{
+				format:  tc.format,
+				level:   c.CompressionLevel,
+				threads: c.CompressionThreads,
+			})
+		}
+	}
+
+	if c.CompressionThreads != 0 {
+		return errors.Errorf("compression threads are only supported for compressed tarballs, not '%s'", c.Target)
+	}
+	format, err := archiver.ByExtension(c.Target)
+	if err != nil {
+		return errors.Wrap(err, "determining archive format")
+	}
+	if c.CompressionLevel != 0 {
+		z, ok := format.(*archiver.Zip)
+		if !ok {
+			return errors.Errorf("compression level is only supported for compressed tarballs and zip files, not '%s'", c.Target)
+		}
+		if c.CompressionLevel > flate.BestCompression {
+			return errors.Errorf("zip compression level must be between %d and %d", flate.BestSpeed, flate.BestCompression)
+		}
+		z.CompressionLevel = c.CompressionLevel
+	}
+	a, ok := format.(archiver.Archiver)
+	if !ok {
+		return errors.Errorf("format specified by target '%s' is not an archive format", c.Target)
+	}
+	return a.Archive(filenames, c.Target)
+}
+
+// writeCompressedTarball archives the sources into a tarball at target,
+// compressed with the given options. Entries are named exactly as archiver
+// names them, so the result is the same as archiver's own compressed tarballs
+// apart from the compression settings.
+func writeCompressedTarball(sources []string, target string, opts archiveCompressionOptions) (err error) {
+	if err := opts.validate(); err != nil {
+		return errors.Wrap(err, "validating compression options")
+	}
+	if utility.FileExists(target) {
+		return errors.Errorf("file '%s' already exists", target)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return errors.Wrapf(err, "making parent directory for '%s'", target)
+	}
+	targetAbs, err := filepath.Abs(target)
+	if err != nil {
+		return errors.Wrapf(err, "getting absolute path of '%s'", target)
+	}
+
+	f, err := os.Create(target)
+	if err != nil {
+		return errors.Wrapf(err, "creating file '%s'", target)
+	}
+	cw, err := newCompressingWriter(f, opts)
+	if err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "creating compressing writer")
+		catcher.Wrapf(f.Close(), "closing file '%s'", target)
+		return catcher.Resolve()
+	}
+	t := archiver.NewTar()
+	if err := t.Create(cw); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "creating tar writer")
+		catcher.Wrap(cw.Close(), "closing compressing writer")
+		catcher.Wrapf(f.Close(), "closing file '%s'", target)
+		return catcher.Resolve()
+	}
+	defer func() {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(err)
+		catcher.Wrap(t.Close(), "closing tar writer")
+		catcher.Wrap(cw.Close(), "closing compressing writer")
+		catcher.Wrapf(f.Close(), "closing file '%s'", target)
+		err = catcher.Resolve()
+	}()
+
+	for _, source := range sources {
+		if err := writeTarballSource(t, source, targetAbs); err != nil {
+			return errors.Wrapf(err, "walking '%s'", source)
+		}
+	}
+	return nil
+}
+
+// writeTarballSource writes the source file, or the directory tree rooted at
+// it, into the tarball. Neither the tarball itself nor the directories
+// containing it are written.
+func writeTarballSource(t *archiver.Tar, source, targetAbs string) error {
+	sourceInfo, err := os.Stat(source)
+	if err != nil {
+		return errors.Wrapf(err, "getting file info for '%s'", source)
+	}
+
+	return filepath.Walk(source, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return errors.Wrapf(err, "traversing '%s'", fpath)
+		}
+		fpathAbs, err := filepath.Abs(fpath)
+		if err != nil {
+			return errors.Wrapf(err, "getting absolute path of '%s'", fpath)
+		}
+		if relPath, err := filepath.Rel(fpathAbs, targetAbs); err == nil && !strings.Contains(relPath, "..") {
+			return nil
+		}
+
+		nameInArchive, err := archiver.NameInArchive(sourceInfo, source, fpath)
+		if err != nil {
+			return errors.Wrapf(err, "getting archive name for '%s'", fpath)
+		}
+
+		var file io.ReadCloser
+		if info.Mode().IsRegular() {
+			file, err = os.Open(fpath)
+			if err != nil {
+				return errors.Wrapf(err, "opening '%s'", fpath)
+			}
+			defer file.Close()
+		}
+		return errors.Wrapf(t.Write(archiver.File{
+			FileInfo: archiver.FileInfo{
+				FileInfo:   info,
+				CustomName: nameInArchive,
+				SourcePath: fpath,
+			},
+			ReadCloser: file,
+		}), "writing '%s'", fpath)
+	})
+}
@@ -70,6 +70,23 @@ func TestArchiveAutoPackParseParams(t *testing.T) {
 				"exclude_files": []string{"excluded_file"},
 			}))
 		},
+		"SucceedsWithCompressionOptions": func(t *testing.T, cmd Command) {
+			require.NoError(t, cmd.ParseParams(map[string]any{
+				"target":              "some_target.tar.zst",
+				"source_dir":          "some_source_dir",
+				"compression_level":   19,
+				"compression_threads": 4,
+			}))
+			assert.Equal(t, 19, cmd.(*autoArchiveCreate).CompressionLevel)
+			assert.Equal(t, 4, cmd.(*autoArchiveCreate).CompressionThreads)
+		},
+		"FailsWithNegativeCompressionThreads": func(t *testing.T, cmd Command) {
+			assert.Error(t, cmd.ParseParams(map[string]any{
+				"target":              "some_target.tar.zst",
+				"source_dir":          "some_source_dir",
+				"compression_threads": -1,
+			}))
+		},
 		"VerboseDefaultsToFalse": func(t *testing.T, cmd Command) {
 			require.NoError(t, cmd.ParseParams(map[string]any{
 				"target":     "some_target",
@@ -248,6 +265,58 @@ func TestArchiveAutoPackExecute(t *testing.T) {
 				assert.True(t, found, "expected to find file '%s' in archive", filename)
 			}
 		},
+		"SucceedsAndCreatesZstdArchiveWithCompressionOptions": func(ctx context.Context, t *testing.T, cmd *autoArchiveCreate, client client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			_, thisFile, _, _ := runtime.Caller(0)
+			cmd.Include = []string{filepath.Base(thisFile)}
+			cmd.Target = filepath.Join(t.TempDir(), "archive.tar.zst")
+			cmd.CompressionLevel = 19
+			cmd.CompressionThreads = 2
+
+			require.NoError(t, cmd.Execute(ctx, client, logger, conf))
+
+			unarchiveDir := t.TempDir()
+			require.NoError(t, archiver.NewTarZstd().Unarchive(cmd.Target, unarchiveDir))
+			assert.FileExists(t, filepath.Join(unarchiveDir, filepath.Base(thisFile)))
+		},
+		"SucceedsAndCreatesXzArchiveWithCompressionLevel": func(ctx context.Context, t *testing.T, cmd *autoArchiveCreate, client client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			_, thisFile, _, _ := runtime.Caller(0)
+			cmd.Include = []string{filepath.Base(thisFile)}
+			cmd.Target = filepath.Join(t.TempDir(), "archive.tar.xz")
+			cmd.CompressionLevel = 1
+
+			require.NoError(t, cmd.Execute(ctx, client, logger, conf))
+
+			unarchiveDir := t.TempDir()
+			require.NoError(t, archiver.NewTarXz().Unarchive(cmd.Target, unarchiveDir))
+			assert.FileExists(t, filepath.Join(unarchiveDir, filepath.Base(thisFile)))
+		},
+		"SucceedsAndCreatesZipArchiveWithCompressionLevel": func(ctx context.Context, t *testing.T, cmd *autoArchiveCreate, client client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			_, thisFile, _, _ := runtime.Caller(0)
+			cmd.Include = []string{filepath.Base(thisFile)}
+			cmd.Target = filepath.Join(t.TempDir(), "archive.zip")
+			cmd.CompressionLevel = 9
+
+			require.NoError(t, cmd.Execute(ctx, client, logger, conf))
+
+			unarchiveDir := t.TempDir()
+			require.NoError(t, archiver.NewZip().Unarchive(cmd.Target, unarchiveDir))
+			assert.FileExists(t, filepath.Join(unarchiveDir, filepath.Base(thisFile)))
+		},
+		"FailsWithInvalidCompressionLevel": func(ctx context.Context, t *testing.T, cmd *autoArchiveCreate, client client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			cmd.CompressionLevel = 10
+			assert.Error(t, cmd.Execute(ctx, client, logger, conf))
+			assert.NoFileExists(t, cmd.Target)
+		},
+		"FailsWithCompressionLevelForUncompressedTarball": func(ctx context.Context, t *testing.T, cmd *autoArchiveCreate, client client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			cmd.Target = filepath.Join(t.TempDir(), "archive.tar")
+			cmd.CompressionLevel = 5
+			assert.Error(t, cmd.Execute(ctx, client, logger, conf))
+		},
+		"FailsWithCompressionThreadsForZip": func(ctx context.Context, t *testing.T, cmd *autoArchiveCreate, client client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			cmd.Target = filepath.Join(t.TempDir(), "archive.zip")
+			cmd.CompressionThreads = 2
+			assert.Error(t, cmd.Execute(ctx, client, logger, conf))
+		},
 	} {
 		t.Run(tName, func(t *testing.T) {
 			ctx := t.Context()
@@ -1,7 +1,9 @@
 package command
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -55,9 +57,66 @@ func (e *autoExtract) Execute(ctx context.Context,
 		return errors.Errorf("archive '%s' does not exist", e.ArchivePath)
 	}
 
-	if err := archiver.Unarchive(e.ArchivePath, e.TargetDirectory); err != nil {
+	unarchiver, err := detectUnarchiver(e.ArchivePath)
+	if err != nil {
+		return errors.Wrapf(err, "detecting format of archive '%s'", e.ArchivePath)
+	}
+	if unarchiver != nil {
+		err = unarchiver.Unarchive(e.ArchivePath, e.TargetDirectory)
+	} else {
+		err = archiver.Unarchive(e.ArchivePath, e.TargetDirectory)
+	}
+	if err != nil {
 		return errors.Wrapf(err, "extracting archive '%s' to '%s'", e.ArchivePath, e.TargetDirectory)
 	}
 
 	return nil
 }
+
+var (
+	zipMagic   = []byte("PK\x03\x04")
+	bzip2Magic = []byte("BZh")
+	// tarMagic is found at tarMagicOffset in the header of the first entry of
+	// a tar archive.
+	tarMagic       = []byte("ustar")
+	tarMagicOffset = 257
+)
+
+// detectUnarchiver returns the unarchiver for the archive at path based on the
+// magic bytes at the start of the file, so archives are extracted correctly
+// regardless of their file extension. It returns nil if the format isn't
+// recognized, in which case the format should be determined from the
+// extension instead.
+func detectUnarchiver(path string) (archiver.Unarchiver, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening archive '%s'", path)
+	}
+	defer f.Close()
+
+	header := make([]byte, tarMagicOffset+len(tarMagic))
+	n, err := io.ReadFull(f, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, errors.Wrapf(err, "reading header of archive '%s'", path)
+	}
+	header = header[:n]
+
+	switch detectCompression(header) {
+	case archiveCompressionGzip:
+		return archiver.NewTarGz(), nil
+	case archiveCompressionZstd:
+		return archiver.NewTarZstd(), nil
+	case archiveCompressionXz:
+		return archiver.NewTarXz(), nil
+	}
+	switch {
+	case bytes.HasPrefix(header, zipMagic):
+		return archiver.NewZip(), nil
+	case bytes.HasPrefix(header, bzip2Magic):
+		return archiver.NewTarBz2(), nil
+	case len(header) == tarMagicOffset+len(tarMagic) && bytes.Equal(header[tarMagicOffset:], tarMagic):
+		return archiver.NewTar(), nil
+	default:
+		return nil, nil
+	}
+}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"io/fs"
+	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -138,6 +139,41 @@ func (s *AutoExtractSuite) TestExtractionTarSucceedsButIsNotIdempotent() {
 	s.Error(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
 }
 
+func (s *AutoExtractSuite) TestExtractionDetectsFormatFromMagicBytes() {
+	srcDir := s.T().TempDir()
+	testFileDir := filepath.Join(srcDir, "artifacts", "dir1", "dir2")
+	s.Require().NoError(os.MkdirAll(testFileDir, 0755))
+	s.Require().NoError(os.WriteFile(filepath.Join(testFileDir, "testfile.txt"), []byte("test"), 0644))
+
+	for _, format := range []string{archiveCompressionGzip, archiveCompressionZstd, archiveCompressionXz} {
+		s.Run(format, func() {
+			// The archive's extension doesn't match any format, so it can
+			// only be extracted by detecting its format.
+			archivePath := filepath.Join(s.T().TempDir(), "artifacts.bin")
+			s.Require().NoError(writeCompressedTarball([]string{filepath.Join(srcDir, "artifacts")}, archivePath, archiveCompressionOptions{format: format}))
+
+			s.cmd.TargetDirectory = s.T().TempDir()
+			s.cmd.ArchivePath = archivePath
+			s.Require().NoError(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+
+			checkCommonExtractedArchiveContents(s.T(), s.cmd.TargetDirectory)
+		})
+	}
+}
+
+func (s *AutoExtractSuite) TestExtractionZipDetectedFromMagicBytes() {
+	archivePath := filepath.Join(s.T().TempDir(), "artifacts.bin")
+	contents, err := os.ReadFile(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "archive", "artifacts.zip"))
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(archivePath, contents, 0644))
+
+	s.cmd.TargetDirectory = s.targetLocation
+	s.cmd.ArchivePath = archivePath
+	s.Require().NoError(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+
+	checkCommonExtractedArchiveContents(s.T(), s.cmd.TargetDirectory)
+}
+
 // checkCommonExtractedArchiveContents checks that the testdata's archive file
 // extracted to the expected contents.
 func checkCommonExtractedArchiveContents(t *testing.T, targetDirectory string) {
@@ -17,7 +17,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-// Plugin command responsible for creating a tgz archive.
+// Plugin command responsible for creating a compressed tar archive.
 type tarballCreate struct {
 	// the tgz file that will be created
 	Target string `mapstructure:"target" plugin:"expand"`
@@ -36,6 +36,19 @@ type tarballCreate struct {
 	// Verbose enables per-file logging during archive creation.
 	Verbose bool `mapstructure:"verbose"`
 
+	// Compression is the compression format of the tarball, either "gzip"
+	// (the default), "zstd", or "xz".
+	Compression string `mapstructure:"compression"`
+
+	// CompressionLevel is the format-specific compression level. If unset,
+	// the format's default level is used.
+	CompressionLevel int `mapstructure:"compression_level"`
+
+	// CompressionThreads is the number of threads used to compress the
+	// tarball. If unset, archives larger than 1 MB are compressed with one
+	// thread per CPU.
+	CompressionThreads int `mapstructure:"compression_threads"`
+
 	// This is only incremented in the case of a panic.
 	Attempt int
 
@@ -68,7 +81,15 @@ func (c *tarballCreate) ParseParams(params map[string]any) error {
 		return errors.New("include cannot be empty")
 	}
 
-	return nil
+	return errors.Wrap(c.compressionOptions().validate(), "validating compression options")
+}
+
+func (c *tarballCreate) compressionOptions() archiveCompressionOptions {
+	return archiveCompressionOptions{
+		format:  c.Compression,
+		level:   c.CompressionLevel,
+		threads: c.CompressionThreads,
+	}
 }
 
 // Execute builds the archive.
@@ -149,10 +170,10 @@ func (c *tarballCreate) Execute(ctx context.Context,
 
 }
 
-// thresholdSizeForParallelGzipCompression is the total size (in bytes) of the
-// files to archive after which using parallel gzip may improve performance
-// compared to regular gzip.
-const thresholdSizeForParallelGzipCompression = 1024 * 1024
+// thresholdSizeForParallelCompression is the total size (in bytes) of the
+// files to archive after which using parallel compression may improve
+// performance compared to single-threaded compression.
+const thresholdSizeForParallelCompression = 1024 * 1024
 
 // Build the archive.
 // Returns the number of files included in the archive (0 means empty archive).
@@ -162,14 +183,13 @@ func (c *tarballCreate) makeArchive(ctx context.Context, logger grip.Journaler,
 		return 0, errors.Wrap(err, "getting archive contents")
 	}
 
-	useParallelGzip := totalSize > thresholdSizeForParallelGzipCompression
-	f, gz, tarWriter, err := tarGzWriter(c.Target, useParallelGzip)
+	f, cw, tarWriter, err := compressedTarWriter(c.Target, c.compressionOptions().forArchiveSize(totalSize))
 	if err != nil {
 		return -1, errors.Wrapf(err, "opening target archive file '%s'", c.Target)
 	}
 	defer func() {
 		logger.Error(ctx, tarWriter.Close())
-		logger.Error(ctx, gz.Close())
+		logger.Error(ctx, cw.Close())
 		logger.Error(ctx, f.Close())
 	}()
 
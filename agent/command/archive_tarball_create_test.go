@@ -102,6 +102,53 @@ func TestTarGzPackParseParams(t *testing.T) {
 				So(cmd.Verbose, ShouldBeTrue)
 			})
 
+			Convey("compression options should be parsed into the command", func() {
+				params := map[string]any{
+					"target":              "t",
+					"source_dir":          "s",
+					"include":             []string{"i"},
+					"compression":         "zstd",
+					"compression_level":   19,
+					"compression_threads": 4,
+				}
+				So(cmd.ParseParams(params), ShouldBeNil)
+				So(cmd.Compression, ShouldEqual, archiveCompressionZstd)
+				So(cmd.CompressionLevel, ShouldEqual, 19)
+				So(cmd.CompressionThreads, ShouldEqual, 4)
+			})
+
+			Convey("an unsupported compression format should cause an error", func() {
+				params := map[string]any{
+					"target":      "t",
+					"source_dir":  "s",
+					"include":     []string{"i"},
+					"compression": "lz4",
+				}
+				So(cmd.ParseParams(params), ShouldNotBeNil)
+			})
+
+			Convey("an out of range compression level should cause an error", func() {
+				params := map[string]any{
+					"target":            "t",
+					"source_dir":        "s",
+					"include":           []string{"i"},
+					"compression":       "gzip",
+					"compression_level": 12,
+				}
+				So(cmd.ParseParams(params), ShouldNotBeNil)
+			})
+
+			Convey("compression threads with xz compression should cause an error", func() {
+				params := map[string]any{
+					"target":              "t",
+					"source_dir":          "s",
+					"include":             []string{"i"},
+					"compression":         "xz",
+					"compression_threads": 2,
+				}
+				So(cmd.ParseParams(params), ShouldNotBeNil)
+			})
+
 		})
 	})
 }
@@ -2,6 +2,8 @@ package command
 
 import (
 	"archive/tar"
+	"bufio"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"io"
@@ -9,11 +11,14 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/evergreen-ci/utility"
+	"github.com/klauspost/compress/zstd"
 	"github.com/klauspost/pgzip"
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
+	"github.com/ulikunitz/xz"
 )
 
 // pathEscapesRoot reports whether a path relative to the root (as returned by
@@ -78,6 +83,10 @@ type buildArchiveOptions struct {
 	// targets); otherwise they are dereferenced and the target's contents are
 	// stored at the symlink's path.
 	preserveSymlinks bool
+	// normalizeHeaders writes headers that only depend on each file's path,
+	// type, mode, and size, so that archiving the same contents again, such
+	// as after they are extracted, produces the same bytes.
+	normalizeHeaders bool
 }
 
 // buildArchive reads the rootPath directory into the tar.Writer,
@@ -155,6 +164,14 @@ FileLoop:
 		hdr.Name = strings.TrimPrefix(intarball, rootPathPrefix)
 		hdr.Mode = int64(file.info.Mode() & os.ModePerm)
 		hdr.ModTime = file.info.ModTime()
+		if opts.normalizeHeaders {
+			hdr.ModTime = time.Unix(0, 0)
+			hdr.AccessTime = time.Time{}
+			hdr.ChangeTime = time.Time{}
+			hdr.Uid, hdr.Gid = 0, 0
+			hdr.Uname, hdr.Gname = "", ""
+			hdr.Format = tar.FormatPAX
+		}
 
 		if file.info.IsDir() {
 			if hdr.Name != "" && !strings.HasSuffix(hdr.Name, "/") {
@@ -220,13 +237,14 @@ FileLoop:
 }
 
 func extractTarball(ctx context.Context, reader io.Reader, rootPath string, excludes []string, preserveSymlinks bool) error {
-	// wrap the reader in a gzip reader and a tar reader
-	gzipReader, err := pgzip.NewReader(reader)
+	// wrap the reader in a decompressing reader and a tar reader
+	decompressor, err := newDecompressingReader(reader)
 	if err != nil {
-		return errors.Wrap(err, "creating gzip reader")
+		return errors.Wrap(err, "creating decompressing reader")
 	}
+	defer decompressor.Close()
 
-	tarReader := tar.NewReader(gzipReader)
+	tarReader := tar.NewReader(decompressor)
 	err = extractTarballArchive(ctx, tarReader, rootPath, excludes, preserveSymlinks)
 	if err != nil {
 		return errors.Wrapf(err, "extracting path '%s'", rootPath)
@@ -362,22 +380,203 @@ func writeFileWithContentsAndPermission(ctx context.Context, path string, conten
 	return errors.Wrapf(os.Chmod(f.Name(), mode), "changing file '%s' mode to %d", f.Name(), mode)
 }
 
-// tarGzWriter returns a file, gzip writer, and tarWriter for the path.
-// The tar writer wraps the gzip writer, which wraps the file. If
-// useParallelGzip is true, then it will use the parallel gzip algorithm;
-// otherwise, it'll use the standard single-threaded gzip algorithm.
-func tarGzWriter(path string, useParallelGzip bool) (f, gz io.WriteCloser, tarWriter *tar.Writer, err error) {
+// compressedTarWriter returns a file, compressing writer, and tarWriter for
+// the path. The tar writer wraps the compressing writer, which wraps the file.
+func compressedTarWriter(path string, opts archiveCompressionOptions) (f, cw io.WriteCloser, tarWriter *tar.Writer, err error) {
 	f, err = os.Create(path)
 	if err != nil {
 		return nil, nil, nil, errors.Wrapf(err, "creating file '%s'", path)
 	}
-	if useParallelGzip {
-		gz = pgzip.NewWriter(f)
-	} else {
-		gz = gzip.NewWriter(f)
+	cw, err = newCompressingWriter(f, opts)
+	if err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "creating compressing writer")
+		catcher.Wrapf(f.Close(), "closing file '%s'", path)
+		return nil, nil, nil, catcher.Resolve()
+	}
+	tarWriter = tar.NewWriter(cw)
+	return f, cw, tarWriter, nil
+}
+
+const (
+	archiveCompressionGzip = "gzip"
+	archiveCompressionZstd = "zstd"
+	archiveCompressionXz   = "xz"
+)
+
+// archiveCompressionLevels maps each compression format to its range of
+// valid compression levels.
+var archiveCompressionLevels = map[string][2]int{
+	archiveCompressionGzip: {gzip.BestSpeed, gzip.BestCompression},
+	archiveCompressionZstd: {1, 22},
+	archiveCompressionXz:   {1, 9},
+}
+
+// xzDictCaps maps each xz compression level to the dictionary size that the xz
+// command line tool uses for the same preset.
+var xzDictCaps = [...]int{
+	1: 1 << 20,
+	2: 2 << 20,
+	3: 4 << 20,
+	4: 4 << 20,
+	5: 8 << 20,
+	6: 8 << 20,
+	7: 16 << 20,
+	8: 32 << 20,
+	9: 64 << 20,
+}
+
+// archiveCompressionOptions configures how a tarball is compressed.
+type archiveCompressionOptions struct {
+	// format is the compression format. It defaults to gzip.
+	format string
+	// level is the format-specific compression level. Zero uses the format's
+	// default level.
+	level int
+	// threads is the number of goroutines used for compression. Zero uses
+	// one per CPU. It must be zero for xz, which is always single-threaded.
+	threads int
+}
+
+// validate checks that the format and level are supported.
+func (o archiveCompressionOptions) validate() error {
+	format := o.format
+	if format == "" {
+		format = archiveCompressionGzip
+	}
+	levels, ok := archiveCompressionLevels[format]
+	if !ok {
+		return errors.Errorf("compression format '%s' must be one of '%s', '%s', or '%s'", o.format, archiveCompressionGzip, archiveCompressionZstd, archiveCompressionXz)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(o.level != 0 && (o.level < levels[0] || o.level > levels[1]), "%s compression level must be between %d and %d", format, levels[0], levels[1])
+	catcher.NewWhen(o.threads < 0, "compression threads cannot be negative")
+	catcher.ErrorfWhen(format == archiveCompressionXz && o.threads != 0, "compression threads are not supported for %s compression", archiveCompressionXz)
+	return catcher.Resolve()
+}
+
+// forArchiveSize returns the options to use for an archive whose contents
+// total the given number of bytes. Small archives are compressed with a
+// single thread unless the number of threads is set explicitly, since
+// parallel compression only pays off for larger archives. xz compression is
+// always single-threaded, so its options are never changed.
+func (o archiveCompressionOptions) forArchiveSize(totalSize int) archiveCompressionOptions {
+	if o.threads == 0 && o.format != archiveCompressionXz && totalSize <= thresholdSizeForParallelCompression {
+		o.threads = 1
+	}
+	return o
+}
+
+// newCompressingWriter returns a writer that compresses into w. Closing it
+// flushes the compressed stream but does not close w.
+func newCompressingWriter(w io.Writer, opts archiveCompressionOptions) (io.WriteCloser, error) {
+	if err := opts.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid compression options")
+	}
+
+	switch opts.format {
+	case archiveCompressionZstd:
+		zstdOpts := []zstd.EOption{}
+		if opts.level != 0 {
+			zstdOpts = append(zstdOpts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(opts.level)))
+		}
+		if opts.threads != 0 {
+			zstdOpts = append(zstdOpts, zstd.WithEncoderConcurrency(opts.threads))
+		}
+		zw, err := zstd.NewWriter(w, zstdOpts...)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating zstd writer")
+		}
+		return zw, nil
+	case archiveCompressionXz:
+		conf := xz.WriterConfig{}
+		if opts.level != 0 {
+			conf.DictCap = xzDictCaps[opts.level]
+		}
+		xw, err := conf.NewWriter(w)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating xz writer")
+		}
+		return xw, nil
+	default:
+		level := opts.level
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		if opts.threads == 1 {
+			gz, err := gzip.NewWriterLevel(w, level)
+			if err != nil {
+				return nil, errors.Wrap(err, "creating gzip writer")
+			}
+			return gz, nil
+		}
+		gz, err := pgzip.NewWriterLevel(w, level)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating parallel gzip writer")
+		}
+		if opts.threads != 0 {
+			if err := gz.SetConcurrency(parallelGzipBlockSize, opts.threads); err != nil {
+				return nil, errors.Wrap(err, "setting gzip concurrency")
+			}
+		}
+		return gz, nil
+	}
+}
+
+// parallelGzipBlockSize is the size of the blocks that parallel gzip
+// compresses concurrently, matching the pgzip default.
+const parallelGzipBlockSize = 1 << 20
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	xzMagic   = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+)
+
+// detectCompression returns the compression format of a stream beginning with
+// header, or an empty string if it is not one of the supported formats.
+func detectCompression(header []byte) string {
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		return archiveCompressionGzip
+	case bytes.HasPrefix(header, zstdMagic):
+		return archiveCompressionZstd
+	case bytes.HasPrefix(header, xzMagic):
+		return archiveCompressionXz
+	default:
+		return ""
+	}
+}
+
+// newDecompressingReader returns a reader that decompresses r, detecting the
+// compression format from its magic bytes.
+func newDecompressingReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(len(xzMagic))
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "reading compression header")
+	}
+
+	switch detectCompression(header) {
+	case archiveCompressionGzip:
+		gz, err := pgzip.NewReader(br)
+		return gz, errors.Wrap(err, "creating gzip reader")
+	case archiveCompressionZstd:
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating zstd reader")
+		}
+		return zr.IOReadCloser(), nil
+	case archiveCompressionXz:
+		xr, err := xz.NewReader(br)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating xz reader")
+		}
+		return io.NopCloser(xr), nil
+	default:
+		return nil, errors.Errorf("unrecognized compression format, must be one of '%s', '%s', or '%s'", archiveCompressionGzip, archiveCompressionZstd, archiveCompressionXz)
 	}
-	tarWriter = tar.NewWriter(gz)
-	return f, gz, tarWriter, nil
 }
 
 // archiveContentFile represents a tar file on disk.
@@ -2,6 +2,7 @@ package command
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -152,10 +153,10 @@ func TestBuildArchive(t *testing.T) {
 
 		for testCase, makeTarGzWriter := range map[string]func(outputFile string) (f io.WriteCloser, gz io.WriteCloser, tarWriter *tar.Writer, err error){
 			"Gzip": func(outputFile string) (f io.WriteCloser, gz io.WriteCloser, tarWriter *tar.Writer, err error) {
-				return tarGzWriter(outputFile, false)
+				return compressedTarWriter(outputFile, archiveCompressionOptions{threads: 1})
 			},
 			"ParallelGzip": func(outputFile string) (f io.WriteCloser, gz io.WriteCloser, tarWriter *tar.Writer, err error) {
-				return tarGzWriter(outputFile, true)
+				return compressedTarWriter(outputFile, archiveCompressionOptions{})
 			},
 		} {
 			Convey(fmt.Sprintf("with %s implementation", testCase), func() {
@@ -202,7 +203,7 @@ func TestBuildArchiveVerbose(t *testing.T) {
 	}()
 	require.NoError(t, outputFile.Close())
 
-	f, gz, tarWriter, err := tarGzWriter(outputFile.Name(), false)
+	f, gz, tarWriter, err := compressedTarWriter(outputFile.Name(), archiveCompressionOptions{threads: 1})
 	require.NoError(t, err)
 	defer f.Close()
 	defer gz.Close()
@@ -268,7 +269,7 @@ func TestBuildArchivePreserveSymlinks(t *testing.T) {
 
 	t.Run("PreservedAsSymlinkEntries", func(t *testing.T) {
 		target := filepath.Join(t.TempDir(), "preserve.tgz")
-		f, gz, tarWriter, err := tarGzWriter(target, false)
+		f, gz, tarWriter, err := compressedTarWriter(target, archiveCompressionOptions{threads: 1})
 		require.NoError(t, err)
 		_, err = buildArchive(ctx, buildArchiveOptions{
 			tarWriter:        tarWriter,
@@ -303,7 +304,7 @@ func TestBuildArchivePreserveSymlinks(t *testing.T) {
 
 	t.Run("DereferencedWhenDisabled", func(t *testing.T) {
 		target := filepath.Join(t.TempDir(), "deref.tgz")
-		f, gz, tarWriter, err := tarGzWriter(target, false)
+		f, gz, tarWriter, err := compressedTarWriter(target, archiveCompressionOptions{threads: 1})
 		require.NoError(t, err)
 		_, err = buildArchive(ctx, buildArchiveOptions{
 			tarWriter: tarWriter,
@@ -404,7 +405,7 @@ func TestExtractTarballPreserveSymlinks(t *testing.T) {
 		contents, _, err := gatherCacheContents(srcDir, []string{"."})
 		require.NoError(t, err)
 		target := filepath.Join(t.TempDir(), "archive.tgz")
-		f, gz, tarWriter, err := tarGzWriter(target, false)
+		f, gz, tarWriter, err := compressedTarWriter(target, archiveCompressionOptions{threads: 1})
 		require.NoError(t, err)
 		_, err = buildArchive(ctx, buildArchiveOptions{
 			tarWriter:        tarWriter,
@@ -547,10 +548,10 @@ func TestBuildArchiveRoundTrip(t *testing.T) {
 
 		for testCase, makeTarGzWriter := range map[string]func(outputFile string) (f io.WriteCloser, gz io.WriteCloser, tarWriter *tar.Writer, err error){
 			"Gzip": func(outputFile string) (f io.WriteCloser, gz io.WriteCloser, tarWriter *tar.Writer, err error) {
-				return tarGzWriter(outputFile, false)
+				return compressedTarWriter(outputFile, archiveCompressionOptions{threads: 1})
 			},
 			"ParallelGzip": func(outputFile string) (f io.WriteCloser, gz io.WriteCloser, tarWriter *tar.Writer, err error) {
-				return tarGzWriter(outputFile, true)
+				return compressedTarWriter(outputFile, archiveCompressionOptions{})
 			},
 		} {
 			Convey(fmt.Sprintf("with %s implementation", testCase), func() {
@@ -917,3 +918,89 @@ func TestGlobPatternCombinations(t *testing.T) {
 		})
 	}
 }
+
+func TestCompressedTarballRoundTrip(t *testing.T) {
+	ctx := t.Context()
+	logger := logging.NewGrip("test.archive")
+
+	srcDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "nested"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "nested", "file.txt"), bytes.Repeat([]byte("compressible "), 1024*1024), 0644))
+
+	for testCase, opts := range map[string]archiveCompressionOptions{
+		"DefaultIsGzip":                   {},
+		"GzipWithLevel":                   {format: archiveCompressionGzip, level: 9},
+		"SingleThreadedGzip":              {format: archiveCompressionGzip, threads: 1},
+		"Zstd":                            {format: archiveCompressionZstd},
+		"ZstdWithLevelAndThreads":         {format: archiveCompressionZstd, level: 19, threads: 4},
+		"SingleThreadedZstd":              {format: archiveCompressionZstd, threads: 1},
+		"Xz":                              {format: archiveCompressionXz},
+		"XzWithLevel":                     {format: archiveCompressionXz, level: 1},
+		"ZstdForSmallArchiveSingleThread": archiveCompressionOptions{format: archiveCompressionZstd}.forArchiveSize(1),
+	} {
+		t.Run(testCase, func(t *testing.T) {
+			target := filepath.Join(t.TempDir(), "archive")
+			f, cw, tarWriter, err := compressedTarWriter(target, opts)
+			require.NoError(t, err)
+			contents, _, err := findContentsToArchive(ctx, srcDir, []string{"**"}, nil)
+			require.NoError(t, err)
+			_, err = buildArchive(ctx, buildArchiveOptions{
+				tarWriter: tarWriter,
+				rootPath:  srcDir,
+				paths:     contents,
+				logger:    logger,
+			})
+			require.NoError(t, err)
+			require.NoError(t, tarWriter.Close())
+			require.NoError(t, cw.Close())
+			require.NoError(t, f.Close())
+
+			header := make([]byte, 6)
+			archive, err := os.Open(target)
+			require.NoError(t, err)
+			defer archive.Close()
+			_, err = io.ReadFull(archive, header)
+			require.NoError(t, err)
+			expectedFormat := opts.format
+			if expectedFormat == "" {
+				expectedFormat = archiveCompressionGzip
+			}
+			assert.Equal(t, expectedFormat, detectCompression(header))
+
+			_, err = archive.Seek(0, io.SeekStart)
+			require.NoError(t, err)
+			destDir := t.TempDir()
+			require.NoError(t, extractTarball(ctx, archive, destDir, nil, false))
+			extracted, err := os.ReadFile(filepath.Join(destDir, "nested", "file.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, bytes.Repeat([]byte("compressible "), 1024*1024), extracted)
+		})
+	}
+
+	t.Run("UnrecognizedFormatErrors", func(t *testing.T) {
+		err := extractTarball(ctx, strings.NewReader("not an archive"), t.TempDir(), nil, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unrecognized compression format")
+	})
+}
+
+func TestArchiveCompressionOptionsValidate(t *testing.T) {
+	assert.NoError(t, archiveCompressionOptions{}.validate())
+	assert.NoError(t, archiveCompressionOptions{format: archiveCompressionGzip, level: 1}.validate())
+	assert.NoError(t, archiveCompressionOptions{format: archiveCompressionZstd, level: 22, threads: 8}.validate())
+	assert.NoError(t, archiveCompressionOptions{format: archiveCompressionXz, level: 9}.validate())
+
+	assert.Error(t, archiveCompressionOptions{format: "lz4"}.validate())
+	assert.Error(t, archiveCompressionOptions{format: archiveCompressionGzip, level: 10}.validate())
+	assert.Error(t, archiveCompressionOptions{format: archiveCompressionZstd, level: 23}.validate())
+	assert.Error(t, archiveCompressionOptions{format: archiveCompressionXz, level: -1}.validate())
+	assert.Error(t, archiveCompressionOptions{threads: -1}.validate())
+	assert.Error(t, archiveCompressionOptions{format: archiveCompressionXz, threads: 1}.validate())
+}
+
+func TestArchiveCompressionOptionsForArchiveSize(t *testing.T) {
+	assert.Equal(t, 1, archiveCompressionOptions{}.forArchiveSize(thresholdSizeForParallelCompression).threads)
+	assert.Zero(t, archiveCompressionOptions{}.forArchiveSize(thresholdSizeForParallelCompression+1).threads)
+	assert.Equal(t, 4, archiveCompressionOptions{threads: 4}.forArchiveSize(1).threads)
+	assert.Zero(t, archiveCompressionOptions{format: archiveCompressionXz}.forArchiveSize(1).threads)
+}
@@ -0,0 +1,650 @@
+package command
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	// cacheModeArchive stores each cache as a single tarball per key.
+	cacheModeArchive = "archive"
+	// cacheModeChunked splits each cache into content-defined chunks that are
+	// stored once per unique content, plus a small manifest per key listing
+	// the chunks that make up the cache.
+	cacheModeChunked = "chunked"
+)
+
+const (
+	// cacheManifestSuffix is the final segment of a chunked cache manifest's
+	// S3 key.
+	cacheManifestSuffix = ".manifest.json"
+	// cacheManifestVersion is the manifest format version. Restores reject
+	// manifests with a different version rather than guess at their contents.
+	cacheManifestVersion = 1
+
+	// cacheChunksRemoteDir and cacheFallbackRemoteDir are the directories
+	// under the remote path holding chunks and fallback manifests. Neither can
+	// collide with a cache key, since keys are hex-encoded.
+	cacheChunksRemoteDir   = "chunks"
+	cacheFallbackRemoteDir = "fallback"
+
+	// cacheChunkStoreDirName is the directory, in the agent's working
+	// directory, where chunks are kept between tasks. It's a dot directory so
+	// the agent's cleanup of leftover task directories doesn't remove it.
+	cacheChunkStoreDirName = ".cache-chunks"
+	// cacheChunkStoreMaxBytes is the size the local chunk store is pruned to
+	// after each chunked cache command, removing the least recently used
+	// chunks first.
+	cacheChunkStoreMaxBytes = 5 * 1024 * 1024 * 1024
+	// cacheChunkFileSuffix is the extension of a gzipped chunk in the local
+	// chunk store.
+	cacheChunkFileSuffix = ".gz"
+)
+
+const (
+	// cacheChunkMinSize, cacheChunkMaxSize, and cacheChunkBoundaryMask bound
+	// content-defined chunks. The mask has 20 bits set, so boundaries occur on
+	// average every 1 MiB past the minimum size.
+	cacheChunkMinSize      = 256 * 1024
+	cacheChunkMaxSize      = 4 * 1024 * 1024
+	cacheChunkBoundaryMask = uint64(1<<20-1) << 44
+)
+
+// cacheChunkGearTable maps each byte to a pseudorandom value for the rolling
+// gear hash that finds chunk boundaries. It's generated from a fixed seed
+// because chunk boundaries, and therefore chunk hashes, must be stable across
+// agent versions for chunks to be deduplicated.
+var cacheChunkGearTable = func() [256]uint64 {
+	var table [256]uint64
+	// splitmix64
+	state := uint64(0x6576657267726565)
+	for i := range table {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return table
+}()
+
+// cacheManifest lists, in order, the chunks that concatenate to a cache's
+// uncompressed tarball.
+type cacheManifest struct {
+	Version int                  `json:"version"`
+	Chunks  []cacheManifestChunk `json:"chunks"`
+}
+
+// cacheManifestChunk identifies a chunk by the hex-encoded SHA-256 of its
+// uncompressed contents.
+type cacheManifestChunk struct {
+	Hash string `json:"hash"`
+	Size int    `json:"size"`
+}
+
+// totalSize returns the size of the cache's uncompressed tarball.
+func (m *cacheManifest) totalSize() int {
+	total := 0
+	for _, chunk := range m.Chunks {
+		total += chunk.Size
+	}
+	return total
+}
+
+// uniqueHashes returns the manifest's chunk hashes without duplicates, in the
+// order they first appear.
+func (m *cacheManifest) uniqueHashes() []string {
+	seen := map[string]bool{}
+	var hashes []string
+	for _, chunk := range m.Chunks {
+		if !seen[chunk.Hash] {
+			seen[chunk.Hash] = true
+			hashes = append(hashes, chunk.Hash)
+		}
+	}
+	return hashes
+}
+
+// splitCacheChunks splits r into content-defined chunks and calls emit with
+// each one in order. A boundary is placed where a gear hash over the preceding
+// bytes matches cacheChunkBoundaryMask, so an edit only changes the chunks
+// around it and the rest of the stream splits the same way as before. The
+// chunk passed to emit is only valid until emit returns.
+func splitCacheChunks(r io.Reader, emit func(chunk []byte) error) error {
+	buf := make([]byte, cacheChunkMaxSize)
+	n := 0
+	eof := false
+	for {
+		if !eof {
+			read, err := io.ReadFull(r, buf[n:])
+			n += read
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				eof = true
+			} else if err != nil {
+				return errors.Wrap(err, "reading cache contents")
+			}
+		}
+		if n == 0 {
+			return nil
+		}
+
+		cut := findCacheChunkBoundary(buf[:n])
+		if err := emit(buf[:cut]); err != nil {
+			return err
+		}
+		n = copy(buf, buf[cut:n])
+	}
+}
+
+// findCacheChunkBoundary returns the length of the first chunk in data.
+func findCacheChunkBoundary(data []byte) int {
+	if len(data) <= cacheChunkMinSize {
+		return len(data)
+	}
+	end := min(len(data), cacheChunkMaxSize)
+	var hash uint64
+	for i := cacheChunkMinSize; i < end; i++ {
+		hash = (hash << 1) + cacheChunkGearTable[data[i]]
+		if hash&cacheChunkBoundaryMask == 0 {
+			return i + 1
+		}
+	}
+	return end
+}
+
+// hashCacheChunk returns the hex-encoded SHA-256 of a chunk.
+func hashCacheChunk(chunk []byte) string {
+	sum := sha256.Sum256(chunk)
+	return hex.EncodeToString(sum[:])
+}
+
+// cacheChunkStore is a directory of gzipped chunks named by their hash that
+// persists between tasks on the host, so restores only download the chunks
+// the host doesn't already have.
+type cacheChunkStore struct {
+	dir string
+}
+
+// newCacheChunkStore returns the host's chunk store, creating it if needed.
+func newCacheChunkStore(conf *internal.TaskConfig) (*cacheChunkStore, error) {
+	dir := filepath.Join(filepath.Dir(conf.WorkDir), cacheChunkStoreDirName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "creating cache chunk store '%s'", dir)
+	}
+	return &cacheChunkStore{dir: dir}, nil
+}
+
+func (s *cacheChunkStore) path(hash string) string {
+	return filepath.Join(s.dir, hash+cacheChunkFileSuffix)
+}
+
+// has reports whether the chunk is in the store, marking it as recently used
+// so pruning keeps it.
+func (s *cacheChunkStore) has(hash string) bool {
+	now := time.Now()
+	return os.Chtimes(s.path(hash), now, now) == nil
+}
+
+// put compresses the chunk into the store if it's not already there.
+func (s *cacheChunkStore) put(hash string, chunk []byte) error {
+	if s.has(hash) {
+		return nil
+	}
+	return s.write(hash, func(w io.Writer) error {
+		gz := gzip.NewWriter(w)
+		if _, err := gz.Write(chunk); err != nil {
+			return errors.Wrap(err, "compressing chunk")
+		}
+		return errors.Wrap(gz.Close(), "compressing chunk")
+	})
+}
+
+// putCompressed adds a gzipped chunk to the store after checking that its
+// contents match its hash, so a corrupt download never enters the store.
+func (s *cacheChunkStore) putCompressed(hash string, compressed io.Reader) error {
+	return s.write(hash, func(w io.Writer) error {
+		h := sha256.New()
+		gz, err := gzip.NewReader(io.TeeReader(compressed, w))
+		if err != nil {
+			return errors.Wrap(err, "reading compressed chunk")
+		}
+		if _, err := io.Copy(h, gz); err != nil {
+			return errors.Wrap(err, "decompressing chunk")
+		}
+		if actual := hex.EncodeToString(h.Sum(nil)); actual != hash {
+			return errors.Errorf("chunk contents hash to '%s'", actual)
+		}
+		return nil
+	})
+}
+
+// write writes a chunk to a temporary file and renames it into place, so a
+// partially written chunk is never visible in the store.
+func (s *cacheChunkStore) write(hash string, writeContents func(w io.Writer) error) error {
+	f, err := os.CreateTemp(s.dir, "tmp-*")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary chunk file")
+	}
+	tmpPath := f.Name()
+	writeErr := writeContents(f)
+	closeErr := f.Close()
+	if writeErr != nil || closeErr != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(writeErr)
+		catcher.Wrap(closeErr, "closing temporary chunk file")
+		catcher.Wrap(os.Remove(tmpPath), "removing temporary chunk file")
+		return errors.Wrapf(catcher.Resolve(), "writing chunk '%s'", hash)
+	}
+	return errors.Wrapf(os.Rename(tmpPath, s.path(hash)), "moving chunk '%s' into the chunk store", hash)
+}
+
+// reader returns the concatenated, decompressed contents of the manifest's
+// chunks. All of the chunks must already be in the store.
+func (s *cacheChunkStore) reader(m *cacheManifest) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		for _, chunk := range m.Chunks {
+			if err := s.copyChunk(pw, chunk.Hash); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.Close()
+	}()
+	return pr
+}
+
+func (s *cacheChunkStore) copyChunk(w io.Writer, hash string) error {
+	f, err := os.Open(s.path(hash))
+	if err != nil {
+		return errors.Wrapf(err, "opening chunk '%s'", hash)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return errors.Wrapf(err, "reading chunk '%s'", hash)
+	}
+	_, err = io.Copy(w, gz)
+	return errors.Wrapf(err, "decompressing chunk '%s'", hash)
+}
+
+// prune removes the least recently used chunks until the store is no larger
+// than maxBytes.
+func (s *cacheChunkStore) prune(maxBytes int64) error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return errors.Wrapf(err, "reading cache chunk store '%s'", s.dir)
+	}
+	var infos []os.FileInfo
+	var total int64
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), cacheChunkFileSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		infos = append(infos, info)
+		total += info.Size()
+	}
+	if total <= maxBytes {
+		return nil
+	}
+
+	slices.SortFunc(infos, func(a, b os.FileInfo) int {
+		return a.ModTime().Compare(b.ModTime())
+	})
+	catcher := grip.NewBasicCatcher()
+	for _, info := range infos {
+		if total <= maxBytes {
+			break
+		}
+		if err := os.Remove(filepath.Join(s.dir, info.Name())); err != nil {
+			catcher.Wrapf(err, "removing chunk file '%s'", info.Name())
+			continue
+		}
+		total -= info.Size()
+	}
+	return catcher.Resolve()
+}
+
+// manifestKey returns the S3 object key for the manifest of a chunked cache
+// with the given content key.
+func (c *cacheCommon) manifestKey(key string) string {
+	return path.Join(c.RemotePath, key, c.CacheName+cacheManifestSuffix)
+}
+
+// fallbackManifestKeys returns the S3 object keys of the fallback manifests a
+// chunked cache is saved under, from most to least specific. Each one is keyed
+// by a prefix of the key expansions alone, so a cache whose key files changed
+// can still find the most recent cache saved with the same leading
+// expansions.
+func (c *cacheCommon) fallbackManifestKeys() ([]string, error) {
+	keys := make([]string, 0, len(c.KeyExpansions))
+	for n := len(c.KeyExpansions); n > 0; n-- {
+		key, err := computeCacheKey(nil, c.KeyExpansions[:n], c.PreserveSymlinks)
+		if err != nil {
+			return nil, errors.Wrap(err, "computing fallback cache key")
+		}
+		keys = append(keys, path.Join(c.RemotePath, cacheFallbackRemoteDir, key, c.CacheName+cacheManifestSuffix))
+	}
+	return keys, nil
+}
+
+// chunkKey returns the S3 object key for a gzipped chunk. Chunks are shared by
+// every cache under the remote path.
+func (c *cacheCommon) chunkKey(hash string) string {
+	return path.Join(c.RemotePath, cacheChunksRemoteDir, hash)
+}
+
+// saveChunked stores the paths as a chunked cache under the given key. Chunks
+// the bucket already has are not uploaded again. The manifest is written under
+// the key and under each fallback key; fallbackBucket must overwrite existing
+// objects so the fallback manifests always point to the latest cache.
+func (c *cacheSave) saveChunked(ctx context.Context, logger grip.Journaler, conf *internal.TaskConfig, key string, fallbackBucket pail.Bucket) error {
+	store, err := newCacheChunkStore(conf)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		logger.Warning(ctx, errors.Wrap(store.prune(cacheChunkStoreMaxBytes), "pruning cache chunk store"))
+	}()
+
+	manifest, err := c.chunkCacheContents(ctx, logger, conf, store)
+	if err != nil {
+		return errors.Wrap(err, "splitting cache into chunks")
+	}
+
+	uploaded := 0
+	for _, hash := range manifest.uniqueHashes() {
+		didUpload, err := c.uploadChunk(ctx, logger, store, hash)
+		if err != nil {
+			return err
+		}
+		if didUpload {
+			uploaded++
+		}
+	}
+	logger.Infof(ctx, "cache.save: uploaded %d of %d unique chunks (%d bytes uncompressed in total).", uploaded, len(manifest.uniqueHashes()), manifest.totalSize())
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.Wrap(err, "marshalling cache manifest")
+	}
+	manifestKey := c.manifestKey(key)
+	alreadyExists, err := c.putCacheObject(ctx, logger, c.bucket, manifestKey, data)
+	if err != nil {
+		return errors.Wrapf(err, "uploading cache manifest '%s'", manifestKey)
+	}
+	if alreadyExists {
+		logger.Infof(ctx, "cache.save: not uploading manifest because '%s/%s' already exists.", c.Bucket, manifestKey)
+	} else {
+		logger.Infof(ctx, "cache.save: uploaded cache manifest to '%s/%s'.", c.Bucket, manifestKey)
+	}
+
+	fallbackKeys, err := c.fallbackManifestKeys()
+	if err != nil {
+		return err
+	}
+	for _, fallbackKey := range fallbackKeys {
+		if _, err := c.putCacheObject(ctx, logger, fallbackBucket, fallbackKey, data); err != nil {
+			return errors.Wrapf(err, "uploading fallback cache manifest '%s'", fallbackKey)
+		}
+	}
+	return nil
+}
+
+// chunkCacheContents archives the paths into an uncompressed tarball, splits it
+// into chunks, and adds the chunks to the store. The tarball is never written
+// to disk in full.
+func (c *cacheSave) chunkCacheContents(ctx context.Context, logger grip.Journaler, conf *internal.TaskConfig, store *cacheChunkStore) (*cacheManifest, error) {
+	contents, _, err := gatherCacheContents(conf.WorkDir, c.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		tarWriter := tar.NewWriter(pw)
+		_, err := buildArchive(ctx, buildArchiveOptions{
+			tarWriter:        tarWriter,
+			rootPath:         conf.WorkDir,
+			paths:            contents,
+			logger:           logger,
+			preserveSymlinks: c.PreserveSymlinks,
+			// Extracting the cache doesn't restore file times, so headers
+			// are normalized to keep chunks the same across a
+			// restore-then-save round trip.
+			normalizeHeaders: true,
+		})
+		if err == nil {
+			err = tarWriter.Close()
+		}
+		pw.CloseWithError(errors.Wrap(err, "building cache archive"))
+	}()
+
+	manifest := &cacheManifest{Version: cacheManifestVersion}
+	err = splitCacheChunks(pr, func(chunk []byte) error {
+		hash := hashCacheChunk(chunk)
+		manifest.Chunks = append(manifest.Chunks, cacheManifestChunk{Hash: hash, Size: len(chunk)})
+		return store.put(hash, chunk)
+	})
+	// Closing the reader unblocks the archive goroutine if splitting stopped
+	// early.
+	pr.CloseWithError(err)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// uploadChunk uploads a chunk from the store unless the bucket already has it,
+// and returns whether it was uploaded.
+func (c *cacheSave) uploadChunk(ctx context.Context, logger grip.Journaler, store *cacheChunkStore, hash string) (bool, error) {
+	chunkKey := c.chunkKey(hash)
+	// An error checking for the chunk (e.g. access denied for credentials
+	// without s3:ListBucket) isn't fatal, it just means the chunk is
+	// uploaded. The upload doesn't overwrite an existing chunk.
+	if exists, err := c.bucket.Exists(ctx, chunkKey); err == nil && exists {
+		return false, nil
+	}
+
+	alreadyExists := false
+	uploadDesc := fmt.Sprintf("upload cache chunk '%s'", chunkKey)
+	err := retryCacheChunkOp(ctx, logger, uploadDesc, func() (bool, error) {
+		exists, canRetry, err := classifyCacheUploadErr(c.bucket.Upload(ctx, chunkKey, store.path(hash)))
+		alreadyExists = exists
+		return canRetry, err
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "uploading cache chunk '%s'", chunkKey)
+	}
+	return !alreadyExists, nil
+}
+
+// retryCacheChunkOp is like retryS3Op, but only logs failed attempts, since a
+// cache can have thousands of chunks.
+func retryCacheChunkOp(ctx context.Context, logger grip.Journaler, description string, op utility.RetryableFunc) error {
+	return utility.Retry(ctx, func() (bool, error) {
+		canRetry, err := op()
+		if err != nil && canRetry {
+			logger.Errorf(ctx, "Problem while attempting to %s, retrying: %s", description, err)
+		}
+		return canRetry, err
+	}, utility.RetryOptions{
+		MaxAttempts: maxS3OpAttempts,
+		MinDelay:    s3OpSleep,
+		MaxDelay:    s3OpRetryMaxSleep,
+	})
+}
+
+// putCacheObject uploads a small object and returns whether it wasn't uploaded
+// because the object already exists.
+func (c *cacheCommon) putCacheObject(ctx context.Context, logger grip.Journaler, bucket pail.Bucket, key string, data []byte) (bool, error) {
+	alreadyExists := false
+	uploadDesc := fmt.Sprintf("upload cache object '%s'", key)
+	err := retryS3Op(ctx, logger, uploadDesc, func() (bool, error) {
+		exists, canRetry, err := classifyCacheUploadErr(bucket.Put(ctx, key, bytes.NewReader(data)))
+		alreadyExists = exists
+		return canRetry, err
+	})
+	return alreadyExists, err
+}
+
+// restoreChunked restores the chunked cache saved under the given key into the
+// working directory. If there's no cache for the key and fallback is set, it
+// restores the most specific fallback cache instead. It returns whether a
+// cache was restored and whether it was a fallback cache. A chunk missing from
+// the bucket, for example because the bucket's expiration policy removed it,
+// makes that cache a miss.
+func (c *cacheRestore) restoreChunked(ctx context.Context, logger grip.Journaler, conf *internal.TaskConfig, key string) (restored bool, fromFallback bool, err error) {
+	manifestKeys := []string{c.manifestKey(key)}
+	if c.PrefixFallback {
+		fallbackKeys, err := c.fallbackManifestKeys()
+		if err != nil {
+			return false, false, err
+		}
+		manifestKeys = append(manifestKeys, fallbackKeys...)
+	}
+
+	store, err := newCacheChunkStore(conf)
+	if err != nil {
+		return false, false, err
+	}
+	defer func() {
+		logger.Warning(ctx, errors.Wrap(store.prune(cacheChunkStoreMaxBytes), "pruning cache chunk store"))
+	}()
+
+	for i, manifestKey := range manifestKeys {
+		manifest, err := c.downloadManifest(ctx, logger, manifestKey)
+		if err != nil {
+			return false, false, errors.Wrapf(err, "downloading cache manifest '%s'", manifestKey)
+		}
+		if manifest == nil {
+			continue
+		}
+
+		complete, err := c.downloadMissingChunks(ctx, logger, store, manifest)
+		if err != nil {
+			return false, false, err
+		}
+		if !complete {
+			logger.Warningf(ctx, "cache.restore: cache manifest '%s/%s' references chunks that no longer exist, treating it as a cache miss.", c.Bucket, manifestKey)
+			continue
+		}
+
+		if err := c.extractChunked(ctx, store, manifest, conf.WorkDir); err != nil {
+			return false, false, errors.Wrap(err, "extracting chunked cache")
+		}
+		logger.Infof(ctx, "cache.restore: restored chunked cache from '%s/%s'.", c.Bucket, manifestKey)
+		return true, i > 0, nil
+	}
+	return false, false, nil
+}
+
+// downloadManifest downloads and parses a manifest. It returns a nil manifest
+// if the manifest doesn't exist.
+func (c *cacheRestore) downloadManifest(ctx context.Context, logger grip.Journaler, manifestKey string) (*cacheManifest, error) {
+	var data []byte
+	downloadDesc := fmt.Sprintf("download cache manifest '%s'", manifestKey)
+	err := retryS3Op(ctx, logger, downloadDesc, func() (bool, error) {
+		var canRetry bool
+		var err error
+		data, canRetry, err = c.readCacheObject(ctx, logger, manifestKey)
+		return canRetry, err
+	})
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	manifest := &cacheManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "parsing cache manifest")
+	}
+	if manifest.Version != cacheManifestVersion {
+		return nil, errors.Errorf("unsupported cache manifest version %d", manifest.Version)
+	}
+	return manifest, nil
+}
+
+// readCacheObject reads a small object, returning nil contents if it doesn't
+// exist, along with whether a returned error can be retried.
+func (c *cacheRestore) readCacheObject(ctx context.Context, logger grip.Journaler, key string) ([]byte, bool, error) {
+	r, err := c.bucket.Get(ctx, key)
+	if err == nil {
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		return data, true, err
+	}
+	switch classifyCacheDownloadErr(err) {
+	case cacheDownloadMaybeMiss:
+		logger.Warningf(ctx, "cache.restore: got access-denied downloading '%s/%s', treating as a cache miss; if a cache was expected here, verify the credentials grant s3:GetObject on this path.", c.Bucket, key)
+		return nil, false, nil
+	case cacheDownloadMiss:
+		return nil, false, nil
+	case cacheDownloadFatal:
+		return nil, false, err
+	default:
+		return nil, true, err
+	}
+}
+
+// downloadMissingChunks downloads the manifest's chunks that aren't in the
+// store. It returns false if any chunk is missing from the bucket.
+func (c *cacheRestore) downloadMissingChunks(ctx context.Context, logger grip.Journaler, store *cacheChunkStore, manifest *cacheManifest) (bool, error) {
+	hashes := manifest.uniqueHashes()
+	downloaded := 0
+	for _, hash := range hashes {
+		if store.has(hash) {
+			continue
+		}
+
+		chunkKey := c.chunkKey(hash)
+		var data []byte
+		downloadDesc := fmt.Sprintf("download cache chunk '%s'", chunkKey)
+		err := retryCacheChunkOp(ctx, logger, downloadDesc, func() (bool, error) {
+			var canRetry bool
+			var err error
+			data, canRetry, err = c.readCacheObject(ctx, logger, chunkKey)
+			return canRetry, err
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "downloading cache chunk '%s'", chunkKey)
+		}
+		if data == nil {
+			return false, nil
+		}
+		if err := store.putCompressed(hash, bytes.NewReader(data)); err != nil {
+			return false, errors.Wrapf(err, "storing cache chunk '%s'", chunkKey)
+		}
+		downloaded++
+	}
+	logger.Infof(ctx, "cache.restore: downloaded %d of %d unique chunks, the rest were already on the host.", downloaded, len(hashes))
+	return true, nil
+}
+
+func (c *cacheRestore) extractChunked(ctx context.Context, store *cacheChunkStore, manifest *cacheManifest, dest string) error {
+	r := store.reader(manifest)
+	defer r.Close()
+	return errors.Wrap(extractTarballArchive(ctx, tar.NewReader(r), dest, []string{}, c.PreserveSymlinks), "extracting tarball")
+}
@@ -0,0 +1,304 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/pail"
+	"github.com/mongodb/grip/logging"
+	"github.com/mongodb/grip/send"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func randomCacheBytes(seed byte, size int) []byte {
+	data := make([]byte, size)
+	_, _ = rand.NewChaCha8([32]byte{seed}).Read(data)
+	return data
+}
+
+func splitCacheChunksForTest(t *testing.T, data []byte) [][]byte {
+	var chunks [][]byte
+	require.NoError(t, splitCacheChunks(bytes.NewReader(data), func(chunk []byte) error {
+		chunks = append(chunks, slices.Clone(chunk))
+		return nil
+	}))
+	return chunks
+}
+
+func TestSplitCacheChunks(t *testing.T) {
+	data := randomCacheBytes(1, 12*1024*1024)
+
+	t.Run("ChunksConcatenateToInput", func(t *testing.T) {
+		chunks := splitCacheChunksForTest(t, data)
+		require.Greater(t, len(chunks), 2)
+		for i, chunk := range chunks {
+			assert.LessOrEqual(t, len(chunk), cacheChunkMaxSize)
+			if i < len(chunks)-1 {
+				assert.Greater(t, len(chunk), cacheChunkMinSize)
+			}
+		}
+		assert.Equal(t, data, bytes.Join(chunks, nil))
+	})
+
+	t.Run("SplittingIsDeterministic", func(t *testing.T) {
+		assert.Equal(t, splitCacheChunksForTest(t, data), splitCacheChunksForTest(t, data))
+	})
+
+	t.Run("InsertionOnlyChangesNearbyChunks", func(t *testing.T) {
+		edited := slices.Concat(data[:6*1024*1024], []byte("inserted"), data[6*1024*1024:])
+
+		original := map[string]bool{}
+		for _, chunk := range splitCacheChunksForTest(t, data) {
+			original[hashCacheChunk(chunk)] = true
+		}
+		editedChunks := splitCacheChunksForTest(t, edited)
+		changed := 0
+		for _, chunk := range editedChunks {
+			if !original[hashCacheChunk(chunk)] {
+				changed++
+			}
+		}
+		assert.NotZero(t, changed)
+		assert.LessOrEqual(t, changed, 2)
+	})
+
+	t.Run("EmptyInputHasNoChunks", func(t *testing.T) {
+		assert.Empty(t, splitCacheChunksForTest(t, nil))
+	})
+}
+
+func TestCacheChunkStore(t *testing.T) {
+	newStore := func(t *testing.T) *cacheChunkStore {
+		store, err := newCacheChunkStore(&internal.TaskConfig{WorkDir: filepath.Join(t.TempDir(), "task")})
+		require.NoError(t, err)
+		return store
+	}
+
+	t.Run("ReaderConcatenatesChunks", func(t *testing.T) {
+		store := newStore(t)
+		first, second := []byte("first chunk "), []byte("second chunk")
+		require.NoError(t, store.put(hashCacheChunk(first), first))
+		require.NoError(t, store.put(hashCacheChunk(second), second))
+		assert.True(t, store.has(hashCacheChunk(first)))
+
+		r := store.reader(&cacheManifest{Chunks: []cacheManifestChunk{
+			{Hash: hashCacheChunk(first)},
+			{Hash: hashCacheChunk(second)},
+			{Hash: hashCacheChunk(first)},
+		}})
+		defer r.Close()
+		contents, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "first chunk second chunkfirst chunk ", string(contents))
+	})
+
+	t.Run("ReaderFailsForMissingChunk", func(t *testing.T) {
+		store := newStore(t)
+		r := store.reader(&cacheManifest{Chunks: []cacheManifestChunk{{Hash: hashCacheChunk([]byte("missing"))}}})
+		defer r.Close()
+		_, err := io.ReadAll(r)
+		assert.Error(t, err)
+	})
+
+	t.Run("PutCompressedRejectsMismatchedHash", func(t *testing.T) {
+		store := newStore(t)
+		source := newStore(t)
+		chunk := []byte("chunk")
+		require.NoError(t, source.put(hashCacheChunk(chunk), chunk))
+		compressed, err := os.ReadFile(source.path(hashCacheChunk(chunk)))
+		require.NoError(t, err)
+
+		wrongHash := hashCacheChunk([]byte("other"))
+		assert.Error(t, store.putCompressed(wrongHash, bytes.NewReader(compressed)))
+		assert.False(t, store.has(wrongHash))
+
+		require.NoError(t, store.putCompressed(hashCacheChunk(chunk), bytes.NewReader(compressed)))
+		assert.True(t, store.has(hashCacheChunk(chunk)))
+	})
+
+	t.Run("PruneRemovesLeastRecentlyUsedChunks", func(t *testing.T) {
+		store := newStore(t)
+		var hashes []string
+		for i := range 3 {
+			chunk := randomCacheBytes(byte(i), 1024)
+			hash := hashCacheChunk(chunk)
+			require.NoError(t, store.put(hash, chunk))
+			used := time.Now().Add(time.Duration(i-3) * time.Hour)
+			require.NoError(t, os.Chtimes(store.path(hash), used, used))
+			hashes = append(hashes, hash)
+		}
+		info, err := os.Stat(store.path(hashes[2]))
+		require.NoError(t, err)
+
+		require.NoError(t, store.prune(info.Size()))
+		assert.False(t, store.has(hashes[0]))
+		assert.False(t, store.has(hashes[1]))
+		assert.True(t, store.has(hashes[2]))
+	})
+}
+
+func TestChunkedCacheRoundTrip(t *testing.T) {
+	ctx := t.Context()
+	logger := logging.MakeGrip(send.MakeInternalLogger())
+
+	bucketDir := t.TempDir()
+	bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: bucketDir})
+	require.NoError(t, err)
+
+	common := func(keyExpansions ...string) cacheCommon {
+		return cacheCommon{
+			CacheName:     "deps",
+			Bucket:        "bucket",
+			RemotePath:    "caches",
+			KeyExpansions: keyExpansions,
+			Mode:          cacheModeChunked,
+			bucket:        bucket,
+		}
+	}
+	// newHostConf returns a task config in a fresh agent working directory, so
+	// each host starts with an empty chunk store.
+	newHostConf := func(t *testing.T) *internal.TaskConfig {
+		workDir := filepath.Join(t.TempDir(), "task")
+		require.NoError(t, os.MkdirAll(workDir, 0755))
+		return &internal.TaskConfig{WorkDir: workDir}
+	}
+	countRemoteChunks := func(t *testing.T) int {
+		entries, err := os.ReadDir(filepath.Join(bucketDir, "caches", cacheChunksRemoteDir))
+		require.NoError(t, err)
+		return len(entries)
+	}
+	save := func(t *testing.T, conf *internal.TaskConfig, key string, keyExpansions ...string) {
+		c := &cacheSave{cacheCommon: common(keyExpansions...), Paths: []string{"deps"}}
+		require.NoError(t, c.saveChunked(ctx, logger, conf, key, bucket))
+	}
+	restore := func(t *testing.T, conf *internal.TaskConfig, key string, prefixFallback bool, keyExpansions ...string) (bool, bool) {
+		c := &cacheRestore{cacheCommon: common(keyExpansions...), PrefixFallback: prefixFallback}
+		restored, fromFallback, err := c.restoreChunked(ctx, logger, conf, key)
+		require.NoError(t, err)
+		return restored, fromFallback
+	}
+
+	blob := randomCacheBytes(2, 8*1024*1024)
+	saveConf := newHostConf(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(saveConf.WorkDir, "deps"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(saveConf.WorkDir, "deps", "blob.bin"), blob, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(saveConf.WorkDir, "deps", "small.txt"), []byte("small"), 0644))
+	save(t, saveConf, "key1", "linux", "main")
+	firstSaveChunks := countRemoteChunks(t)
+	require.NotZero(t, firstSaveChunks)
+
+	editedBlob := slices.Concat(blob[:4*1024*1024], []byte("edited"), blob[4*1024*1024:])
+	require.NoError(t, os.WriteFile(filepath.Join(saveConf.WorkDir, "deps", "blob.bin"), editedBlob, 0644))
+	save(t, saveConf, "key2", "linux", "main")
+	assert.LessOrEqual(t, countRemoteChunks(t)-firstSaveChunks, 3, "only the chunks around the edit should be uploaded")
+
+	t.Run("ExactKeyIsRestored", func(t *testing.T) {
+		conf := newHostConf(t)
+		restored, fromFallback := restore(t, conf, "key1", false, "linux", "main")
+		require.True(t, restored)
+		assert.False(t, fromFallback)
+
+		contents, err := os.ReadFile(filepath.Join(conf.WorkDir, "deps", "blob.bin"))
+		require.NoError(t, err)
+		assert.Equal(t, blob, contents)
+		contents, err = os.ReadFile(filepath.Join(conf.WorkDir, "deps", "small.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "small", string(contents))
+	})
+
+	t.Run("MissingKeyIsAMissWithoutFallback", func(t *testing.T) {
+		restored, _ := restore(t, newHostConf(t), "key3", false, "linux", "main")
+		assert.False(t, restored)
+	})
+
+	t.Run("FallbackRestoresLatestCacheWithLongestMatchingPrefix", func(t *testing.T) {
+		conf := newHostConf(t)
+		restored, fromFallback := restore(t, conf, "key3", true, "linux", "feature-branch")
+		require.True(t, restored)
+		assert.True(t, fromFallback)
+
+		contents, err := os.ReadFile(filepath.Join(conf.WorkDir, "deps", "blob.bin"))
+		require.NoError(t, err)
+		assert.Equal(t, editedBlob, contents)
+	})
+
+	t.Run("FallbackMissesWithoutMatchingPrefix", func(t *testing.T) {
+		restored, _ := restore(t, newHostConf(t), "key3", true, "windows", "main")
+		assert.False(t, restored)
+	})
+
+	t.Run("RestoreUsesChunksAlreadyOnHost", func(t *testing.T) {
+		conf := newHostConf(t)
+		restored, _ := restore(t, conf, "key1", false, "linux", "main")
+		require.True(t, restored)
+
+		// Hide the remote chunks so a later task on the same host can only
+		// restore from the chunks it already has.
+		chunksDir := filepath.Join(bucketDir, "caches", cacheChunksRemoteDir)
+		require.NoError(t, os.Rename(chunksDir, chunksDir+".hidden"))
+		defer func() {
+			require.NoError(t, os.Rename(chunksDir+".hidden", chunksDir))
+		}()
+
+		nextConf := &internal.TaskConfig{WorkDir: filepath.Join(filepath.Dir(conf.WorkDir), "next-task")}
+		require.NoError(t, os.MkdirAll(nextConf.WorkDir, 0755))
+		restored, _ = restore(t, nextConf, "key1", false, "linux", "main")
+		require.True(t, restored)
+
+		contents, err := os.ReadFile(filepath.Join(nextConf.WorkDir, "deps", "blob.bin"))
+		require.NoError(t, err)
+		assert.Equal(t, blob, contents)
+	})
+
+	t.Run("RestoreThenSaveReusesChunks", func(t *testing.T) {
+		conf := newHostConf(t)
+		restored, _ := restore(t, conf, "key1", false, "linux", "main")
+		require.True(t, restored)
+		numChunks := countRemoteChunks(t)
+
+		// A later task extracts and saves the cache at a different time.
+		later := time.Now().Add(time.Hour)
+		require.NoError(t, filepath.WalkDir(filepath.Join(conf.WorkDir, "deps"), func(path string, _ fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Chtimes(path, later, later)
+		}))
+		save(t, conf, "key1-resaved", "linux", "main")
+		assert.Equal(t, numChunks, countRemoteChunks(t), "saving restored contents should not upload new chunks")
+
+		readManifest := func(t *testing.T, key string) cacheManifest {
+			c := common("linux", "main")
+			contents, err := os.ReadFile(filepath.Join(bucketDir, filepath.FromSlash(c.manifestKey(key))))
+			require.NoError(t, err)
+			manifest := cacheManifest{}
+			require.NoError(t, json.Unmarshal(contents, &manifest))
+			return manifest
+		}
+		assert.Equal(t, readManifest(t, "key1").Chunks, readManifest(t, "key1-resaved").Chunks)
+	})
+
+	t.Run("MissingRemoteChunkIsAMiss", func(t *testing.T) {
+		chunksDir := filepath.Join(bucketDir, "caches", cacheChunksRemoteDir)
+		entries, err := os.ReadDir(chunksDir)
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+		require.NoError(t, os.Remove(filepath.Join(chunksDir, entries[0].Name())))
+
+		// Every remote chunk belongs to one of the saved caches, so at least
+		// one of them can no longer be restored.
+		restoredKey1, _ := restore(t, newHostConf(t), "key1", false, "linux", "main")
+		restoredKey2, _ := restore(t, newHostConf(t), "key2", false, "linux", "main")
+		assert.False(t, restoredKey1 && restoredKey2)
+	})
+}
@@ -20,6 +20,12 @@ import (
 // can branch on.
 type cacheRestore struct {
 	cacheCommon `mapstructure:",squash" plugin:"expand"`
+
+	// PrefixFallback, when set for a chunked cache, restores the most recently
+	// saved cache whose key_expansions share the longest prefix with this
+	// one's if there's no cache for the exact key.
+	PrefixFallback bool `mapstructure:"prefix_fallback"`
+
 	base
 }
 
@@ -36,6 +42,7 @@ func (c *cacheRestore) ParseParams(params map[string]any) error {
 func (c *cacheRestore) validate() error {
 	catcher := grip.NewSimpleCatcher()
 	c.validateCommon(catcher)
+	catcher.NewWhen(c.PrefixFallback && c.Mode != cacheModeChunked, "prefix_fallback is only supported for chunked caches")
 	return catcher.Resolve()
 }
 
@@ -58,7 +65,11 @@ func (c *cacheRestore) Execute(ctx context.Context, comm client.Communicator, lo
 	remoteKey := c.remoteKey(key)
 
 	logger.Task().Infof(ctx, "cache.restore: computed cache key '%s'.", key)
-	logger.Task().Infof(ctx, "cache.restore: looking up cache at '%s/%s'.", c.Bucket, remoteKey)
+	if c.Mode == cacheModeChunked {
+		logger.Task().Infof(ctx, "cache.restore: looking up cache manifest at '%s/%s'.", c.Bucket, c.manifestKey(key))
+	} else {
+		logger.Task().Infof(ctx, "cache.restore: looking up cache at '%s/%s'.", c.Bucket, remoteKey)
+	}
 
 	httpClient := utility.GetHTTPClient()
 	httpClient.Timeout = s3HTTPClientTimeout
@@ -70,6 +81,25 @@ func (c *cacheRestore) Execute(ctx context.Context, comm client.Communicator, lo
 		return errors.Wrap(err, "checking bucket")
 	}
 
+	if c.Mode == cacheModeChunked {
+		restored, fromFallback, err := c.restoreChunked(ctx, logger.Task(), conf, key)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !restored:
+			logger.Task().Infof(ctx, "cache.restore: cache miss for key '%s'.", key)
+		case fromFallback:
+			// A fallback cache is only a starting point, so it isn't a hit and
+			// cache.save still saves the cache for the exact key.
+			logger.Task().Infof(ctx, "cache.restore: cache miss for key '%s', restored a fallback cache into '%s'.", key, conf.WorkDir)
+		default:
+			logger.Task().Infof(ctx, "cache.restore: cache hit for key '%s', extracted into '%s'.", key, conf.WorkDir)
+		}
+		setCacheHit(conf, c.CacheName, restored && !fromFallback)
+		return nil
+	}
+
 	localPath, err := createTempCacheArchive(conf.WorkDir)
 	if err != nil {
 		return errors.Wrap(err, "creating local cache file")
@@ -111,6 +111,34 @@ func TestCacheRestoreParseParams(t *testing.T) {
 		c := &cacheRestore{}
 		require.NoError(t, c.ParseParams(params))
 	})
+
+	t.Run("ChunkedModeAccepted", func(t *testing.T) {
+		params := validParams()
+		params["mode"] = "chunked"
+		params["prefix_fallback"] = true
+		c := &cacheRestore{}
+		require.NoError(t, c.ParseParams(params))
+		assert.Equal(t, cacheModeChunked, c.Mode)
+		assert.True(t, c.PrefixFallback)
+	})
+
+	t.Run("InvalidModeRejected", func(t *testing.T) {
+		params := validParams()
+		params["mode"] = "zip"
+		c := &cacheRestore{}
+		err := c.ParseParams(params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "mode")
+	})
+
+	t.Run("PrefixFallbackWithoutChunkedModeRejected", func(t *testing.T) {
+		params := validParams()
+		params["prefix_fallback"] = true
+		c := &cacheRestore{}
+		err := c.ParseParams(params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "prefix_fallback")
+	})
 }
 
 func TestClassifyCacheDownloadErr(t *testing.T) {
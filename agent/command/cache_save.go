@@ -71,6 +71,26 @@ func (c *cacheSave) Execute(ctx context.Context, comm client.Communicator, logge
 
 	remoteKey := c.remoteKey(key)
 
+	logger.Task().Infof(ctx, "cache.save: computed cache key '%s'.", key)
+
+	httpClient := utility.GetHTTPClient()
+	httpClient.Timeout = s3HTTPClientTimeout
+	defer utility.PutHTTPClient(httpClient)
+	if err := c.createPailBucket(ctx, comm, httpClient, true); err != nil {
+		return errors.Wrap(err, "connecting to S3")
+	}
+	if err := c.bucket.Check(ctx); err != nil {
+		return errors.Wrap(err, "checking bucket")
+	}
+
+	if c.Mode == cacheModeChunked {
+		fallbackBucket, err := c.newPailBucket(ctx, comm, httpClient, false)
+		if err != nil {
+			return errors.Wrap(err, "connecting to S3")
+		}
+		return c.saveChunked(ctx, logger.Task(), conf, key, fallbackBucket)
+	}
+
 	localPath, err := createTempCacheArchive(conf.WorkDir)
 	if err != nil {
 		return errors.Wrap(err, "creating local cache file")
@@ -79,44 +99,18 @@ func (c *cacheSave) Execute(ctx context.Context, comm client.Communicator, logge
 		logger.Task().Error(ctx, errors.Wrapf(os.Remove(localPath), "removing local cache archive '%s'", localPath))
 	}()
 
-	logger.Task().Infof(ctx, "cache.save: computed cache key '%s'.", key)
 	logger.Task().Infof(ctx, "cache.save: bundling paths %s into '%s'.", c.Paths, localPath)
 
-	if err := makeCacheArchive(ctx, conf.WorkDir, c.Paths, localPath, logger.Task(), c.PreserveSymlinks); err != nil {
+	if err := makeCacheArchive(ctx, conf.WorkDir, c.Paths, localPath, c.compressionOptions(), logger.Task(), c.PreserveSymlinks); err != nil {
 		return errors.Wrap(err, "creating cache archive")
 	}
 
-	httpClient := utility.GetHTTPClient()
-	httpClient.Timeout = s3HTTPClientTimeout
-	defer utility.PutHTTPClient(httpClient)
-	if err := c.createPailBucket(ctx, comm, httpClient, true); err != nil {
-		return errors.Wrap(err, "connecting to S3")
-	}
-	if err := c.bucket.Check(ctx); err != nil {
-		return errors.Wrap(err, "checking bucket")
-	}
-
 	alreadyExists := false
 	uploadDesc := fmt.Sprintf("upload cache object '%s'", remoteKey)
 	err = retryS3Op(ctx, logger.Task(), uploadDesc, func() (bool, error) {
-		uploadErr := c.bucket.Upload(ctx, remoteKey, localPath)
-		if uploadErr == nil {
-			return false, nil
-		}
-		// Skip-existing semantics: S3 reports PreconditionFailed when the
-		// object already exists and IfNotExists is set on the request. That is
-		// not an error for caching, it just means another task saved first.
-		var apiErr smithy.APIError
-		if errors.As(uploadErr, &apiErr) && apiErr.ErrorCode() == "PreconditionFailed" {
-			alreadyExists = true
-			return false, nil
-		}
-		// Other client errors (4xx) won't succeed on retry, so fail fast rather
-		// than burning the full retry budget.
-		if isS3ClientError(uploadErr) {
-			return false, uploadErr
-		}
-		return true, uploadErr
+		exists, canRetry, uploadErr := classifyCacheUploadErr(c.bucket.Upload(ctx, remoteKey, localPath))
+		alreadyExists = exists
+		return canRetry, uploadErr
 	})
 	if err != nil {
 		return errors.Wrapf(err, "uploading cache object '%s'", remoteKey)
@@ -129,3 +123,25 @@ func (c *cacheSave) Execute(ctx context.Context, comm client.Communicator, logge
 	logger.Task().Infof(ctx, "cache.save: uploaded cache to '%s/%s'.", c.Bucket, remoteKey)
 	return nil
 }
+
+// classifyCacheUploadErr decides how cache.save should react to an upload
+// error. It returns whether the object already exists and whether the error
+// can be retried, along with the error to return, if any.
+func classifyCacheUploadErr(err error) (alreadyExists bool, canRetry bool, _ error) {
+	if err == nil {
+		return false, false, nil
+	}
+	// Skip-existing semantics: S3 reports PreconditionFailed when the object
+	// already exists and IfNotExists is set on the request. That is not an
+	// error for caching, it just means another task saved first.
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode() == "PreconditionFailed" {
+		return true, false, nil
+	}
+	// Other client errors (4xx) won't succeed on retry, so fail fast rather
+	// than burning the full retry budget.
+	if isS3ClientError(err) {
+		return false, false, err
+	}
+	return false, true, err
+}
@@ -69,6 +69,42 @@ func TestCacheSaveParseParams(t *testing.T) {
 		require.NoError(t, c.ParseParams(params))
 		assert.True(t, c.PreserveSymlinks)
 	})
+
+	t.Run("ModeDecoded", func(t *testing.T) {
+		params := validParams()
+		params["mode"] = "chunked"
+		c := &cacheSave{}
+		require.NoError(t, c.ParseParams(params))
+		assert.Equal(t, cacheModeChunked, c.Mode)
+
+		params["mode"] = "zip"
+		c = &cacheSave{}
+		assert.Error(t, c.ParseParams(params))
+	})
+
+	t.Run("CompressionDecoded", func(t *testing.T) {
+		params := validParams()
+		params["compression"] = "zstd"
+		params["compression_level"] = 19
+		c := &cacheSave{}
+		require.NoError(t, c.ParseParams(params))
+		assert.Equal(t, archiveCompressionZstd, c.Compression)
+		assert.Equal(t, 19, c.CompressionLevel)
+
+		params["compression"] = "lz4"
+		c = &cacheSave{}
+		assert.Error(t, c.ParseParams(params))
+	})
+
+	t.Run("CompressionWithChunkedModeRejected", func(t *testing.T) {
+		params := validParams()
+		params["mode"] = "chunked"
+		params["compression"] = "zstd"
+		c := &cacheSave{}
+		err := c.ParseParams(params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "compression")
+	})
 }
 
 // TestCacheSaveRecomputedKeyMatchesRestore verifies cache.save derives the same
@@ -27,10 +27,19 @@ import (
 	"github.com/pkg/errors"
 )
 
-// cacheArchiveSuffix is the extension given to every cache tarball, both locally
-// and as the final segment of its S3 key.
+// cacheArchiveSuffix is the extension given to every local cache tarball, and
+// as the final segment of the S3 key of gzipped cache tarballs.
 const cacheArchiveSuffix = ".tgz"
 
+// cacheArchiveSuffixes maps each cache compression format to the final segment
+// of the S3 key of cache tarballs compressed with it.
+var cacheArchiveSuffixes = map[string]string{
+	"":                     cacheArchiveSuffix,
+	archiveCompressionGzip: cacheArchiveSuffix,
+	archiveCompressionZstd: ".tar.zst",
+	archiveCompressionXz:   ".tar.xz",
+}
+
 // cacheHitValue is the expansion value cache.restore sets on a hit and cache.save
 // checks before deciding to skip.
 const cacheHitValue = "true"
@@ -69,6 +78,20 @@ type cacheCommon struct {
 	// cache.restore.
 	PreserveSymlinks bool `mapstructure:"preserve_symlinks"`
 
+	// Mode is how the cache is stored, either "archive" (the default) or
+	// "chunked". It must match between cache.save and cache.restore.
+	Mode string `mapstructure:"mode"`
+
+	// Compression is the compression format of an archive cache, either
+	// "gzip" (the default), "zstd", or "xz". It is part of the cache's S3
+	// key, so it must match between cache.save and cache.restore.
+	Compression string `mapstructure:"compression"`
+
+	// CompressionLevel is the format-specific compression level cache.save
+	// uses for an archive cache. If unset, the format's default level is
+	// used.
+	CompressionLevel int `mapstructure:"compression_level"`
+
 	// AwsKey, AwsSecret, and AwsSessionToken are the user's credentials for
 	// authenticating interactions with S3.
 	AwsKey          string `mapstructure:"aws_key" plugin:"expand"`
@@ -112,6 +135,9 @@ func (c *cacheCommon) validateCommon(catcher grip.Catcher) {
 	catcher.Wrapf(validateS3BucketName(c.Bucket), "validating bucket name '%s'", c.Bucket)
 	catcher.NewWhen(c.RemotePath == "", "remote_path cannot be blank")
 	catcher.NewWhen(len(c.KeyExpansions) == 0, "at least one key_expansions value is required")
+	catcher.ErrorfWhen(c.Mode != "" && c.Mode != cacheModeArchive && c.Mode != cacheModeChunked, "mode '%s' must be either '%s' or '%s'", c.Mode, cacheModeArchive, cacheModeChunked)
+	catcher.Wrap(c.compressionOptions().validate(), "validating compression options")
+	catcher.NewWhen(c.Mode == cacheModeChunked && (c.Compression != "" || c.CompressionLevel != 0), "compression options are only supported for archive caches")
 	validateCacheCredentials(catcher, c.RoleARN, c.AwsKey, c.AwsSecret, c.AwsSessionToken)
 }
 
@@ -160,7 +186,14 @@ func (c *cacheCommon) resolveCacheKey(conf *internal.TaskConfig) (string, error)
 // This layout is the contract that lets a cache.save be found by a later
 // cache.restore, so it lives in one place.
 func (c *cacheCommon) remoteKey(key string) string {
-	return path.Join(c.RemotePath, key, c.CacheName+cacheArchiveSuffix)
+	return path.Join(c.RemotePath, key, c.CacheName+cacheArchiveSuffixes[c.Compression])
+}
+
+func (c *cacheCommon) compressionOptions() archiveCompressionOptions {
+	return archiveCompressionOptions{
+		format: c.Compression,
+		level:  c.CompressionLevel,
+	}
 }
 
 // createTempCacheArchive creates a uniquely named temporary file in workDir for
@@ -191,6 +224,13 @@ func (c *cacheCommon) getRoleARN() string {
 // ifNotExists for save semantics, where an existing object must not be
 // overwritten.
 func (c *cacheCommon) createPailBucket(ctx context.Context, comm client.Communicator, httpClient *http.Client, ifNotExists bool) error {
+	bucket, err := c.newPailBucket(ctx, comm, httpClient, ifNotExists)
+	c.bucket = bucket
+	return err
+}
+
+// newPailBucket connects to S3 with the command's credentials.
+func (c *cacheCommon) newPailBucket(ctx context.Context, comm client.Communicator, httpClient *http.Client, ifNotExists bool) (pail.Bucket, error) {
 	opts := pail.S3Options{
 		Region:      c.Region,
 		Name:        c.Bucket,
@@ -202,9 +242,7 @@ func (c *cacheCommon) createPailBucket(ctx context.Context, comm client.Communic
 		opts.Credentials = pail.CreateAWSStaticCredentials(c.AwsKey, c.AwsSecret, c.AwsSessionToken)
 	}
 
-	bucket, err := pail.NewS3MultiPartBucketWithHTTPClient(ctx, httpClient, opts)
-	c.bucket = bucket
-	return err
+	return pail.NewS3MultiPartBucketWithHTTPClient(ctx, httpClient, opts)
 }
 
 // retryS3Op runs op with the same exponential backoff the other S3 commands use
@@ -344,24 +382,23 @@ func cacheHitExpansionName(name string) string {
 }
 
 // makeCacheArchive bundles paths (relative to workDir, or absolute) into a
-// gzipped tarball at target. It reuses the same archive helpers as
-// archive.targz_pack. A path that does not exist on disk is an error. When
-// preserveSymlinks is true, symlinks are archived as symlinks rather than
-// dereferenced.
-func makeCacheArchive(ctx context.Context, workDir string, paths []string, target string, logger grip.Journaler, preserveSymlinks bool) error {
+// tarball at target, compressed with the given options. It reuses the same
+// archive helpers as archive.targz_pack. A path that does not exist on disk is
+// an error. When preserveSymlinks is true, symlinks are archived as symlinks
+// rather than dereferenced.
+func makeCacheArchive(ctx context.Context, workDir string, paths []string, target string, compression archiveCompressionOptions, logger grip.Journaler, preserveSymlinks bool) error {
 	contents, totalSize, err := gatherCacheContents(workDir, paths)
 	if err != nil {
 		return err
 	}
 
-	useParallelGzip := totalSize > thresholdSizeForParallelGzipCompression
-	f, gz, tarWriter, err := tarGzWriter(target, useParallelGzip)
+	f, cw, tarWriter, err := compressedTarWriter(target, compression.forArchiveSize(totalSize))
 	if err != nil {
 		return errors.Wrapf(err, "creating archive file '%s'", target)
 	}
 	defer func() {
 		logger.Error(ctx, tarWriter.Close())
-		logger.Error(ctx, gz.Close())
+		logger.Error(ctx, cw.Close())
 		logger.Error(ctx, f.Close())
 	}()
 
@@ -118,22 +118,40 @@ func TestCacheArchiveRoundTrip(t *testing.T) {
 	require.NoError(t, os.MkdirAll(nested, 0755))
 	require.NoError(t, os.WriteFile(filepath.Join(nested, "lib.txt"), []byte("library"), 0644))
 
-	archivePath := filepath.Join(t.TempDir(), "cache.tgz")
-	require.NoError(t, makeCacheArchive(ctx, srcDir, []string{"top.txt", "deps"}, archivePath, logger, false))
+	for _, compression := range []string{archiveCompressionGzip, archiveCompressionZstd, archiveCompressionXz} {
+		t.Run(compression, func(t *testing.T) {
+			archivePath := filepath.Join(t.TempDir(), "cache.tgz")
+			require.NoError(t, makeCacheArchive(ctx, srcDir, []string{"top.txt", "deps"}, archivePath, archiveCompressionOptions{format: compression}, logger, false))
+
+			destDir := t.TempDir()
+			archive, err := os.Open(archivePath)
+			require.NoError(t, err)
+			t.Cleanup(func() { assert.NoError(t, archive.Close()) })
+			require.NoError(t, extractTarball(ctx, archive, destDir, nil, false))
+
+			top, err := os.ReadFile(filepath.Join(destDir, "top.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "top", string(top))
+
+			lib, err := os.ReadFile(filepath.Join(destDir, "deps", "lib.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "library", string(lib))
+		})
+	}
+}
 
-	destDir := t.TempDir()
-	archive, err := os.Open(archivePath)
-	require.NoError(t, err)
-	t.Cleanup(func() { assert.NoError(t, archive.Close()) })
-	require.NoError(t, extractTarball(ctx, archive, destDir, nil, false))
+func TestCacheRemoteKey(t *testing.T) {
+	c := cacheCommon{CacheName: "deps", RemotePath: "caches"}
+	assert.Equal(t, "caches/key/deps.tgz", c.remoteKey("key"), "gzip caches should keep their existing keys")
 
-	top, err := os.ReadFile(filepath.Join(destDir, "top.txt"))
-	require.NoError(t, err)
-	assert.Equal(t, "top", string(top))
+	c.Compression = archiveCompressionGzip
+	assert.Equal(t, "caches/key/deps.tgz", c.remoteKey("key"))
 
-	lib, err := os.ReadFile(filepath.Join(destDir, "deps", "lib.txt"))
-	require.NoError(t, err)
-	assert.Equal(t, "library", string(lib))
+	c.Compression = archiveCompressionZstd
+	assert.Equal(t, "caches/key/deps.tar.zst", c.remoteKey("key"))
+
+	c.Compression = archiveCompressionXz
+	assert.Equal(t, "caches/key/deps.tar.xz", c.remoteKey("key"))
 }
 
 func TestCacheArchiveRoundTripPreservesSymlinks(t *testing.T) {
@@ -148,7 +166,7 @@ func TestCacheArchiveRoundTripPreservesSymlinks(t *testing.T) {
 	require.NoError(t, os.Symlink("real.txt", filepath.Join(srcDir, "link.txt")))
 
 	archivePath := filepath.Join(t.TempDir(), "cache.tgz")
-	require.NoError(t, makeCacheArchive(ctx, srcDir, []string{"real.txt", "link.txt"}, archivePath, logger, true))
+	require.NoError(t, makeCacheArchive(ctx, srcDir, []string{"real.txt", "link.txt"}, archivePath, archiveCompressionOptions{}, logger, true))
 
 	destDir := t.TempDir()
 	archive, err := os.Open(archivePath)
@@ -170,7 +188,7 @@ func TestMakeCacheArchiveMissingPathErrors(t *testing.T) {
 	srcDir := t.TempDir()
 	archivePath := filepath.Join(t.TempDir(), "cache.tgz")
 
-	err := makeCacheArchive(t.Context(), srcDir, []string{"missing-dir"}, archivePath, logger, false)
+	err := makeCacheArchive(t.Context(), srcDir, []string{"missing-dir"}, archivePath, archiveCompressionOptions{}, logger, false)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "missing-dir")
 }
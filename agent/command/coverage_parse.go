@@ -0,0 +1,149 @@
+package command
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// coverageParse parses code coverage reports and sends the per-file coverage
+// back to the server.
+type coverageParse struct {
+	// Format is the name of the format of the coverage reports.
+	Format string `mapstructure:"format" plugin:"expand"`
+
+	// Files is a list of file globs, relative to the task's working
+	// directory, of the coverage reports to parse.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	// OptionalOutput, when set to true, causes this command to be skipped
+	// over without an error when no files are found to be parsed.
+	OptionalOutput bool `mapstructure:"optional_output"`
+
+	base
+}
+
+func coverageParseFactory() Command   { return &coverageParse{} }
+func (c *coverageParse) Name() string { return evergreen.CoverageParseCommandName }
+
+// ParseParams parses the command's parameters and validates that a format and
+// at least one file pattern is specified.
+func (c *coverageParse) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	if c.Format == "" {
+		return errors.Errorf("must specify a coverage format, one of: %s", strings.Join(coverage.ValidFormats, ", "))
+	}
+	if len(c.Files) == 0 {
+		return errors.New("must specify at least one file pattern to parse")
+	}
+
+	return nil
+}
+
+// Execute parses the coverage reports matching the given files in the given
+// format, merges them and sends the resulting per-file coverage to the server.
+func (c *coverageParse) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+
+	parser, ok := coverageParsers[c.Format]
+	if !ok {
+		return errors.Errorf("unrecognized coverage format '%s', must be one of: %s", c.Format, strings.Join(coverage.ValidFormats, ", "))
+	}
+
+	patterns := make([]string, 0, len(c.Files))
+	for _, file := range c.Files {
+		patterns = append(patterns, GetWorkingDirectory(conf, file))
+	}
+	reportFiles, err := globFiles(patterns...)
+	if err != nil {
+		return errors.Wrap(err, "obtaining names of coverage report files")
+	}
+	if len(reportFiles) == 0 {
+		if c.OptionalOutput {
+			return nil
+		}
+		return errors.New("no coverage report files found to be parsed")
+	}
+
+	lines := lineCoverage{}
+	for _, reportFile := range reportFiles {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "canceled while parsing coverage report files")
+		}
+
+		if err := c.parseReportFile(ctx, parser, reportFile, lines); err != nil {
+			return errors.Wrapf(err, "parsing coverage report file '%s'", reportFile)
+		}
+	}
+
+	files := lines.fileCoverage()
+	var covered, total int
+	for _, f := range files {
+		covered += f.CoveredLines
+		total += f.TotalLines
+	}
+	logger.Task().Infof(ctx, "Parsed coverage for %d source file(s) from %d '%s' coverage report file(s): %d of %d lines covered.", len(files), len(reportFiles), c.Format, covered, total)
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	return errors.Wrap(comm.SendCoverage(ctx, td, files), "sending coverage")
+}
+
+func (c *coverageParse) parseReportFile(ctx context.Context, parser coverageParser, reportFile string, lines lineCoverage) error {
+	f, err := os.Open(reportFile)
+	if err != nil {
+		return errors.Wrap(err, "opening file")
+	}
+	defer f.Close()
+
+	return parser(ctx, f, lines)
+}
+
+// lineCoverage tracks, for each source file, whether each of its executable
+// lines was covered. Reports that cover the same file are merged, so a line
+// is covered if any report covered it.
+type lineCoverage map[string]map[int]bool
+
+func (l lineCoverage) addLine(file string, line int, covered bool) {
+	if _, ok := l[file]; !ok {
+		l[file] = map[int]bool{}
+	}
+	l[file][line] = l[file][line] || covered
+}
+
+// fileCoverage returns the coverage of each file sorted by file name.
+func (l lineCoverage) fileCoverage() []coverage.FileCoverage {
+	files := make([]coverage.FileCoverage, 0, len(l))
+	for name, lines := range l {
+		f := coverage.FileCoverage{
+			Name:       name,
+			TotalLines: len(lines),
+		}
+		for _, covered := range lines {
+			if covered {
+				f.CoveredLines++
+			}
+		}
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+
+	return files
+}
@@ -0,0 +1,136 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoverageParseParseParams(t *testing.T) {
+	t.Run("SucceedsWithFormatAndFiles", func(t *testing.T) {
+		cmd := &coverageParse{}
+		require.NoError(t, cmd.ParseParams(map[string]any{
+			"format": coverage.FormatLCOV,
+			"files":  []string{"coverage/*.info"},
+		}))
+		assert.Equal(t, coverage.FormatLCOV, cmd.Format)
+		assert.Equal(t, []string{"coverage/*.info"}, cmd.Files)
+	})
+	t.Run("FailsWithoutFormat", func(t *testing.T) {
+		cmd := &coverageParse{}
+		assert.Error(t, cmd.ParseParams(map[string]any{
+			"files": []string{"coverage/*.info"},
+		}))
+	})
+	t.Run("FailsWithoutFiles", func(t *testing.T) {
+		cmd := &coverageParse{}
+		assert.Error(t, cmd.ParseParams(map[string]any{
+			"format": coverage.FormatLCOV,
+		}))
+	})
+}
+
+func TestCoverageParseExecute(t *testing.T) {
+	ctx := t.Context()
+	cwd := testutil.GetDirectoryOfFile()
+
+	conf := &internal.TaskConfig{
+		Task:       task.Task{Id: "task_id", Secret: "secret"},
+		WorkDir:    filepath.Join(cwd, "testdata", "coverage"),
+		Expansions: *util.NewExpansions(map[string]string{"format": coverage.FormatGoCover}),
+	}
+
+	t.Run("SendsMergedCoverage", func(t *testing.T) {
+		comm := client.NewMock("url")
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+
+		cmd := &coverageParse{Format: "${format}", Files: []string{"*.out"}}
+		require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+
+		assert.Equal(t, []coverage.FileCoverage{
+			{Name: "github.com/example/project/pkg/calc.go", CoveredLines: 6, TotalLines: 8},
+			{Name: "github.com/example/project/pkg/util.go", CoveredLines: 0, TotalLines: 3},
+		}, comm.Coverage[conf.Task.Id])
+	})
+	t.Run("FailsWithUnrecognizedFormat", func(t *testing.T) {
+		comm := client.NewMock("url")
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+
+		cmd := &coverageParse{Format: "jacoco", Files: []string{"*.out"}}
+		assert.Error(t, cmd.Execute(ctx, comm, logger, conf))
+		assert.Empty(t, comm.Coverage)
+	})
+	t.Run("FailsWithoutMatchingFiles", func(t *testing.T) {
+		comm := client.NewMock("url")
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+
+		cmd := &coverageParse{Format: coverage.FormatLCOV, Files: []string{"*.nonexistent"}}
+		assert.Error(t, cmd.Execute(ctx, comm, logger, conf))
+	})
+	t.Run("SucceedsWithoutMatchingFilesWhenOptional", func(t *testing.T) {
+		comm := client.NewMock("url")
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+
+		cmd := &coverageParse{Format: coverage.FormatLCOV, Files: []string{"*.nonexistent"}, OptionalOutput: true}
+		assert.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+		assert.Empty(t, comm.Coverage)
+	})
+}
+
+func TestCoverageParsers(t *testing.T) {
+	ctx := t.Context()
+	cwd := testutil.GetDirectoryOfFile()
+
+	parseFile := func(t *testing.T, parser coverageParser, name string) []coverage.FileCoverage {
+		f, err := os.Open(filepath.Join(cwd, "testdata", "coverage", name))
+		require.NoError(t, err)
+		defer f.Close()
+
+		lines := lineCoverage{}
+		require.NoError(t, parser(ctx, f, lines))
+		return lines.fileCoverage()
+	}
+
+	t.Run("LCOV", func(t *testing.T) {
+		// Records for the same file from different tests are merged.
+		assert.Equal(t, []coverage.FileCoverage{
+			{Name: "src/math.js", CoveredLines: 3, TotalLines: 5},
+			{Name: "src/strings.js", CoveredLines: 2, TotalLines: 2},
+		}, parseFile(t, parseLCOVReport, "lcov.info"))
+	})
+	t.Run("LCOVFailsWithLineDataOutsideOfRecord", func(t *testing.T) {
+		assert.Error(t, parseLCOVReport(ctx, strings.NewReader("DA:1,1\n"), lineCoverage{}))
+	})
+	t.Run("Cobertura", func(t *testing.T) {
+		assert.Equal(t, []coverage.FileCoverage{
+			{Name: "app/models.py", CoveredLines: 2, TotalLines: 3},
+			{Name: "app/views.py", CoveredLines: 1, TotalLines: 2},
+		}, parseFile(t, parseCoberturaReport, "cobertura.xml"))
+	})
+	t.Run("CoberturaFailsWithInvalidXML", func(t *testing.T) {
+		assert.Error(t, parseCoberturaReport(ctx, strings.NewReader("<coverage>"), lineCoverage{}))
+	})
+	t.Run("GoCover", func(t *testing.T) {
+		assert.Equal(t, []coverage.FileCoverage{
+			{Name: "github.com/example/project/pkg/calc.go", CoveredLines: 6, TotalLines: 8},
+			{Name: "github.com/example/project/pkg/util.go", CoveredLines: 0, TotalLines: 3},
+		}, parseFile(t, parseGoCoverProfile, "coverage.out"))
+	})
+	t.Run("GoCoverFailsWithMalformedBlock", func(t *testing.T) {
+		assert.Error(t, parseGoCoverProfile(ctx, strings.NewReader("mode: set\nfile.go:1.1 1 1\n"), lineCoverage{}))
+	})
+}
@@ -0,0 +1,153 @@
+package command
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/pkg/errors"
+)
+
+// coverageParser parses a single coverage report and adds the line coverage
+// found in it to the given line coverage.
+type coverageParser func(ctx context.Context, report io.Reader, lines lineCoverage) error
+
+var coverageParsers = map[string]coverageParser{
+	coverage.FormatLCOV:      parseLCOVReport,
+	coverage.FormatCobertura: parseCoberturaReport,
+	coverage.FormatGoCover:   parseGoCoverProfile,
+}
+
+// parseLCOVReport parses an lcov tracefile, as produced by lcov, c8, nyc,
+// grcov and similar tools. Only line coverage (DA records) is used; branch
+// and function records are ignored.
+func parseLCOVReport(ctx context.Context, report io.Reader, lines lineCoverage) error {
+	var sourceFile string
+
+	scanner := bufio.NewScanner(report)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "canceled while parsing lcov report")
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "SF:"):
+			sourceFile = strings.TrimPrefix(line, "SF:")
+		case line == "end_of_record":
+			sourceFile = ""
+		case strings.HasPrefix(line, "DA:"):
+			if sourceFile == "" {
+				return errors.New("found line data outside of a source file record")
+			}
+			// DA records have the form DA:<line>,<hits>[,<checksum>].
+			fields := strings.Split(strings.TrimPrefix(line, "DA:"), ",")
+			if len(fields) < 2 {
+				return errors.Errorf("malformed line data '%s'", line)
+			}
+			lineNum, err := strconv.Atoi(fields[0])
+			if err != nil {
+				return errors.Wrapf(err, "parsing line number in '%s'", line)
+			}
+			// Some tools report negative or fractional hit counts, so only
+			// check whether the count is zero.
+			hits, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return errors.Wrapf(err, "parsing hit count in '%s'", line)
+			}
+			lines.addLine(sourceFile, lineNum, hits != 0)
+		}
+	}
+
+	return errors.Wrap(scanner.Err(), "reading lcov report")
+}
+
+// coberturaCoverage is the root element of a Cobertura XML coverage report.
+type coberturaCoverage struct {
+	Classes []coberturaClass `xml:"packages>package>classes>class"`
+}
+
+type coberturaClass struct {
+	FileName string          `xml:"filename,attr"`
+	Lines    []coberturaLine `xml:"lines>line"`
+}
+
+type coberturaLine struct {
+	Number int    `xml:"number,attr"`
+	Hits   string `xml:"hits,attr"`
+}
+
+// parseCoberturaReport parses a Cobertura XML coverage report, as produced by
+// coverage.py, JaCoCo converters, gcovr and similar tools. Lines listed under
+// a class's methods are duplicates of the class's lines, so they are ignored.
+func parseCoberturaReport(ctx context.Context, report io.Reader, lines lineCoverage) error {
+	var doc coberturaCoverage
+	if err := xml.NewDecoder(newContextReader(ctx, report, contextCheckInterval)).Decode(&doc); err != nil {
+		return errors.Wrap(err, "decoding Cobertura report")
+	}
+
+	for _, class := range doc.Classes {
+		if class.FileName == "" {
+			continue
+		}
+		for _, line := range class.Lines {
+			hits, err := strconv.ParseFloat(line.Hits, 64)
+			if err != nil {
+				return errors.Wrapf(err, "parsing hit count of line %d in file '%s'", line.Number, class.FileName)
+			}
+			lines.addLine(class.FileName, line.Number, hits != 0)
+		}
+	}
+
+	return nil
+}
+
+// goCoverBlockRegex matches a block in a Go coverage profile, saving the file
+// name, the start and end lines, and the execution count. Blocks have the form
+// name.go:line.column,line.column numberOfStatements count.
+var goCoverBlockRegex = regexp.MustCompile(`^(.+):([0-9]+)\.[0-9]+,([0-9]+)\.[0-9]+ [0-9]+ ([0-9]+)$`)
+
+// parseGoCoverProfile parses a Go coverage profile, as produced by
+// `go test -coverprofile`. Go profiles report coverage for blocks of
+// statements, so a line is covered if any block that spans it was executed.
+func parseGoCoverProfile(ctx context.Context, report io.Reader, lines lineCoverage) error {
+	scanner := bufio.NewScanner(report)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "canceled while parsing Go coverage profile")
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+
+		matches := goCoverBlockRegex.FindStringSubmatch(line)
+		if matches == nil {
+			return errors.Errorf("malformed coverage block '%s'", line)
+		}
+		startLine, err := strconv.Atoi(matches[2])
+		if err != nil {
+			return errors.Wrapf(err, "parsing start line in '%s'", line)
+		}
+		endLine, err := strconv.Atoi(matches[3])
+		if err != nil {
+			return errors.Wrapf(err, "parsing end line in '%s'", line)
+		}
+		count, err := strconv.Atoi(matches[4])
+		if err != nil {
+			return errors.Wrapf(err, "parsing execution count in '%s'", line)
+		}
+
+		for lineNum := startLine; lineNum <= endLine; lineNum++ {
+			lines.addLine(matches[1], lineNum, count > 0)
+		}
+	}
+
+	return errors.Wrap(scanner.Err(), "reading Go coverage profile")
+}
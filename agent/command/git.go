@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -33,6 +34,12 @@ const (
 	gitFetchProjectRetries = 5
 	shallowCloneDepth      = 100
 
+	// gitReferenceReposDirName is the directory, in the agent's working
+	// directory, holding the reference repositories that clones borrow objects
+	// from. It's a dot directory so the agent's cleanup of leftover task
+	// directories doesn't remove it.
+	gitReferenceReposDirName = ".git-references"
+
 	gitGetProjectAttribute = "evergreen.command.git_get_project"
 
 	generatedTokenKey = "EVERGREEN_GENERATED_GITHUB_TOKEN"
@@ -70,6 +77,21 @@ type gitFetchProject struct {
 
 	CommitterEmail string `mapstructure:"committer_email"`
 
+	// SparseCheckout are the directories of the project to check out with a
+	// cone-mode sparse checkout. Files in the root of the project are always
+	// checked out. If empty, the entire project is checked out.
+	SparseCheckout []string `plugin:"expand" mapstructure:"sparse_checkout"`
+
+	// CloneFilter makes the project and its modules partial clones, either
+	// "blobless" or "treeless", so that file contents (and for treeless
+	// clones, directory trees) are only downloaded when they're checked out.
+	CloneFilter string `mapstructure:"clone_filter"`
+
+	// UseReferenceRepo clones the project and its modules using a reference
+	// repository kept on the host, so each clone only downloads the objects
+	// that were added since a previous task on the host.
+	UseReferenceRepo bool `mapstructure:"use_reference_repo"`
+
 	refNotFound bool
 
 	base
@@ -84,6 +106,25 @@ type cloneOpts struct {
 	recurseSubmodules bool
 	useVerbose        bool
 	cloneDepth        int
+	// filter is the git partial clone filter spec (e.g. "blob:none").
+	filter string
+	// sparse starts the clone with a sparse checkout of only the files in the
+	// root of the repository.
+	sparse bool
+	// referenceDir is the path to a reference repository to borrow objects
+	// from while cloning.
+	referenceDir string
+}
+
+const (
+	cloneFilterBlobless = "blobless"
+	cloneFilterTreeless = "treeless"
+)
+
+// gitCloneFilterSpecs maps the clone_filter values to git's filter specs.
+var gitCloneFilterSpecs = map[string]string{
+	cloneFilterBlobless: "blob:none",
+	cloneFilterTreeless: "tree:0",
 }
 
 func (opts cloneOpts) validate() error {
@@ -92,6 +133,7 @@ func (opts cloneOpts) validate() error {
 	catcher.NewWhen(opts.repo == "", "missing required repo")
 
 	catcher.NewWhen(opts.cloneDepth < 0, "clone depth cannot be negative")
+	catcher.NewWhen(opts.filter != "" && opts.referenceDir != "", "cannot use both a partial clone filter and a reference repository")
 	return catcher.Resolve()
 }
 
@@ -151,6 +193,17 @@ func (opts cloneOpts) getCloneCommand() ([]string, error) {
 	if opts.branch != "" {
 		clone = fmt.Sprintf("%s --branch '%s'", clone, opts.branch)
 	}
+	if opts.filter != "" {
+		clone = fmt.Sprintf("%s --filter=%s", clone, opts.filter)
+	}
+	if opts.sparse {
+		clone = fmt.Sprintf("%s --sparse", clone)
+	}
+	if opts.referenceDir != "" {
+		// Dissociating copies the borrowed objects into the clone, so the
+		// clone doesn't break if the reference repository is later pruned.
+		clone = fmt.Sprintf("%s --reference-if-able %s --dissociate", clone, shellQuote(opts.referenceDir))
+	}
 
 	return []string{
 		"set +o xtrace",
@@ -161,6 +214,29 @@ func (opts cloneOpts) getCloneCommand() ([]string, error) {
 	}, nil
 }
 
+// getReferenceRepoUpdateCommand returns the commands to create or update the
+// bare reference repository with the remote's branches. The remote URL is
+// passed to fetch rather than saved as a remote, so the clone token isn't
+// written to disk. Failing to update the reference repository doesn't fail
+// the clone, since the clone only uses the reference repository if it's
+// usable.
+func (opts cloneOpts) getReferenceRepoUpdateCommand() []string {
+	gitURL := thirdparty.FormGitURLForApp(opts.owner, opts.repo, opts.token)
+	refDir := shellQuote(opts.referenceDir)
+	return []string{
+		"set +o xtrace",
+		fmt.Sprintf("echo %s", strconv.Quote(fmt.Sprintf("Updating reference repository %s.", refDir))),
+		fmt.Sprintf("(git init --quiet --bare %s && git -C %s fetch --quiet --prune %s '+refs/heads/*:refs/heads/*') || echo %s",
+			refDir, refDir, gitURL, strconv.Quote(fmt.Sprintf("Could not update reference repository %s, cloning without it.", refDir))),
+		"set -o xtrace",
+	}
+}
+
+// shellQuote single-quotes s for bash.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 // getCloneCommandForWikiModule runs a plain git clone to opts.dir. GitHub
 // wikis are cloned at the remote default branch (HEAD) only; branch, ref,
 // depth, and submodules are not used.
@@ -218,9 +294,47 @@ func (c *gitFetchProject) ParseParams(params map[string]any) error {
 		return errors.New("directory must not be blank")
 	}
 
+	return c.validatePartialCheckout()
+}
+
+// validatePartialCheckout checks the sparse checkout, partial clone, and
+// reference repository params. Sparse checkout directories that use
+// expansions are validated once they're expanded.
+func (c *gitFetchProject) validatePartialCheckout() error {
+	catcher := grip.NewBasicCatcher()
+	for _, dir := range c.SparseCheckout {
+		if util.IsExpandable(dir) {
+			continue
+		}
+		catcher.Add(validateSparseCheckoutDir(dir))
+	}
+	if c.CloneFilter != "" {
+		_, ok := gitCloneFilterSpecs[c.CloneFilter]
+		catcher.ErrorfWhen(!ok, "clone filter '%s' must be either '%s' or '%s'", c.CloneFilter, cloneFilterBlobless, cloneFilterTreeless)
+	}
+	catcher.NewWhen(c.CloneFilter != "" && c.UseReferenceRepo, "cannot use both a clone filter and a reference repository")
+	return catcher.Resolve()
+}
+
+// validateSparseCheckoutDir checks that a sparse checkout directory is a
+// relative path inside the project.
+func validateSparseCheckoutDir(dir string) error {
+	if dir == "" {
+		return errors.New("sparse checkout directory cannot be empty")
+	}
+	cleaned := path.Clean(filepath.ToSlash(dir))
+	if path.IsAbs(cleaned) || filepath.IsAbs(dir) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return errors.Errorf("sparse checkout directory '%s' must be a relative path inside the project", dir)
+	}
 	return nil
 }
 
+// gitReferenceRepoDir returns the path to the host's reference repository for
+// the given repository.
+func gitReferenceRepoDir(conf *internal.TaskConfig, owner, repo string) string {
+	return filepath.ToSlash(filepath.Join(filepath.Dir(conf.WorkDir), gitReferenceReposDirName, owner, repo+".git"))
+}
+
 func (c *gitFetchProject) buildSourceCloneCommand(conf *internal.TaskConfig, opts cloneOpts) ([]string, error) {
 	gitCommands := []string{
 		"set -o xtrace",
@@ -229,12 +343,20 @@ func (c *gitFetchProject) buildSourceCloneCommand(conf *internal.TaskConfig, opt
 		fmt.Sprintf("rm -rf %s", c.Directory),
 	}
 
+	if opts.referenceDir != "" {
+		gitCommands = append(gitCommands, opts.getReferenceRepoUpdateCommand()...)
+	}
+
 	cloneCmd, err := opts.getCloneCommand()
 	if err != nil {
 		return nil, errors.Wrap(err, "getting command to clone repo")
 	}
 	gitCommands = append(gitCommands, cloneCmd...)
 
+	if len(c.SparseCheckout) > 0 {
+		gitCommands = append(gitCommands, getSparseCheckoutCommand("set", c.SparseCheckout))
+	}
+
 	// If there's a PR checkout the ref containing the changes.
 	if usesGitHubParentPRCheckout(conf) {
 		var suffix, localBranchName, remoteBranchName, commitToTest string
@@ -302,6 +424,10 @@ func (c *gitFetchProject) buildModuleCloneCommand(conf *internal.TaskConfig, opt
 		return nil, errors.New("empty ref/branch to check out")
 	}
 
+	if opts.referenceDir != "" {
+		gitCommands = append(gitCommands, opts.getReferenceRepoUpdateCommand()...)
+	}
+
 	cloneCmd, err := opts.getCloneCommand()
 	if err != nil {
 		return nil, errors.Wrap(err, "getting command to clone repo")
@@ -332,6 +458,11 @@ func (c *gitFetchProject) opts(ctx context.Context, cloneToken string, logger cl
 		dir:               c.Directory,
 		token:             cloneToken,
 		recurseSubmodules: c.RecurseSubmodules,
+		filter:            gitCloneFilterSpecs[c.CloneFilter],
+		sparse:            len(c.SparseCheckout) > 0,
+	}
+	if c.UseReferenceRepo {
+		opts.referenceDir = gitReferenceRepoDir(conf, opts.owner, opts.repo)
 	}
 
 	cloneDepth := c.CloneDepth
@@ -365,6 +496,11 @@ func (c *gitFetchProject) Execute(ctx context.Context, comm client.Communicator,
 	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
 		return errors.Wrap(err, "applying expansions")
 	}
+	for _, dir := range c.SparseCheckout {
+		if err := validateSparseCheckoutDir(dir); err != nil {
+			return errors.Wrap(err, "validating expanded sparse checkout directories")
+		}
+	}
 
 	cloneToken, err := getCloneToken(ctx, comm, conf, c.Token)
 	if err != nil {
@@ -560,6 +696,7 @@ func (c *gitFetchProject) fetchModuleSource(ctx context.Context,
 	opts := cloneOpts{
 		branch: "",
 		dir:    moduleBase,
+		filter: gitCloneFilterSpecs[c.CloneFilter],
 	}
 
 	// If the module repo is using the deprecated ssh cloning method, extract the owner
@@ -568,6 +705,9 @@ func (c *gitFetchProject) fetchModuleSource(ctx context.Context,
 	// This is a temporary workaround which will be removed once users have switched over.
 	opts.owner = owner
 	opts.repo = repo
+	if c.UseReferenceRepo {
+		opts.referenceDir = gitReferenceRepoDir(conf, owner, repo)
+	}
 	if strings.Contains(module.Repo, "git@github.com:") {
 		logger.Task().Warningf(ctx, "ssh cloning is being deprecated. We are manually converting '%s'"+
 			" to https format. Please update your project config.", module.Repo)
@@ -837,7 +977,9 @@ func (c *gitFetchProject) getApplyCommand(patchFile string) (string, error) {
 
 // getPatchCommands, given a module patch of a patch, will return the appropriate list of commands that
 // need to be executed, except for apply. If the patch is empty it will not apply the patch.
-func getPatchCommands(modulePatch patch.ModulePatch, moduleDir, patchPath string) []string {
+// sparseDirs are directories to add to a sparse checkout so that the files the
+// patch changes are checked out.
+func getPatchCommands(modulePatch patch.ModulePatch, moduleDir, patchPath string, sparseDirs []string) []string {
 	patchCommands := []string{
 		"set -o xtrace",
 		"set -o errexit",
@@ -845,6 +987,9 @@ func getPatchCommands(modulePatch patch.ModulePatch, moduleDir, patchPath string
 	if moduleDir != "" {
 		patchCommands = append(patchCommands, fmt.Sprintf("cd '%s'", moduleDir))
 	}
+	if len(sparseDirs) > 0 {
+		patchCommands = append(patchCommands, getSparseCheckoutCommand("add", sparseDirs))
+	}
 	patchCommands = append(patchCommands, fmt.Sprintf("git reset --hard '%s'", modulePatch.Githash))
 
 	if modulePatch.PatchSet.Patch == "" {
@@ -918,8 +1063,16 @@ func (c *gitFetchProject) applyPatch(ctx context.Context, logger client.LoggerPr
 		}
 		tempAbsPath := tempFile.Name()
 
+		// Add the directories the patch changes to the sparse checkout, so
+		// the patched files are checked out along with the rest of their
+		// directories rather than as lone files outside the checkout.
+		var sparseDirs []string
+		if patchPart.ModuleName == "" && len(c.SparseCheckout) > 0 {
+			sparseDirs = patchedDirs(patchPart.PatchSet.Patch)
+		}
+
 		// this applies the patch using the patch files in the temp directory
-		patchCommandStrings := getPatchCommands(patchPart, moduleDir, tempAbsPath)
+		patchCommandStrings := getPatchCommands(patchPart, moduleDir, tempAbsPath, sparseDirs)
 		applyCommand, err := c.getApplyCommand(tempAbsPath)
 		if err != nil {
 			return errors.Wrap(err, "getting git apply command")
@@ -938,6 +1091,116 @@ func (c *gitFetchProject) applyPatch(ctx context.Context, logger client.LoggerPr
 	return nil
 }
 
+// getSparseCheckoutCommand returns the command to set or add to the
+// directories in a cone-mode sparse checkout.
+func getSparseCheckoutCommand(subcommand string, dirs []string) string {
+	quoted := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		quoted = append(quoted, shellQuote(dir))
+	}
+	return fmt.Sprintf("git sparse-checkout %s --cone -- %s", subcommand, strings.Join(quoted, " "))
+}
+
+// patchedDirs returns the directories containing the files a patch changes,
+// including the old paths of renamed or copied files. Files in the root of the
+// repository are skipped, since a cone-mode sparse checkout always includes
+// them.
+func patchedDirs(patchContents string) []string {
+	var paths []string
+	// oldLinesLeft and newLinesLeft are the number of lines left in the
+	// current hunk, so that removed or added lines that happen to look like
+	// file headers (e.g. removing the line "-- comment") are skipped.
+	var oldLinesLeft, newLinesLeft int
+	for line := range strings.Lines(patchContents) {
+		line = strings.TrimRight(line, "\r\n")
+		if oldLinesLeft > 0 || newLinesLeft > 0 {
+			switch {
+			case strings.HasPrefix(line, "-"):
+				oldLinesLeft--
+			case strings.HasPrefix(line, "+"):
+				newLinesLeft--
+			case strings.HasPrefix(line, " "), line == "":
+				oldLinesLeft--
+				newLinesLeft--
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "@@ "):
+			oldLinesLeft, newLinesLeft = parseHunkLineCounts(line)
+		case strings.HasPrefix(line, "--- "), strings.HasPrefix(line, "+++ "):
+			name := unquotePatchPath(line[len("--- "):])
+			if name == "/dev/null" {
+				continue
+			}
+			if _, after, ok := strings.Cut(name, "/"); ok {
+				paths = append(paths, after)
+			}
+		case strings.HasPrefix(line, "rename "), strings.HasPrefix(line, "copy "):
+			for _, prefix := range []string{"rename from ", "rename to ", "copy from ", "copy to "} {
+				if name, ok := strings.CutPrefix(line, prefix); ok {
+					paths = append(paths, unquotePatchPath(name))
+				}
+			}
+		case strings.HasPrefix(line, "diff --git "):
+			// Binary and mode-only changes have no ---/+++ lines, so use the
+			// header when its paths are unambiguous.
+			fields := strings.Fields(strings.TrimPrefix(line, "diff --git "))
+			if len(fields) == 2 && strings.HasPrefix(fields[0], "a/") && strings.HasPrefix(fields[1], "b/") {
+				paths = append(paths, fields[0][len("a/"):], fields[1][len("b/"):])
+			}
+		}
+	}
+
+	var dirs []string
+	for _, p := range paths {
+		if dir := path.Dir(p); dir != "." && !utility.StringSliceContains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// parseHunkLineCounts returns the number of old and new lines in the hunk
+// with the given header, e.g. "@@ -1,5 +1,6 @@". A count that is omitted
+// defaults to 1.
+func parseHunkLineCounts(header string) (oldLines, newLines int) {
+	fields := strings.Fields(header)
+	if len(fields) < 3 {
+		return 0, 0
+	}
+	return parseHunkRangeCount(fields[1], "-"), parseHunkRangeCount(fields[2], "+")
+}
+
+func parseHunkRangeCount(hunkRange, prefix string) int {
+	hunkRange, ok := strings.CutPrefix(hunkRange, prefix)
+	if !ok {
+		return 0
+	}
+	_, count, ok := strings.Cut(hunkRange, ",")
+	if !ok {
+		return 1
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// unquotePatchPath returns a path from a patch header, which git quotes if it
+// contains special characters. Any trailing timestamp is removed.
+func unquotePatchPath(name string) string {
+	if strings.HasPrefix(name, `"`) {
+		if unquoted, err := strconv.Unquote(name); err == nil {
+			return unquoted
+		}
+	}
+	name, _, _ = strings.Cut(name, "\t")
+	return name
+}
+
 // usesGitHubParentPRCheckout reports whether the main project checkout should use
 // a GitHub PR or merge-queue ref instead of the task revision.
 func usesGitHubParentPRCheckout(conf *internal.TaskConfig) bool {
@@ -152,18 +152,18 @@ func TestGetPatchCommands(t *testing.T) {
 		},
 	}
 
-	cmds := getPatchCommands(modulePatch, "/teapot", "/tmp/bestest.patch")
+	cmds := getPatchCommands(modulePatch, "/teapot", "/tmp/bestest.patch", nil)
 
 	assert.Len(cmds, 4)
 	assert.Equal("cd '/teapot'", cmds[2])
 	assert.Equal("git reset --hard 'a4aa03d0472d8503380479b76aef96c044182822'", cmds[3])
 
 	modulePatch.PatchSet.Patch = "bestest code"
-	cmds = getPatchCommands(modulePatch, "/teapot", "/tmp/bestest.patch")
+	cmds = getPatchCommands(modulePatch, "/teapot", "/tmp/bestest.patch", nil)
 	assert.Len(cmds, 5)
 	assert.Equal("git apply --stat '/tmp/bestest.patch' || true", cmds[4])
 
-	cmds = getPatchCommands(modulePatch, "/teapot", "/tmp/bestest.patch")
+	cmds = getPatchCommands(modulePatch, "/teapot", "/tmp/bestest.patch", nil)
 	assert.Len(cmds, 5)
 	assert.Equal("git reset --hard 'a4aa03d0472d8503380479b76aef96c044182822'", cmds[3])
 	assert.Equal("git apply --stat '/tmp/bestest.patch' || true", cmds[4])
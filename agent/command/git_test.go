@@ -858,6 +858,244 @@ func TestBuildModuleCloneCommandWiki(t *testing.T) {
 	assert.Contains(t, joined, "myorg/parent.wiki")
 }
 
+func TestGitFetchProjectParseParamsPartialCheckout(t *testing.T) {
+	t.Run("SucceedsWithSparseCheckoutAndCloneFilter", func(t *testing.T) {
+		c := &gitFetchProject{}
+		require.NoError(t, c.ParseParams(map[string]any{
+			"directory":       "src",
+			"sparse_checkout": []string{"services/api", "${sparse_dir}"},
+			"clone_filter":    "blobless",
+		}))
+		assert.Equal(t, []string{"services/api", "${sparse_dir}"}, c.SparseCheckout)
+		assert.Equal(t, cloneFilterBlobless, c.CloneFilter)
+	})
+	t.Run("FailsWithInvalidCloneFilter", func(t *testing.T) {
+		c := &gitFetchProject{}
+		assert.Error(t, c.ParseParams(map[string]any{
+			"directory":    "src",
+			"clone_filter": "shallow",
+		}))
+	})
+	t.Run("FailsWithSparseCheckoutOutsideProject", func(t *testing.T) {
+		for _, dir := range []string{"", "/abs", "..", "../other", "."} {
+			c := &gitFetchProject{}
+			assert.Error(t, c.ParseParams(map[string]any{
+				"directory":       "src",
+				"sparse_checkout": []string{dir},
+			}), dir)
+		}
+	})
+	t.Run("FailsWithCloneFilterAndReferenceRepo", func(t *testing.T) {
+		c := &gitFetchProject{}
+		assert.Error(t, c.ParseParams(map[string]any{
+			"directory":          "src",
+			"clone_filter":       "treeless",
+			"use_reference_repo": true,
+		}))
+	})
+}
+
+func TestBuildSourceCloneCommandPartialCheckout(t *testing.T) {
+	conf := &internal.TaskConfig{
+		Task:       task.Task{Revision: "abcdef"},
+		ProjectRef: model.ProjectRef{Owner: "evergreen-ci", Repo: "evergreen", Branch: "main"},
+		WorkDir:    "/data/mci/task_dir",
+	}
+
+	t.Run("SparseCheckoutIsSetBeforeCheckingOutRevision", func(t *testing.T) {
+		c := &gitFetchProject{Directory: "dir", SparseCheckout: []string{"services/api", "docs"}, CloneFilter: cloneFilterBlobless}
+		opts := cloneOpts{
+			token:  projectGitHubToken,
+			branch: conf.ProjectRef.Branch,
+			owner:  conf.ProjectRef.Owner,
+			repo:   conf.ProjectRef.Repo,
+			dir:    c.Directory,
+			filter: gitCloneFilterSpecs[c.CloneFilter],
+			sparse: true,
+		}
+		cmds, err := c.buildSourceCloneCommand(conf, opts)
+		require.NoError(t, err)
+		assert.True(t, utility.ContainsOrderedSubset(cmds, []string{
+			fmt.Sprintf("git clone https://x-access-token:%s@github.com/evergreen-ci/evergreen.git 'dir' --branch 'main' --filter=blob:none --sparse", projectGitHubToken),
+			"cd dir",
+			"git sparse-checkout set --cone -- 'services/api' 'docs'",
+			"git reset --hard abcdef",
+		}), cmds)
+	})
+
+	t.Run("ReferenceRepoIsUpdatedBeforeCloning", func(t *testing.T) {
+		c := &gitFetchProject{Directory: "dir", UseReferenceRepo: true}
+		opts := cloneOpts{
+			token:        projectGitHubToken,
+			branch:       conf.ProjectRef.Branch,
+			owner:        conf.ProjectRef.Owner,
+			repo:         conf.ProjectRef.Repo,
+			dir:          c.Directory,
+			referenceDir: gitReferenceRepoDir(conf, conf.ProjectRef.Owner, conf.ProjectRef.Repo),
+		}
+		cmds, err := c.buildSourceCloneCommand(conf, opts)
+		require.NoError(t, err)
+		joined := strings.Join(cmds, "\n")
+		refDir := "/data/mci/.git-references/evergreen-ci/evergreen.git"
+		assert.Contains(t, joined, fmt.Sprintf("git init --quiet --bare '%s' && git -C '%s' fetch --quiet --prune https://x-access-token:%s@github.com/evergreen-ci/evergreen.git '+refs/heads/*:refs/heads/*'", refDir, refDir, projectGitHubToken))
+		assert.Contains(t, joined, fmt.Sprintf("--reference-if-able '%s' --dissociate", refDir))
+		assert.Less(t, strings.Index(joined, "git init"), strings.Index(joined, "git clone"))
+		assert.NotContains(t, joined, "sparse-checkout")
+	})
+}
+
+func TestBuildModuleCloneCommandReferenceRepo(t *testing.T) {
+	c := &gitFetchProject{Directory: "dir", UseReferenceRepo: true}
+	conf := &internal.TaskConfig{WorkDir: "/data/mci/task_dir"}
+	opts := cloneOpts{
+		token:        projectGitHubToken,
+		owner:        "evergreen-ci",
+		repo:         "module",
+		dir:          "module",
+		referenceDir: gitReferenceRepoDir(conf, "evergreen-ci", "module"),
+	}
+	cmds, err := c.buildModuleCloneCommand(conf, opts, "main", nil)
+	require.NoError(t, err)
+	joined := strings.Join(cmds, "\n")
+	assert.Contains(t, joined, "git init --quiet --bare '/data/mci/.git-references/evergreen-ci/module.git'")
+	assert.Contains(t, joined, "--reference-if-able '/data/mci/.git-references/evergreen-ci/module.git' --dissociate")
+}
+
+func TestPatchedDirs(t *testing.T) {
+	patchContents := `diff --git a/services/api/main.go b/services/api/main.go
+index 1111111..2222222 100644
+--- a/services/api/main.go
++++ b/services/api/main.go
+@@ -1 +1 @@
+-old
++new
+diff --git a/README.md b/README.md
+index 1111111..2222222 100644
+--- a/README.md
++++ b/README.md
+@@ -1 +1 @@
+-old
++new
+diff --git a/tools/old/gen.sh b/tools/new/gen.sh
+similarity index 100%
+rename from tools/old/gen.sh
+rename to tools/new/gen.sh
+diff --git a/assets/logo.png b/assets/logo.png
+new file mode 100644
+index 0000000..3333333
+Binary files /dev/null and b/assets/logo.png differ
+diff --git "a/docs/with space/a.md" "b/docs/with space/a.md"
+deleted file mode 100644
+--- "a/docs/with space/a.md"
++++ /dev/null
+@@ -1 +0,0 @@
+-old
+`
+	assert.ElementsMatch(t, []string{
+		"services/api",
+		"tools/old",
+		"tools/new",
+		"assets",
+		"docs/with space",
+	}, patchedDirs(patchContents))
+	assert.Empty(t, patchedDirs(""))
+
+	t.Run("IgnoresHunkLinesThatLookLikeHeaders", func(t *testing.T) {
+		patchContents := `diff --git a/db/schema.sql b/db/schema.sql
+index 1111111..2222222 100644
+--- a/db/schema.sql
++++ b/db/schema.sql
+@@ -1,4 +1,3 @@
+ CREATE TABLE t (id int);
+--- a/removed/comment
++++ b/added/comment
+-- unchanged/comment
+
+diff --git a/src/lib/util.go b/src/lib/util.go
+index 1111111..2222222 100644
+--- a/src/lib/util.go
++++ b/src/lib/util.go
+@@ -1 +1,2 @@
+ package lib
++// new
+`
+		assert.ElementsMatch(t, []string{"db", "src/lib"}, patchedDirs(patchContents))
+	})
+}
+
+func TestParseHunkLineCounts(t *testing.T) {
+	for header, expected := range map[string][2]int{
+		"@@ -1,5 +1,6 @@":               {5, 6},
+		"@@ -1 +1 @@":                   {1, 1},
+		"@@ -0,0 +1,3 @@":               {0, 3},
+		"@@ -10,2 +10 @@ func main() {": {2, 1},
+		"@@ malformed":                  {0, 0},
+	} {
+		oldLines, newLines := parseHunkLineCounts(header)
+		assert.Equal(t, expected, [2]int{oldLines, newLines}, header)
+	}
+}
+
+func TestApplyPatchInSparseCheckout(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	ctx := t.Context()
+	runScript := func(t *testing.T, dir string, cmds ...string) {
+		cmd := exec.CommandContext(ctx, "bash", "-c", strings.Join(cmds, "\n"))
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@a", "GIT_COMMITTER_NAME=a", "GIT_COMMITTER_EMAIL=a@a")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	originDir := t.TempDir()
+	runScript(t, originDir,
+		"set -o errexit",
+		"git init --quiet .",
+		"mkdir -p included excluded",
+		"echo included > included/file.txt",
+		"echo excluded > excluded/file.txt",
+		"git add .",
+		"git commit --quiet -m initial",
+	)
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "HEAD")
+	cmd.Dir = originDir
+	out, err := cmd.Output()
+	require.NoError(t, err)
+	revision := strings.TrimSpace(string(out))
+
+	cloneDir := filepath.Join(t.TempDir(), "src")
+	runScript(t, filepath.Dir(cloneDir),
+		"set -o errexit",
+		fmt.Sprintf("git clone --quiet --sparse 'file://%s' src", originDir),
+		"cd src",
+		getSparseCheckoutCommand("set", []string{"included"}),
+	)
+	require.NoFileExists(t, filepath.Join(cloneDir, "excluded", "file.txt"))
+
+	patchContents := `diff --git a/excluded/file.txt b/excluded/file.txt
+index 0000000..1111111 100644
+--- a/excluded/file.txt
++++ b/excluded/file.txt
+@@ -1 +1 @@
+-excluded
++patched
+`
+	patchPath := filepath.Join(t.TempDir(), "patch")
+	require.NoError(t, os.WriteFile(patchPath, []byte(patchContents), 0644))
+	modulePatch := patch.ModulePatch{Githash: revision, PatchSet: patch.PatchSet{Patch: patchContents}}
+	c := &gitFetchProject{SparseCheckout: []string{"included"}}
+	applyCmd, err := c.getApplyCommand(patchPath)
+	require.NoError(t, err)
+	cmds := append(getPatchCommands(modulePatch, "", patchPath, patchedDirs(patchContents)), applyCmd)
+	runScript(t, cloneDir, cmds...)
+
+	contents, err := os.ReadFile(filepath.Join(cloneDir, "excluded", "file.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "patched\n", string(contents))
+}
+
 func (s *GitGetProjectSuite) TestGetApplyCommand() {
 	c := &gitFetchProject{
 		Directory:      "dir",
@@ -27,9 +27,14 @@ func init() {
 		"archive.zip_extract":                   zipExtractFactory,
 		evergreen.AttachResultsCommandName:      attachResultsFactory,
 		evergreen.AttachXUnitResultsCommandName: xunitResultsFactory,
+		evergreen.AttachTestResultsCommandName:  testReportResultsFactory,
 		evergreen.AttachArtifactsCommandName:    attachArtifactsFactory,
+		evergreen.CoverageParseCommandName:      coverageParseFactory,
 		evergreen.CacheRestoreCommandName:       cacheRestoreFactory,
 		evergreen.CacheSaveCommandName:          cacheSaveFactory,
+		evergreen.TaskFilesPublishCommandName:   taskFilesPublishFactory,
+		evergreen.TaskFilesFetchCommandName:     taskFilesFetchFactory,
+		"task_outputs.set":                      taskOutputsSetFactory,
 		evergreen.HostCreateCommandName:         createHostFactory,
 		"ec2.assume_role":                       ec2AssumeRoleFactory,
 		"host.list":                             listHostFactory,
@@ -50,6 +55,7 @@ func init() {
 		"s3Copy.copy":                           s3CopyFactory,
 		evergreen.ShellExecCommandName:          shellExecFactory,
 		"subprocess.exec":                       subprocessExecFactory,
+		"service.start":                         serviceStartFactory,
 		"setup.initial":                         initialSetupFactory,
 		"test_selection.get":                    testSelectionGetFactory,
 		"timeout.update":                        timeoutUpdateFactory,
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// cargoTestEvent is a single line of the JSON output of
+// `cargo test -- -Z unstable-options --format json --report-time`.
+type cargoTestEvent struct {
+	Type  string `json:"type"`
+	Event string `json:"event"`
+	Name  string `json:"name"`
+	// ExecTime is the test's duration in seconds. It is only reported when
+	// the test binary is run with --report-time.
+	ExecTime float64 `json:"exec_time"`
+	Stdout   string  `json:"stdout"`
+	Message  string  `json:"message"`
+}
+
+// cargoTestReportBufferSize is the maximum size of a single line of cargo test
+// JSON output, which can contain the entire captured output of a test.
+const cargoTestReportBufferSize = 16 * 1024 * 1024
+
+// parseCargoJSONReport parses the line-delimited JSON output of cargo test.
+// Lines that are not JSON objects, such as compiler output, are ignored. Logs
+// are only generated for tests that report captured output or a message, which
+// cargo only includes for failed and ignored tests unless run with
+// --show-output.
+func parseCargoJSONReport(ctx context.Context, conf *internal.TaskConfig, _ client.LoggerProducer, report io.Reader, _ string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	var (
+		results []testresult.TestResult
+		logs    []testlog.TestLog
+	)
+
+	scanner := bufio.NewScanner(report)
+	scanner.Buffer(make([]byte, 0, 64*1024), cargoTestReportBufferSize)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, errors.Wrap(err, "canceled while parsing cargo test report")
+		}
+
+		line := bytes.TrimSpace(scanner.Bytes())
+		if !bytes.HasPrefix(line, []byte("{")) {
+			continue
+		}
+
+		var event cargoTestEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			return nil, nil, errors.Wrap(err, "decoding cargo test event")
+		}
+		if event.Type != "test" {
+			continue
+		}
+		status, ok := cargoTestEventToStatus(event.Event)
+		if !ok {
+			continue
+		}
+
+		end := time.Now()
+		res := testresult.TestResult{
+			TestName:      event.Name,
+			Status:        status,
+			TestStartTime: end.Add(-time.Duration(event.ExecTime * float64(time.Second))),
+			TestEndTime:   end,
+		}
+
+		if lines := splitLogLines(event.Stdout + event.Message); len(lines) > 0 {
+			log := testlog.TestLog{
+				// When sending test logs we need to use a unique string
+				// since there may be duplicate test names.
+				Name:          utility.RandomString(),
+				Task:          conf.Task.Id,
+				TaskExecution: conf.Task.Execution,
+				Lines:         lines,
+			}
+			res.LogInfo = &testresult.TestLogInfo{LogName: log.Name}
+			logs = append(logs, log)
+		}
+
+		results = append(results, res)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, errors.Wrap(err, "reading cargo test report")
+	}
+
+	return results, logs, nil
+}
+
+// cargoTestEventToStatus converts a cargo test event to an Evergreen test
+// status. It returns false for events that do not indicate that a test
+// finished, such as a test starting or exceeding the slow test threshold.
+func cargoTestEventToStatus(event string) (string, bool) {
+	switch event {
+	case "ok":
+		return evergreen.TestSucceededStatus, true
+	case "failed":
+		return evergreen.TestFailedStatus, true
+	case "ignored":
+		return evergreen.TestSkippedStatus, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCargoJSONReport(t *testing.T) {
+	conf := &internal.TaskConfig{Task: task.Task{Id: "task_id", Execution: 1}}
+
+	t.Run("ParsesTestEvents", func(t *testing.T) {
+		report := strings.Join([]string{
+			"   Compiling calculator v0.1.0 (/src)",
+			"    Finished test [unoptimized + debuginfo] target(s) in 1.00s",
+			`{ "type": "suite", "event": "started", "test_count": 4 }`,
+			`{ "type": "test", "event": "started", "name": "tests::adds" }`,
+			`{ "type": "test", "event": "started", "name": "tests::divides" }`,
+			`{ "type": "test", "name": "tests::adds", "event": "ok", "exec_time": 0.5 }`,
+			`{ "type": "test", "name": "tests::slow", "event": "timeout" }`,
+			`{ "type": "test", "name": "tests::divides", "event": "failed", "exec_time": 1.25, "stdout": "thread 'tests::divides' panicked at 'attempt to divide by zero'\n" }`,
+			`{ "type": "test", "event": "ignored", "name": "tests::future" }`,
+			`{ "type": "test", "name": "tests::slow", "event": "ok", "exec_time": 61.0 }`,
+			`{ "type": "suite", "event": "failed", "passed": 2, "failed": 1, "ignored": 1, "measured": 0, "filtered_out": 0, "exec_time": 62.0 }`,
+		}, "\n")
+
+		results, logs, err := parseCargoJSONReport(t.Context(), conf, nil, strings.NewReader(report), "suite")
+		require.NoError(t, err)
+		require.Len(t, results, 4)
+
+		assert.Equal(t, "tests::adds", results[0].TestName)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[0].Status)
+		assert.Equal(t, 500*time.Millisecond, results[0].TestEndTime.Sub(results[0].TestStartTime))
+		assert.Nil(t, results[0].LogInfo)
+
+		assert.Equal(t, "tests::divides", results[1].TestName)
+		assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+		assert.Equal(t, 1250*time.Millisecond, results[1].TestEndTime.Sub(results[1].TestStartTime))
+		require.NotNil(t, results[1].LogInfo)
+
+		assert.Equal(t, "tests::future", results[2].TestName)
+		assert.Equal(t, evergreen.TestSkippedStatus, results[2].Status)
+
+		assert.Equal(t, "tests::slow", results[3].TestName)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[3].Status)
+
+		require.Len(t, logs, 1)
+		assert.Equal(t, results[1].LogInfo.LogName, logs[0].Name)
+		assert.Equal(t, []string{"thread 'tests::divides' panicked at 'attempt to divide by zero'"}, logs[0].Lines)
+	})
+	t.Run("FailsWithInvalidJSON", func(t *testing.T) {
+		_, _, err := parseCargoJSONReport(t.Context(), conf, nil, strings.NewReader(`{ "type": "test",`), "suite")
+		assert.Error(t, err)
+	})
+}
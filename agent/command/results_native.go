@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -111,13 +112,30 @@ func (c *attachResults) Execute(ctx context.Context, comm client.Communicator, l
 	}
 	defer reportFile.Close()
 
-	var nativeResults nativeTestResults
-	if err = utility.ReadJSON(reportFile, &nativeResults); err != nil {
+	nativeResults, err := readNativeTestResults(reportFile)
+	if err != nil {
 		return errors.Wrapf(err, "reading report file '%s'", reportFileLoc)
 	}
+	testLogs := nativeResults.extractRawLogs(conf)
+
+	return sendTestLogsAndResults(ctx, comm, logger, conf, testLogs, nativeResults.convertToService())
+}
 
+// readNativeTestResults reads test results in Evergreen's JSON test result
+// format.
+func readNativeTestResults(report io.Reader) (nativeTestResults, error) {
+	var nativeResults nativeTestResults
+	if err := utility.ReadJSON(io.NopCloser(report), &nativeResults); err != nil {
+		return nativeTestResults{}, errors.Wrap(err, "reading JSON test results")
+	}
+	return nativeResults, nil
+}
+
+// extractRawLogs creates a test log for each result that includes its raw log
+// contents and links the result to its newly-created log.
+func (t nativeTestResults) extractRawLogs(conf *internal.TaskConfig) []testlog.TestLog {
 	var testLogs []testlog.TestLog
-	for i, res := range nativeResults.Results {
+	for i, res := range t.Results {
 		if res.LogRaw != "" {
 			testLogs = append(testLogs, testlog.TestLog{
 				// When sending test logs we need to use a
@@ -128,9 +146,8 @@ func (c *attachResults) Execute(ctx context.Context, comm client.Communicator, l
 				TaskExecution: conf.Task.Execution,
 				Lines:         strings.Split(res.LogRaw, "\n"),
 			})
-			nativeResults.Results[i].LogInfo = &testresult.TestLogInfo{LogName: testLogs[len(testLogs)-1].Name}
+			t.Results[i].LogInfo = &testresult.TestLogInfo{LogName: testLogs[len(testLogs)-1].Name}
 		}
 	}
-
-	return sendTestLogsAndResults(ctx, comm, logger, conf, testLogs, nativeResults.convertToService())
+	return testLogs
 }
@@ -0,0 +1,158 @@
+package command
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// Test report formats that can be parsed into Evergreen test results.
+const (
+	testResultsFormatGoTest     = "gotest"
+	testResultsFormatXUnit      = "xunit"
+	testResultsFormatEvergreen  = "evergreen"
+	testResultsFormatTAP        = "tap"
+	testResultsFormatTRX        = "trx"
+	testResultsFormatPytestJSON = "pytest_json"
+	testResultsFormatCargoJSON  = "cargo_json"
+)
+
+// testResultsParser parses a single test report into Evergreen test results
+// and, if the format contains them, the test logs linked from those results.
+// The suite name identifies the report and is used to name logs that cover
+// the entire report.
+type testResultsParser func(ctx context.Context, conf *internal.TaskConfig, logger client.LoggerProducer, report io.Reader, suiteName string) ([]testresult.TestResult, []testlog.TestLog, error)
+
+var testResultsParsers *testResultsParserRegistry
+
+func init() {
+	testResultsParsers = newTestResultsParserRegistry()
+
+	parsers := map[string]testResultsParser{
+		testResultsFormatGoTest:     parseGoTestReport,
+		testResultsFormatXUnit:      parseXUnitReport,
+		testResultsFormatEvergreen:  parseEvergreenReport,
+		testResultsFormatTAP:        parseTAPReport,
+		testResultsFormatTRX:        parseTRXReport,
+		testResultsFormatPytestJSON: parsePytestJSONReport,
+		testResultsFormatCargoJSON:  parseCargoJSONReport,
+	}
+
+	for format, parser := range parsers {
+		grip.EmergencyPanic(context.Background(), errors.Wrapf(testResultsParsers.register(format, parser), "registering test results parser for format '%s'", format))
+	}
+}
+
+type testResultsParserRegistry struct {
+	mu      *sync.RWMutex
+	parsers map[string]testResultsParser
+}
+
+func newTestResultsParserRegistry() *testResultsParserRegistry {
+	return &testResultsParserRegistry{
+		parsers: map[string]testResultsParser{},
+		mu:      &sync.RWMutex{},
+	}
+}
+
+func (r *testResultsParserRegistry) register(format string, parser testResultsParser) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if format == "" {
+		return errors.New("cannot register a test results parser without a format")
+	}
+	if _, ok := r.parsers[format]; ok {
+		return errors.Errorf("test results format '%s' is already registered", format)
+	}
+	if parser == nil {
+		return errors.Errorf("cannot register a nil parser for test results format '%s'", format)
+	}
+
+	r.parsers[format] = parser
+	return nil
+}
+
+func (r *testResultsParserRegistry) get(format string) (testResultsParser, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	parser, ok := r.parsers[format]
+	return parser, ok
+}
+
+// formats returns the sorted names of all registered test results formats.
+func (r *testResultsParserRegistry) formats() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]string, 0, len(r.parsers))
+	for format := range r.parsers {
+		out = append(out, format)
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+// parseGoTestReport adapts the gotest.parse_files parser to the test results
+// parser registry.
+func parseGoTestReport(ctx context.Context, conf *internal.TaskConfig, _ client.LoggerProducer, report io.Reader, suiteName string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	log, results, err := parseTestOutput(ctx, conf, report, suiteName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, []testlog.TestLog{log}, nil
+}
+
+// parseXUnitReport adapts the attach.xunit_results parser to the test results
+// parser registry.
+func parseXUnitReport(ctx context.Context, conf *internal.TaskConfig, logger client.LoggerProducer, report io.Reader, _ string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	suites, err := parseXMLResults(ctx, report)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parsing xunit report")
+	}
+
+	cumulative := testcaseAccumulator{}
+	for idx, suite := range suites {
+		cumulative = addTestCasesForSuite(ctx, suite, idx, conf, cumulative, logger)
+	}
+
+	logs := make([]testlog.TestLog, 0, len(cumulative.logs))
+	for _, log := range cumulative.logs {
+		logs = append(logs, *log)
+	}
+
+	return cumulative.tests, logs, nil
+}
+
+// parseEvergreenReport adapts the attach.results parser to the test results
+// parser registry.
+func parseEvergreenReport(_ context.Context, conf *internal.TaskConfig, _ client.LoggerProducer, report io.Reader, _ string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	nativeResults, err := readNativeTestResults(report)
+	if err != nil {
+		return nil, nil, err
+	}
+	logs := nativeResults.extractRawLogs(conf)
+
+	return nativeResults.convertToService(), logs, nil
+}
+
+// splitLogLines splits raw test output into log lines, dropping trailing
+// whitespace.
+func splitLogLines(output string) []string {
+	output = strings.TrimRight(output, " \t\r\n")
+	if output == "" {
+		return nil
+	}
+	return strings.Split(output, "\n")
+}
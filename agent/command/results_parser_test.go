@@ -0,0 +1,83 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestResultsParserRegistry(t *testing.T) {
+	t.Run("IncludesAllFormats", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{
+			testResultsFormatGoTest,
+			testResultsFormatXUnit,
+			testResultsFormatEvergreen,
+			testResultsFormatTAP,
+			testResultsFormatTRX,
+			testResultsFormatPytestJSON,
+			testResultsFormatCargoJSON,
+		}, testResultsParsers.formats())
+	})
+	t.Run("RegisterFailsWithDuplicateFormat", func(t *testing.T) {
+		r := newTestResultsParserRegistry()
+		require.NoError(t, r.register(testResultsFormatTAP, parseTAPReport))
+		assert.Error(t, r.register(testResultsFormatTAP, parseTAPReport))
+	})
+	t.Run("RegisterFailsWithoutFormat", func(t *testing.T) {
+		r := newTestResultsParserRegistry()
+		assert.Error(t, r.register("", parseTAPReport))
+	})
+	t.Run("RegisterFailsWithNilParser", func(t *testing.T) {
+		r := newTestResultsParserRegistry()
+		assert.Error(t, r.register(testResultsFormatTAP, nil))
+	})
+	t.Run("GetReturnsRegisteredParser", func(t *testing.T) {
+		r := newTestResultsParserRegistry()
+		require.NoError(t, r.register(testResultsFormatTAP, parseTAPReport))
+		parser, ok := r.get(testResultsFormatTAP)
+		assert.True(t, ok)
+		assert.NotNil(t, parser)
+		_, ok = r.get(testResultsFormatTRX)
+		assert.False(t, ok)
+	})
+}
+
+func TestRegisteredTestResultsParsers(t *testing.T) {
+	ctx := t.Context()
+	cwd := testutil.GetDirectoryOfFile()
+	conf := &internal.TaskConfig{Task: task.Task{Id: "task_id", Execution: 1}}
+	comm := client.NewMock("url")
+	logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+	require.NoError(t, err)
+
+	for format, reportPath := range map[string]string{
+		testResultsFormatGoTest:    filepath.Join(cwd, "testdata", "gotest", "1_simple.log"),
+		testResultsFormatXUnit:     filepath.Join(cwd, "testdata", "xunit", "junit_1.xml"),
+		testResultsFormatEvergreen: filepath.Join(cwd, "testdata", "attach", "plugin_attach_results.json"),
+	} {
+		t.Run(format, func(t *testing.T) {
+			parser, ok := testResultsParsers.get(format)
+			require.True(t, ok)
+
+			f, err := os.Open(reportPath)
+			require.NoError(t, err)
+			defer f.Close()
+
+			results, _, err := parser(ctx, conf, logger, f, "suite")
+			require.NoError(t, err)
+			require.NotEmpty(t, results)
+			for _, res := range results {
+				assert.NotEmpty(t, res.TestName)
+				assert.Contains(t, []string{evergreen.TestSucceededStatus, evergreen.TestFailedStatus, evergreen.TestSkippedStatus, evergreen.TestSilentlyFailedStatus}, res.Status)
+			}
+		})
+	}
+}
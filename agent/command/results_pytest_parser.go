@@ -0,0 +1,140 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// pytestReport is a report produced by the pytest-json-report plugin
+// (`pytest --json-report`).
+type pytestReport struct {
+	// Created is the time the report was created, in seconds since the UNIX
+	// epoch.
+	Created float64 `json:"created"`
+	// Duration is the total duration of the test session in seconds.
+	Duration float64      `json:"duration"`
+	Tests    []pytestTest `json:"tests"`
+}
+
+type pytestTest struct {
+	NodeID   string       `json:"nodeid"`
+	Outcome  string       `json:"outcome"`
+	Setup    *pytestStage `json:"setup"`
+	Call     *pytestStage `json:"call"`
+	Teardown *pytestStage `json:"teardown"`
+}
+
+type pytestStage struct {
+	Duration float64 `json:"duration"`
+	Outcome  string  `json:"outcome"`
+	LongRepr string  `json:"longrepr"`
+	Stdout   string  `json:"stdout"`
+	Stderr   string  `json:"stderr"`
+}
+
+// parsePytestJSONReport parses a report produced by the pytest-json-report
+// plugin. The report does not include per-test timestamps, so tests are laid
+// out sequentially from the start of the test session using their stage
+// durations. Logs are only generated for tests that did not pass or that
+// wrote output.
+func parsePytestJSONReport(ctx context.Context, conf *internal.TaskConfig, _ client.LoggerProducer, report io.Reader, _ string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	var pr pytestReport
+	if err := json.NewDecoder(newContextReader(ctx, report, contextCheckInterval)).Decode(&pr); err != nil {
+		return nil, nil, errors.Wrap(err, "decoding pytest JSON report")
+	}
+
+	start := time.Now()
+	if pr.Created > 0 {
+		start = utility.FromPythonTime(pr.Created - pr.Duration)
+	}
+
+	var (
+		results []testresult.TestResult
+		logs    []testlog.TestLog
+	)
+	for _, test := range pr.Tests {
+		res := testresult.TestResult{
+			TestName:      test.NodeID,
+			Status:        pytestOutcomeToStatus(test.Outcome),
+			TestStartTime: start,
+			TestEndTime:   start.Add(test.duration()),
+		}
+		start = res.TestEndTime
+
+		if lines := test.logLines(); len(lines) > 0 {
+			log := testlog.TestLog{
+				// When sending test logs we need to use a unique string
+				// since there may be duplicate test names.
+				Name:          utility.RandomString(),
+				Task:          conf.Task.Id,
+				TaskExecution: conf.Task.Execution,
+				Lines:         lines,
+			}
+			res.LogInfo = &testresult.TestLogInfo{LogName: log.Name}
+			logs = append(logs, log)
+		}
+
+		results = append(results, res)
+	}
+
+	return results, logs, nil
+}
+
+func (t pytestTest) stages() []*pytestStage {
+	return []*pytestStage{t.Setup, t.Call, t.Teardown}
+}
+
+// duration returns the total duration of the test's setup, call and teardown
+// stages.
+func (t pytestTest) duration() time.Duration {
+	var secs float64
+	for _, stage := range t.stages() {
+		if stage != nil {
+			secs += stage.Duration
+		}
+	}
+	return time.Duration(secs * float64(time.Second))
+}
+
+// logLines returns the failure details and captured output of each of the
+// test's stages.
+func (t pytestTest) logLines() []string {
+	stageNames := []string{"setup", "call", "teardown"}
+
+	var lines []string
+	for i, stage := range t.stages() {
+		if stage == nil {
+			continue
+		}
+		if stage.LongRepr != "" {
+			lines = append(lines, fmt.Sprintf("%s %s:", stageNames[i], stage.Outcome))
+			lines = append(lines, splitLogLines(stage.LongRepr)...)
+		}
+		lines = append(lines, constructSystemLogs(stage.Stdout, stage.Stderr)...)
+	}
+	return lines
+}
+
+// pytestOutcomeToStatus converts a pytest outcome to an Evergreen test status.
+// Expected failures are reported as skipped and unexpected passes as passed.
+func pytestOutcomeToStatus(outcome string) string {
+	switch outcome {
+	case "passed", "xpassed":
+		return evergreen.TestSucceededStatus
+	case "skipped", "xfailed", "deselected":
+		return evergreen.TestSkippedStatus
+	default:
+		return evergreen.TestFailedStatus
+	}
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePytestJSONReport(t *testing.T) {
+	conf := &internal.TaskConfig{Task: task.Task{Id: "task_id", Execution: 1}}
+
+	f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "pytest", "report.json"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	results, logs, err := parsePytestJSONReport(t.Context(), conf, nil, f, "report")
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	sessionStart := time.Unix(1700000000, 0)
+	expected := []struct {
+		name   string
+		status string
+		start  time.Duration
+		end    time.Duration
+	}{
+		{name: "tests/test_math.py::test_add", status: evergreen.TestSucceededStatus, start: 0, end: 2 * time.Second},
+		{name: "tests/test_math.py::test_divide", status: evergreen.TestFailedStatus, start: 2 * time.Second, end: 5 * time.Second},
+		{name: "tests/test_math.py::test_skipped", status: evergreen.TestSkippedStatus, start: 5 * time.Second, end: 5 * time.Second},
+		{name: "tests/test_math.py::test_expected_failure", status: evergreen.TestSkippedStatus, start: 5 * time.Second, end: 6 * time.Second},
+	}
+	for i, exp := range expected {
+		assert.Equal(t, exp.name, results[i].TestName)
+		assert.Equal(t, exp.status, results[i].Status)
+		assert.WithinDuration(t, sessionStart.Add(exp.start), results[i].TestStartTime, time.Millisecond)
+		assert.WithinDuration(t, sessionStart.Add(exp.end), results[i].TestEndTime, time.Millisecond)
+	}
+
+	assert.Nil(t, results[0].LogInfo)
+	assert.Nil(t, results[3].LogInfo)
+	require.Len(t, logs, 2)
+	require.NotNil(t, results[1].LogInfo)
+	assert.Equal(t, results[1].LogInfo.LogName, logs[0].Name)
+	assert.Equal(t, []string{
+		"call failed:",
+		"def test_divide():",
+		">       assert 1 / 0",
+		"E       ZeroDivisionError: division by zero",
+		systemOut,
+		"dividing\n",
+	}, logs[0].Lines)
+	require.NotNil(t, results[2].LogInfo)
+	assert.Equal(t, results[2].LogInfo.LogName, logs[1].Name)
+}
@@ -0,0 +1,122 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// testReportResults parses test reports in any format registered in the test
+// results parser registry and sends the results back to the server.
+type testReportResults struct {
+	// Format is the name of the format of the test reports.
+	Format string `mapstructure:"format" plugin:"expand"`
+
+	// Files is a list of file globs, relative to the task's working
+	// directory, of the test reports to parse.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	// OptionalOutput, when set to true, causes this command to be skipped
+	// over without an error when no files are found to be parsed.
+	OptionalOutput bool `mapstructure:"optional_output"`
+
+	base
+}
+
+func testReportResultsFactory() Command   { return &testReportResults{} }
+func (c *testReportResults) Name() string { return evergreen.AttachTestResultsCommandName }
+
+// ParseParams parses the command's parameters and validates that a format and
+// at least one file pattern is specified.
+func (c *testReportResults) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	if c.Format == "" {
+		return errors.Errorf("must specify a test results format, one of: %s", strings.Join(testResultsParsers.formats(), ", "))
+	}
+	if len(c.Files) == 0 {
+		return errors.New("must specify at least one file pattern to parse")
+	}
+
+	return nil
+}
+
+// Execute parses the test reports matching the given files in the given
+// format and sends the test logs and results found in them to the server.
+func (c *testReportResults) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+
+	parser, ok := testResultsParsers.get(c.Format)
+	if !ok {
+		return errors.Errorf("unrecognized test results format '%s', must be one of: %s", c.Format, strings.Join(testResultsParsers.formats(), ", "))
+	}
+
+	patterns := make([]string, 0, len(c.Files))
+	for _, file := range c.Files {
+		patterns = append(patterns, GetWorkingDirectory(conf, file))
+	}
+	reportFiles, err := globFiles(patterns...)
+	if err != nil {
+		return errors.Wrap(err, "obtaining names of test report files")
+	}
+	if len(reportFiles) == 0 {
+		if c.OptionalOutput {
+			return nil
+		}
+		return errors.New("no test report files found to be parsed")
+	}
+
+	var (
+		allResults []testresult.TestResult
+		allLogs    []testlog.TestLog
+	)
+	for _, reportFile := range reportFiles {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "canceled while parsing test report files")
+		}
+
+		results, logs, err := c.parseReportFile(ctx, conf, logger, parser, reportFile)
+		if err != nil {
+			return errors.Wrapf(err, "parsing test report file '%s'", reportFile)
+		}
+		allResults = append(allResults, results...)
+		allLogs = append(allLogs, logs...)
+	}
+
+	logger.Task().Infof(ctx, "Parsed %d test results from %d '%s' test report file(s).", len(allResults), len(reportFiles), c.Format)
+
+	if len(allResults) == 0 {
+		if conf.Task.MustHaveResults {
+			return errors.New("no test results found in test report files")
+		}
+		return nil
+	}
+
+	return errors.Wrap(sendTestLogsAndResults(ctx, comm, logger, conf, allLogs, allResults), "sending test logs and test results")
+}
+
+func (c *testReportResults) parseReportFile(ctx context.Context, conf *internal.TaskConfig, logger client.LoggerProducer, parser testResultsParser, reportFile string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	f, err := os.Open(reportFile)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "opening file")
+	}
+	defer f.Close()
+
+	suiteName := strings.TrimSuffix(filepath.Base(reportFile), filepath.Ext(reportFile))
+
+	return parser(ctx, conf, logger, f, suiteName)
+}
@@ -0,0 +1,138 @@
+package command
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/pkg/errors"
+)
+
+var (
+	// Match a top-level TAP test point, saving whether it is "not ok", the
+	// optional test number, the optional description and the optional
+	// directive.
+	tapTestPointRegex = regexp.MustCompile(`^(not )?ok\b\s*([0-9]+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(\S+)(.*))?$`)
+
+	// Match a TAP "Bail out!" line, which indicates that the test run was
+	// aborted.
+	tapBailOutRegex = regexp.MustCompile(`^Bail out!\s*(.*)$`)
+)
+
+// tapTestPoint is a single test point parsed from a TAP report.
+type tapTestPoint struct {
+	name      string
+	status    string
+	startLine int
+}
+
+// parseTAPReport parses a report in the Test Anything Protocol (TAP) format.
+// Only top-level test points are converted into test results; indented
+// subtests and YAML diagnostic blocks are kept in the report's test log. The
+// whole report is uploaded as a single log and each result is linked to the
+// line where its test point appears.
+func parseTAPReport(ctx context.Context, conf *internal.TaskConfig, _ client.LoggerProducer, report io.Reader, suiteName string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	var (
+		lines  []string
+		points []tapTestPoint
+	)
+
+	scanner := bufio.NewScanner(report)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, errors.Wrap(err, "canceled while parsing TAP report")
+		}
+
+		line := scanner.Text()
+		lines = append(lines, line)
+
+		if matches := tapBailOutRegex.FindStringSubmatch(line); matches != nil {
+			points = append(points, tapTestPoint{
+				name:      strings.TrimSpace("Bail out! " + matches[1]),
+				status:    evergreen.TestFailedStatus,
+				startLine: len(lines),
+			})
+			break
+		}
+
+		matches := tapTestPointRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+		points = append(points, tapTestPoint{
+			name:      tapTestPointName(matches[2], matches[3], len(points)+1),
+			status:    tapTestPointStatus(matches[1] == "", matches[4]),
+			startLine: len(lines),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, errors.Wrap(err, "reading TAP report")
+	}
+
+	if len(points) == 0 && len(lines) == 0 {
+		return nil, nil, errors.New("no results found")
+	}
+
+	now := time.Now()
+	results := make([]testresult.TestResult, 0, len(points))
+	for _, point := range points {
+		results = append(results, testresult.TestResult{
+			TestName:      point.name,
+			Status:        point.status,
+			TestStartTime: now,
+			TestEndTime:   now,
+			LogInfo: &testresult.TestLogInfo{
+				LogName: suiteName,
+				LineNum: int32(point.startLine - 1),
+			},
+		})
+	}
+
+	log := testlog.TestLog{
+		Name:          suiteName,
+		Task:          conf.Task.Id,
+		TaskExecution: conf.Task.Execution,
+		Lines:         lines,
+	}
+
+	return results, []testlog.TestLog{log}, nil
+}
+
+// tapTestPointName returns the name of a TAP test point, falling back to its
+// number when there is no description.
+func tapTestPointName(number, description string, ordinal int) string {
+	if description != "" {
+		return description
+	}
+	if number != "" {
+		return fmt.Sprintf("test %s", number)
+	}
+	return fmt.Sprintf("test %d", ordinal)
+}
+
+// tapTestPointStatus returns the Evergreen test status for a TAP test point.
+// Tests with a SKIP directive are skipped and failing tests with a TODO
+// directive are expected failures, so they are reported as skipped too.
+func tapTestPointStatus(ok bool, directive string) string {
+	switch strings.ToUpper(directive) {
+	case "SKIP", "SKIPPED":
+		return evergreen.TestSkippedStatus
+	case "TODO":
+		if !ok {
+			return evergreen.TestSkippedStatus
+		}
+	}
+	if ok {
+		return evergreen.TestSucceededStatus
+	}
+	return evergreen.TestFailedStatus
+}
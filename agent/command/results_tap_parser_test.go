@@ -0,0 +1,82 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTAPReport(t *testing.T) {
+	conf := &internal.TaskConfig{Task: task.Task{Id: "task_id", Execution: 1}}
+
+	t.Run("ParsesTestPoints", func(t *testing.T) {
+		report := strings.Join([]string{
+			"TAP version 13",
+			"1..6",
+			"ok 1 - adds numbers",
+			"not ok 2 - divides by zero",
+			"  ---",
+			"  message: division by zero",
+			"  ...",
+			"ok 3 - not ready # SKIP needs a database",
+			"not ok 4 - future feature # TODO not implemented",
+			"ok 5",
+			"    ok 1 - indented subtest",
+			"ok",
+		}, "\n")
+
+		results, logs, err := parseTAPReport(t.Context(), conf, nil, strings.NewReader(report), "suite")
+		require.NoError(t, err)
+		require.Len(t, results, 6)
+
+		expected := []struct {
+			name   string
+			status string
+			line   int32
+		}{
+			{name: "adds numbers", status: evergreen.TestSucceededStatus, line: 2},
+			{name: "divides by zero", status: evergreen.TestFailedStatus, line: 3},
+			{name: "not ready", status: evergreen.TestSkippedStatus, line: 7},
+			{name: "future feature", status: evergreen.TestSkippedStatus, line: 8},
+			{name: "test 5", status: evergreen.TestSucceededStatus, line: 9},
+			{name: "test 6", status: evergreen.TestSucceededStatus, line: 11},
+		}
+		for i, exp := range expected {
+			assert.Equal(t, exp.name, results[i].TestName)
+			assert.Equal(t, exp.status, results[i].Status)
+			require.NotNil(t, results[i].LogInfo)
+			assert.Equal(t, "suite", results[i].LogInfo.LogName)
+			assert.Equal(t, exp.line, results[i].LogInfo.LineNum)
+		}
+
+		require.Len(t, logs, 1)
+		assert.Equal(t, "suite", logs[0].Name)
+		assert.Equal(t, conf.Task.Id, logs[0].Task)
+		assert.Equal(t, conf.Task.Execution, logs[0].TaskExecution)
+		assert.Len(t, logs[0].Lines, 12)
+	})
+	t.Run("StopsAtBailOut", func(t *testing.T) {
+		report := strings.Join([]string{
+			"1..3",
+			"ok 1 - first",
+			"Bail out! database unavailable",
+			"ok 2 - second",
+		}, "\n")
+
+		results, _, err := parseTAPReport(t.Context(), conf, nil, strings.NewReader(report), "suite")
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "first", results[0].TestName)
+		assert.Equal(t, "Bail out! database unavailable", results[1].TestName)
+		assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+	})
+	t.Run("FailsWithEmptyReport", func(t *testing.T) {
+		_, _, err := parseTAPReport(t.Context(), conf, nil, strings.NewReader(""), "suite")
+		assert.Error(t, err)
+	})
+}
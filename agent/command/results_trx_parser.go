@@ -0,0 +1,180 @@
+package command
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// trxTestRun is the root element of a Visual Studio test results (TRX) file,
+// as produced by `dotnet test --logger trx`.
+type trxTestRun struct {
+	Results     []trxUnitTestResult `xml:"Results>UnitTestResult"`
+	Definitions []trxUnitTest       `xml:"TestDefinitions>UnitTest"`
+}
+
+type trxUnitTestResult struct {
+	TestID    string    `xml:"testId,attr"`
+	TestName  string    `xml:"testName,attr"`
+	Outcome   string    `xml:"outcome,attr"`
+	Duration  string    `xml:"duration,attr"`
+	StartTime string    `xml:"startTime,attr"`
+	EndTime   string    `xml:"endTime,attr"`
+	Output    trxOutput `xml:"Output"`
+	// InnerResults contains the results of data-driven test rows.
+	InnerResults []trxUnitTestResult `xml:"InnerResults>UnitTestResult"`
+}
+
+type trxOutput struct {
+	StdOut     string `xml:"StdOut"`
+	StdErr     string `xml:"StdErr"`
+	Message    string `xml:"ErrorInfo>Message"`
+	StackTrace string `xml:"ErrorInfo>StackTrace"`
+}
+
+type trxUnitTest struct {
+	ID         string        `xml:"id,attr"`
+	Name       string        `xml:"name,attr"`
+	TestMethod trxTestMethod `xml:"TestMethod"`
+}
+
+type trxTestMethod struct {
+	ClassName string `xml:"className,attr"`
+	Name      string `xml:"name,attr"`
+}
+
+// trxDurationRegex matches TRX durations of the form hh:mm:ss.fffffff.
+var trxDurationRegex = regexp.MustCompile(`^([0-9]+):([0-9]{2}):([0-9]{2}(?:\.[0-9]+)?)$`)
+
+// parseTRXReport parses a .NET TRX test results file. Logs are only generated
+// for tests that did not pass or that wrote output.
+func parseTRXReport(ctx context.Context, conf *internal.TaskConfig, _ client.LoggerProducer, report io.Reader, _ string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	var run trxTestRun
+	if err := xml.NewDecoder(newContextReader(ctx, report, contextCheckInterval)).Decode(&run); err != nil {
+		return nil, nil, errors.Wrap(err, "decoding TRX report")
+	}
+
+	classNames := map[string]string{}
+	for _, def := range run.Definitions {
+		classNames[def.ID] = def.TestMethod.ClassName
+	}
+
+	var (
+		results []testresult.TestResult
+		logs    []testlog.TestLog
+	)
+	for _, res := range run.Results {
+		testResults, testLogs := res.toModelTestResultsAndLogs(conf, classNames[res.TestID])
+		results = append(results, testResults...)
+		logs = append(logs, testLogs...)
+	}
+
+	return results, logs, nil
+}
+
+func (r trxUnitTestResult) toModelTestResultsAndLogs(conf *internal.TaskConfig, className string) ([]testresult.TestResult, []testlog.TestLog) {
+	// Data-driven tests report one inner result per data row and a parent
+	// result that summarizes them, so only the inner results are kept.
+	if len(r.InnerResults) > 0 {
+		var (
+			results []testresult.TestResult
+			logs    []testlog.TestLog
+		)
+		for _, inner := range r.InnerResults {
+			innerResults, innerLogs := inner.toModelTestResultsAndLogs(conf, className)
+			results = append(results, innerResults...)
+			logs = append(logs, innerLogs...)
+		}
+		return results, logs
+	}
+
+	res := testresult.TestResult{
+		TestName: r.TestName,
+		Status:   trxOutcomeToStatus(r.Outcome),
+	}
+	if className != "" {
+		res.TestName = fmt.Sprintf("%s.%s", className, r.TestName)
+	}
+	res.TestStartTime, res.TestEndTime = r.times()
+
+	var lines []string
+	if r.Output.Message != "" || r.Output.StackTrace != "" {
+		lines = append(lines, fmt.Sprintf("%s: %s", r.Outcome, r.Output.Message))
+		lines = append(lines, splitLogLines(r.Output.StackTrace)...)
+	}
+	lines = append(lines, constructSystemLogs(r.Output.StdOut, r.Output.StdErr)...)
+	if len(lines) == 0 {
+		return []testresult.TestResult{res}, nil
+	}
+
+	log := testlog.TestLog{
+		// When sending test logs we need to use a unique string since there
+		// may be duplicate test names.
+		Name:          utility.RandomString(),
+		Task:          conf.Task.Id,
+		TaskExecution: conf.Task.Execution,
+		Lines:         lines,
+	}
+	res.LogInfo = &testresult.TestLogInfo{LogName: log.Name}
+
+	return []testresult.TestResult{res}, []testlog.TestLog{log}
+}
+
+// times returns the start and end times of the test. If the report does not
+// include valid timestamps, the times are calculated from the duration.
+func (r trxUnitTestResult) times() (time.Time, time.Time) {
+	start, startErr := time.Parse(time.RFC3339Nano, r.StartTime)
+	end, endErr := time.Parse(time.RFC3339Nano, r.EndTime)
+	if startErr == nil && endErr == nil && !end.Before(start) {
+		return start, end
+	}
+
+	start = time.Now()
+	return start, start.Add(parseTRXDuration(r.Duration))
+}
+
+// parseTRXDuration parses a TRX duration. Invalid durations are treated as
+// zero.
+func parseTRXDuration(duration string) time.Duration {
+	matches := trxDurationRegex.FindStringSubmatch(duration)
+	if matches == nil {
+		return 0
+	}
+	hours, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0
+	}
+	minutes, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return 0
+	}
+	seconds, err := strconv.ParseFloat(matches[3], 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds*float64(time.Second))
+}
+
+// trxOutcomeToStatus converts a TRX test outcome to an Evergreen test status.
+func trxOutcomeToStatus(outcome string) string {
+	switch outcome {
+	case "Passed", "PassedButRunAborted", "Warning":
+		return evergreen.TestSucceededStatus
+	case "NotExecuted", "NotRunnable", "Inconclusive", "Pending", "Disconnected":
+		return evergreen.TestSkippedStatus
+	default:
+		return evergreen.TestFailedStatus
+	}
+}
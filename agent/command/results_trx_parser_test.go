@@ -0,0 +1,65 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTRXReport(t *testing.T) {
+	conf := &internal.TaskConfig{Task: task.Task{Id: "task_id", Execution: 1}}
+
+	f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "trx", "results.trx"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	results, logs, err := parseTRXReport(t.Context(), conf, nil, f, "results")
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	assert.Equal(t, "Calculator.Tests.AddsNumbers", results[0].TestName)
+	assert.Equal(t, evergreen.TestSucceededStatus, results[0].Status)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC), results[0].TestStartTime.UTC())
+	assert.Equal(t, 12345*time.Microsecond, results[0].TestEndTime.Sub(results[0].TestStartTime))
+	assert.Nil(t, results[0].LogInfo)
+
+	assert.Equal(t, "Calculator.Tests.DividesByZero", results[1].TestName)
+	assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+	require.NotNil(t, results[1].LogInfo)
+
+	assert.Equal(t, "Calculator.Tests.NotYetImplemented", results[2].TestName)
+	assert.Equal(t, evergreen.TestSkippedStatus, results[2].Status)
+	assert.Equal(t, results[2].TestStartTime, results[2].TestEndTime)
+
+	assert.Equal(t, "Calculator.Tests.Rows (1)", results[3].TestName)
+	assert.Equal(t, evergreen.TestSucceededStatus, results[3].Status)
+	assert.Equal(t, time.Millisecond, results[3].TestEndTime.Sub(results[3].TestStartTime))
+	assert.Equal(t, "Calculator.Tests.Rows (2)", results[4].TestName)
+	assert.Equal(t, evergreen.TestFailedStatus, results[4].Status)
+
+	require.Len(t, logs, 1)
+	assert.Equal(t, results[1].LogInfo.LogName, logs[0].Name)
+	assert.Equal(t, conf.Task.Id, logs[0].Task)
+	assert.Equal(t, []string{
+		"Failed: Assert.Equal() Failure",
+		"   at Calculator.Tests.DividesByZero() in CalculatorTests.cs:line 20",
+		"   at Calculator.Tests.Run()",
+		systemOut,
+		"dividing",
+	}, logs[0].Lines)
+}
+
+func TestParseTRXDuration(t *testing.T) {
+	assert.Equal(t, 1500*time.Millisecond, parseTRXDuration("00:00:01.5000000"))
+	assert.Equal(t, 2*time.Hour+3*time.Minute+4*time.Second, parseTRXDuration("02:03:04"))
+	assert.Zero(t, parseTRXDuration(""))
+	assert.Zero(t, parseTRXDuration("1.5s"))
+}
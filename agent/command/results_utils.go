@@ -2,6 +2,9 @@ package command
 
 import (
 	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -28,6 +31,10 @@ func sendTestResults(ctx context.Context, comm client.Communicator, logger clien
 		return errors.New("cannot send nil results")
 	}
 
+	if conf.LocalOutputDir != "" {
+		return errors.Wrap(writeLocalTestResults(ctx, logger, conf, results), "writing local test results report")
+	}
+
 	logger.Task().Info(ctx, "Attaching test results...")
 	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
 
@@ -54,11 +61,12 @@ func sendTestLogsAndResults(ctx context.Context, comm client.Communicator, logge
 		Expansions:         conf.NewExpansions,
 		Redacted:           conf.Redacted,
 		InternalRedactions: conf.InternalRedactions,
+		SecretDetector:     conf.SecretDetector,
 	}
 
 	succeeded, err := agentutil.ParallelWorkerExec(ctx, "sending test log", logs, logger.Task(),
 		func(log *testlog.TestLog) error {
-			return taskoutput.AppendTestLog(ctx, &conf.Task, opts, log, conf.S3Usage)
+			return appendTestLog(ctx, conf, opts, log)
 		},
 	)
 	if err != nil {
@@ -70,6 +78,87 @@ func sendTestLogsAndResults(ctx context.Context, comm client.Communicator, logge
 	return sendTestResults(ctx, comm, logger, conf, results)
 }
 
+// appendTestLog sends the test log to the task's test log storage or, when
+// running locally, writes it to the task's local output directory.
+func appendTestLog(ctx context.Context, conf *internal.TaskConfig, opts redactor.RedactionOptions, log *testlog.TestLog) error {
+	if conf.LocalOutputDir == "" {
+		return taskoutput.AppendTestLog(ctx, &conf.Task, opts, log, conf.S3Usage)
+	}
+
+	// Cleaning the name as an absolute path keeps the log within the logs
+	// directory even if the test name contains "..".
+	path := filepath.Join(conf.LocalOutputDir, localTestLogsDir, localTaskName(conf), filepath.Clean("/"+log.Name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "creating local directory for test log '%s'", log.Name)
+	}
+	contents := strings.Join(log.Lines, "\n") + "\n"
+	return errors.Wrapf(os.WriteFile(path, []byte(contents), 0644), "writing local test log '%s'", log.Name)
+}
+
+const (
+	// localTestResultsFile is the report within the task's local output
+	// directory that test results are appended to when running locally.
+	localTestResultsFile = "test_results.jsonl"
+	// localTestLogsDir is the directory within the task's local output
+	// directory that test logs are written to when running locally.
+	localTestLogsDir = "test_logs"
+)
+
+// localTestResult is a single line of the local test results report.
+type localTestResult struct {
+	Task    string `json:"task"`
+	Variant string `json:"variant"`
+	testresult.TestResult
+}
+
+// localTaskName returns the name of the task being run locally.
+func localTaskName(conf *internal.TaskConfig) string {
+	if name := conf.Expansions.Get("task_name"); name != "" {
+		return name
+	}
+	return conf.Task.DisplayName
+}
+
+// writeLocalTestResults appends the test results to the local test results
+// report, one JSON document per line, so that results from every task run
+// locally end up in a single report.
+func writeLocalTestResults(ctx context.Context, logger client.LoggerProducer, conf *internal.TaskConfig, results []testresult.TestResult) (err error) {
+	if err := os.MkdirAll(conf.LocalOutputDir, 0755); err != nil {
+		return errors.Wrap(err, "creating local output directory")
+	}
+	path := filepath.Join(conf.LocalOutputDir, localTestResultsFile)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "opening report '%s'", path)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil && err == nil {
+			err = errors.Wrapf(closeErr, "closing report '%s'", path)
+		}
+	}()
+
+	taskName := localTaskName(conf)
+	variant := conf.Expansions.Get("build_variant")
+	enc := json.NewEncoder(f)
+	var failedCount int
+	for _, result := range results {
+		if result.Status == evergreen.TestFailedStatus {
+			failedCount++
+		}
+		if err := enc.Encode(localTestResult{Task: taskName, Variant: variant, TestResult: result}); err != nil {
+			return errors.Wrapf(err, "writing result for test '%s'", result.GetDisplayTestName())
+		}
+	}
+
+	conf.HasTestResults = true
+	if failedCount > 0 {
+		conf.HasFailingTestResult = true
+	}
+	logger.Task().Infof(ctx, "Wrote %d test results (%d failed) to local report '%s'.", len(results), failedCount, path)
+
+	return nil
+}
+
 func attachTestResults(ctx context.Context, conf *internal.TaskConfig, td client.TaskData, comm client.Communicator, results []testresult.TestResult) error {
 	output, ok := conf.Task.GetTaskOutputSafe()
 	if !ok || output == nil {
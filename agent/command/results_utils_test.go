@@ -2,8 +2,11 @@ package command
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -14,9 +17,11 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testlog"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	resultTestutil "github.com/evergreen-ci/evergreen/model/testresult/testutil"
 	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/pail"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip/sometimes"
@@ -247,3 +252,43 @@ func TestMakeTestResultsTruncatesOversizedDisplayTestName(t *testing.T) {
 	assert.Len(t, results[2].DisplayTestName, maxDisplayTestNameLength)
 	assert.Equal(t, longName[:maxDisplayTestNameLength], results[2].DisplayTestName)
 }
+
+func TestSendTestLogsAndResultsToLocalOutputDir(t *testing.T) {
+	ctx := t.Context()
+	comm := client.NewMock("url")
+	conf := &internal.TaskConfig{
+		Expansions:     util.Expansions{"task_name": "test", "build_variant": "ubuntu"},
+		LocalOutputDir: t.TempDir(),
+	}
+	logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+	require.NoError(t, err)
+
+	logs := []testlog.TestLog{{Name: "../TestPass", Lines: []string{"line 1", "line 2"}}}
+	results := []testresult.TestResult{
+		{TestName: "TestPass", Status: evergreen.TestSucceededStatus},
+		{TestName: "TestFail", Status: evergreen.TestFailedStatus},
+	}
+	require.NoError(t, sendTestLogsAndResults(ctx, comm, logger, conf, logs, results))
+	require.NoError(t, sendTestResults(ctx, comm, logger, conf, results[:1]))
+	assert.True(t, conf.HasTestResults)
+	assert.True(t, conf.HasFailingTestResult)
+	assert.Empty(t, comm.LocalTestResults)
+
+	logContents, err := os.ReadFile(filepath.Join(conf.LocalOutputDir, localTestLogsDir, "test", "TestPass"))
+	require.NoError(t, err)
+	assert.Equal(t, "line 1\nline 2\n", string(logContents))
+
+	report, err := os.ReadFile(filepath.Join(conf.LocalOutputDir, localTestResultsFile))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(report)), "\n")
+	require.Len(t, lines, 3)
+	var names []string
+	for _, line := range lines {
+		result := localTestResult{}
+		require.NoError(t, json.Unmarshal([]byte(line), &result))
+		assert.Equal(t, "test", result.Task)
+		assert.Equal(t, "ubuntu", result.Variant)
+		names = append(names, result.TestName)
+	}
+	assert.Equal(t, []string{"TestPass", "TestFail", "TestPass"}, names)
+}
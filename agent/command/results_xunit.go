@@ -12,7 +12,6 @@ import (
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
 	"github.com/evergreen-ci/evergreen/agent/internal/redactor"
-	"github.com/evergreen-ci/evergreen/agent/internal/taskoutput"
 	agentutil "github.com/evergreen-ci/evergreen/agent/util"
 	"github.com/evergreen-ci/evergreen/model/testlog"
 	"github.com/evergreen-ci/evergreen/model/testresult"
@@ -251,11 +250,12 @@ func (c *xunitResults) parseAndUploadResults(ctx context.Context, conf *internal
 		Expansions:         conf.NewExpansions,
 		Redacted:           conf.Redacted,
 		InternalRedactions: conf.InternalRedactions,
+		SecretDetector:     conf.SecretDetector,
 	}
 
 	succeeded, err := agentutil.ParallelWorkerExec(ctx, "sending test log", indexedLogs, logger.Task(),
 		func(item *indexedLog) error {
-			err := taskoutput.AppendTestLog(ctx, &conf.Task, opts, item.log, conf.S3Usage)
+			err := appendTestLog(ctx, conf, opts, item.log)
 			if err == nil {
 				cumulative.tests[cumulative.logIdxToTestIdx[item.idx]].LineNum = 1
 			}
@@ -103,6 +103,10 @@ type s3get struct {
 
 	bucket pail.FastGetS3Bucket
 
+	// localBucket is true when the bucket is backed by the task's local
+	// output directory, in which case no AWS credentials are needed.
+	localBucket bool
+
 	taskData client.TaskData
 	base
 }
@@ -136,12 +140,15 @@ func (c *s3get) ParseParams(params map[string]any) error {
 func (c *s3get) validate() error {
 	catcher := grip.NewSimpleCatcher()
 
-	if c.RoleARN != "" {
+	switch {
+	case c.localBucket:
+		// AWS credentials are ignored when using a local bucket.
+	case c.RoleARN != "":
 		// When using the role ARN, there should be no provided AWS credentials.
 		catcher.NewWhen(c.AwsKey != "", "AWS key must be empty when using role ARN")
 		catcher.NewWhen(c.AwsSecret != "", "AWS secret must be empty when using role ARN")
 		catcher.NewWhen(c.AwsSessionToken != "", "AWS session token must be empty when using role ARN")
-	} else {
+	default:
 		catcher.NewWhen(c.AwsKey == "", "AWS key cannot be blank")
 		catcher.NewWhen(c.AwsSecret == "", "AWS secret cannot be blank")
 	}
@@ -190,6 +197,8 @@ func (c *s3get) Execute(ctx context.Context, comm client.Communicator, logger cl
 		return errors.Wrap(err, "expanding params")
 	}
 
+	c.localBucket = conf.LocalOutputDir != ""
+
 	// validate the params
 	if err := c.validate(); err != nil {
 		return errors.Wrap(err, "validating expanded params")
@@ -218,11 +227,19 @@ func (c *s3get) Execute(ctx context.Context, comm client.Communicator, logger cl
 	)
 
 	// create pail bucket
-	httpClient := utility.GetHTTPClient()
-	httpClient.Timeout = s3HTTPClientTimeout
-	defer utility.PutHTTPClient(httpClient)
-	if err := c.createPailBucket(ctx, comm, httpClient); err != nil {
-		return errors.Wrap(err, "creating S3 bucket")
+	if c.localBucket {
+		bucket, err := newLocalS3Bucket(conf, c.Bucket)
+		if err != nil {
+			return errors.Wrap(err, "creating local bucket")
+		}
+		c.bucket = localFastGetBucket{Bucket: bucket}
+	} else {
+		httpClient := utility.GetHTTPClient()
+		httpClient.Timeout = s3HTTPClientTimeout
+		defer utility.PutHTTPClient(httpClient)
+		if err := c.createPailBucket(ctx, comm, httpClient); err != nil {
+			return errors.Wrap(err, "creating S3 bucket")
+		}
 	}
 
 	if err := c.bucket.Check(ctx); err != nil {
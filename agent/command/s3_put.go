@@ -176,6 +176,10 @@ type s3put struct {
 
 	bucket pail.Bucket
 
+	// localBucket is true when the bucket is backed by the task's local
+	// output directory, in which case no AWS credentials are needed.
+	localBucket bool
+
 	taskData client.TaskData
 	base
 }
@@ -203,12 +207,15 @@ func (s3pc *s3put) ParseParams(params map[string]any) error {
 func (s3pc *s3put) validate() error {
 	catcher := grip.NewSimpleCatcher()
 
-	if s3pc.RoleARN != "" {
+	switch {
+	case s3pc.localBucket:
+		// AWS credentials are ignored when using a local bucket.
+	case s3pc.RoleARN != "":
 		// When using the role ARN, there should be no provided AWS credentials.
 		catcher.NewWhen(s3pc.AwsKey != "", "AWS key must be empty when using role ARN")
 		catcher.NewWhen(s3pc.AwsSecret != "", "AWS secret must be empty when using role ARN")
 		catcher.NewWhen(s3pc.AwsSessionToken != "", "AWS session token must be empty when using role ARN")
-	} else {
+	default:
 		catcher.NewWhen(s3pc.AwsKey == "", "AWS key cannot be blank")
 		catcher.NewWhen(s3pc.AwsSecret == "", "AWS secret cannot be blank")
 	}
@@ -335,6 +342,8 @@ func (s3pc *s3put) Execute(ctx context.Context, comm client.Communicator, logger
 		return errors.WithStack(err)
 	}
 
+	s3pc.localBucket = conf.LocalOutputDir != ""
+
 	// re-validate command here, in case an expansion is not defined
 	if err := s3pc.validate(); err != nil {
 		return errors.Wrap(err, "validating expanded parameters")
@@ -399,6 +408,13 @@ func (s3pc *s3put) Execute(ctx context.Context, comm client.Communicator, logger
 	)
 
 	// create pail bucket
+	if s3pc.localBucket && s3pc.bucket == nil {
+		bucket, err := newLocalS3Bucket(conf, s3pc.Bucket)
+		if err != nil {
+			return errors.Wrap(err, "creating local bucket")
+		}
+		s3pc.bucket = bucket
+	}
 	httpClient := utility.GetHTTPClient()
 	httpClient.Timeout = s3HTTPClientTimeout
 	defer utility.PutHTTPClient(httpClient)
@@ -596,23 +612,26 @@ retryLoop:
 		return nil
 	}
 
-	maxPuts, minPuts := s3usage.ComputePerFileExtremes(uploadedFiles)
-	conf.S3Usage.IncrementArtifacts(s3usage.ArtifactIncrementOptions{
-		PutRequests:               totalRetryPuts,
-		UploadBytes:               totalFileSize,
-		FileCount:                 len(uploadedFiles),
-		MaxPuts:                   maxPuts,
-		MinPuts:                   minPuts,
-		Bucket:                    s3pc.Bucket,
-		AWSRoleARN:                s3pc.getRoleARN(),
-		AWSAccountID:              s3pc.resolvedAWSAccountID,
-		Files:                     uploadedFiles,
-		DevprodOwnedAWSAccountIDs: conf.DevprodOwnedAWSAccountIDs,
-	})
+	if s3pc.localBucket {
+		logger.Task().Infof(ctx, "Not attaching uploaded files to the task because S3 is backed by local directory '%s'.", filepath.Join(conf.LocalOutputDir, localS3Dir))
+	} else {
+		maxPuts, minPuts := s3usage.ComputePerFileExtremes(uploadedFiles)
+		conf.S3Usage.IncrementArtifacts(s3usage.ArtifactIncrementOptions{
+			PutRequests:               totalRetryPuts,
+			UploadBytes:               totalFileSize,
+			FileCount:                 len(uploadedFiles),
+			MaxPuts:                   maxPuts,
+			MinPuts:                   minPuts,
+			Bucket:                    s3pc.Bucket,
+			AWSRoleARN:                s3pc.getRoleARN(),
+			AWSAccountID:              s3pc.resolvedAWSAccountID,
+			Files:                     uploadedFiles,
+			DevprodOwnedAWSAccountIDs: conf.DevprodOwnedAWSAccountIDs,
+		})
 
-	err = errors.WithStack(s3pc.attachFiles(ctx, comm, uploadedFiles))
-	if err != nil {
-		return err
+		if err = s3pc.attachFiles(ctx, comm, uploadedFiles); err != nil {
+			return errors.WithStack(err)
+		}
 	}
 
 	logger.Task().WarningWhen(ctx, strings.Contains(s3pc.Bucket, "."), "Bucket names containing dots that are created after Sept. 30, 2020 are not guaranteed to have valid attached URLs.")
@@ -2,6 +2,9 @@ package command
 
 import (
 	"context"
+	"io"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -12,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/pail"
 	"github.com/evergreen-ci/utility"
 	"github.com/jpillora/backoff"
 	"github.com/pkg/errors"
@@ -22,6 +26,10 @@ const (
 	s3HTTPClientTimeout = 60 * time.Minute
 	s3OpSleep           = 2 * time.Second
 	s3OpRetryMaxSleep   = 20 * time.Second
+
+	// localS3Dir is the directory within the task's local output directory
+	// that holds the local stand-ins for S3 buckets.
+	localS3Dir = "s3"
 )
 
 var (
@@ -131,3 +139,35 @@ func resolveAWSAccountIDFromStaticCredentials(ctx context.Context, awsKey, awsSe
 	}
 	return *output.Account, nil
 }
+
+// newLocalS3Bucket returns a bucket backed by a directory within the task's
+// local output directory, which is used in place of the S3 bucket with the
+// given name when running tasks locally.
+func newLocalS3Bucket(conf *internal.TaskConfig, bucketName string) (pail.Bucket, error) {
+	path := filepath.Join(conf.LocalOutputDir, localS3Dir, bucketName)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, errors.Wrapf(err, "creating local directory for bucket '%s'", bucketName)
+	}
+	bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: path})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating local bucket '%s'", bucketName)
+	}
+	return bucket, nil
+}
+
+// localFastGetBucket adapts a local bucket to the interface used by s3.get.
+type localFastGetBucket struct {
+	pail.Bucket
+}
+
+// GetToWriter copies the contents of the key to the writer.
+func (b localFastGetBucket) GetToWriter(ctx context.Context, key string, w io.WriterAt) error {
+	r, err := b.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	_, err = io.Copy(io.NewOffsetWriter(w, 0), r)
+	return errors.Wrapf(err, "copying key '%s'", key)
+}
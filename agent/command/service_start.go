@@ -0,0 +1,409 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/google/shlex"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultServiceHealthCheckIntervalSecs = 1
+	defaultServiceHealthCheckTimeoutSecs  = 60
+	defaultServiceStopTimeoutSecs         = 10
+)
+
+// serviceStart starts a long-running process, such as a database or mock
+// server, in the background and waits for it to become healthy. The service
+// is stopped when the task finishes or, if it is started in a task group's
+// setup group, when the task group finishes.
+type serviceStart struct {
+	Binary  string   `mapstructure:"binary" plugin:"expand"`
+	Args    []string `mapstructure:"args" plugin:"expand"`
+	Command string   `mapstructure:"command"`
+
+	Env                    map[string]string `mapstructure:"env" plugin:"expand"`
+	AddExpansionsToEnv     bool              `mapstructure:"add_expansions_to_env"`
+	IncludeExpansionsInEnv []string          `mapstructure:"include_expansions_in_env"`
+	AddToPath              []string          `mapstructure:"add_to_path" plugin:"expand"`
+
+	// WorkingDir is the working directory to start the service in.
+	WorkingDir string `mapstructure:"working_dir" plugin:"expand"`
+
+	// LogPrefix is prepended to every line of the service's output in the
+	// task logs. Defaults to the name of the binary.
+	LogPrefix string `mapstructure:"log_prefix" plugin:"expand"`
+
+	// HealthCheck determines when the service is ready to use. If no health
+	// check is given, the command returns as soon as the service starts.
+	HealthCheck serviceHealthCheck `mapstructure:"health_check" plugin:"expand"`
+
+	// StopTimeoutSecs is how long to wait for the service to exit after
+	// it's asked to stop before it's killed.
+	StopTimeoutSecs int `mapstructure:"stop_timeout_secs"`
+
+	base
+}
+
+// serviceHealthCheck is a check that passes once the service is ready. Only
+// one of TCP, HTTP, or Command may be set.
+type serviceHealthCheck struct {
+	// TCP is an address in host:port form that accepts connections once the
+	// service is ready.
+	TCP string `mapstructure:"tcp" plugin:"expand"`
+	// HTTP is a URL that responds to GET requests once the service is ready.
+	HTTP string `mapstructure:"http" plugin:"expand"`
+	// ExpectedStatus is the status code the HTTP check must return. Defaults
+	// to any 2xx status code.
+	ExpectedStatus int `mapstructure:"expected_status"`
+	// Command is a command string that exits successfully once the service
+	// is ready.
+	Command string `mapstructure:"command" plugin:"expand"`
+
+	IntervalSecs int `mapstructure:"interval_secs"`
+	TimeoutSecs  int `mapstructure:"timeout_secs"`
+}
+
+func serviceStartFactory() Command   { return &serviceStart{} }
+func (c *serviceStart) Name() string { return "service.start" }
+
+func (c *serviceStart) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	if c.Command != "" {
+		if c.Binary != "" || len(c.Args) > 0 {
+			return errors.New("must specify command as either binary and arguments, or a command string, but not both")
+		}
+
+		args, err := shlex.Split(c.Command)
+		if err != nil {
+			return errors.Wrapf(err, "parsing command using shell lexing rules")
+		}
+		if len(args) == 0 {
+			return errors.Errorf("command could not be split using shell lexing rules")
+		}
+
+		c.Binary = args[0]
+		if len(args) > 1 {
+			c.Args = args[1:]
+		}
+	}
+
+	return c.validate()
+}
+
+func (c *serviceStart) validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.Binary == "", "must specify a command or binary to run")
+	catcher.NewWhen(c.StopTimeoutSecs < 0, "stop timeout cannot be negative")
+
+	hc := c.HealthCheck
+	numChecks := 0
+	for _, check := range []string{hc.TCP, hc.HTTP, hc.Command} {
+		if check != "" {
+			numChecks++
+		}
+	}
+	catcher.NewWhen(numChecks > 1, "can only specify one of a TCP, HTTP, or command health check")
+	catcher.NewWhen(hc.ExpectedStatus != 0 && hc.HTTP == "", "can only specify an expected status for an HTTP health check")
+	catcher.NewWhen(hc.IntervalSecs < 0, "health check interval cannot be negative")
+	catcher.NewWhen(hc.TimeoutSecs < 0, "health check timeout cannot be negative")
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	if c.Env == nil {
+		c.Env = map[string]string{}
+	}
+	if c.StopTimeoutSecs == 0 {
+		c.StopTimeoutSecs = defaultServiceStopTimeoutSecs
+	}
+	if c.HealthCheck.IntervalSecs == 0 {
+		c.HealthCheck.IntervalSecs = defaultServiceHealthCheckIntervalSecs
+	}
+	if c.HealthCheck.TimeoutSecs == 0 {
+		c.HealthCheck.TimeoutSecs = defaultServiceHealthCheckTimeoutSecs
+	}
+
+	return nil
+}
+
+func (c *serviceStart) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if c.LogPrefix == "" {
+		c.LogPrefix = filepath.Base(c.Binary)
+	}
+
+	var err error
+	c.WorkingDir, err = getWorkingDirectoryLegacy(conf, c.WorkingDir)
+	if err != nil {
+		return errors.Wrap(err, "getting working directory")
+	}
+	taskTmpDir, err := getWorkingDirectoryLegacy(conf, "tmp")
+	if err != nil {
+		logger.Execution().Notice(ctx, errors.Wrap(err, "getting temporary directory"))
+	}
+
+	serviceID := utility.RandomString()
+	c.Env = defaultAndApplyExpansionsToEnv(c.Env, modifyEnvOptions{
+		taskID:                 conf.Task.Id,
+		workingDir:             c.WorkingDir,
+		tmpDir:                 taskTmpDir,
+		expansions:             conf.Expansions,
+		includeExpansionsInEnv: c.IncludeExpansionsInEnv,
+		addExpansionsToEnv:     c.AddExpansionsToEnv,
+		addToPath:              c.AddToPath,
+	})
+	c.Env[agentutil.MarkerServiceID] = serviceID
+
+	// The service outlives this command, so it's tied to its own context
+	// rather than the command's. Canceling it before the service is stopped
+	// marks the service's exit as expected teardown rather than a failure.
+	procCtx, cancelProc := context.WithCancel(context.WithoutCancel(ctx))
+	cmd, proc, err := c.start(procCtx, conf, logger)
+	if err != nil {
+		cancelProc()
+		return errors.Wrapf(err, "starting service '%s'", c.LogPrefix)
+	}
+
+	conf.AddCommandCleanup(c.FullDisplayName(), func(ctx context.Context) error {
+		cancelProc()
+		return errors.Wrapf(c.stop(ctx, cmd, proc, serviceID, logger), "stopping service '%s'", c.LogPrefix)
+	})
+
+	logger.Task().Infof(ctx, "Started service '%s' with PID %d.", c.LogPrefix, proc.Info(ctx).PID)
+
+	check := c.healthCheck(conf)
+	if check == nil {
+		return nil
+	}
+	logger.Task().Infof(ctx, "Waiting up to %d seconds for service '%s' to become healthy.", c.HealthCheck.TimeoutSecs, c.LogPrefix)
+	if err := c.waitForHealthy(ctx, proc, check); err != nil {
+		return errors.Wrapf(err, "waiting for service '%s' to become healthy", c.LogPrefix)
+	}
+	logger.Task().Infof(ctx, "Service '%s' is healthy.", c.LogPrefix)
+
+	return nil
+}
+
+// start launches the service in the background and returns the command and
+// process running it.
+func (c *serviceStart) start(ctx context.Context, conf *internal.TaskConfig, logger client.LoggerProducer) (*jasper.Command, jasper.Process, error) {
+	var proc jasper.Process
+	cmd := c.JasperManager().CreateCommand(ctx).Add(append([]string{c.Binary}, c.Args...)).
+		Background(true).Environment(c.Env).Directory(c.WorkingDir).
+		AppendTags(c.FullDisplayName()).
+		ProcConstructor(func(lctx context.Context, opts *options.Create) (jasper.Process, error) {
+			var err error
+			proc, err = runJasperProcessWithContainer(lctx, opts, c.FullDisplayName(), c.WorkingDir, conf, c.JasperManager(), true, logger, conf.Task.Id, conf.BackgroundFailures, false, conf.BackgroundCommandFailureEnabled)
+			return proc, err
+		})
+
+	prefix := fmt.Sprintf("[%s] ", c.LogPrefix)
+	cmd.SetOutputSender(level.Info, newPrefixedSender(logger.Task().GetSender(), prefix))
+	cmd.SetErrorSender(level.Error, newPrefixedSender(logger.Task().GetSender(), prefix))
+
+	if conf.Distro != nil {
+		if execUser := conf.Distro.ExecUser; execUser != "" {
+			cmd.SudoAs(execUser)
+		}
+	}
+
+	if err := cmd.Run(ctx); err != nil {
+		return nil, nil, err
+	}
+	if proc == nil {
+		return nil, nil, errors.New("service process was not created")
+	}
+
+	return cmd, proc, nil
+}
+
+// waitForHealthy polls the health check until it passes. It errors if the
+// service exits or the health check does not pass before the timeout.
+func (c *serviceStart) waitForHealthy(ctx context.Context, proc jasper.Process, check func(context.Context) error) error {
+	timeout := time.Duration(c.HealthCheck.TimeoutSecs) * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := time.Duration(c.HealthCheck.IntervalSecs) * time.Second
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	var checkErr error
+	for {
+		select {
+		case <-ctx.Done():
+			if checkErr == nil {
+				checkErr = ctx.Err()
+			}
+			return errors.Wrapf(checkErr, "health check did not pass within %s", timeout)
+		case <-timer.C:
+			if proc.Complete(ctx) {
+				return errors.Errorf("service exited with code %d before becoming healthy", proc.Info(ctx).ExitCode)
+			}
+			attemptCtx, attemptCancel := context.WithTimeout(ctx, interval)
+			checkErr = check(attemptCtx)
+			attemptCancel()
+			if checkErr == nil {
+				return nil
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// healthCheck returns the function that checks whether the service is
+// healthy, or nil if the service has no health check.
+func (c *serviceStart) healthCheck(conf *internal.TaskConfig) func(context.Context) error {
+	hc := c.HealthCheck
+	switch {
+	case hc.TCP != "":
+		return func(ctx context.Context) error {
+			var d net.Dialer
+			conn, err := d.DialContext(ctx, "tcp", hc.TCP)
+			if err != nil {
+				return errors.Wrapf(err, "connecting to '%s'", hc.TCP)
+			}
+			return errors.Wrap(conn.Close(), "closing connection")
+		}
+	case hc.HTTP != "":
+		return func(ctx context.Context) error {
+			return checkServiceHTTPHealth(ctx, hc.HTTP, hc.ExpectedStatus)
+		}
+	case hc.Command != "":
+		return func(ctx context.Context) error {
+			return c.runHealthCheckCommand(ctx, conf)
+		}
+	default:
+		return nil
+	}
+}
+
+func checkServiceHTTPHealth(ctx context.Context, url string, expectedStatus int) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrapf(err, "creating request for '%s'", url)
+	}
+
+	httpClient := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(httpClient)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "requesting '%s'", url)
+	}
+	defer resp.Body.Close()
+
+	if expectedStatus != 0 && resp.StatusCode != expectedStatus {
+		return errors.Errorf("'%s' returned status %d, expected %d", url, resp.StatusCode, expectedStatus)
+	}
+	if expectedStatus == 0 && (resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices) {
+		return errors.Errorf("'%s' returned non-success status %d", url, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *serviceStart) runHealthCheckCommand(ctx context.Context, conf *internal.TaskConfig) error {
+	args, err := shlex.Split(c.HealthCheck.Command)
+	if err != nil {
+		return errors.Wrap(err, "parsing health check command using shell lexing rules")
+	}
+	if len(args) == 0 {
+		return errors.New("health check command could not be split using shell lexing rules")
+	}
+
+	cmd := c.JasperManager().CreateCommand(ctx).Add(args).
+		Environment(c.Env).Directory(c.WorkingDir).
+		SuppressStandardOutput(true).SuppressStandardError(true).
+		ProcConstructor(func(lctx context.Context, opts *options.Create) (jasper.Process, error) {
+			if conf.ContainerID != "" {
+				if err := agentutil.WrapWithContainer(lctx, opts, conf.ContainerID, c.WorkingDir, conf.EnvFileHostDir); err != nil {
+					return nil, errors.Wrap(err, "wrapping command for container execution")
+				}
+			}
+			return c.JasperManager().CreateProcess(lctx, opts)
+		})
+	if conf.Distro != nil {
+		if execUser := conf.Distro.ExecUser; execUser != "" {
+			cmd.SudoAs(execUser)
+		}
+	}
+
+	return errors.Wrap(cmd.Run(ctx), "running health check command")
+}
+
+// stop asks the service to exit, kills it if it does not exit in time, and
+// then kills any processes it left behind.
+func (c *serviceStart) stop(ctx context.Context, cmd *jasper.Command, proc jasper.Process, serviceID string, logger client.LoggerProducer) error {
+	catcher := grip.NewBasicCatcher()
+	if proc.Running(ctx) {
+		catcher.Wrap(jasper.Terminate(ctx, proc), "sending terminate signal")
+
+		waitCtx, cancel := context.WithTimeout(ctx, time.Duration(c.StopTimeoutSecs)*time.Second)
+		_, _ = proc.Wait(waitCtx)
+		cancel()
+
+		if proc.Running(ctx) {
+			catcher.Wrap(jasper.Kill(ctx, proc), "sending kill signal")
+		}
+	}
+
+	// The service may have started processes of its own that do not exit
+	// along with it.
+	journaler := grip.NewJournaler(c.Name())
+	if !logger.Closed() {
+		journaler = logger.Execution()
+	}
+	catcher.Wrap(agentutil.KillServiceProcs(ctx, serviceID, journaler), "cleaning up service processes")
+
+	// Background commands are not closed when their process exits, so the
+	// service's last lines of output are only flushed here.
+	catcher.Wrap(cmd.Close(), "flushing service output")
+
+	if !logger.Closed() {
+		logger.Task().Infof(ctx, "Stopped service '%s'.", c.LogPrefix)
+	}
+
+	return catcher.Resolve()
+}
+
+// prefixedSender prepends a fixed prefix to every message it sends so that
+// the output of a service can be told apart from other task output.
+type prefixedSender struct {
+	prefix string
+	send.Sender
+}
+
+func newPrefixedSender(sender send.Sender, prefix string) send.Sender {
+	return &prefixedSender{prefix: prefix, Sender: sender}
+}
+
+func (s *prefixedSender) Send(ctx context.Context, m message.Composer) {
+	if !m.Loggable() {
+		return
+	}
+	s.Sender.Send(ctx, message.NewDefaultMessage(m.Priority(), s.prefix+m.String()))
+}
@@ -0,0 +1,250 @@
+package command
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/jasper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceStartParseParams(t *testing.T) {
+	for tName, tCase := range map[string]func(t *testing.T, cmd *serviceStart){
+		"FailsWithEmptyParams": func(t *testing.T, cmd *serviceStart) {
+			assert.Error(t, cmd.ParseParams(map[string]any{}))
+		},
+		"FailsWithBothCommandAndBinary": func(t *testing.T, cmd *serviceStart) {
+			assert.Error(t, cmd.ParseParams(map[string]any{
+				"command": "mongod --port 27017",
+				"binary":  "mongod",
+			}))
+		},
+		"FailsWithMultipleHealthChecks": func(t *testing.T, cmd *serviceStart) {
+			assert.Error(t, cmd.ParseParams(map[string]any{
+				"command": "mongod --port 27017",
+				"health_check": map[string]any{
+					"tcp":  "localhost:27017",
+					"http": "http://localhost:27017",
+				},
+			}))
+		},
+		"FailsWithExpectedStatusForNonHTTPCheck": func(t *testing.T, cmd *serviceStart) {
+			assert.Error(t, cmd.ParseParams(map[string]any{
+				"command": "mongod --port 27017",
+				"health_check": map[string]any{
+					"tcp":             "localhost:27017",
+					"expected_status": 200,
+				},
+			}))
+		},
+		"FailsWithNegativeTimeout": func(t *testing.T, cmd *serviceStart) {
+			assert.Error(t, cmd.ParseParams(map[string]any{
+				"command": "mongod --port 27017",
+				"health_check": map[string]any{
+					"tcp":          "localhost:27017",
+					"timeout_secs": -1,
+				},
+			}))
+		},
+		"SucceedsWithCommandAndSetsDefaults": func(t *testing.T, cmd *serviceStart) {
+			require.NoError(t, cmd.ParseParams(map[string]any{
+				"command": "mongod --port 27017",
+				"health_check": map[string]any{
+					"tcp": "localhost:27017",
+				},
+			}))
+			assert.Equal(t, "mongod", cmd.Binary)
+			assert.Equal(t, []string{"--port", "27017"}, cmd.Args)
+			assert.Equal(t, "localhost:27017", cmd.HealthCheck.TCP)
+			assert.Equal(t, defaultServiceHealthCheckIntervalSecs, cmd.HealthCheck.IntervalSecs)
+			assert.Equal(t, defaultServiceHealthCheckTimeoutSecs, cmd.HealthCheck.TimeoutSecs)
+			assert.Equal(t, defaultServiceStopTimeoutSecs, cmd.StopTimeoutSecs)
+			assert.NotNil(t, cmd.Env)
+		},
+		"SucceedsWithoutHealthCheck": func(t *testing.T, cmd *serviceStart) {
+			require.NoError(t, cmd.ParseParams(map[string]any{
+				"binary": "mongod",
+				"args":   []string{"--port", "27017"},
+			}))
+			assert.Equal(t, "mongod", cmd.Binary)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			cmd, ok := serviceStartFactory().(*serviceStart)
+			require.True(t, ok)
+
+			tCase(t, cmd)
+		})
+	}
+}
+
+func TestServiceStartExecute(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("services in this test are shell scripts")
+	}
+
+	jpm, err := jasper.NewSynchronizedManager(false)
+	require.NoError(t, err)
+
+	// closedAddress returns an address that nothing is listening on.
+	closedAddress := func(t *testing.T) string {
+		l, err := net.Listen("tcp", "localhost:0")
+		require.NoError(t, err)
+		addr := l.Addr().String()
+		require.NoError(t, l.Close())
+		return addr
+	}
+	// servicePID returns the PID that the service wrote to its PID file.
+	servicePID := func(t *testing.T, conf *internal.TaskConfig) int {
+		var pid int
+		require.Eventually(t, func() bool {
+			contents, err := os.ReadFile(filepath.Join(conf.WorkDir, "pid"))
+			if err != nil {
+				return false
+			}
+			pid, err = strconv.Atoi(strings.TrimSpace(string(contents)))
+			return err == nil
+		}, 10*time.Second, 50*time.Millisecond)
+		return pid
+	}
+	isRunning := func(pid int) bool {
+		return syscall.Kill(pid, 0) == nil
+	}
+	// sleepingService is a service that records its PID and then runs until
+	// it's stopped.
+	sleepingService := []string{"-c", "echo $$ > pid; echo started; exec sleep 60"}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, cmd *serviceStart, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig){
+		"SucceedsWithTCPHealthCheckAndStopsAtCleanup": func(ctx context.Context, t *testing.T, cmd *serviceStart, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			l, err := net.Listen("tcp", "localhost:0")
+			require.NoError(t, err)
+			defer l.Close()
+			cmd.HealthCheck.TCP = l.Addr().String()
+
+			require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+			pid := servicePID(t, conf)
+			assert.True(t, isRunning(pid))
+
+			require.Len(t, conf.CommandCleanups, 1)
+			cleanup := conf.CommandCleanups[0]
+			assert.Equal(t, "service.start", cleanup.Command)
+			require.NoError(t, cleanup.Run(ctx))
+			assert.False(t, isRunning(pid), "service should be stopped by its cleanup")
+		},
+		"SucceedsWithHTTPHealthCheck": func(ctx context.Context, t *testing.T, cmd *serviceStart, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer srv.Close()
+			cmd.HealthCheck.HTTP = srv.URL
+
+			assert.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+		},
+		"SucceedsWithCommandHealthCheck": func(ctx context.Context, t *testing.T, cmd *serviceStart, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			cmd.HealthCheck.Command = "test -f pid"
+
+			require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+			assert.FileExists(t, filepath.Join(conf.WorkDir, "pid"))
+		},
+		"PrefixesServiceOutput": func(ctx context.Context, t *testing.T, cmd *serviceStart, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			cmd.LogPrefix = "my-service"
+			cmd.HealthCheck.Command = "test -f pid"
+
+			require.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+
+			// Short output is buffered until the service is stopped.
+			require.Len(t, conf.CommandCleanups, 1)
+			require.NoError(t, conf.CommandCleanups[0].Run(ctx))
+			assert.Eventually(t, func() bool {
+				for _, line := range comm.GetTaskLogs(conf.Task.Id) {
+					if line.Data == "[my-service] started" {
+						return true
+					}
+				}
+				return false
+			}, 10*time.Second, 50*time.Millisecond)
+		},
+		"FailsWhenHealthCheckTimesOut": func(ctx context.Context, t *testing.T, cmd *serviceStart, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			cmd.HealthCheck.TCP = closedAddress(t)
+			cmd.HealthCheck.TimeoutSecs = 1
+
+			err := cmd.Execute(ctx, comm, logger, conf)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "health check did not pass")
+
+			pid := servicePID(t, conf)
+			require.Len(t, conf.CommandCleanups, 1, "service should still be stopped after failing")
+			require.NoError(t, conf.CommandCleanups[0].Run(ctx))
+			assert.False(t, isRunning(pid))
+		},
+		"FailsWhenHTTPStatusIsUnexpected": func(ctx context.Context, t *testing.T, cmd *serviceStart, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+			cmd.HealthCheck.HTTP = srv.URL
+			cmd.HealthCheck.ExpectedStatus = http.StatusOK
+			cmd.HealthCheck.TimeoutSecs = 1
+
+			err := cmd.Execute(ctx, comm, logger, conf)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "returned status 503, expected 200")
+		},
+		"FailsWhenServiceExitsBeforeHealthy": func(ctx context.Context, t *testing.T, cmd *serviceStart, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			cmd.Args = []string{"-c", "exit 3"}
+			cmd.HealthCheck.TCP = closedAddress(t)
+
+			err := cmd.Execute(ctx, comm, logger, conf)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "service exited with code 3 before becoming healthy")
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+			defer cancel()
+
+			conf := &internal.TaskConfig{
+				Expansions: util.Expansions{},
+				Distro:     &apimodels.DistroView{},
+				Task:       task.Task{Id: "task_id"},
+				WorkDir:    t.TempDir(),
+			}
+			comm := client.NewMock("url")
+			logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+			require.NoError(t, err)
+
+			cmd := &serviceStart{
+				Binary: "sh",
+				Args:   sleepingService,
+			}
+			cmd.SetFullDisplayName("service.start")
+			cmd.SetJasperManager(jpm)
+			require.NoError(t, cmd.validate())
+			cmd.HealthCheck.IntervalSecs = 1
+
+			defer func() {
+				for _, cleanup := range conf.GetAndClearCommandCleanups() {
+					assert.NoError(t, cleanup.Run(context.Background()))
+				}
+			}()
+
+			tCase(ctx, t, cmd, comm, logger, conf)
+		})
+	}
+}
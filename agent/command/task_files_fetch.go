@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+	"os"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/taskfiles"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// taskFilesFetch downloads files that a dependency published with
+// task_files.publish and extracts them into the working directory. The
+// dependency must be listed in the task's depends_on.
+type taskFilesFetch struct {
+	// FilesName is the name the files were published under.
+	FilesName string `mapstructure:"name" plugin:"expand"`
+
+	// Task is the name of the dependency that published the files.
+	Task string `mapstructure:"task" plugin:"expand"`
+
+	// Variant is the build variant of the dependency. Defaults to the
+	// current task's build variant.
+	Variant string `mapstructure:"variant" plugin:"expand"`
+
+	// Destination is the directory, relative to the working directory, to
+	// extract the files into. Defaults to the working directory.
+	Destination string `mapstructure:"destination" plugin:"expand"`
+
+	// PreserveSymlinks extracts symlinks in the archive as symlinks.
+	PreserveSymlinks bool `mapstructure:"preserve_symlinks"`
+
+	base
+}
+
+func taskFilesFetchFactory() Command   { return &taskFilesFetch{} }
+func (c *taskFilesFetch) Name() string { return evergreen.TaskFilesFetchCommandName }
+
+func (c *taskFilesFetch) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+	return errors.Wrap(c.validate(), "validating params")
+}
+
+func (c *taskFilesFetch) validate() error {
+	catcher := grip.NewSimpleCatcher()
+	catcher.Add(taskfiles.ValidateName(c.FilesName))
+	catcher.NewWhen(c.Task == "", "must specify the task that published the files")
+	return catcher.Resolve()
+}
+
+func (c *taskFilesFetch) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if err := c.validate(); err != nil {
+		return errors.Wrap(err, "validating expanded params")
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	url, err := comm.GetTaskFilesDownloadURL(ctx, td, apimodels.TaskFilesFetchRequest{
+		Name:    c.FilesName,
+		Task:    c.Task,
+		Variant: c.Variant,
+	})
+	if err != nil {
+		return errors.Wrap(err, "getting download URL")
+	}
+
+	localPath, err := createTempCacheArchive(conf.WorkDir)
+	if err != nil {
+		return errors.Wrap(err, "creating local archive file")
+	}
+	defer func() {
+		logger.Task().Error(ctx, errors.Wrapf(os.Remove(localPath), "removing local archive '%s'", localPath))
+	}()
+
+	logger.Task().Infof(ctx, "Downloading task files '%s' from task '%s'.", c.FilesName, c.Task)
+	if err := downloadTaskFilesArchive(ctx, logger.Task(), url, localPath); err != nil {
+		return errors.Wrapf(err, "downloading task files '%s'", c.FilesName)
+	}
+
+	dest := GetWorkingDirectory(conf, c.Destination)
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return errors.Wrapf(err, "creating destination directory '%s'", dest)
+	}
+	f, err := os.Open(localPath)
+	if err != nil {
+		return errors.Wrapf(err, "opening archive '%s'", localPath)
+	}
+	defer f.Close()
+	if err := extractTarball(ctx, f, dest, []string{}, c.PreserveSymlinks); err != nil {
+		return errors.Wrapf(err, "extracting task files '%s'", c.FilesName)
+	}
+
+	logger.Task().Infof(ctx, "Extracted task files '%s' into '%s'.", c.FilesName, dest)
+	return nil
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskFilesFetchParseParams(t *testing.T) {
+	t.Run("ValidParamsAccepted", func(t *testing.T) {
+		c := &taskFilesFetch{}
+		require.NoError(t, c.ParseParams(map[string]any{
+			"name":        "binaries",
+			"task":        "compile",
+			"variant":     "ubuntu",
+			"destination": "deps",
+		}))
+		assert.Equal(t, "binaries", c.FilesName)
+		assert.Equal(t, "compile", c.Task)
+		assert.Equal(t, "ubuntu", c.Variant)
+		assert.Equal(t, "deps", c.Destination)
+	})
+	t.Run("MissingNameRejected", func(t *testing.T) {
+		c := &taskFilesFetch{}
+		assert.Error(t, c.ParseParams(map[string]any{
+			"task": "compile",
+		}))
+	})
+	t.Run("MissingTaskRejected", func(t *testing.T) {
+		c := &taskFilesFetch{}
+		assert.Error(t, c.ParseParams(map[string]any{
+			"name": "binaries",
+		}))
+	})
+}
+
+func TestTaskFilesFetchFailsWhenDownloadIsRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	ctx := t.Context()
+	comm := client.NewMock("url")
+	comm.TaskFilesURL = srv.URL
+	conf := &internal.TaskConfig{
+		Task:    task.Task{Id: "consumer"},
+		WorkDir: t.TempDir(),
+	}
+	logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+	require.NoError(t, err)
+
+	c := &taskFilesFetch{FilesName: "binaries", Task: "compile"}
+	err = c.Execute(ctx, comm, logger, conf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "got status 403")
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"os"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/taskfiles"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// taskFilesPublish bundles paths into a tarball and uploads it to
+// Evergreen-managed storage under a name, so that tasks which depend on this
+// task can download it with task_files.fetch.
+type taskFilesPublish struct {
+	// FilesName identifies the files to the dependent tasks that fetch them.
+	FilesName string `mapstructure:"name" plugin:"expand"`
+
+	// Paths are file or directory paths, relative to the working directory, to
+	// bundle into the tarball.
+	Paths []string `mapstructure:"paths" plugin:"expand"`
+
+	// PreserveSymlinks archives symlinks as symlinks rather than the files
+	// they point to.
+	PreserveSymlinks bool `mapstructure:"preserve_symlinks"`
+
+	base
+}
+
+func taskFilesPublishFactory() Command   { return &taskFilesPublish{} }
+func (c *taskFilesPublish) Name() string { return evergreen.TaskFilesPublishCommandName }
+
+func (c *taskFilesPublish) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+	return errors.Wrap(c.validate(), "validating params")
+}
+
+func (c *taskFilesPublish) validate() error {
+	catcher := grip.NewSimpleCatcher()
+	catcher.Add(taskfiles.ValidateName(c.FilesName))
+	catcher.NewWhen(len(c.Paths) == 0, "at least one paths value is required")
+	return catcher.Resolve()
+}
+
+func (c *taskFilesPublish) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if err := c.validate(); err != nil {
+		return errors.Wrap(err, "validating expanded params")
+	}
+
+	localPath, err := createTempCacheArchive(conf.WorkDir)
+	if err != nil {
+		return errors.Wrap(err, "creating local archive file")
+	}
+	defer func() {
+		logger.Task().Error(ctx, errors.Wrapf(os.Remove(localPath), "removing local archive '%s'", localPath))
+	}()
+
+	logger.Task().Infof(ctx, "Bundling paths %s into task files '%s'.", c.Paths, c.FilesName)
+	if err := makeCacheArchive(ctx, conf.WorkDir, c.Paths, localPath, archiveCompressionOptions{}, logger.Task(), c.PreserveSymlinks); err != nil {
+		return errors.Wrap(err, "creating archive")
+	}
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return errors.Wrapf(err, "getting info for archive '%s'", localPath)
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	url, err := comm.GetTaskFilesUploadURL(ctx, td, c.FilesName)
+	if err != nil {
+		return errors.Wrap(err, "getting upload URL")
+	}
+	if err := uploadTaskFilesArchive(ctx, logger.Task(), url, localPath); err != nil {
+		return errors.Wrapf(err, "uploading task files '%s'", c.FilesName)
+	}
+
+	if err := comm.PublishTaskFiles(ctx, td, apimodels.TaskFilesPublishRequest{
+		Name:      c.FilesName,
+		SizeBytes: info.Size(),
+	}); err != nil {
+		return errors.Wrapf(err, "publishing task files '%s'", c.FilesName)
+	}
+
+	logger.Task().Infof(ctx, "Published task files '%s' (%d bytes).", c.FilesName, info.Size())
+	return nil
+}
@@ -0,0 +1,113 @@
+package command
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskFilesPublishParseParams(t *testing.T) {
+	t.Run("ValidParamsAccepted", func(t *testing.T) {
+		c := &taskFilesPublish{}
+		require.NoError(t, c.ParseParams(map[string]any{
+			"name":  "binaries",
+			"paths": []string{"bin"},
+		}))
+		assert.Equal(t, "binaries", c.FilesName)
+		assert.Equal(t, []string{"bin"}, c.Paths)
+	})
+	t.Run("MissingNameRejected", func(t *testing.T) {
+		c := &taskFilesPublish{}
+		assert.Error(t, c.ParseParams(map[string]any{
+			"paths": []string{"bin"},
+		}))
+	})
+	t.Run("NameWithPathSeparatorRejected", func(t *testing.T) {
+		c := &taskFilesPublish{}
+		assert.Error(t, c.ParseParams(map[string]any{
+			"name":  "build/binaries",
+			"paths": []string{"bin"},
+		}))
+	})
+	t.Run("EmptyPathsRejected", func(t *testing.T) {
+		c := &taskFilesPublish{}
+		err := c.ParseParams(map[string]any{
+			"name": "binaries",
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "paths")
+	})
+}
+
+func TestTaskFilesPublishAndFetch(t *testing.T) {
+	var uploaded []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			uploaded = body
+		case http.MethodGet:
+			if uploaded == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, err := w.Write(uploaded)
+			require.NoError(t, err)
+		}
+	}))
+	defer srv.Close()
+
+	newTaskConfig := func(t *testing.T, taskID string) *internal.TaskConfig {
+		return &internal.TaskConfig{
+			Task:       task.Task{Id: taskID, Secret: "secret"},
+			WorkDir:    t.TempDir(),
+			Expansions: util.Expansions{"bin_dir": "bin"},
+		}
+	}
+
+	ctx := t.Context()
+	comm := client.NewMock("url")
+	comm.TaskFilesURL = srv.URL
+
+	publishConf := newTaskConfig(t, "producer")
+	require.NoError(t, os.MkdirAll(filepath.Join(publishConf.WorkDir, "bin"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(publishConf.WorkDir, "bin", "app"), []byte("binary contents"), 0644))
+	publishLogger, err := comm.GetLoggerProducer(ctx, &publishConf.Task, nil)
+	require.NoError(t, err)
+
+	publish := &taskFilesPublish{FilesName: "binaries", Paths: []string{"${bin_dir}"}}
+	require.NoError(t, publish.Execute(ctx, comm, publishLogger, publishConf))
+
+	require.Len(t, comm.PublishedTaskFiles["producer"], 1)
+	published := comm.PublishedTaskFiles["producer"][0]
+	assert.Equal(t, "binaries", published.Name)
+	assert.EqualValues(t, len(uploaded), published.SizeBytes)
+	entries, err := os.ReadDir(publishConf.WorkDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "local archive should be removed after publishing")
+
+	fetchConf := newTaskConfig(t, "consumer")
+	fetchLogger, err := comm.GetLoggerProducer(ctx, &fetchConf.Task, nil)
+	require.NoError(t, err)
+
+	fetch := &taskFilesFetch{FilesName: "binaries", Task: "compile", Destination: "deps"}
+	require.NoError(t, fetch.Execute(ctx, comm, fetchLogger, fetchConf))
+
+	require.NotNil(t, comm.TaskFilesFetchRequest)
+	assert.Equal(t, apimodels.TaskFilesFetchRequest{Name: "binaries", Task: "compile"}, *comm.TaskFilesFetchRequest)
+	contents, err := os.ReadFile(filepath.Join(fetchConf.WorkDir, "deps", "bin", "app"))
+	require.NoError(t, err)
+	assert.Equal(t, "binary contents", string(contents))
+}
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// taskFilesTransferError is an error from transferring a task files archive
+// to or from its presigned URL.
+type taskFilesTransferError struct {
+	statusCode int
+	body       string
+}
+
+func (e *taskFilesTransferError) Error() string {
+	return fmt.Sprintf("got status %d: %s", e.statusCode, e.body)
+}
+
+// retryable returns whether the transfer may succeed if it's tried again.
+// Client errors, such as an expired URL, won't.
+func (e *taskFilesTransferError) retryable() bool {
+	return e.statusCode >= http.StatusInternalServerError || e.statusCode == http.StatusTooManyRequests
+}
+
+// uploadTaskFilesArchive uploads the archive at localPath to the presigned
+// URL, retrying transient errors.
+func uploadTaskFilesArchive(ctx context.Context, logger grip.Journaler, url, localPath string) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return errors.Wrapf(err, "getting info for archive '%s'", localPath)
+	}
+
+	httpClient := utility.GetHTTPClient()
+	httpClient.Timeout = s3HTTPClientTimeout
+	defer utility.PutHTTPClient(httpClient)
+
+	return retryS3Op(ctx, logger, "upload task files archive", func() (bool, error) {
+		f, err := os.Open(localPath)
+		if err != nil {
+			return false, errors.Wrapf(err, "opening archive '%s'", localPath)
+		}
+		defer f.Close()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, f)
+		if err != nil {
+			return false, errors.Wrap(err, "creating upload request")
+		}
+		req.ContentLength = info.Size()
+
+		return doTaskFilesTransfer(httpClient, req, nil)
+	})
+}
+
+// downloadTaskFilesArchive downloads the archive from the presigned URL to
+// localPath, retrying transient errors.
+func downloadTaskFilesArchive(ctx context.Context, logger grip.Journaler, url, localPath string) error {
+	httpClient := utility.GetHTTPClient()
+	httpClient.Timeout = s3HTTPClientTimeout
+	defer utility.PutHTTPClient(httpClient)
+
+	return retryS3Op(ctx, logger, "download task files archive", func() (bool, error) {
+		f, err := os.Create(localPath)
+		if err != nil {
+			return false, errors.Wrapf(err, "creating archive '%s'", localPath)
+		}
+		defer f.Close()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return false, errors.Wrap(err, "creating download request")
+		}
+
+		return doTaskFilesTransfer(httpClient, req, f)
+	})
+}
+
+// doTaskFilesTransfer sends the request and, if out is non-nil, copies the
+// response body into it. It returns whether a failed transfer can be retried.
+func doTaskFilesTransfer(httpClient *http.Client, req *http.Request, out io.Writer) (bool, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return true, errors.Wrap(err, "sending request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		transferErr := &taskFilesTransferError{statusCode: resp.StatusCode, body: string(body)}
+		return transferErr.retryable(), transferErr
+	}
+	if out == nil {
+		return false, nil
+	}
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		return true, errors.Wrap(err, "reading response body")
+	}
+	return false, nil
+}
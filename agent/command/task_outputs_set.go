@@ -0,0 +1,136 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// taskOutputsSet sets typed outputs on the task, such as a build ID or a
+// computed version string. Tasks that depend on this task receive the outputs
+// as expansions namespaced by this task's name.
+type taskOutputsSet struct {
+	// Outputs are the output names mapped to their values. The type of each
+	// output is determined by its value.
+	Outputs map[string]any `mapstructure:"outputs"`
+
+	// File is a YAML or JSON file, relative to the working directory, that
+	// maps output names to values. It is used to set outputs computed by
+	// earlier commands.
+	File              string `mapstructure:"file"`
+	IgnoreMissingFile bool   `mapstructure:"ignore_missing_file"`
+
+	base
+}
+
+func taskOutputsSetFactory() Command   { return &taskOutputsSet{} }
+func (c *taskOutputsSet) Name() string { return "task_outputs.set" }
+
+func (c *taskOutputsSet) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+	if len(c.Outputs) == 0 && c.File == "" {
+		return errors.New("must specify outputs or a file containing outputs")
+	}
+	_, err := toStructuredOutputs(c.Outputs)
+	return errors.Wrap(err, "validating outputs")
+}
+
+func (c *taskOutputsSet) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	values := map[string]any{}
+	for key, value := range c.Outputs {
+		if s, ok := value.(string); ok {
+			expanded, err := conf.Expansions.ExpandString(s)
+			if err != nil {
+				return errors.Wrapf(err, "expanding output '%s'", key)
+			}
+			value = expanded
+		}
+		values[key] = value
+	}
+
+	if c.File != "" {
+		fileValues, err := c.readFile(conf)
+		if err != nil {
+			return err
+		}
+		maps.Copy(values, fileValues)
+	}
+
+	outputs, err := toStructuredOutputs(values)
+	if err != nil {
+		return errors.Wrap(err, "validating outputs")
+	}
+	if len(outputs) == 0 {
+		logger.Task().Info(ctx, "No outputs to set.")
+		return nil
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	if err := comm.SetStructuredOutputs(ctx, td, outputs); err != nil {
+		return errors.Wrap(err, "setting outputs")
+	}
+
+	logger.Task().Infof(ctx, "Set task outputs: %s.", slices.Sorted(maps.Keys(outputs)))
+	return nil
+}
+
+// readFile returns the output values in the file, or nil if the file doesn't
+// exist and missing files are ignored.
+func (c *taskOutputsSet) readFile(conf *internal.TaskConfig) (map[string]any, error) {
+	fileName, err := conf.Expansions.ExpandString(c.File)
+	if err != nil {
+		return nil, errors.Wrap(err, "expanding file name")
+	}
+	fileName = GetWorkingDirectory(conf, fileName)
+
+	data, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) && c.IgnoreMissingFile {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading outputs file '%s'", fileName)
+	}
+
+	values := map[string]any{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, errors.Wrapf(err, "parsing outputs file '%s'", fileName)
+	}
+	return values, nil
+}
+
+// toStructuredOutputs converts the output values to structured outputs,
+// using the type of each value as the output's type.
+func toStructuredOutputs(values map[string]any) (map[string]task.StructuredOutput, error) {
+	outputs := make(map[string]task.StructuredOutput, len(values))
+	for key, value := range values {
+		var output task.StructuredOutput
+		switch v := value.(type) {
+		case string:
+			output = task.StructuredOutput{Type: task.StructuredOutputTypeString, Value: v}
+		case bool:
+			output = task.StructuredOutput{Type: task.StructuredOutputTypeBool, Value: strconv.FormatBool(v)}
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			output = task.StructuredOutput{Type: task.StructuredOutputTypeInt, Value: fmt.Sprint(v)}
+		case float32:
+			output = task.StructuredOutput{Type: task.StructuredOutputTypeFloat, Value: strconv.FormatFloat(float64(v), 'f', -1, 32)}
+		case float64:
+			output = task.StructuredOutput{Type: task.StructuredOutputTypeFloat, Value: strconv.FormatFloat(v, 'f', -1, 64)}
+		default:
+			return nil, errors.Errorf("output '%s' has unsupported value of type %T, outputs must be strings, numbers, or booleans", key, value)
+		}
+		outputs[key] = output
+	}
+	return outputs, task.ValidateStructuredOutputs(outputs)
+}
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskOutputsSetParseParams(t *testing.T) {
+	t.Run("FailsWithoutOutputsOrFile", func(t *testing.T) {
+		c := &taskOutputsSet{}
+		assert.Error(t, c.ParseParams(map[string]any{}))
+	})
+	t.Run("FailsWithInvalidOutputName", func(t *testing.T) {
+		c := &taskOutputsSet{}
+		assert.Error(t, c.ParseParams(map[string]any{
+			"outputs": map[string]any{"build.id": "abc"},
+		}))
+	})
+	t.Run("FailsWithNestedValue", func(t *testing.T) {
+		c := &taskOutputsSet{}
+		assert.Error(t, c.ParseParams(map[string]any{
+			"outputs": map[string]any{"build_id": []string{"abc"}},
+		}))
+	})
+	t.Run("SucceedsWithOutputs", func(t *testing.T) {
+		c := &taskOutputsSet{}
+		require.NoError(t, c.ParseParams(map[string]any{
+			"outputs": map[string]any{"build_id": "${build_id}", "count": 3},
+		}))
+		assert.Len(t, c.Outputs, 2)
+	})
+	t.Run("SucceedsWithFile", func(t *testing.T) {
+		c := &taskOutputsSet{}
+		require.NoError(t, c.ParseParams(map[string]any{
+			"file": "outputs.yml",
+		}))
+		assert.Equal(t, "outputs.yml", c.File)
+	})
+}
+
+func TestTaskOutputsSetExecute(t *testing.T) {
+	for tName, tCase := range map[string]func(t *testing.T, c *taskOutputsSet, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig){
+		"SetsTypedOutputs": func(t *testing.T, c *taskOutputsSet, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			c.Outputs = map[string]any{
+				"build_id": "${build_id}",
+				"count":    3,
+				"ratio":    0.25,
+				"release":  true,
+			}
+			require.NoError(t, c.Execute(t.Context(), comm, logger, conf))
+
+			assert.Equal(t, map[string]task.StructuredOutput{
+				"build_id": {Type: task.StructuredOutputTypeString, Value: "build-123"},
+				"count":    {Type: task.StructuredOutputTypeInt, Value: "3"},
+				"ratio":    {Type: task.StructuredOutputTypeFloat, Value: "0.25"},
+				"release":  {Type: task.StructuredOutputTypeBool, Value: "true"},
+			}, comm.StructuredOutputs[conf.Task.Id])
+		},
+		"SetsOutputsFromFile": func(t *testing.T, c *taskOutputsSet, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "outputs.yml"), []byte("version: 1.2.3-rc1\nnum_tests: 42\n"), 0644))
+			c.Outputs = map[string]any{"version": "overridden by file", "build_id": "abc"}
+			c.File = "outputs.yml"
+			require.NoError(t, c.Execute(t.Context(), comm, logger, conf))
+
+			assert.Equal(t, map[string]task.StructuredOutput{
+				"build_id":  {Type: task.StructuredOutputTypeString, Value: "abc"},
+				"version":   {Type: task.StructuredOutputTypeString, Value: "1.2.3-rc1"},
+				"num_tests": {Type: task.StructuredOutputTypeInt, Value: "42"},
+			}, comm.StructuredOutputs[conf.Task.Id])
+		},
+		"FailsWithMissingFile": func(t *testing.T, c *taskOutputsSet, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			c.File = "outputs.yml"
+			assert.Error(t, c.Execute(t.Context(), comm, logger, conf))
+		},
+		"IgnoresMissingFile": func(t *testing.T, c *taskOutputsSet, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			c.File = "outputs.yml"
+			c.IgnoreMissingFile = true
+			require.NoError(t, c.Execute(t.Context(), comm, logger, conf))
+			assert.Empty(t, comm.StructuredOutputs[conf.Task.Id])
+		},
+		"FailsWithNestedValueInFile": func(t *testing.T, c *taskOutputsSet, comm *client.Mock, logger client.LoggerProducer, conf *internal.TaskConfig) {
+			require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "outputs.yml"), []byte("binaries:\n  - a\n  - b\n"), 0644))
+			c.File = "outputs.yml"
+			err := c.Execute(t.Context(), comm, logger, conf)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "unsupported value")
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			conf := &internal.TaskConfig{
+				Task:       task.Task{Id: "task_id", Secret: "secret"},
+				WorkDir:    t.TempDir(),
+				Expansions: util.Expansions{"build_id": "build-123"},
+			}
+			comm := client.NewMock("url")
+			logger, err := comm.GetLoggerProducer(t.Context(), &conf.Task, nil)
+			require.NoError(t, err)
+
+			tCase(t, &taskOutputsSet{}, comm, logger, conf)
+		})
+	}
+}
@@ -336,7 +336,12 @@ func (a *Agent) tryCleanupDirectory(ctx context.Context, dir string) {
 			return nil
 		}
 
+		// Hidden directories hold data that's kept between tasks on the host,
+		// such as caches, so they're skipped entirely.
 		if strings.HasPrefix(di.Name(), ".") {
+			if di.IsDir() {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 
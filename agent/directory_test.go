@@ -154,6 +154,13 @@ func TestDirectoryCleanup(t *testing.T) {
 	_, err = os.Stat(toDelete)
 	assert.True(os.IsNotExist(err))
 
+	// verify hidden directories and their contents are kept
+	hiddenDir := filepath.Join(dir, ".cache", "nested")
+	require.NoError(t, os.MkdirAll(hiddenDir, 0777))
+	a.tryCleanupDirectory(t.Context(), dir)
+	_, err = os.Stat(hiddenDir)
+	assert.True(osExists(err))
+
 	// should delete nothing if we hit .git first
 	gitDir := filepath.Join(dir, ".git")
 	require.NoError(t, os.MkdirAll(gitDir, 0777))
@@ -14,8 +14,10 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/s3usage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
@@ -644,6 +646,125 @@ func (c *baseCommunicator) AttachFiles(ctx context.Context, taskData TaskData, t
 	return nil
 }
 
+// SendCoverage sends the task's per-file code coverage.
+func (c *baseCommunicator) SendCoverage(ctx context.Context, taskData TaskData, files []coverage.FileCoverage) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("coverage")
+	resp, err := c.retryRequest(ctx, info, files)
+	if err != nil {
+		return util.RespError(resp, errors.Wrap(err, "sending coverage").Error())
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// SendResourceUsage sends the task's resource usage time series.
+func (c *baseCommunicator) SendResourceUsage(ctx context.Context, taskData TaskData, samples []resourceusage.Sample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("resource_usage")
+	resp, err := c.retryRequest(ctx, info, samples)
+	if err != nil {
+		return util.RespError(resp, errors.Wrap(err, "sending resource usage").Error())
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// SetStructuredOutputs sets typed outputs on the task that are passed to the
+// tasks that depend on it.
+func (c *baseCommunicator) SetStructuredOutputs(ctx context.Context, taskData TaskData, outputs map[string]task.StructuredOutput) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("structured_outputs")
+	resp, err := c.retryRequest(ctx, info, outputs)
+	if err != nil {
+		return util.RespError(resp, errors.Wrap(err, "setting structured outputs").Error())
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetTaskFilesUploadURL returns a presigned URL to upload the archive for the
+// task's file set with the given name.
+func (c *baseCommunicator) GetTaskFilesUploadURL(ctx context.Context, taskData TaskData, name string) (string, error) {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("task_files/upload_url")
+	resp, err := c.retryRequest(ctx, info, apimodels.TaskFilesPublishRequest{Name: name})
+	if err != nil {
+		return "", util.RespError(resp, errors.Wrapf(err, "getting upload URL for task files '%s'", name).Error())
+	}
+	defer resp.Body.Close()
+
+	var urlResp apimodels.TaskFilesURLResponse
+	if err := utility.ReadJSON(resp.Body, &urlResp); err != nil {
+		return "", errors.Wrap(err, "reading upload URL response")
+	}
+	return urlResp.URL, nil
+}
+
+// PublishTaskFiles records that the task uploaded the archive for a file set.
+func (c *baseCommunicator) PublishTaskFiles(ctx context.Context, taskData TaskData, req apimodels.TaskFilesPublishRequest) error {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("task_files")
+	resp, err := c.retryRequest(ctx, info, req)
+	if err != nil {
+		return util.RespError(resp, errors.Wrapf(err, "publishing task files '%s'", req.Name).Error())
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetTaskFilesDownloadURL returns a presigned URL to download the archive for
+// a file set published by one of the task's dependencies.
+func (c *baseCommunicator) GetTaskFilesDownloadURL(ctx context.Context, taskData TaskData, req apimodels.TaskFilesFetchRequest) (string, error) {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("task_files/download_url")
+	resp, err := c.retryRequest(ctx, info, req)
+	if err != nil {
+		return "", util.RespError(resp, errors.Wrapf(err, "getting download URL for task files '%s' from task '%s'", req.Name, req.Task).Error())
+	}
+	defer resp.Body.Close()
+
+	var urlResp apimodels.TaskFilesURLResponse
+	if err := utility.ReadJSON(resp.Body, &urlResp); err != nil {
+		return "", errors.Wrap(err, "reading download URL response")
+	}
+	return urlResp.URL, nil
+}
+
 func (c *baseCommunicator) ReportS3Usage(ctx context.Context, taskData TaskData, usage s3usage.S3Usage, final bool) error {
 	if usage.IsZero() {
 		return nil
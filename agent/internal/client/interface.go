@@ -10,8 +10,10 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/s3usage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
@@ -94,6 +96,23 @@ type SharedCommunicator interface {
 	NewPush(context.Context, TaskData, *apimodels.S3CopyRequest) (*model.PushLog, error)
 	UpdatePushStatus(context.Context, TaskData, *model.PushLog) error
 	AttachFiles(context.Context, TaskData, []*artifact.File) error
+	// SendCoverage sends the per-file code coverage reported by the task.
+	SendCoverage(context.Context, TaskData, []coverage.FileCoverage) error
+	// SendResourceUsage sends the resource usage time series recorded while
+	// the task ran.
+	SendResourceUsage(context.Context, TaskData, []resourceusage.Sample) error
+	// SetStructuredOutputs sets typed outputs on the task that are passed to
+	// the tasks that depend on it.
+	SetStructuredOutputs(context.Context, TaskData, map[string]task.StructuredOutput) error
+	// GetTaskFilesUploadURL returns a presigned URL to upload the archive for
+	// the task's file set with the given name.
+	GetTaskFilesUploadURL(context.Context, TaskData, string) (string, error)
+	// PublishTaskFiles records that the task uploaded the archive for a file
+	// set, making it available to its dependent tasks.
+	PublishTaskFiles(context.Context, TaskData, apimodels.TaskFilesPublishRequest) error
+	// GetTaskFilesDownloadURL returns a presigned URL to download the archive
+	// for a file set published by one of the task's dependencies.
+	GetTaskFilesDownloadURL(context.Context, TaskData, apimodels.TaskFilesFetchRequest) (string, error)
 	// ReportS3Usage reports the task's accumulated S3 usage to the server. When final is true, the server increments the version cost and emits the OTel span.
 	ReportS3Usage(context.Context, TaskData, s3usage.S3Usage, bool) error
 	// ReportHighExecTimeout reports to the app server that this task
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -16,9 +17,11 @@ import (
 	"github.com/evergreen-ci/evergreen/apimodels"
 	serviceModel "github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchModel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/s3usage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
@@ -83,14 +86,22 @@ type Mock struct {
 	ReportHighExecTimeoutShouldFail bool
 	ReportedHighExecTimeoutSecs     int
 	AttachedFiles                   map[string][]*artifact.File
-	LogID                           string
-	LocalTestResults                []testresult.TestResult
-	HasTestResults                  bool
-	ResultsFailed                   bool
-	TestResultStats                 testresult.TaskTestResultsStats
-	FailedTestSample                []string
-	TestLogs                        []*testlog.TestLog
-	TestLogCount                    int
+	Coverage                        map[string][]coverage.FileCoverage
+	ResourceUsage                   map[string][]resourceusage.Sample
+	StructuredOutputs               map[string]map[string]task.StructuredOutput
+	// TaskFilesURL is returned as the presigned URL for both publishing and
+	// fetching task files.
+	TaskFilesURL          string
+	PublishedTaskFiles    map[string][]apimodels.TaskFilesPublishRequest
+	TaskFilesFetchRequest *apimodels.TaskFilesFetchRequest
+	LogID                 string
+	LocalTestResults      []testresult.TestResult
+	HasTestResults        bool
+	ResultsFailed         bool
+	TestResultStats       testresult.TaskTestResultsStats
+	FailedTestSample      []string
+	TestLogs              []*testlog.TestLog
+	TestLogCount          int
 
 	taskLogs   map[string][]log.LogLine
 	PatchFiles map[string]string
@@ -117,14 +128,18 @@ type EndTaskResult struct {
 // NewMock returns a Communicator for testing.
 func NewMock(serverURL string) *Mock {
 	return &Mock{
-		maxAttempts:   defaultMaxAttempts,
-		timeoutStart:  defaultTimeoutStart,
-		timeoutMax:    defaultTimeoutMax,
-		taskLogs:      make(map[string][]log.LogLine),
-		PatchFiles:    make(map[string]string),
-		keyVal:        make(map[string]*serviceModel.KeyVal),
-		AttachedFiles: make(map[string][]*artifact.File),
-		serverURL:     serverURL,
+		maxAttempts:        defaultMaxAttempts,
+		timeoutStart:       defaultTimeoutStart,
+		timeoutMax:         defaultTimeoutMax,
+		taskLogs:           make(map[string][]log.LogLine),
+		PatchFiles:         make(map[string]string),
+		keyVal:             make(map[string]*serviceModel.KeyVal),
+		AttachedFiles:      make(map[string][]*artifact.File),
+		Coverage:           make(map[string][]coverage.FileCoverage),
+		ResourceUsage:      make(map[string][]resourceusage.Sample),
+		PublishedTaskFiles: make(map[string][]apimodels.TaskFilesPublishRequest),
+		StructuredOutputs:  make(map[string]map[string]task.StructuredOutput),
+		serverURL:          serverURL,
 	}
 }
 
@@ -463,6 +478,69 @@ func (c *Mock) AttachFiles(ctx context.Context, td TaskData, taskFiles []*artifa
 	return nil
 }
 
+// SendCoverage stores the coverage sent for the task.
+func (c *Mock) SendCoverage(ctx context.Context, td TaskData, files []coverage.FileCoverage) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Coverage[td.ID] = append(c.Coverage[td.ID], files...)
+
+	return nil
+}
+
+// SendResourceUsage stores the resource usage sent for the task.
+func (c *Mock) SendResourceUsage(ctx context.Context, td TaskData, samples []resourceusage.Sample) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ResourceUsage[td.ID] = samples
+
+	return nil
+}
+
+// SetStructuredOutputs adds the structured outputs to the ones stored for
+// the task.
+func (c *Mock) SetStructuredOutputs(ctx context.Context, td TaskData, outputs map[string]task.StructuredOutput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.StructuredOutputs[td.ID] == nil {
+		c.StructuredOutputs[td.ID] = map[string]task.StructuredOutput{}
+	}
+	maps.Copy(c.StructuredOutputs[td.ID], outputs)
+
+	return nil
+}
+
+// GetTaskFilesUploadURL returns the mock's task files URL.
+func (c *Mock) GetTaskFilesUploadURL(ctx context.Context, td TaskData, name string) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.TaskFilesURL, nil
+}
+
+// PublishTaskFiles stores the file set published by the task.
+func (c *Mock) PublishTaskFiles(ctx context.Context, td TaskData, req apimodels.TaskFilesPublishRequest) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.PublishedTaskFiles[td.ID] = append(c.PublishedTaskFiles[td.ID], req)
+
+	return nil
+}
+
+// GetTaskFilesDownloadURL records the request and returns the mock's task
+// files URL.
+func (c *Mock) GetTaskFilesDownloadURL(ctx context.Context, td TaskData, req apimodels.TaskFilesFetchRequest) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.TaskFilesFetchRequest = &req
+
+	return c.TaskFilesURL, nil
+}
+
 func (c *Mock) ReportS3Usage(_ context.Context, _ TaskData, usage s3usage.S3Usage, _ bool) error {
 	if c.ReportS3UsageShouldFail {
 		return errors.New("reporting S3 usage")
@@ -3,8 +3,10 @@ package redactor
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/evergreen-ci/evergreen/agent/globals"
 	"github.com/evergreen-ci/evergreen/agent/util"
@@ -21,6 +23,10 @@ type redactingSender struct {
 	expansionsToRedact []string
 	internalRedactions *util.DynamicExpansions
 	allRedacted        []util.RedactInfo
+	secretDetector     *SecretDetector
+
+	mu           sync.Mutex
+	inPrivateKey bool
 
 	send.Sender
 }
@@ -44,6 +50,9 @@ type RedactionOptions struct {
 	// of task runtime. It can only be safely set if we're confident that expansions
 	// will no longer be changing.
 	PreloadRedactions bool
+	// SecretDetector, if set, additionally redacts values that look like
+	// credentials.
+	SecretDetector *SecretDetector
 }
 
 func (r *redactingSender) Send(ctx context.Context, m message.Composer) {
@@ -51,8 +60,6 @@ func (r *redactingSender) Send(ctx context.Context, m message.Composer) {
 		return
 	}
 
-	msg := m.String()
-
 	var allRedacted []util.RedactInfo
 	if len(r.allRedacted) > 0 {
 		allRedacted = r.allRedacted
@@ -60,9 +67,9 @@ func (r *redactingSender) Send(ctx context.Context, m message.Composer) {
 		allRedacted = getAllRedacted(r.expansions, r.internalRedactions, r.expansionsToRedact)
 	}
 
-	for _, info := range allRedacted {
-		msg = strings.ReplaceAll(msg, info.Value, fmt.Sprintf(redactedVariableTemplate, info.Key))
-	}
+	r.mu.Lock()
+	msg := redact(m.String(), allRedacted, r.secretDetector, &r.inPrivateKey)
+	r.mu.Unlock()
 
 	r.Sender.Send(ctx, message.NewDefaultMessage(m.Priority(), msg))
 }
@@ -81,6 +88,7 @@ func NewRedactingSender(sender send.Sender, opts RedactionOptions) send.Sender {
 		expansions:         opts.Expansions,
 		expansionsToRedact: append(opts.Redacted, globals.ExpansionsToRedact...),
 		internalRedactions: opts.InternalRedactions,
+		secretDetector:     opts.SecretDetector,
 		Sender:             sender,
 	}
 	if opts.PreloadRedactions {
@@ -89,6 +97,39 @@ func NewRedactingSender(sender send.Sender, opts RedactionOptions) send.Sender {
 	return redacter
 }
 
+// Redact returns the message with sensitive values replaced in accordance
+// with the redaction options. It's intended for redacting values outside of
+// logs, such as expansions that are written to a file.
+func Redact(msg string, opts RedactionOptions) string {
+	if opts.Expansions == nil {
+		opts.Expansions = &util.DynamicExpansions{}
+	}
+	if opts.InternalRedactions == nil {
+		opts.InternalRedactions = &util.DynamicExpansions{}
+	}
+
+	allRedacted := getAllRedacted(opts.Expansions, opts.InternalRedactions, slices.Concat(opts.Redacted, globals.ExpansionsToRedact))
+	inPrivateKey := false
+	return redact(msg, allRedacted, opts.SecretDetector, &inPrivateKey)
+}
+
+// redact replaces each of the redacted values in the message with its key and,
+// if the secret detector is set, redacts anything that looks like a
+// credential. inPrivateKey tracks whether the message continues a private key
+// that started in an earlier message.
+func redact(msg string, allRedacted []util.RedactInfo, secretDetector *SecretDetector, inPrivateKey *bool) string {
+	for _, info := range allRedacted {
+		if info.Value == "" {
+			continue
+		}
+		msg = strings.ReplaceAll(msg, info.Value, fmt.Sprintf(redactedVariableTemplate, info.Key))
+	}
+	if secretDetector != nil {
+		msg = secretDetector.redact(msg, inPrivateKey)
+	}
+	return msg
+}
+
 func getAllRedacted(expansions, internalRedactions *util.DynamicExpansions, expansionsToRedact []string) []util.RedactInfo {
 	allRedacted := expansions.GetRedacted()
 	for _, expansion := range expansionsToRedact {
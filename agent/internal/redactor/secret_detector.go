@@ -0,0 +1,248 @@
+package redactor
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	awsAccessKeySecretType = "aws_access_key"
+	githubTokenSecretType  = "github_token"
+	jwtSecretType          = "jwt"
+	privateKeySecretType   = "private_key"
+	highEntropySecretType  = "high_entropy"
+
+	// minHighEntropyTokenLength is the shortest token that is checked for
+	// high entropy. Shorter tokens do not contain enough characters for their
+	// entropy to distinguish random secrets from ordinary words.
+	minHighEntropyTokenLength = 24
+	// minHighEntropyBits is the Shannon entropy, in bits per character, at or
+	// above which a token is considered a secret.
+	minHighEntropyBits = 4.2
+)
+
+var (
+	builtinSecretPatterns = []secretPattern{
+		{name: awsAccessKeySecretType, regex: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+		{name: githubTokenSecretType, regex: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{22,255})\b`)},
+		{name: jwtSecretType, regex: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)},
+	}
+
+	privateKeyBeginRegex = regexp.MustCompile(`-----BEGIN[A-Z0-9 ]*PRIVATE KEY-----`)
+	privateKeyEndRegex   = regexp.MustCompile(`-----END[A-Z0-9 ]*PRIVATE KEY-----`)
+	highEntropyToken     = regexp.MustCompile(`[A-Za-z0-9+/_-]{` + fmt.Sprint(minHighEntropyTokenLength) + `,}={0,2}`)
+
+	// privateKeyBodyLine matches the lines that can be inside a PEM block,
+	// which are base64 data and headers such as "Proc-Type: 4,ENCRYPTED".
+	privateKeyBodyLine = regexp.MustCompile(`^(?:[A-Za-z0-9+/=]*|[A-Za-z0-9-]+:.*)$`)
+)
+
+type secretPattern struct {
+	name  string
+	regex *regexp.Regexp
+}
+
+// SecretDetector finds values in log messages that look like credentials
+// and keeps count of each type of secret it redacts. It is safe for
+// concurrent use by multiple redacting senders.
+type SecretDetector struct {
+	patterns    []secretPattern
+	highEntropy bool
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// NewSecretDetector returns a detector for the well-known credential shapes
+// and the project's own patterns.
+func NewSecretDetector(settings model.SecretDetectionSettings) (*SecretDetector, error) {
+	d := &SecretDetector{
+		highEntropy: !settings.HighEntropyDisabled,
+		counts:      map[string]int{},
+	}
+	// Project patterns come first since they are the most specific to what
+	// the task logs.
+	for _, p := range settings.Patterns {
+		re, err := regexp.Compile(p.Regex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compiling regex for secret pattern '%s'", p.Name)
+		}
+		d.patterns = append(d.patterns, secretPattern{name: p.Name, regex: re})
+	}
+	d.patterns = append(d.patterns, builtinSecretPatterns...)
+
+	return d, nil
+}
+
+// Summary returns the number of secrets of each type the detector has
+// redacted so far, or nil if it has not redacted any.
+func (d *SecretDetector) Summary() *apimodels.RedactionSummary {
+	if d == nil {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.counts) == 0 {
+		return nil
+	}
+	detected := make(map[string]int, len(d.counts))
+	for secretType, count := range d.counts {
+		detected[secretType] = count
+	}
+	return &apimodels.RedactionSummary{DetectedSecrets: detected}
+}
+
+// redact replaces the secrets in the message. Private keys are logged over
+// several lines, so inPrivateKey tracks whether the previous message from
+// the same log ended inside a private key block.
+func (d *SecretDetector) redact(msg string, inPrivateKey *bool) string {
+	msg = d.redactPrivateKeys(msg, inPrivateKey)
+
+	for _, p := range d.patterns {
+		msg = p.regex.ReplaceAllStringFunc(msg, func(string) string {
+			d.record(p.name)
+			return fmt.Sprintf(redactedVariableTemplate, p.name)
+		})
+	}
+
+	if d.highEntropy {
+		msg = highEntropyToken.ReplaceAllStringFunc(msg, func(token string) string {
+			if !isHighEntropy(token) {
+				return token
+			}
+			d.record(highEntropySecretType)
+			return fmt.Sprintf(redactedVariableTemplate, highEntropySecretType)
+		})
+	}
+
+	return msg
+}
+
+// redactPrivateKeys replaces each PEM private key block with a single
+// redaction, including any part of the block in the message that continues
+// a block from a previous message.
+func (d *SecretDetector) redactPrivateKeys(msg string, inPrivateKey *bool) string {
+	redacted := fmt.Sprintf(redactedVariableTemplate, privateKeySecretType)
+
+	var sb strings.Builder
+	// A block that continues from a previous message was already counted
+	// when it began.
+	afterBegin := false
+	for {
+		if *inPrivateKey {
+			end, closed := privateKeyBlockEnd(msg, afterBegin)
+			if afterBegin || end > 0 {
+				sb.WriteString(redacted)
+			}
+			if !closed {
+				return sb.String()
+			}
+			msg = msg[end:]
+			*inPrivateKey = false
+		}
+
+		begin := privateKeyBeginRegex.FindStringIndex(msg)
+		if begin == nil {
+			sb.WriteString(msg)
+			return sb.String()
+		}
+		d.record(privateKeySecretType)
+		sb.WriteString(msg[:begin[0]])
+		msg = msg[begin[1]:]
+		*inPrivateKey = true
+		afterBegin = true
+	}
+}
+
+// privateKeyBlockEnd returns the index just past the end of the private key
+// block that msg is in, and whether the block ends in msg. The block ends
+// after its END marker, or before the first line that can't be in a PEM
+// block, so that a BEGIN marker without an END marker doesn't redact the rest
+// of the log. If afterBegin is true, msg starts right after the block's BEGIN
+// marker, and the rest of that line is always part of the block.
+func privateKeyBlockEnd(msg string, afterBegin bool) (int, bool) {
+	start := 0
+	for {
+		line := msg[start:]
+		lineEnd := strings.IndexByte(line, '\n')
+		if lineEnd >= 0 {
+			line = line[:lineEnd]
+		}
+
+		if end := privateKeyEndRegex.FindStringIndex(line); end != nil {
+			return start + end[1], true
+		}
+		if (start > 0 || !afterBegin) && !privateKeyBodyLine.MatchString(strings.TrimSpace(line)) {
+			// Keep the newline before the line that isn't in the block.
+			return max(start-1, 0), true
+		}
+		if lineEnd < 0 {
+			return len(msg), false
+		}
+		start += lineEnd + 1
+	}
+}
+
+func (d *SecretDetector) record(secretType string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.counts[secretType]++
+}
+
+// isHighEntropy returns whether the token looks like a randomly-generated
+// secret rather than a word, identifier, hash, or path.
+func isHighEntropy(token string) bool {
+	// Paths are made of readable segments that happen to be long together.
+	if strings.HasPrefix(token, "/") {
+		return false
+	}
+
+	var hasLetter, hasDigit bool
+	onlyHex := true
+	for _, r := range token {
+		switch {
+		case r >= '0' && r <= '9':
+			hasDigit = true
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			hasLetter = true
+			if !strings.ContainsRune("abcdefABCDEF", r) {
+				onlyHex = false
+			}
+		case r != '-':
+			onlyHex = false
+		}
+	}
+	// Hex strings such as commit hashes and UUIDs are common in logs and are
+	// rarely secrets on their own.
+	if !hasLetter || !hasDigit || onlyHex {
+		return false
+	}
+
+	return shannonEntropy(token) >= minHighEntropyBits
+}
+
+// shannonEntropy returns the entropy of the string in bits per character.
+func shannonEntropy(s string) float64 {
+	freqs := map[rune]float64{}
+	for _, r := range s {
+		freqs[r]++
+	}
+
+	var entropy float64
+	length := float64(len(s))
+	for _, count := range freqs {
+		p := count / length
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/agent/internal/redactor"
 	"github.com/evergreen-ci/evergreen/agent/internal/taskoutput"
 	agentutil "github.com/evergreen-ci/evergreen/agent/util"
 	"github.com/evergreen-ci/evergreen/apimodels"
@@ -60,8 +61,11 @@ type TaskConfig struct {
 	// that cannot be exposed to tasks as expansions.
 	// This only reuses agentutil.DynamicExpansions for thread safety.
 	InternalRedactions *agentutil.DynamicExpansions
-	WorkDir            string
-	TaskOutputDir      *taskoutput.Directory
+	// SecretDetector redacts values in the task's logs that look like
+	// credentials. It is nil if the project has not enabled secret detection.
+	SecretDetector *redactor.SecretDetector
+	WorkDir        string
+	TaskOutputDir  *taskoutput.Directory
 	// GithubPatchData stores GitHub PR patch metadata.
 	GithubPatchData thirdparty.GithubPatch
 	// GithubMergeData stores GitHub merge queue metadata.
@@ -107,6 +111,12 @@ type TaskConfig struct {
 	// Set alongside ContainerID when container isolation is enabled.
 	EnvFileHostDir string
 
+	// LocalOutputDir is set when running tasks through the local task
+	// debugger. When set, S3 commands read and write files under this
+	// directory instead of S3, and parsed test results are written to a
+	// report in it instead of being sent to Evergreen.
+	LocalOutputDir string
+
 	// PatchOrVersionDescription holds the description of a patch or
 	// message of a version to be used in the otel attributes.
 	PatchOrVersionDescription string
@@ -298,6 +308,14 @@ func NewTaskConfig(opts TaskConfigOptions) (*TaskConfig, error) {
 		taskConfig.PatchOrVersionDescription = opts.Version.Message
 	}
 
+	if taskConfig.ProjectRef.SecretDetection.IsEnabled() {
+		detector, err := redactor.NewSecretDetector(taskConfig.ProjectRef.SecretDetection)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating secret detector")
+		}
+		taskConfig.SecretDetector = detector
+	}
+
 	if opts.ExpansionsAndVars != nil {
 		taskConfig.DevprodOwnedAWSAccountIDs = opts.ExpansionsAndVars.DevprodOwnedAWSAccountIDs
 		taskConfig.ArtifactAWSAccountsWithoutLifecycleRules = opts.ExpansionsAndVars.ArtifactAWSAccountsWithoutLifecycleRules
@@ -121,6 +121,7 @@ func (a *Agent) prepLogger(ctx context.Context, tc *taskContext, commandName str
 			Expansions:         tc.taskConfig.NewExpansions,
 			Redacted:           tc.taskConfig.Redacted,
 			InternalRedactions: tc.taskConfig.InternalRedactions,
+			SecretDetector:     tc.taskConfig.SecretDetector,
 		},
 		S3Usage: tc.taskConfig.S3Usage,
 	}
@@ -2,14 +2,19 @@ package agent
 
 import (
 	"context"
+	"io/fs"
+	"path/filepath"
 	"sync"
 	"time"
 
 	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/process"
 )
 
 const (
@@ -19,8 +24,23 @@ const (
 	// sustainedSampleCount is the number of consecutive samples above threshold
 	// required to mark a resource as constrained. 20 samples = 5 minutes of sustained usage at 15s intervals.
 	sustainedSampleCount = 20
+	// maxUsageSamples is the most samples kept in a task's resource usage
+	// time series. Once it is full, every other periodic sample is dropped so
+	// that the series still covers the whole task at a coarser resolution.
+	maxUsageSamples = 1000
+	// workDirSizeInterval is how often the size of the task's working
+	// directory is recomputed. Walking the directory is much more expensive
+	// than the other samples, so it runs less often and each usage sample
+	// reports the most recent size.
+	workDirSizeInterval = time.Minute
+	// workDirSizeTimeout and maxWorkDirSizeEntries bound how long a single
+	// walk of the working directory can take.
+	workDirSizeTimeout    = 10 * time.Second
+	maxWorkDirSizeEntries = 1000000
 )
 
+var errWorkDirSizeIncomplete = errors.New("stopped walking the directory before visiting every entry")
+
 type resourceMonitor struct {
 	mu sync.Mutex
 
@@ -33,9 +53,31 @@ type resourceMonitor struct {
 	peakCPUPercent    float64
 	peakMemoryPercent float64
 
+	// workDir is the task's working directory, whose size is recorded in
+	// each usage sample.
+	workDir string
+	// workDirSize is the total size of the files in the working directory
+	// as of the last time it was computed.
+	workDirSize uint64
+	// currentCommand is the full display name of the running command.
+	currentCommand string
+	// usageSamples is the time series of resource usage for the task.
+	usageSamples []usageSample
+	// lastDiskRead and lastDiskWrite are the cumulative disk I/O counters
+	// from the previous usage sample.
+	lastDiskRead  uint64
+	lastDiskWrite uint64
+
 	logger grip.Journaler
 }
 
+// usageSample is a sample in the resource usage time series. Samples taken at
+// command boundaries are always kept when the series is thinned.
+type usageSample struct {
+	resourceusage.Sample
+	boundary bool
+}
+
 func newResourceMonitor(logger grip.Journaler) *resourceMonitor {
 	if logger == nil {
 		logger = grip.NewJournaler("resource_monitor")
@@ -49,26 +91,130 @@ func newResourceMonitor(logger grip.Journaler) *resourceMonitor {
 func (rm *resourceMonitor) start(ctx context.Context) {
 	ticker := time.NewTicker(resourceMonitorInterval)
 	defer ticker.Stop()
+	workDirTicker := time.NewTicker(workDirSizeInterval)
+	defer workDirTicker.Stop()
 
+	rm.updateWorkDirSize(ctx)
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			rm.sample(ctx)
+			rm.sample(ctx, 200*time.Millisecond, false)
+		case <-workDirTicker.C:
+			rm.updateWorkDirSize(ctx)
+		}
+	}
+}
+
+// setWorkDir sets the working directory whose size is sampled.
+func (rm *resourceMonitor) setWorkDir(workDir string) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	rm.workDir = workDir
+	rm.workDirSize = 0
+}
+
+// updateWorkDirSize recomputes the size of the working directory. If the walk
+// is cut short, the size only includes the files visited so far.
+func (rm *resourceMonitor) updateWorkDirSize(ctx context.Context) {
+	workDir := rm.getWorkDir()
+	if workDir == "" {
+		return
+	}
+
+	walkCtx, cancel := context.WithTimeout(ctx, workDirSizeTimeout)
+	defer cancel()
+	size, err := dirSize(walkCtx, workDir, maxWorkDirSizeEntries)
+	if err != nil {
+		rm.logger.Debug(ctx, errors.Wrapf(err, "computing size of working directory '%s'", workDir))
+		if !errors.Is(err, errWorkDirSizeIncomplete) {
+			return
 		}
 	}
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	// The working directory may have changed during the walk.
+	if rm.workDir == workDir {
+		rm.workDirSize = size
+	}
+}
+
+// dirSize returns the total size of the regular files under dir. Entries that
+// can't be read, such as files removed during the walk, are skipped. If the
+// context is done or more than maxEntries entries are visited, it returns
+// errWorkDirSizeIncomplete along with the size of the files visited so far.
+func dirSize(ctx context.Context, dir string, maxEntries int) (uint64, error) {
+	var size uint64
+	var numEntries int
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			return nil
+		}
+		numEntries++
+		if ctx.Err() != nil || numEntries > maxEntries {
+			return errWorkDirSizeIncomplete
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		size += uint64(info.Size())
+		return nil
+	})
+	return size, err
+}
+
+// startCommand samples resource usage as the command starts and tags the
+// samples taken while it runs with its name.
+func (rm *resourceMonitor) startCommand(ctx context.Context, cmdName string) {
+	rm.mu.Lock()
+	rm.currentCommand = cmdName
+	rm.mu.Unlock()
+
+	rm.sample(ctx, 0, true)
+}
+
+// endCommand samples resource usage as the running command finishes.
+func (rm *resourceMonitor) endCommand(ctx context.Context) {
+	rm.sample(ctx, 0, true)
+
+	rm.mu.Lock()
+	rm.currentCommand = ""
+	rm.mu.Unlock()
 }
 
-func (rm *resourceMonitor) sample(ctx context.Context) {
-	// CPU percent is measured over a 200ms interval. This call will block,
-	// so it should be kept low to avoid long delays. We expect exactly 1
-	// result because we pass percpu=false.
-	cpuPercents, err := cpu.PercentWithContext(ctx, 200*time.Millisecond, false)
+// sample records the current resource usage. CPU usage is measured over the
+// given interval. If the interval is zero, the sample doesn't block, so
+// samples at command boundaries do not delay the task. Boundary samples are
+// only added to the usage time series. They aren't evenly spaced like periodic
+// samples, so they don't count toward detecting sustained resource constraints.
+func (rm *resourceMonitor) sample(ctx context.Context, cpuInterval time.Duration, boundary bool) {
+	s := resourceusage.Sample{Time: time.Now()}
+
+	// With a nonzero interval, this call blocks for the interval, so it
+	// should be kept low to avoid long delays. With a zero interval, it
+	// returns immediately with the usage since the previous zero-interval
+	// call in this process, which is the previous command boundary (or the
+	// agent starting, for the first one), not the previous periodic sample.
+	// We expect exactly 1 result because we pass percpu=false.
+	cpuPercents, err := cpu.PercentWithContext(ctx, cpuInterval, false)
 	if err != nil {
 		rm.logger.Debug(ctx, errors.Wrap(err, "sampling CPU usage"))
 	} else if len(cpuPercents) > 0 {
-		rm.recordCPU(cpuPercents[0])
+		if !boundary {
+			rm.recordCPU(cpuPercents[0])
+		}
+		s.CPUPercent = cpuPercents[0]
 	} else {
 		rm.logger.Warning(ctx, "CPU usage sampling returned empty result")
 	}
@@ -77,8 +223,46 @@ func (rm *resourceMonitor) sample(ctx context.Context) {
 	if err != nil {
 		rm.logger.Warning(ctx, errors.Wrap(err, "sampling memory usage"))
 	} else if memStat != nil {
-		rm.recordMemory(memStat.UsedPercent)
+		if !boundary {
+			rm.recordMemory(memStat.UsedPercent)
+		}
+		s.MemoryPercent = memStat.UsedPercent
+		s.MemoryUsedBytes = memStat.Used
 	}
+
+	var diskRead, diskWrite uint64
+	ioCounters, err := disk.IOCountersWithContext(ctx)
+	if err != nil {
+		rm.logger.Debug(ctx, errors.Wrap(err, "sampling disk I/O"))
+	}
+	for _, counters := range ioCounters {
+		diskRead += counters.ReadBytes
+		diskWrite += counters.WriteBytes
+	}
+
+	s.WorkDirDiskUsedBytes = rm.getWorkDirSize()
+
+	pids, err := process.PidsWithContext(ctx)
+	if err != nil {
+		rm.logger.Debug(ctx, errors.Wrap(err, "sampling process count"))
+	}
+	s.NumProcesses = len(pids)
+
+	rm.recordUsage(s, diskRead, diskWrite, boundary)
+}
+
+func (rm *resourceMonitor) getWorkDir() string {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	return rm.workDir
+}
+
+func (rm *resourceMonitor) getWorkDirSize() uint64 {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	return rm.workDirSize
 }
 
 func (rm *resourceMonitor) recordCPU(percent float64) {
@@ -117,6 +301,74 @@ func (rm *resourceMonitor) recordMemory(percent float64) {
 	}
 }
 
+// recordUsage adds a sample to the resource usage time series, given the
+// cumulative disk I/O counters at the time of the sample.
+func (rm *resourceMonitor) recordUsage(s resourceusage.Sample, diskRead, diskWrite uint64, boundary bool) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	s.Command = rm.currentCommand
+	// The first sample has no previous counters to compare against, and the
+	// counters can go backwards if a disk is removed.
+	if len(rm.usageSamples) > 0 && diskRead >= rm.lastDiskRead && diskWrite >= rm.lastDiskWrite {
+		s.DiskReadBytes = diskRead - rm.lastDiskRead
+		s.DiskWriteBytes = diskWrite - rm.lastDiskWrite
+	}
+	rm.lastDiskRead = diskRead
+	rm.lastDiskWrite = diskWrite
+
+	rm.usageSamples = append(rm.usageSamples, usageSample{Sample: s, boundary: boundary})
+	if len(rm.usageSamples) > maxUsageSamples {
+		rm.thinUsageSamples()
+	}
+}
+
+// thinUsageSamples drops every other periodic sample, adding each dropped
+// sample's disk I/O to the next kept sample. Samples at command boundaries are
+// kept unless there are too many of them to fit, and the latest sample is
+// always kept.
+func (rm *resourceMonitor) thinUsageSamples() {
+	var numBoundary int
+	for _, s := range rm.usageSamples {
+		if s.boundary {
+			numBoundary++
+		}
+	}
+	numPeriodic := len(rm.usageSamples) - numBoundary
+	keepBoundaries := numBoundary+(numPeriodic+1)/2 <= maxUsageSamples
+
+	thinned := make([]usageSample, 0, maxUsageSamples)
+	var numCandidates int
+	var droppedRead, droppedWrite uint64
+	for i, s := range rm.usageSamples {
+		if !(s.boundary && keepBoundaries) && i < len(rm.usageSamples)-1 {
+			numCandidates++
+			if numCandidates%2 == 0 {
+				droppedRead += s.DiskReadBytes
+				droppedWrite += s.DiskWriteBytes
+				continue
+			}
+		}
+		s.DiskReadBytes += droppedRead
+		s.DiskWriteBytes += droppedWrite
+		droppedRead, droppedWrite = 0, 0
+		thinned = append(thinned, s)
+	}
+	rm.usageSamples = thinned
+}
+
+// usage returns the resource usage time series recorded so far.
+func (rm *resourceMonitor) usage() []resourceusage.Sample {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	samples := make([]resourceusage.Sample, 0, len(rm.usageSamples))
+	for _, s := range rm.usageSamples {
+		samples = append(samples, s.Sample)
+	}
+	return samples
+}
+
 func (rm *resourceMonitor) report() *apimodels.ResourceConstraintInfo {
 	rm.mu.Lock()
 	defer rm.mu.Unlock()
@@ -1,8 +1,13 @@
 package agent
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,3 +138,145 @@ func TestResourceMonitorBothConstrained(t *testing.T) {
 	assert.InDelta(t, 95.0, info.PeakCPUPercent, 0.01)
 	assert.InDelta(t, 93.0, info.PeakMemoryPercent, 0.01)
 }
+
+func TestResourceMonitorUsage(t *testing.T) {
+	t.Run("SamplesAreTaggedWithCommand", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		rm.recordUsage(resourceusage.Sample{MemoryPercent: 10}, 0, 0, false)
+		rm.mu.Lock()
+		rm.currentCommand = "shell.exec"
+		rm.mu.Unlock()
+		rm.recordUsage(resourceusage.Sample{MemoryPercent: 20}, 0, 0, true)
+		rm.recordUsage(resourceusage.Sample{MemoryPercent: 30}, 0, 0, false)
+
+		samples := rm.usage()
+		require.Len(t, samples, 3)
+		assert.Empty(t, samples[0].Command)
+		assert.Equal(t, "shell.exec", samples[1].Command)
+		assert.Equal(t, "shell.exec", samples[2].Command)
+		assert.InDelta(t, 30.0, samples[2].MemoryPercent, 0.01)
+	})
+
+	t.Run("BoundarySamplesDoNotAffectConstraints", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		for range sustainedSampleCount - 1 {
+			rm.recordCPU(95.0)
+			rm.recordMemory(95.0)
+		}
+
+		rm.startCommand(t.Context(), "shell.exec")
+		rm.endCommand(t.Context())
+
+		samples := rm.usage()
+		require.Len(t, samples, 2)
+		assert.Equal(t, "shell.exec", samples[0].Command)
+		rm.mu.Lock()
+		assert.Equal(t, sustainedSampleCount-1, rm.cpuConsecutive)
+		assert.Equal(t, sustainedSampleCount-1, rm.memoryConsecutive)
+		assert.InDelta(t, 95.0, rm.peakCPUPercent, 0.01)
+		assert.InDelta(t, 95.0, rm.peakMemoryPercent, 0.01)
+		rm.mu.Unlock()
+		assert.Nil(t, rm.report())
+	})
+
+	t.Run("DiskIOIsSincePreviousSample", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		rm.recordUsage(resourceusage.Sample{}, 100, 200, false)
+		rm.recordUsage(resourceusage.Sample{}, 150, 260, false)
+		// Counters going backwards do not produce a delta.
+		rm.recordUsage(resourceusage.Sample{}, 10, 20, false)
+		rm.recordUsage(resourceusage.Sample{}, 15, 30, false)
+
+		samples := rm.usage()
+		require.Len(t, samples, 4)
+		assert.Zero(t, samples[0].DiskReadBytes)
+		assert.Zero(t, samples[0].DiskWriteBytes)
+		assert.EqualValues(t, 50, samples[1].DiskReadBytes)
+		assert.EqualValues(t, 60, samples[1].DiskWriteBytes)
+		assert.Zero(t, samples[2].DiskReadBytes)
+		assert.EqualValues(t, 5, samples[3].DiskReadBytes)
+		assert.EqualValues(t, 10, samples[3].DiskWriteBytes)
+	})
+
+	t.Run("SeriesIsBounded", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		start := time.Now()
+		for i := range 3 * maxUsageSamples {
+			boundary := i%100 == 0
+			rm.recordUsage(resourceusage.Sample{Time: start.Add(time.Duration(i) * time.Second)}, uint64(i), 0, boundary)
+		}
+
+		samples := rm.usage()
+		assert.LessOrEqual(t, len(samples), maxUsageSamples)
+		assert.Greater(t, len(samples), maxUsageSamples/4)
+		assert.Equal(t, start, samples[0].Time, "the first sample should be kept")
+		assert.Equal(t, start.Add(time.Duration(3*maxUsageSamples-1)*time.Second), samples[len(samples)-1].Time, "the latest sample should be kept")
+
+		var totalRead uint64
+		for _, s := range samples {
+			totalRead += s.DiskReadBytes
+		}
+		assert.EqualValues(t, 3*maxUsageSamples-1, totalRead, "disk I/O of dropped samples should be kept")
+
+		var numBoundary int
+		rm.mu.Lock()
+		for _, s := range rm.usageSamples {
+			if s.boundary {
+				numBoundary++
+			}
+		}
+		rm.mu.Unlock()
+		assert.Equal(t, 3*maxUsageSamples/100, numBoundary, "samples at command boundaries should be kept")
+	})
+
+	t.Run("SeriesOfOnlyBoundariesIsBounded", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		for range 2*maxUsageSamples + 1 {
+			rm.recordUsage(resourceusage.Sample{}, 0, 0, true)
+		}
+		assert.LessOrEqual(t, len(rm.usage()), maxUsageSamples)
+	})
+}
+
+func TestResourceMonitorWorkDirSize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	workDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "a"), make([]byte, 100), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(workDir, "src", "pkg"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "src", "pkg", "b"), make([]byte, 50), 0644))
+
+	t.Run("SumsFileSizes", func(t *testing.T) {
+		size, err := dirSize(ctx, workDir, maxWorkDirSizeEntries)
+		require.NoError(t, err)
+		assert.EqualValues(t, 150, size)
+	})
+	t.Run("StopsAfterMaxEntries", func(t *testing.T) {
+		size, err := dirSize(ctx, workDir, 2)
+		assert.ErrorIs(t, err, errWorkDirSizeIncomplete)
+		assert.Less(t, size, uint64(150))
+	})
+	t.Run("StopsWhenContextIsDone", func(t *testing.T) {
+		cancelledCtx, cancelWalk := context.WithCancel(ctx)
+		cancelWalk()
+		_, err := dirSize(cancelledCtx, workDir, maxWorkDirSizeEntries)
+		assert.ErrorIs(t, err, errWorkDirSizeIncomplete)
+	})
+	t.Run("FailsForMissingDirectory", func(t *testing.T) {
+		_, err := dirSize(ctx, filepath.Join(workDir, "nonexistent"), maxWorkDirSizeEntries)
+		assert.Error(t, err)
+	})
+	t.Run("SamplesReportLatestSize", func(t *testing.T) {
+		rm := newResourceMonitor(nil)
+		rm.setWorkDir(workDir)
+		rm.sample(ctx, 0, true)
+		rm.updateWorkDirSize(ctx)
+		rm.sample(ctx, 0, true)
+
+		samples := rm.usage()
+		require.Len(t, samples, 2)
+		assert.Zero(t, samples[0].WorkDirDiskUsedBytes, "size should not be known before the directory is walked")
+		assert.EqualValues(t, 150, samples[1].WorkDirDiskUsedBytes)
+	})
+}
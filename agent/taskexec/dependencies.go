@@ -0,0 +1,191 @@
+package taskexec
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/pkg/errors"
+)
+
+// ResolveTaskDependencies returns the tasks to run, in order, to run a task
+// on a build variant locally. The task's depends_on chain within the variant
+// is sorted topologically and ends with the task itself. Dependencies on
+// tasks in other variants are skipped since only one variant runs locally.
+func (e *LocalExecutor) ResolveTaskDependencies(ctx context.Context, taskName, variantName string) ([]string, error) {
+	if e.project == nil {
+		return nil, errors.New("project not loaded")
+	}
+	if variantName == "" {
+		return nil, errors.New("build variant is required to resolve task dependencies")
+	}
+	if e.project.FindBuildVariant(variantName) == nil {
+		return nil, errors.Errorf("build variant '%s' not found in project", variantName)
+	}
+
+	var variantTasks []string
+	for _, name := range e.project.FindTasksForVariant(variantName) {
+		// Task group names are listed alongside their tasks.
+		if e.project.FindProjectTask(name) != nil && !slices.Contains(variantTasks, name) {
+			variantTasks = append(variantTasks, name)
+		}
+	}
+
+	var (
+		order    []string
+		visited  = map[string]bool{}
+		visiting []string
+	)
+	var visit func(name string) error
+	visit = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		if idx := slices.Index(visiting, name); idx >= 0 {
+			return errors.Errorf("dependency cycle: %s", strings.Join(slices.Concat(visiting[idx:], []string{name}), " -> "))
+		}
+		bvt := e.project.FindTaskForVariant(name, variantName)
+		if bvt == nil {
+			return errors.Errorf("task '%s' is not defined on build variant '%s'", name, variantName)
+		}
+
+		visiting = append(visiting, name)
+		for _, dep := range e.variantDependencies(ctx, name, variantName, bvt, variantTasks) {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		visiting = visiting[:len(visiting)-1]
+
+		visited[name] = true
+		order = append(order, name)
+		return nil
+	}
+	if err := visit(taskName); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// variantDependencies returns the names of the tasks in the variant that the
+// task depends on, in the order they're declared.
+func (e *LocalExecutor) variantDependencies(ctx context.Context, taskName, variantName string, bvt *model.BuildVariantTaskUnit, variantTasks []string) []string {
+	dependsOn := bvt.DependsOn
+	if len(dependsOn) == 0 {
+		if pt := e.project.FindProjectTask(taskName); pt != nil {
+			dependsOn = pt.DependsOn
+		}
+	}
+
+	var deps []string
+	addDep := func(name string) {
+		if name != taskName && !slices.Contains(deps, name) {
+			deps = append(deps, name)
+		}
+	}
+
+	// Tasks in a single host task group implicitly depend on the task before
+	// them in the group.
+	if tg := e.project.FindTaskGroupForTask(variantName, taskName); tg != nil && tg.MaxHosts <= 1 {
+		if idx := slices.Index(tg.Tasks, taskName); idx > 0 {
+			addDep(tg.Tasks[idx-1])
+		}
+	}
+
+	for _, dep := range dependsOn {
+		depVariant := dep.Variant
+		if depVariant == "" {
+			depVariant = variantName
+		}
+		if depVariant != variantName && depVariant != model.AllVariants {
+			e.logger.Warningf(ctx, "Skipping dependency of task '%s' on task '%s' in build variant '%s' because only tasks in build variant '%s' run locally.",
+				taskName, dep.Name, depVariant, variantName)
+			continue
+		}
+
+		if dep.Name == model.AllDependencies {
+			for _, name := range variantTasks {
+				addDep(name)
+			}
+			continue
+		}
+		if !slices.Contains(variantTasks, dep.Name) {
+			if depVariant == variantName {
+				e.logger.Warningf(ctx, "Skipping dependency of task '%s' on task '%s' because it is not defined on build variant '%s'.",
+					taskName, dep.Name, variantName)
+			}
+			continue
+		}
+		addDep(dep.Name)
+	}
+
+	return deps
+}
+
+// RunTaskWithDependencies runs a task on a build variant after running the
+// tasks it depends on within the variant. It stops at the first task that
+// fails or reports a failing test result. When it finishes, the last task
+// that ran stays selected.
+func (e *LocalExecutor) RunTaskWithDependencies(ctx context.Context, taskName, variantName string) (err error) {
+	startTime := time.Now()
+	defer func() {
+		// Failures outside of a step don't otherwise reach the stream.
+		if err != nil && e.streamWriter != nil {
+			e.streamWriter.WriteDone(false, time.Since(startTime).Milliseconds(), e.debugState.CurrentStepIndex, err.Error())
+		}
+	}()
+
+	if variantName == "" {
+		variantName = e.taskConfig.Task.BuildVariant
+	}
+	tasks, err := e.ResolveTaskDependencies(ctx, taskName, variantName)
+	if err != nil {
+		return errors.Wrap(err, "resolving task dependencies")
+	}
+	msg := fmt.Sprintf("Running %d task(s) on build variant '%s': %s.", len(tasks), variantName, strings.Join(tasks, ", "))
+	if outputDir := e.taskConfig.LocalOutputDir; outputDir != "" {
+		msg += fmt.Sprintf(" S3 files and test results are stored in '%s'.", outputDir)
+	}
+	if e.streamWriter != nil {
+		e.streamWriter.WriteChannelMessage(ExecChannel, msg)
+	}
+	e.logger.Info(ctx, msg)
+
+	for i, name := range tasks {
+		msg := fmt.Sprintf("Running task '%s' (%d of %d).", name, i+1, len(tasks))
+		if e.streamWriter != nil {
+			e.streamWriter.WriteChannelMessage(ExecChannel, msg)
+		}
+		e.logger.Info(ctx, msg)
+
+		if err := e.prepareTaskFromStart(ctx, name, variantName); err != nil {
+			return errors.Wrapf(err, "preparing task '%s'", name)
+		}
+
+		if err := e.RunAll(ctx); err != nil {
+			return errors.Wrapf(err, "running task '%s'", name)
+		}
+		if e.taskConfig.HasFailingTestResult {
+			return errors.Errorf("task '%s' has failing test results", name)
+		}
+	}
+
+	return nil
+}
+
+// prepareTaskFromStart prepares a task to run from its first step as if no
+// other task had run before it.
+func (e *LocalExecutor) prepareTaskFromStart(ctx context.Context, taskName, variantName string) error {
+	if err := e.PrepareTask(ctx, taskName, variantName); err != nil {
+		return err
+	}
+	e.debugState.CurrentStepIndex = 0
+	e.debugState.ExecutionHistory = []executionRecord{}
+	e.taskConfig.HasTestResults = false
+	e.taskConfig.HasFailingTestResult = false
+	return nil
+}
@@ -0,0 +1,290 @@
+package taskexec
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadTestExecutor(t *testing.T, yamlContent string) *LocalExecutor {
+	tmpDir := t.TempDir()
+	yamlFile := filepath.Join(tmpDir, "test.yml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(yamlContent), 0644))
+
+	executor, err := NewLocalExecutor(t.Context(), LocalExecutorOptions{WorkingDir: tmpDir})
+	require.NoError(t, err)
+	_, err = executor.LoadProject(yamlFile)
+	require.NoError(t, err)
+	return executor
+}
+
+func TestResolveTaskDependencies(t *testing.T) {
+	t.Run("OrdersChainTopologically", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: compile
+  - name: lint
+  - name: package
+    depends_on:
+      - name: compile
+  - name: test
+    depends_on:
+      - name: package
+      - name: compile
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: compile
+      - name: lint
+      - name: package
+      - name: test
+`)
+		tasks, err := executor.ResolveTaskDependencies(t.Context(), "test", "ubuntu")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"compile", "package", "test"}, tasks)
+	})
+
+	t.Run("SkipsDependenciesOnOtherVariants", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: compile
+  - name: test
+    depends_on:
+      - name: compile
+        variant: windows
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: compile
+      - name: test
+  - name: windows
+    tasks:
+      - name: compile
+`)
+		tasks, err := executor.ResolveTaskDependencies(t.Context(), "test", "ubuntu")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"test"}, tasks)
+	})
+
+	t.Run("AllDependenciesIncludesEveryTaskInVariant", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: compile
+  - name: lint
+  - name: other
+  - name: report
+    depends_on:
+      - name: "*"
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: compile
+      - name: lint
+      - name: report
+`)
+		tasks, err := executor.ResolveTaskDependencies(t.Context(), "report", "ubuntu")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"compile", "lint", "report"}, tasks)
+	})
+
+	t.Run("SingleHostTaskGroupTasksDependOnPreviousTask", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: first
+  - name: second
+  - name: third
+task_groups:
+  - name: group
+    max_hosts: 1
+    tasks:
+      - first
+      - second
+      - third
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: group
+`)
+		tasks, err := executor.ResolveTaskDependencies(t.Context(), "third", "ubuntu")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first", "second", "third"}, tasks)
+	})
+
+	t.Run("ReturnsErrorForCycle", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: a
+    depends_on:
+      - name: b
+  - name: b
+    depends_on:
+      - name: a
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: a
+      - name: b
+`)
+		_, err := executor.ResolveTaskDependencies(t.Context(), "a", "ubuntu")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "dependency cycle: a -> b -> a")
+	})
+
+	t.Run("ReturnsErrorForTaskNotOnVariant", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: compile
+  - name: test
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: compile
+`)
+		_, err := executor.ResolveTaskDependencies(t.Context(), "test", "ubuntu")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "task 'test' is not defined on build variant 'ubuntu'")
+	})
+
+	t.Run("RequiresVariant", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: compile
+`)
+		_, err := executor.ResolveTaskDependencies(t.Context(), "compile", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestRunTaskWithDependencies(t *testing.T) {
+	t.Run("RunsDependenciesWithLocalS3AndTestResults", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "binary" > binary.txt
+      - command: s3.put
+        params:
+          local_file: binary.txt
+          remote_file: builds/binary.txt
+          bucket: artifacts
+          aws_key: ${aws_key}
+          aws_secret: ${aws_secret}
+          content_type: text/plain
+          permissions: private
+          visibility: private
+  - name: test
+    depends_on:
+      - name: compile
+    commands:
+      - command: s3.get
+        params:
+          remote_file: builds/binary.txt
+          bucket: artifacts
+          aws_key: ${aws_key}
+          aws_secret: ${aws_secret}
+          local_file: fetched.txt
+      - command: shell.exec
+        params:
+          script: |
+            echo '{"results": [{"test_file": "TestBinary", "status": "pass"}, {"test_file": "TestOther", "status": "fail"}]}' > results.json
+      - command: attach.results
+        params:
+          file_location: results.json
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: compile
+      - name: test
+`)
+		outputDir := filepath.Join(t.TempDir(), "output")
+		executor.SetLocalOutputDir(outputDir)
+
+		err := executor.RunTaskWithDependencies(t.Context(), "test", "ubuntu")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "task 'test' has failing test results")
+		assert.Equal(t, "test", executor.GetDebugState().SelectedTask)
+
+		fetched, err := os.ReadFile(filepath.Join(executor.workDir, "fetched.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "binary\n", string(fetched))
+		assert.FileExists(t, filepath.Join(outputDir, "s3", "artifacts", "builds", "binary.txt"))
+
+		f, err := os.Open(filepath.Join(outputDir, "test_results.jsonl"))
+		require.NoError(t, err)
+		defer f.Close()
+		statuses := map[string]string{}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var result struct {
+				Task     string `json:"task"`
+				Variant  string `json:"variant"`
+				TestName string `json:"test_name"`
+				Status   string `json:"status"`
+			}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &result))
+			assert.Equal(t, "test", result.Task)
+			assert.Equal(t, "ubuntu", result.Variant)
+			statuses[result.TestName] = result.Status
+		}
+		require.NoError(t, scanner.Err())
+		assert.Equal(t, map[string]string{"TestBinary": "pass", "TestOther": "fail"}, statuses)
+	})
+
+	t.Run("StopsAtFirstFailingTask", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: exit 1
+  - name: test
+    depends_on:
+      - name: compile
+    commands:
+      - command: shell.exec
+        params:
+          script: touch ran-test
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: compile
+      - name: test
+`)
+		err := executor.RunTaskWithDependencies(t.Context(), "test", "ubuntu")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "running task 'compile'")
+		assert.Equal(t, "compile", executor.GetDebugState().SelectedTask)
+		assert.NoFileExists(t, filepath.Join(executor.workDir, "ran-test"))
+	})
+
+	t.Run("SkipsS3PutWithoutLocalOutputDir", func(t *testing.T) {
+		executor := loadTestExecutor(t, `
+tasks:
+  - name: compile
+    commands:
+      - command: s3.put
+        params:
+          local_file: missing.txt
+          remote_file: builds/missing.txt
+          bucket: artifacts
+          aws_key: ${aws_key}
+          aws_secret: ${aws_secret}
+          content_type: text/plain
+          permissions: private
+          visibility: private
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: compile
+`)
+		assert.NoError(t, executor.RunTaskWithDependencies(t.Context(), "compile", "ubuntu"))
+	})
+}
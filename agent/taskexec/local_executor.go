@@ -31,7 +31,9 @@ var noOpCommands = map[string]string{
 	"downstream_expansions.set":             "downstream expansions are not available in local execution",
 	evergreen.AttachXUnitResultsCommandName: "test result attachment is not supported in local execution",
 	evergreen.AttachResultsCommandName:      "result attachment is not supported in local execution",
+	evergreen.AttachTestResultsCommandName:  "test result attachment is not supported in local execution",
 	"gotest.parse_files":                    "result attachment is not supported in local execution",
+	evergreen.CoverageParseCommandName:      "coverage attachment is not supported in local execution",
 	evergreen.AttachArtifactsCommandName:    "artifact attachment is not supported in local execution",
 	"papertrail.trace":                      "papertrail tracing is not available in local execution",
 	"keyval.inc":                            "key-value increment operations are not supported in local execution",
@@ -40,6 +42,17 @@ var noOpCommands = map[string]string{
 	"s3Copy.copy":                           "S3 copy operations are not supported in local execution",
 }
 
+// localOutputCommands are the commands in noOpCommands that run when the
+// executor has a local output directory to stand in for S3 and the test
+// results service.
+var localOutputCommands = map[string]bool{
+	evergreen.AttachXUnitResultsCommandName: true,
+	evergreen.AttachResultsCommandName:      true,
+	evergreen.AttachTestResultsCommandName:  true,
+	"gotest.parse_files":                    true,
+	"s3.put":                                true,
+}
+
 // mockSecret is required to make agent request formation validation pass but it's not used in
 // debug sessions so we hard code it to a mock
 const mockSecret = "mock_secret"
@@ -82,6 +95,9 @@ type LocalExecutor struct {
 	streamWriter      *streamWriter
 	logManager        *logManager
 	streamingProducer *streamingLoggerProducer
+	recorder          *sessionRecorder
+	replaying         bool
+	lastCapturedStep  *RecordedStep
 }
 
 // LocalExecutorOptions contains configuration for the local executor
@@ -93,6 +109,9 @@ type LocalExecutorOptions struct {
 	OAuthToken   string
 	SpawnHostID  string
 	LocalModules map[string]string
+	// LocalOutputDir is the directory that backs S3 commands and receives
+	// the test results report. If it's empty, those commands are no-ops.
+	LocalOutputDir string
 }
 
 // NewLocalExecutor creates a new local task executor
@@ -123,6 +142,7 @@ func NewLocalExecutor(ctx context.Context, opts LocalExecutorOptions) (*LocalExe
 		NewExpansions:         agentutil.NewDynamicExpansions(expansions),
 		WorkDir:               opts.WorkingDir,
 		AssumeRoleInformation: map[string]internal.AssumeRoleInformation{},
+		LocalOutputDir:        opts.LocalOutputDir,
 	}
 
 	jasperManager, err := jasper.NewSynchronizedManager(false)
@@ -154,16 +174,23 @@ func NewLocalExecutor(ctx context.Context, opts LocalExecutorOptions) (*LocalExe
 }
 
 func (e *LocalExecutor) wrapLoggerWithRedactor() {
-	redactedSender := redactor.NewRedactingSender(e.logger.GetSender(), redactor.RedactionOptions{
-		Expansions:         e.taskConfig.NewExpansions,
-		Redacted:           e.taskConfig.Redacted,
-		InternalRedactions: e.taskConfig.InternalRedactions,
-	})
+	redactedSender := redactor.NewRedactingSender(e.logger.GetSender(), e.redactionOptions())
 	redactedLogger := logging.MakeGrip(redactedSender)
 	e.logger = redactedLogger
 	e.loggerProducer = &localLoggerProducer{logger: redactedLogger}
 }
 
+// redactionOptions returns the options for redacting the task's sensitive
+// values.
+func (e *LocalExecutor) redactionOptions() redactor.RedactionOptions {
+	return redactor.RedactionOptions{
+		Expansions:         e.taskConfig.NewExpansions,
+		Redacted:           e.taskConfig.Redacted,
+		InternalRedactions: e.taskConfig.InternalRedactions,
+		SecretDetector:     e.taskConfig.SecretDetector,
+	}
+}
+
 // LoadProject loads and parses an Evergreen project configuration from a file
 func (e *LocalExecutor) LoadProject(configPath string) (*model.Project, error) {
 	e.logger.Infof(context.Background(), "Loading project from: %s", configPath)
@@ -191,6 +218,7 @@ func (e *LocalExecutor) LoadProject(configPath string) (*model.Project, error) {
 	}
 	e.parserProject = pp
 	e.project = project
+	e.debugState.ConfigPath = absPath
 
 	if e.taskConfig != nil {
 		e.taskConfig.Project = *project
@@ -342,9 +370,20 @@ func (e *LocalExecutor) stepNext(ctx context.Context) error {
 
 	startTime := time.Now()
 
-	_, isNoOp := noOpCommands[targetCmd.CommandName]
-	if isNoOp {
+	capture := e.startStepCapture(targetCmd)
+	if capture != nil {
+		originalProducer := capture.producer
+		defer func() {
+			e.loggerProducer = originalProducer
+		}()
+	}
+
+	if e.isNoOp(targetCmd.CommandName) {
 		noOpMsg := e.getNoOpMessage(targetCmd.CommandName)
+		if capture != nil {
+			capture.step.NoOp = true
+			capture.output.addLines(noOpMsg)
+		}
 		if e.streamWriter != nil {
 			e.streamWriter.WriteChannelMessage(ExecChannel, noOpMsg)
 		}
@@ -357,6 +396,7 @@ func (e *LocalExecutor) stepNext(ctx context.Context) error {
 			durationMs: durationMs,
 		}
 		e.debugState.addExecutionRecord(record)
+		e.finishStepCapture(ctx, capture, true, durationMs, "")
 
 		if e.streamWriter != nil {
 			nextStep := e.debugState.CurrentStepIndex
@@ -435,6 +475,7 @@ func (e *LocalExecutor) stepNext(ctx context.Context) error {
 				return err
 			}
 			defer cleanup()
+			e.captureExpansions(capture)
 
 			err = cmd.Execute(ctx, e.communicator, e.loggerProducer, e.taskConfig)
 			if err != nil {
@@ -462,6 +503,7 @@ func (e *LocalExecutor) stepNext(ctx context.Context) error {
 		record.durationMs = durationMs
 		record.errMsg = err.Error()
 		e.debugState.addExecutionRecord(record)
+		e.finishStepCapture(ctx, capture, false, durationMs, err.Error())
 
 		if e.streamWriter != nil {
 			e.streamWriter.WriteDone(false, durationMs, e.debugState.CurrentStepIndex, err.Error())
@@ -478,6 +520,7 @@ func (e *LocalExecutor) stepNext(ctx context.Context) error {
 	durationMs := time.Since(startTime).Milliseconds()
 	record.durationMs = durationMs
 	e.debugState.addExecutionRecord(record)
+	e.finishStepCapture(ctx, capture, true, durationMs, "")
 
 	if e.streamWriter != nil {
 		e.streamWriter.WriteDone(true, durationMs, e.debugState.CurrentStepIndex, "")
@@ -536,11 +579,7 @@ func (e *LocalExecutor) SetStreamWriter(sw *streamWriter) {
 	producer := newStreamingLoggerProducer(sw)
 
 	baseSender := producer.Execution()
-	redactedSender := redactor.NewRedactingSender(baseSender, redactor.RedactionOptions{
-		Expansions:         e.taskConfig.NewExpansions,
-		Redacted:           e.taskConfig.Redacted,
-		InternalRedactions: e.taskConfig.InternalRedactions,
-	})
+	redactedSender := redactor.NewRedactingSender(baseSender, e.redactionOptions())
 
 	producer.setSender(redactedSender)
 	e.streamingProducer = producer
@@ -661,8 +700,33 @@ func (e *LocalExecutor) runCommandWithTracking(
 
 // isLocalNoOpCommand checks if a command should be no-op in local execution
 func (e *LocalExecutor) isLocalNoOpCommand(cmd command.Command) bool {
-	_, ok := noOpCommands[cmd.Name()]
-	return ok
+	return e.isNoOp(cmd.Name())
+}
+
+// isNoOp checks if the command with the given name should be no-op in local
+// execution.
+func (e *LocalExecutor) isNoOp(cmdName string) bool {
+	if _, ok := noOpCommands[cmdName]; !ok {
+		return false
+	}
+	return e.taskConfig.LocalOutputDir == "" || !localOutputCommands[cmdName]
+}
+
+// SetLocalOutputDir sets the directory that backs S3 commands and receives
+// the test results report. If dir is empty, it defaults to a directory
+// within the working directory.
+func (e *LocalExecutor) SetLocalOutputDir(dir string) {
+	if dir == "" {
+		dir = filepath.Join(e.workDir, logBaseDir, localOutputSubDir)
+	}
+	e.taskConfig.LocalOutputDir = dir
+	e.logger.Infof(context.Background(), "Local output directory set to: %s", dir)
+}
+
+// GetLocalOutputDir returns the directory that backs S3 commands and
+// receives the test results report, or an empty string if there is none.
+func (e *LocalExecutor) GetLocalOutputDir() string {
+	return e.taskConfig.LocalOutputDir
 }
 
 // handleNoOpCommand logs a message for commands that are no-op in local execution
@@ -684,6 +748,7 @@ func (e *LocalExecutor) PrepareTask(ctx context.Context, taskName, variantName s
 	}
 
 	e.debugState.SelectedTask = taskName
+	e.taskConfig.Expansions.Put("task_name", taskName)
 	e.logger.Infof(ctx, "Preparing task: %s", taskName)
 
 	if variantName == "" && e.taskConfig.Task.BuildVariant != "" {
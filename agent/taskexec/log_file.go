@@ -20,6 +20,9 @@ const (
 	setupSubDir   = "setup"
 	outputLogFile = "output.log"
 
+	localOutputSubDir = "output"
+	recordingsSubDir  = "recordings"
+
 	setupLogPath = "/tmp/debug-setup.log"
 )
 
@@ -0,0 +1,540 @@
+package taskexec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/agent/internal/redactor"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/logging"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+const (
+	sessionRecordingVersion = 1
+	// maxRecordedOutputLines is the maximum number of output lines recorded
+	// for a single step.
+	maxRecordedOutputLines = 1000
+)
+
+// SessionRecordingHeader describes a recorded debug session. A session file
+// starts with the header, followed by one RecordedStep per step that ran.
+type SessionRecordingHeader struct {
+	Version    int       `json:"version"`
+	RecordedAt time.Time `json:"recorded_at"`
+	ConfigPath string    `json:"config_path,omitempty"`
+	WorkingDir string    `json:"working_dir,omitempty"`
+}
+
+// SessionRecording is a debug session loaded from a session file.
+type SessionRecording struct {
+	SessionRecordingHeader
+	Steps []RecordedStep
+}
+
+// RecordedStep is the record of a single step that ran in a debug session.
+// Expansion values, errors and output are redacted.
+type RecordedStep struct {
+	Task            string            `json:"task"`
+	Variant         string            `json:"variant,omitempty"`
+	StepNumber      string            `json:"step_number"`
+	DisplayName     string            `json:"display_name"`
+	CommandName     string            `json:"command_name"`
+	NoOp            bool              `json:"no_op,omitempty"`
+	Expansions      map[string]string `json:"expansions"`
+	Success         bool              `json:"success"`
+	Error           string            `json:"error,omitempty"`
+	StartedAt       time.Time         `json:"started_at"`
+	DurationMs      int64             `json:"duration_ms"`
+	Output          []string          `json:"output"`
+	OutputTruncated bool              `json:"output_truncated,omitempty"`
+}
+
+// LoadSessionRecording reads a session file written while recording a debug
+// session.
+func LoadSessionRecording(path string) (*SessionRecording, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening session file '%s'", path)
+	}
+	defer f.Close()
+
+	recording := &SessionRecording{}
+	dec := json.NewDecoder(f)
+	if err := dec.Decode(&recording.SessionRecordingHeader); err != nil {
+		return nil, errors.Wrapf(err, "reading session file header from '%s'", path)
+	}
+	if recording.Version != sessionRecordingVersion {
+		return nil, errors.Errorf("unsupported session file version %d", recording.Version)
+	}
+	for {
+		var step RecordedStep
+		if err := dec.Decode(&step); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "reading step %d from session file '%s'", len(recording.Steps)+1, path)
+		}
+		recording.Steps = append(recording.Steps, step)
+	}
+
+	return recording, nil
+}
+
+// sessionRecorder appends recorded steps to a session file as they finish,
+// so the file is usable even if the session ends unexpectedly.
+type sessionRecorder struct {
+	path string
+	file *os.File
+}
+
+func newSessionRecorder(path string, header SessionRecordingHeader) (*sessionRecorder, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "creating session file directory '%s'", dir)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating session file '%s'", path)
+	}
+
+	r := &sessionRecorder{path: path, file: f}
+	if err := r.write(header); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(err)
+		catcher.Add(f.Close())
+		return nil, catcher.Resolve()
+	}
+	return r, nil
+}
+
+func (r *sessionRecorder) add(step RecordedStep) error {
+	return r.write(step)
+}
+
+func (r *sessionRecorder) write(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshalling session record")
+	}
+	data = append(data, '\n')
+	_, err = r.file.Write(data)
+	return errors.Wrapf(err, "writing to session file '%s'", r.path)
+}
+
+func (r *sessionRecorder) close() error {
+	return errors.Wrapf(r.file.Close(), "closing session file '%s'", r.path)
+}
+
+// StartRecording starts recording every step that runs to a session file at
+// the given path. If path is empty, the file is created in the local
+// evergreen directory in the user's home directory.
+func (e *LocalExecutor) StartRecording(path string) (string, error) {
+	if e.recorder != nil {
+		return "", errors.Errorf("session is already being recorded to '%s'", e.recorder.path)
+	}
+	if path == "" {
+		homeDir, err := util.GetUserHome()
+		if err != nil {
+			return "", errors.Wrap(err, "getting home directory")
+		}
+		path = filepath.Join(homeDir, logBaseDir, recordingsSubDir, fmt.Sprintf("session-%s.jsonl", time.Now().Format("20060102-150405")))
+	}
+
+	recorder, err := newSessionRecorder(path, SessionRecordingHeader{
+		Version:    sessionRecordingVersion,
+		RecordedAt: time.Now(),
+		ConfigPath: e.debugState.ConfigPath,
+		WorkingDir: e.workDir,
+	})
+	if err != nil {
+		return "", err
+	}
+	e.recorder = recorder
+	e.logger.Infof(context.Background(), "Recording session to: %s", path)
+
+	return path, nil
+}
+
+// StopRecording stops recording the session and returns the path of the
+// session file.
+func (e *LocalExecutor) StopRecording() (string, error) {
+	if e.recorder == nil {
+		return "", errors.New("session is not being recorded")
+	}
+	recorder := e.recorder
+	e.recorder = nil
+	if err := recorder.close(); err != nil {
+		return "", err
+	}
+	e.logger.Infof(context.Background(), "Stopped recording session to: %s", recorder.path)
+
+	return recorder.path, nil
+}
+
+// GetRecordingPath returns the path of the session file that the session is
+// being recorded to, or an empty string if it's not being recorded.
+func (e *LocalExecutor) GetRecordingPath() string {
+	if e.recorder == nil {
+		return ""
+	}
+	return e.recorder.path
+}
+
+// stepCapture collects the record of a step while it runs.
+type stepCapture struct {
+	step   RecordedStep
+	output *capturedOutput
+	// producer is the logger producer to restore once the step finishes.
+	producer client.LoggerProducer
+}
+
+// startStepCapture starts capturing the task output of the step if the
+// session is being recorded or replayed. It returns nil otherwise.
+func (e *LocalExecutor) startStepCapture(targetCmd CommandInfo) *stepCapture {
+	if e.recorder == nil && !e.replaying {
+		return nil
+	}
+
+	output := newCapturedOutput()
+	capture := &stepCapture{
+		step: RecordedStep{
+			Task:        e.debugState.SelectedTask,
+			Variant:     e.debugState.SelectedVariant,
+			StepNumber:  targetCmd.FullStepNumber(),
+			DisplayName: targetCmd.DisplayName,
+			CommandName: targetCmd.CommandName,
+			StartedAt:   time.Now(),
+		},
+		output:   output,
+		producer: e.loggerProducer,
+	}
+
+	sender := &teeSender{
+		Sender: e.loggerProducer.Task().GetSender(),
+		tee:    redactor.NewRedactingSender(output, e.redactionOptions()),
+	}
+	e.loggerProducer = &recordingLoggerProducer{
+		LoggerProducer: e.loggerProducer,
+		task:           logging.MakeGrip(sender),
+	}
+
+	return capture
+}
+
+// captureExpansions records the expansions that are in effect for the step.
+func (e *LocalExecutor) captureExpansions(capture *stepCapture) {
+	if capture == nil {
+		return
+	}
+
+	expansions := map[string]string{}
+	e.taskConfig.NewExpansions.Range(func(k, v string) bool {
+		expansions[k] = v
+		return true
+	})
+	opts := e.redactionOptions()
+	for k, v := range expansions {
+		expansions[k] = redactor.Redact(v, opts)
+	}
+	capture.step.Expansions = expansions
+}
+
+// finishStepCapture completes the record of the step and adds it to the
+// session file if the session is being recorded.
+func (e *LocalExecutor) finishStepCapture(ctx context.Context, capture *stepCapture, success bool, durationMs int64, errMsg string) {
+	if capture == nil {
+		return
+	}
+
+	if capture.step.Expansions == nil {
+		e.captureExpansions(capture)
+	}
+	capture.step.Success = success
+	capture.step.DurationMs = durationMs
+	capture.step.Error = redactor.Redact(errMsg, e.redactionOptions())
+	capture.step.Output, capture.step.OutputTruncated = capture.output.get()
+
+	step := capture.step
+	e.lastCapturedStep = &step
+	if e.recorder != nil {
+		if err := e.recorder.add(step); err != nil {
+			e.logger.Warningf(ctx, "Could not record step %s: %s", step.StepNumber, err)
+		}
+	}
+}
+
+// ReplayOptions configures replaying a recorded debug session.
+type ReplayOptions struct {
+	// ResumeAtFailure replays the steps before the first step that failed in
+	// the recording, then stops with the failing step as the next step to
+	// run.
+	ResumeAtFailure bool
+}
+
+// StepDifference describes how a replayed step behaved differently from its
+// recording.
+type StepDifference struct {
+	Task        string   `json:"task"`
+	StepNumber  string   `json:"step_number"`
+	Differences []string `json:"differences"`
+}
+
+// ReplayResult is the outcome of replaying a recorded debug session.
+type ReplayResult struct {
+	StepsReplayed int              `json:"steps_replayed"`
+	Differences   []StepDifference `json:"differences,omitempty"`
+	// ResumeStep is the step number of the failing step that the session
+	// resumed at, if any.
+	ResumeStep string `json:"resume_step,omitempty"`
+}
+
+// ReplaySession runs the steps in a recorded session in the order they were
+// recorded and reports how each step's behavior differs from the recording.
+// A step that fails is compared against the recording rather than stopping
+// the replay.
+func (e *LocalExecutor) ReplaySession(ctx context.Context, path string, opts ReplayOptions) (result *ReplayResult, err error) {
+	startTime := time.Now()
+	defer func() {
+		if e.streamWriter == nil {
+			return
+		}
+		if err != nil {
+			e.streamWriter.WriteDone(false, time.Since(startTime).Milliseconds(), e.debugState.CurrentStepIndex, err.Error())
+			return
+		}
+		var errMsg string
+		if len(result.Differences) > 0 {
+			errMsg = fmt.Sprintf("%d step(s) differ from the recording", len(result.Differences))
+		}
+		e.streamWriter.WriteDone(errMsg == "", time.Since(startTime).Milliseconds(), e.debugState.CurrentStepIndex, errMsg)
+	}()
+
+	if e.project == nil {
+		return nil, errors.New("project not loaded")
+	}
+	recording, err := LoadSessionRecording(path)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := recording.Steps
+	var failingStep *RecordedStep
+	if opts.ResumeAtFailure {
+		idx := slices.IndexFunc(steps, func(step RecordedStep) bool { return !step.Success })
+		if idx < 0 {
+			return nil, errors.New("recorded session has no failing step to resume at")
+		}
+		failingStep = &steps[idx]
+		steps = steps[:idx]
+	}
+
+	e.replaying = true
+	defer func() {
+		e.replaying = false
+	}()
+
+	e.writeExecMessage(ctx, fmt.Sprintf("Replaying %d step(s) recorded at %s.", len(steps), recording.RecordedAt.Format(time.RFC3339)))
+
+	result = &ReplayResult{}
+	var prepared *RecordedStep
+	for i := range steps {
+		recorded := steps[i]
+		if prepared == nil || prepared.Task != recorded.Task || prepared.Variant != recorded.Variant {
+			if err := e.prepareTaskFromStart(ctx, recorded.Task, recorded.Variant); err != nil {
+				return result, errors.Wrapf(err, "preparing task '%s'", recorded.Task)
+			}
+			prepared = &recorded
+		}
+
+		result.StepsReplayed++
+		diffs := e.replayStep(ctx, recorded)
+		if len(diffs) == 0 {
+			continue
+		}
+		result.Differences = append(result.Differences, StepDifference{
+			Task:        recorded.Task,
+			StepNumber:  recorded.StepNumber,
+			Differences: diffs,
+		})
+		for _, diff := range diffs {
+			e.writeExecMessage(ctx, fmt.Sprintf("Step %s of task '%s' differs from the recording: %s.", recorded.StepNumber, recorded.Task, diff))
+		}
+	}
+
+	e.writeExecMessage(ctx, fmt.Sprintf("Replayed %d step(s), %d of which differ from the recording.", result.StepsReplayed, len(result.Differences)))
+
+	if failingStep != nil {
+		if prepared == nil || prepared.Task != failingStep.Task || prepared.Variant != failingStep.Variant {
+			if err := e.prepareTaskFromStart(ctx, failingStep.Task, failingStep.Variant); err != nil {
+				return result, errors.Wrapf(err, "preparing task '%s'", failingStep.Task)
+			}
+		}
+		idx, err := e.debugState.ResolveStepNumber(failingStep.StepNumber)
+		if err != nil {
+			return result, errors.Wrapf(err, "finding failing step in task '%s'", failingStep.Task)
+		}
+		if err := e.JumpTo(idx); err != nil {
+			return result, errors.Wrapf(err, "jumping to failing step %s", failingStep.StepNumber)
+		}
+		result.ResumeStep = failingStep.StepNumber
+		e.writeExecMessage(ctx, fmt.Sprintf("Resuming at step %s of task '%s', which failed in the recording: %s", failingStep.StepNumber, failingStep.Task, failingStep.Error))
+	}
+
+	return result, nil
+}
+
+// replayStep runs a recorded step and returns how its behavior differs from
+// the recording.
+func (e *LocalExecutor) replayStep(ctx context.Context, recorded RecordedStep) []string {
+	idx, err := e.debugState.ResolveStepNumber(recorded.StepNumber)
+	if err != nil {
+		return []string{"step no longer exists in the task"}
+	}
+	if err := e.JumpTo(idx); err != nil {
+		return []string{fmt.Sprintf("could not jump to step: %s", err)}
+	}
+
+	e.lastCapturedStep = nil
+	stepErr := e.stepNext(ctx)
+	if e.lastCapturedStep == nil {
+		msg := "step did not run"
+		if stepErr != nil {
+			msg = fmt.Sprintf("step did not run: %s", stepErr)
+		}
+		return []string{msg}
+	}
+
+	return compareRecordedSteps(recorded, *e.lastCapturedStep)
+}
+
+// compareRecordedSteps returns the differences in behavior between a recorded
+// step and the same step when it was replayed.
+func compareRecordedSteps(recorded, replayed RecordedStep) []string {
+	var diffs []string
+	if recorded.CommandName != replayed.CommandName {
+		diffs = append(diffs, fmt.Sprintf("command changed from '%s' to '%s'", recorded.CommandName, replayed.CommandName))
+	}
+	if recorded.Success && !replayed.Success {
+		diffs = append(diffs, fmt.Sprintf("step succeeded in the recording but failed on replay: %s", replayed.Error))
+	} else if !recorded.Success && replayed.Success {
+		diffs = append(diffs, fmt.Sprintf("step failed in the recording but succeeded on replay (recorded error: %s)", recorded.Error))
+	}
+
+	keys := slices.Collect(maps.Keys(recorded.Expansions))
+	for k := range replayed.Expansions {
+		if _, ok := recorded.Expansions[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		recordedVal, inRecorded := recorded.Expansions[k]
+		replayedVal, inReplayed := replayed.Expansions[k]
+		switch {
+		case !inRecorded:
+			diffs = append(diffs, fmt.Sprintf("expansion '%s' was added", k))
+		case !inReplayed:
+			diffs = append(diffs, fmt.Sprintf("expansion '%s' was removed", k))
+		case recordedVal != replayedVal:
+			diffs = append(diffs, fmt.Sprintf("expansion '%s' changed from '%s' to '%s'", k, recordedVal, replayedVal))
+		}
+	}
+
+	for i := range min(len(recorded.Output), len(replayed.Output)) {
+		if recorded.Output[i] != replayed.Output[i] {
+			diffs = append(diffs, fmt.Sprintf("output line %d changed from '%s' to '%s'", i+1, recorded.Output[i], replayed.Output[i]))
+			return diffs
+		}
+	}
+	if len(recorded.Output) != len(replayed.Output) {
+		diffs = append(diffs, fmt.Sprintf("output has %d line(s) but the recording has %d", len(replayed.Output), len(recorded.Output)))
+	}
+
+	return diffs
+}
+
+// writeExecMessage logs a message and sends it to the stream, if any.
+func (e *LocalExecutor) writeExecMessage(ctx context.Context, msg string) {
+	if e.streamWriter != nil {
+		e.streamWriter.WriteChannelMessage(ExecChannel, msg)
+	}
+	e.logger.Info(ctx, msg)
+}
+
+// capturedOutput is a sender that keeps the lines of the messages sent to it.
+type capturedOutput struct {
+	*send.Base
+	mu        sync.Mutex
+	lines     []string
+	truncated bool
+}
+
+func newCapturedOutput() *capturedOutput {
+	c := &capturedOutput{Base: send.NewBase("captured-output")}
+	_ = c.SetLevel(send.LevelInfo{Default: level.Trace, Threshold: level.Trace})
+	return c
+}
+
+func (c *capturedOutput) Send(_ context.Context, m message.Composer) {
+	if !m.Loggable() {
+		return
+	}
+	c.addLines(m.String())
+}
+
+func (c *capturedOutput) Flush(_ context.Context) error { return nil }
+
+func (c *capturedOutput) addLines(msg string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, line := range strings.Split(strings.TrimRight(msg, "\n"), "\n") {
+		if len(c.lines) >= maxRecordedOutputLines {
+			c.truncated = true
+			return
+		}
+		c.lines = append(c.lines, line)
+	}
+}
+
+func (c *capturedOutput) get() ([]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.lines), c.truncated
+}
+
+// teeSender sends messages to its sender and also to a second sender.
+type teeSender struct {
+	send.Sender
+	tee send.Sender
+}
+
+func (s *teeSender) Send(ctx context.Context, m message.Composer) {
+	s.Sender.Send(ctx, m)
+	if s.Level().ShouldLog(m) {
+		s.tee.Send(ctx, m)
+	}
+}
+
+// recordingLoggerProducer overrides the task logger of a logger producer so
+// that a step's task output can be captured.
+type recordingLoggerProducer struct {
+	client.LoggerProducer
+	task grip.Journaler
+}
+
+func (p *recordingLoggerProducer) Task() grip.Journaler { return p.task }
@@ -0,0 +1,195 @@
+package taskexec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sessionRecordingTestProject = `
+tasks:
+  - name: build
+    commands:
+      - command: shell.exec
+        params:
+          script: echo "token ${secret_token}"
+      - command: shell.exec
+        params:
+          script: cat input.txt
+      - command: generate.tasks
+        params:
+          files:
+            - tasks.json
+      - command: shell.exec
+        params:
+          script: test -f ready
+      - command: shell.exec
+        params:
+          script: echo "done"
+buildvariants:
+  - name: ubuntu
+    tasks:
+      - name: build
+`
+
+// recordTestSession records running the test project's build task until it
+// fails and returns the path of the session file.
+func recordTestSession(t *testing.T, executor *LocalExecutor) string {
+	executor.taskConfig.NewExpansions.PutAndRedact("secret_token", "hunter2")
+	require.NoError(t, os.WriteFile(filepath.Join(executor.workDir, "input.txt"), []byte("first\nsecond\n"), 0644))
+	require.NoError(t, executor.PrepareTask(t.Context(), "build", "ubuntu"))
+
+	path, err := executor.StartRecording(filepath.Join(t.TempDir(), "recordings", "session.jsonl"))
+	require.NoError(t, err)
+	assert.Equal(t, path, executor.GetRecordingPath())
+	assert.Error(t, executor.RunAll(t.Context()))
+	stoppedPath, err := executor.StopRecording()
+	require.NoError(t, err)
+	assert.Equal(t, path, stoppedPath)
+	assert.Empty(t, executor.GetRecordingPath())
+
+	return path
+}
+
+func TestSessionRecording(t *testing.T) {
+	t.Run("RecordsEachStepWithRedactedValues", func(t *testing.T) {
+		executor := loadTestExecutor(t, sessionRecordingTestProject)
+		path := recordTestSession(t, executor)
+
+		contents, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(contents), "hunter2")
+
+		recording, err := LoadSessionRecording(path)
+		require.NoError(t, err)
+		assert.Equal(t, sessionRecordingVersion, recording.Version)
+		assert.Equal(t, executor.GetDebugState().ConfigPath, recording.ConfigPath)
+		require.Len(t, recording.Steps, 4)
+
+		steps := recording.Steps
+		for i, stepNumber := range []string{"1", "2", "3", "4"} {
+			assert.Equal(t, stepNumber, steps[i].StepNumber)
+			assert.Equal(t, "build", steps[i].Task)
+			assert.Equal(t, "ubuntu", steps[i].Variant)
+		}
+
+		assert.True(t, steps[0].Success)
+		assert.Equal(t, "shell.exec", steps[0].CommandName)
+		assert.Equal(t, []string{"token <REDACTED:secret_token>"}, steps[0].Output)
+		assert.Equal(t, "<REDACTED:secret_token>", steps[0].Expansions["secret_token"])
+		assert.Equal(t, "ubuntu", steps[0].Expansions["build_variant"])
+
+		assert.Equal(t, []string{"first", "second"}, steps[1].Output)
+
+		assert.True(t, steps[2].Success)
+		assert.True(t, steps[2].NoOp)
+		assert.Equal(t, []string{executor.getNoOpMessage("generate.tasks")}, steps[2].Output)
+
+		assert.False(t, steps[3].Success)
+		assert.NotEmpty(t, steps[3].Error)
+	})
+
+	t.Run("StartFailsWhileAlreadyRecording", func(t *testing.T) {
+		executor := loadTestExecutor(t, sessionRecordingTestProject)
+		_, err := executor.StartRecording(filepath.Join(t.TempDir(), "session.jsonl"))
+		require.NoError(t, err)
+		_, err = executor.StartRecording(filepath.Join(t.TempDir(), "other.jsonl"))
+		assert.Error(t, err)
+		_, err = executor.StopRecording()
+		require.NoError(t, err)
+		_, err = executor.StopRecording()
+		assert.Error(t, err)
+	})
+
+	t.Run("DoesNotCaptureStepsWhenNotRecording", func(t *testing.T) {
+		executor := loadTestExecutor(t, sessionRecordingTestProject)
+		require.NoError(t, executor.PrepareTask(t.Context(), "build", "ubuntu"))
+		require.NoError(t, executor.StepNext(t.Context()))
+		assert.Nil(t, executor.lastCapturedStep)
+	})
+}
+
+func TestReplaySession(t *testing.T) {
+	t.Run("ReportsDifferencesFromRecording", func(t *testing.T) {
+		executor := loadTestExecutor(t, sessionRecordingTestProject)
+		path := recordTestSession(t, executor)
+
+		require.NoError(t, os.WriteFile(filepath.Join(executor.workDir, "input.txt"), []byte("first\nchanged\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(executor.workDir, "ready"), nil, 0644))
+		executor.taskConfig.NewExpansions.Put("new_expansion", "value")
+
+		result, err := executor.ReplaySession(t.Context(), path, ReplayOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.StepsReplayed)
+		assert.Empty(t, result.ResumeStep)
+
+		diffs := map[string][]string{}
+		for _, diff := range result.Differences {
+			assert.Equal(t, "build", diff.Task)
+			diffs[diff.StepNumber] = diff.Differences
+		}
+		assert.Contains(t, diffs["1"], "expansion 'new_expansion' was added")
+		assert.Contains(t, diffs["2"], "output line 2 changed from 'second' to 'changed'")
+		assert.Contains(t, diffs["4"][0], "step failed in the recording but succeeded on replay")
+	})
+
+	t.Run("ResumesAtFirstFailingStep", func(t *testing.T) {
+		executor := loadTestExecutor(t, sessionRecordingTestProject)
+		path := recordTestSession(t, executor)
+		require.NoError(t, executor.PrepareTask(t.Context(), "build", "ubuntu"))
+
+		result, err := executor.ReplaySession(t.Context(), path, ReplayOptions{ResumeAtFailure: true})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.StepsReplayed)
+		assert.Empty(t, result.Differences)
+		assert.Equal(t, "4", result.ResumeStep)
+		assert.Equal(t, 3, executor.GetDebugState().CurrentStepIndex)
+	})
+
+	t.Run("ResumeFailsWithoutFailingStep", func(t *testing.T) {
+		executor := loadTestExecutor(t, sessionRecordingTestProject)
+		require.NoError(t, executor.PrepareTask(t.Context(), "build", "ubuntu"))
+		path, err := executor.StartRecording(filepath.Join(t.TempDir(), "session.jsonl"))
+		require.NoError(t, err)
+		require.NoError(t, executor.StepNext(t.Context()))
+		_, err = executor.StopRecording()
+		require.NoError(t, err)
+
+		_, err = executor.ReplaySession(t.Context(), path, ReplayOptions{ResumeAtFailure: true})
+		assert.Error(t, err)
+	})
+}
+
+func TestCompareRecordedSteps(t *testing.T) {
+	recorded := RecordedStep{
+		CommandName: "shell.exec",
+		Success:     true,
+		Expansions:  map[string]string{"kept": "a", "changed": "b", "removed": "c"},
+		Output:      []string{"one", "two"},
+	}
+
+	t.Run("NoDifferencesForSameBehavior", func(t *testing.T) {
+		assert.Empty(t, compareRecordedSteps(recorded, recorded))
+	})
+
+	t.Run("ReportsEachDifference", func(t *testing.T) {
+		replayed := RecordedStep{
+			CommandName: "subprocess.exec",
+			Success:     false,
+			Error:       "exit code 1",
+			Expansions:  map[string]string{"kept": "a", "changed": "z", "added": "d"},
+			Output:      []string{"one", "two", "three"},
+		}
+		assert.Equal(t, []string{
+			"command changed from 'shell.exec' to 'subprocess.exec'",
+			"step succeeded in the recording but failed on replay: exit code 1",
+			"expansion 'added' was added",
+			"expansion 'changed' changed from 'b' to 'z'",
+			"expansion 'removed' was removed",
+			"output has 3 line(s) but the recording has 2",
+		}, compareRecordedSteps(recorded, replayed))
+	})
+}
@@ -8,6 +8,10 @@ const (
 	MarkerTaskID      = "EVR_TASK_ID"
 	MarkerAgentPID    = "EVR_AGENT_PID"
 	MarkerInEvergreen = "IN_EVERGREEN"
+	// MarkerServiceID identifies the processes started for a single
+	// service.start service so they can be stopped without affecting the
+	// rest of the task's processes.
+	MarkerServiceID = "EVR_SERVICE_ID"
 )
 
 var (
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
@@ -67,6 +68,45 @@ func KillSpawnedProcs(ctx context.Context, key, workingDir, execUser string, log
 
 }
 
+// KillServiceProcs kills the processes that were started for the service with
+// the given ID, including any that the service spawned itself, and waits for
+// them to terminate.
+func KillServiceProcs(ctx context.Context, serviceID string, logger grip.Journaler) error {
+	processes, err := psAllProcesses(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting all processes")
+	}
+
+	marker := MarkerServiceID + "=" + serviceID
+	myPid := os.Getpid()
+	var pidsToKill []int
+	for _, process := range processes {
+		if process.pid == myPid || !slices.Contains(process.env, marker) {
+			continue
+		}
+		pidsToKill = append(pidsToKill, process.pid)
+	}
+
+	for _, pid := range pidsToKill {
+		p := os.Process{Pid: pid}
+		if err := p.Kill(); err != nil {
+			logger.Errorf(ctx, "Cleanup got error killing service process with PID %d: %s.", pid, err)
+		} else {
+			logger.Infof(ctx, "Cleanup killed service process with PID %d.", pid)
+		}
+	}
+
+	pidsStillRunning, err := waitForExit(ctx, pidsToKill)
+	if err != nil {
+		logger.Infof(ctx, "Problem waiting for service processes to exit: %s.", err)
+	}
+	for _, pid := range pidsStillRunning {
+		logger.Infof(ctx, "Failed to clean up service process with PID %d.", pid)
+	}
+
+	return nil
+}
+
 func killUserProcesses(ctx context.Context, execUser string) error {
 	if execUser == "" {
 		return errors.New("execUser cannot be empty")
@@ -61,6 +61,43 @@ func TestKillSpawnedProcs(t *testing.T) {
 
 }
 
+func TestKillServiceProcs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	startSleep := func(serviceID string) (*exec.Cmd, <-chan error) {
+		cmd := exec.Command("sleep", "60")
+		cmd.Env = append(os.Environ(), MarkerServiceID+"="+serviceID)
+		require.NoError(t, cmd.Start())
+		exited := make(chan error, 1)
+		go func() {
+			exited <- cmd.Wait()
+		}()
+		return cmd, exited
+	}
+
+	_, serviceExited := startSleep("service")
+	other, otherExited := startSleep("other_service")
+	defer func() {
+		assert.NoError(t, other.Process.Kill())
+		<-otherExited
+	}()
+
+	require.NoError(t, KillServiceProcs(ctx, "service", grip.GetDefaultJournaler()))
+
+	select {
+	case err := <-serviceExited:
+		assert.Error(t, err, "service process should have been killed")
+	case <-ctx.Done():
+		assert.Fail(t, "service process was not killed")
+	}
+	select {
+	case <-otherExited:
+		assert.Fail(t, "process for a different service should not have been killed")
+	default:
+	}
+}
+
 func TestWaitForExit(t *testing.T) {
 	for testName, test := range map[string]func(ctx context.Context, t *testing.T){
 		"DoesNotReturnNonexistentProcess": func(ctx context.Context, t *testing.T) {
@@ -375,6 +375,13 @@ func KillSpawnedProcsInContainer(_ context.Context, _, _ string) error {
 	return nil
 }
 
+// KillServiceProcs is a no-op on Windows. Service processes are assigned to
+// the task's job object, which is terminated when the task's processes are
+// cleaned up.
+func KillServiceProcs(_ context.Context, _ string, _ grip.Journaler) error {
+	return nil
+}
+
 // GetNice is a no-op in Windows and returns the default nice.
 func GetNice(int) (int, error) {
 	return DefaultNice, nil
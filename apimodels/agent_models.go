@@ -80,6 +80,7 @@ type TaskEndDetail struct {
 	TraceID              string                  `bson:"trace_id,omitempty" json:"trace_id,omitempty"`
 	DiskDevices          []string                `bson:"disk_devices,omitempty" json:"disk_devices,omitempty"`
 	ResourceConstraints  *ResourceConstraintInfo `bson:"resource_constraints,omitempty" json:"resource_constraints,omitempty"`
+	RedactionSummary     *RedactionSummary       `bson:"redaction_summary,omitempty" json:"redaction_summary,omitempty"`
 }
 
 // FailingCommand represents a command that failed in a task.
@@ -93,6 +94,14 @@ type OOMTrackerInfo struct {
 	Pids     []int `bson:"pids" json:"pids"`
 }
 
+// RedactionSummary describes the secrets that were detected and redacted
+// from a task's logs.
+type RedactionSummary struct {
+	// DetectedSecrets maps each type of detected secret to the number of
+	// times it was redacted.
+	DetectedSecrets map[string]int `bson:"detected_secrets,omitempty" json:"detected_secrets,omitempty"`
+}
+
 type ResourceConstraintInfo struct {
 	CPUConstrained    bool    `bson:"cpu_constrained" json:"cpu_constrained"`
 	MemoryConstrained bool    `bson:"memory_constrained" json:"memory_constrained"`
@@ -257,6 +266,34 @@ func (ar *S3CredentialsRequest) Validate() error {
 	return catcher.Resolve()
 }
 
+// TaskFilesPublishRequest describes a named set of files that a task
+// publishes for its dependent tasks.
+type TaskFilesPublishRequest struct {
+	// Name is the name of the file set.
+	Name string `json:"name"`
+	// SizeBytes is the size of the uploaded archive. It is only set once the
+	// archive has been uploaded.
+	SizeBytes int64 `json:"size_bytes"`
+}
+
+// TaskFilesFetchRequest identifies a named set of files published by one of
+// the task's dependencies.
+type TaskFilesFetchRequest struct {
+	// Name is the name of the file set.
+	Name string `json:"name"`
+	// Task is the name of the dependency that published the file set.
+	Task string `json:"task"`
+	// Variant is the build variant of the dependency. It defaults to the
+	// build variant of the requesting task.
+	Variant string `json:"variant"`
+}
+
+// TaskFilesURLResponse contains a presigned URL for transferring a file
+// set's archive.
+type TaskFilesURLResponse struct {
+	URL string `json:"url"`
+}
+
 func (ted *TaskEndDetail) IsEmpty() bool {
 	return ted == nil || ted.Status == ""
 }
@@ -49,6 +49,9 @@ type BucketsConfig struct {
 	RetryFailedLogMoveMaxJobsPerRun int `bson:"retry_failed_log_move_max_jobs_per_run" json:"retry_failed_log_move_max_jobs_per_run" yaml:"retry_failed_log_move_max_jobs_per_run"`
 	// TestResultsBucket is the bucket information for test results.
 	TestResultsBucket BucketConfig `bson:"test_results_bucket" json:"test_results_bucket" yaml:"test_results_bucket"`
+	// TaskFilesBucket is the bucket information for files that tasks publish
+	// for their dependent tasks to fetch.
+	TaskFilesBucket BucketConfig `bson:"task_files_bucket" json:"task_files_bucket" yaml:"task_files_bucket"`
 	// Credentials for accessing the LogBucket.
 	Credentials S3Credentials `bson:"credentials" json:"credentials" yaml:"credentials"`
 }
@@ -61,6 +64,7 @@ var (
 	BucketsConfigRetryFailedLogMoveLookbackDaysKey  = bsonutil.MustHaveTag(BucketsConfig{}, "RetryFailedLogMoveLookbackDays")
 	BucketsConfigRetryFailedLogMoveMaxJobsPerRunKey = bsonutil.MustHaveTag(BucketsConfig{}, "RetryFailedLogMoveMaxJobsPerRun")
 	BucketsConfigTestResultsBucketKey               = bsonutil.MustHaveTag(BucketsConfig{}, "TestResultsBucket")
+	BucketsConfigTaskFilesBucketKey                 = bsonutil.MustHaveTag(BucketsConfig{}, "TaskFilesBucket")
 	BucketsConfigCredentialsKey                     = bsonutil.MustHaveTag(BucketsConfig{}, "Credentials")
 )
 
@@ -118,6 +122,7 @@ func (c *BucketsConfig) Set(ctx context.Context) error {
 				BucketsConfigRetryFailedLogMoveLookbackDaysKey:  c.RetryFailedLogMoveLookbackDays,
 				BucketsConfigRetryFailedLogMoveMaxJobsPerRunKey: c.RetryFailedLogMoveMaxJobsPerRun,
 				BucketsConfigTestResultsBucketKey:               c.TestResultsBucket,
+				BucketsConfigTaskFilesBucketKey:                 c.TaskFilesBucket,
 				BucketsConfigCredentialsKey:                     c.Credentials,
 			},
 		}),
@@ -130,6 +135,10 @@ func (c *BucketsConfig) ValidateAndDefault() error {
 	catcher.Add(c.LogBucket.validate())
 	catcher.Add(c.LogBucketLongRetention.validate())
 	catcher.Add(c.LogBucketFailedTasks.validate())
+	if c.TaskFilesBucket.Name != "" {
+		catcher.Add(c.TaskFilesBucket.validate())
+		catcher.NewWhen(c.TaskFilesBucket.Type == BucketTypeGridFS, "task files bucket cannot be a GridFS bucket")
+	}
 	if c.RetryFailedLogMoveLookbackDays < 0 {
 		catcher.Add(errors.New("retry_failed_log_move_lookback_days cannot be negative"))
 	}
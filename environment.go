@@ -186,6 +186,11 @@ type Environment interface {
 	// all message details.
 	GetSender(SenderKey) (send.Sender, error)
 	SetSender(SenderKey, send.Sender) error
+	// GetRootSender provides the same sender as GetSender, except that it
+	// sends messages synchronously instead of through the notifications
+	// queue. Callers that need to know whether a message was delivered must
+	// use it, since errors from queued sends aren't reported to the caller.
+	GetRootSender(SenderKey) (send.Sender, error)
 
 	// GetGitHubSender provides a grip Sender configured with the given
 	// owner and repo information.
@@ -243,6 +248,7 @@ func NewEnvironment(ctx context.Context, confPath, versionID, clientS3Bucket str
 	e := &envState{
 		ctx:                     cachedEnvCtx,
 		senders:                 map[SenderKey]send.Sender{},
+		rootSenders:             map[SenderKey]send.Sender{},
 		shutdownSequenceStarted: false,
 		versionID:               versionID,
 	}
@@ -338,6 +344,7 @@ type envState struct {
 	clientConfig            *ClientConfig
 	closers                 []closerOp
 	senders                 map[SenderKey]send.Sender
+	rootSenders             map[SenderKey]send.Sender
 	githubSenders           map[string]cachedGitHubSender
 	githubSendersMu         sync.Mutex
 	roleManager             gimlet.RoleManager
@@ -820,6 +827,7 @@ func (e *envState) createNotificationQueue(ctx context.Context, tracer trace.Tra
 	// context.
 	ctx = trace.ContextWithSpan(ctx, nil)
 	for k := range e.senders {
+		e.rootSenders[k] = e.senders[k]
 		e.senders[k] = logger.MakeQueueSender(ctx, e.notificationsQueue, e.senders[k])
 	}
 
@@ -983,6 +991,9 @@ func (e *envState) setSenderErrorHandler(s send.Sender, name string) error {
 		if err == nil {
 			return
 		}
+		// Let the caller that sent the message know that it failed so it can
+		// retry the notification.
+		util.RecordSendError(ctx, err)
 		grip.Error(ctx, message.WrapError(err, message.Fields{
 			"notification":        m.String(),
 			"message_type":        fmt.Sprintf("%T", m),
@@ -1439,6 +1450,18 @@ func (e *envState) GetSender(key SenderKey) (send.Sender, error) {
 	return sender, nil
 }
 
+func (e *envState) GetRootSender(key SenderKey) (send.Sender, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	sender, ok := e.rootSenders[key]
+	if !ok {
+		return nil, errors.Errorf("unknown sender key '%s'", key.String())
+	}
+
+	return sender, nil
+}
+
 func (e *envState) SetSender(key SenderKey, impl send.Sender) error {
 	if impl == nil {
 		return errors.New("cannot add a nil sender")
@@ -1451,6 +1474,7 @@ func (e *envState) SetSender(key SenderKey, impl send.Sender) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.senders[key] = impl
+	e.rootSenders[key] = impl
 
 	return nil
 }
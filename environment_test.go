@@ -8,9 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/amboy"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson"
@@ -62,7 +65,8 @@ func (s *EnvironmentSuite) shouldSkip() {
 
 func (s *EnvironmentSuite) SetupTest() {
 	s.env = &envState{
-		senders: map[SenderKey]send.Sender{},
+		senders:     map[SenderKey]send.Sender{},
+		rootSenders: map[SenderKey]send.Sender{},
 	}
 }
 
@@ -145,6 +149,50 @@ func (s *EnvironmentSuite) TestInitSenders() {
 	}
 }
 
+// erroringSender fails to deliver every message.
+type erroringSender struct {
+	send.Sender
+}
+
+func (s *erroringSender) Send(ctx context.Context, m message.Composer) {
+	s.ErrorHandler()(ctx, errors.New("connection refused"), m)
+}
+
+func (s *EnvironmentSuite) TestRootSendersReportSendErrors() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.env.settings = &Settings{
+		Amboy: AmboyConfig{
+			PoolSizeLocal: 1,
+			LocalStorage:  10,
+		},
+		Notify: NotifyConfig{
+			BufferTargetPerInterval: 10,
+			BufferIntervalSeconds:   1,
+		},
+	}
+	sender := &erroringSender{Sender: send.MakeInternalLogger()}
+	s.Require().NoError(s.env.setSenderErrorHandler(sender, SenderEvergreenWebhook.String()))
+	s.env.senders[SenderEvergreenWebhook] = sender
+	s.Require().NoError(s.env.createNotificationQueue(ctx, noop.NewTracerProvider().Tracer("")))
+	s.Require().NoError(s.env.notificationsQueue.Start(ctx))
+
+	queueSender, err := s.env.GetSender(SenderEvergreenWebhook)
+	s.Require().NoError(err)
+	sendCtx, sendErrs := util.ContextWithSendErrors(ctx)
+	queueSender.Send(sendCtx, message.NewString("queued message"))
+	s.True(amboy.WaitInterval(ctx, s.env.notificationsQueue, 10*time.Millisecond))
+	s.NoError(sendErrs.Resolve(), "the queue sender sends with its own context, so errors should not be reported to the caller")
+
+	rootSender, err := s.env.GetRootSender(SenderEvergreenWebhook)
+	s.Require().NoError(err)
+	sendCtx, sendErrs = util.ContextWithSendErrors(ctx)
+	rootSender.Send(sendCtx, message.NewString("message"))
+	s.Require().Error(sendErrs.Resolve())
+	s.Contains(sendErrs.Resolve().Error(), "connection refused")
+}
+
 func TestGetGitHubSenderConcurrentAccessShouldNotRace(t *testing.T) {
 	e := &envState{
 		ctx:           t.Context(),
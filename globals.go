@@ -917,14 +917,20 @@ const (
 	AttachResultsCommandName      = "attach.results"
 	AttachArtifactsCommandName    = "attach.artifacts"
 	AttachXUnitResultsCommandName = "attach.xunit_results"
+	AttachTestResultsCommandName  = "attach.test_results"
+	CoverageParseCommandName      = "coverage.parse"
 	CacheRestoreCommandName       = "cache.restore"
 	CacheSaveCommandName          = "cache.save"
+	TaskFilesPublishCommandName   = "task_files.publish"
+	TaskFilesFetchCommandName     = "task_files.fetch"
 )
 
 var AttachCommands = []string{
 	AttachResultsCommandName,
 	AttachArtifactsCommandName,
 	AttachXUnitResultsCommandName,
+	AttachTestResultsCommandName,
+	CoverageParseCommandName,
 }
 
 type SenderKey int
@@ -342,6 +342,7 @@ type ComplexityRoot struct {
 		RetryFailedLogMoveLookbackDays   func(childComplexity int) int
 		RetryFailedLogMoveLookbackMonths func(childComplexity int) int
 		RetryFailedLogMoveMaxJobsPerRun  func(childComplexity int) int
+		TaskFilesBucket                  func(childComplexity int) int
 		TestResultsBucket                func(childComplexity int) int
 	}
 
@@ -375,6 +376,12 @@ type ComplexityRoot struct {
 		DBURL  func(childComplexity int) int
 	}
 
+	ChatWebhookSubscriber struct {
+		ContentType func(childComplexity int) int
+		Template    func(childComplexity int) int
+		URL         func(childComplexity int) int
+	}
+
 	ChildPatchAlias struct {
 		Alias   func(childComplexity int) int
 		PatchID func(childComplexity int) int
@@ -440,6 +447,17 @@ type ComplexityRoot struct {
 		SavingsPlanRate func(childComplexity int) int
 	}
 
+	CriticalPathTask struct {
+		BuildVariant      func(childComplexity int) int
+		DependencyWait    func(childComplexity int) int
+		DisplayName       func(childComplexity int) int
+		Duration          func(childComplexity int) int
+		DurationEstimated func(childComplexity int) int
+		QueueWait         func(childComplexity int) int
+		Status            func(childComplexity int) int
+		TaskID            func(childComplexity int) int
+	}
+
 	DebugSpawnHostsConfig struct {
 		SetupScript func(childComplexity int) int
 	}
@@ -582,6 +600,12 @@ type ComplexityRoot struct {
 		FullDisplayName     func(childComplexity int) int
 	}
 
+	FairShareWeight struct {
+		Name     func(childComplexity int) int
+		Projects func(childComplexity int) int
+		Weight   func(childComplexity int) int
+	}
+
 	File struct {
 		AssociatedLinks func(childComplexity int) int
 		Link            func(childComplexity int) int
@@ -590,6 +614,17 @@ type ComplexityRoot struct {
 		Visibility      func(childComplexity int) int
 	}
 
+	FileCoverageDelta struct {
+		BaseCoveredLines func(childComplexity int) int
+		BasePercent      func(childComplexity int) int
+		BaseTotalLines   func(childComplexity int) int
+		CoveredLines     func(childComplexity int) int
+		Delta            func(childComplexity int) int
+		Name             func(childComplexity int) int
+		Percent          func(childComplexity int) int
+		TotalLines       func(childComplexity int) int
+	}
+
 	FileDiff struct {
 		Additions   func(childComplexity int) int
 		Deletions   func(childComplexity int) int
@@ -1287,6 +1322,7 @@ type ComplexityRoot struct {
 	PlannerSettings struct {
 		CommitQueueFactor         func(childComplexity int) int
 		ExpectedRuntimeFactor     func(childComplexity int) int
+		FairShareWeights          func(childComplexity int) int
 		GenerateTaskFactor        func(childComplexity int) int
 		GroupVersions             func(childComplexity int) int
 		MainlineTimeInQueueFactor func(childComplexity int) int
@@ -1352,11 +1388,13 @@ type ComplexityRoot struct {
 		StepbackBisect                     func(childComplexity int) int
 		StepbackDisabled                   func(childComplexity int) int
 		TaskAnnotationSettings             func(childComplexity int) int
+		TestReliability                    func(childComplexity int, options TestReliabilityOptions) int
 		TestSelection                      func(childComplexity int) int
 		Triggers                           func(childComplexity int) int
 		VersionControlEnabled              func(childComplexity int) int
 		WaterfallDisabled                  func(childComplexity int) int
 		WorkstationConfig                  func(childComplexity int) int
+		WorstTests                         func(childComplexity int, options TestReliabilityOptions) int
 	}
 
 	ProjectAlias struct {
@@ -1662,6 +1700,18 @@ type ComplexityRoot struct {
 		VirtualMemoryKB func(childComplexity int) int
 	}
 
+	ResourceUsageSample struct {
+		CPUPercent           func(childComplexity int) int
+		Command              func(childComplexity int) int
+		DiskReadBytes        func(childComplexity int) int
+		DiskWriteBytes       func(childComplexity int) int
+		MemoryPercent        func(childComplexity int) int
+		MemoryUsedBytes      func(childComplexity int) int
+		NumProcesses         func(childComplexity int) int
+		Time                 func(childComplexity int) int
+		WorkDirDiskUsedBytes func(childComplexity int) int
+	}
+
 	RestartAdminTasksPayload struct {
 		NumRestartedTasks func(childComplexity int) int
 	}
@@ -1856,12 +1906,14 @@ type ComplexityRoot struct {
 	}
 
 	Subscriber struct {
+		ChatWebhookSubscriber func(childComplexity int) int
 		EmailSubscriber       func(childComplexity int) int
 		GithubCheckSubscriber func(childComplexity int) int
 		GithubPRSubscriber    func(childComplexity int) int
 		JiraCommentSubscriber func(childComplexity int) int
 		JiraIssueSubscriber   func(childComplexity int) int
 		SlackSubscriber       func(childComplexity int) int
+		TeamsSubscriber       func(childComplexity int) int
 		WebhookSubscriber     func(childComplexity int) int
 	}
 
@@ -1945,6 +1997,7 @@ type ComplexityRoot struct {
 		QuarantinedTestsSkippedCount func(childComplexity int) int
 		Requester                    func(childComplexity int) int
 		ResetWhenFinished            func(childComplexity int) int
+		ResourceUsage                func(childComplexity int) int
 		Revision                     func(childComplexity int) int
 		ScheduledTime                func(childComplexity int) int
 		SpawnHostLink                func(childComplexity int) int
@@ -1969,6 +2022,21 @@ type ComplexityRoot struct {
 		FileTicketWebhook func(childComplexity int) int
 	}
 
+	TaskCoverageDelta struct {
+		BaseCoveredLines func(childComplexity int) int
+		BasePercent      func(childComplexity int) int
+		BaseTaskID       func(childComplexity int) int
+		BaseTotalLines   func(childComplexity int) int
+		BuildVariant     func(childComplexity int) int
+		CoveredLines     func(childComplexity int) int
+		Delta            func(childComplexity int) int
+		Files            func(childComplexity int) int
+		Percent          func(childComplexity int) int
+		TaskID           func(childComplexity int) int
+		TaskName         func(childComplexity int) int
+		TotalLines       func(childComplexity int) int
+	}
+
 	TaskEndDetail struct {
 		Description          func(childComplexity int) int
 		DiskDevices          func(childComplexity int) int
@@ -2139,6 +2207,10 @@ type ComplexityRoot struct {
 		TotalTestCount          func(childComplexity int) int
 	}
 
+	TeamsSubscriber struct {
+		URL func(childComplexity int) int
+	}
+
 	TestLog struct {
 		LineNum       func(childComplexity int) int
 		LogsToMerge   func(childComplexity int) int
@@ -2155,6 +2227,20 @@ type ComplexityRoot struct {
 		TestName              func(childComplexity int) int
 	}
 
+	TestReliability struct {
+		BuildVariant    func(childComplexity int) int
+		Date            func(childComplexity int) int
+		MaxSuccessRate  func(childComplexity int) int
+		NumFail         func(childComplexity int) int
+		NumPass         func(childComplexity int) int
+		NumTotal        func(childComplexity int) int
+		P50DurationPass func(childComplexity int) int
+		P95DurationPass func(childComplexity int) int
+		SuccessRate     func(childComplexity int) int
+		TaskName        func(childComplexity int) int
+		TestName        func(childComplexity int) int
+	}
+
 	TestResult struct {
 		BaseStatus            func(childComplexity int) int
 		Duration              func(childComplexity int) int
@@ -2321,7 +2407,9 @@ type ComplexityRoot struct {
 		BuildVariants              func(childComplexity int, options BuildVariantOptions) int
 		ChildVersions              func(childComplexity int) int
 		Cost                       func(childComplexity int) int
+		Coverage                   func(childComplexity int) int
 		CreateTime                 func(childComplexity int) int
+		CriticalPath               func(childComplexity int) int
 		Errors                     func(childComplexity int) int
 		ExternalLinksForMetadata   func(childComplexity int) int
 		FinishTime                 func(childComplexity int) int
@@ -2355,6 +2443,29 @@ type ComplexityRoot struct {
 		Warnings                   func(childComplexity int) int
 	}
 
+	VersionCoverage struct {
+		BaseCoveredLines func(childComplexity int) int
+		BaseOnlyTasks    func(childComplexity int) int
+		BasePercent      func(childComplexity int) int
+		BaseTotalLines   func(childComplexity int) int
+		BaseVersionID    func(childComplexity int) int
+		CoveredLines     func(childComplexity int) int
+		Delta            func(childComplexity int) int
+		Percent          func(childComplexity int) int
+		Tasks            func(childComplexity int) int
+		TotalLines       func(childComplexity int) int
+		VersionID        func(childComplexity int) int
+	}
+
+	VersionCriticalPath struct {
+		Makespan            func(childComplexity int) int
+		MinimumMakespan     func(childComplexity int) int
+		Tasks               func(childComplexity int) int
+		TotalDependencyWait func(childComplexity int) int
+		TotalQueueWait      func(childComplexity int) int
+		VersionID           func(childComplexity int) int
+	}
+
 	VersionLite struct {
 		Activated           func(childComplexity int) int
 		BaseVersion         func(childComplexity int) int
@@ -2451,6 +2562,7 @@ type ComplexityRoot struct {
 	}
 
 	WebhookSubscriber struct {
+		Format     func(childComplexity int) int
 		Headers    func(childComplexity int) int
 		MinDelayMS func(childComplexity int) int
 		Retries    func(childComplexity int) int
@@ -2635,6 +2747,10 @@ type ProjectResolver interface {
 
 	ParsleyFilters(ctx context.Context, obj *model.APIProjectRef) ([]*parsley.Filter, error)
 	Patches(ctx context.Context, obj *model.APIProjectRef, patchesInput PatchesInput) (*Patches, error)
+
+	TestReliability(ctx context.Context, obj *model.APIProjectRef, options TestReliabilityOptions) ([]*model.APITestReliability, error)
+
+	WorstTests(ctx context.Context, obj *model.APIProjectRef, options TestReliabilityOptions) ([]*model.APITestReliability, error)
 }
 type ProjectLiteResolver interface {
 	IsFavorite(ctx context.Context, obj *model1.ProjectRef) (bool, error)
@@ -2782,6 +2898,8 @@ type TaskResolver interface {
 
 	Project(ctx context.Context, obj *model.APITask) (*model.APIProjectRef, error)
 
+	ResourceUsage(ctx context.Context, obj *model.APITask) ([]*model.APIResourceUsageSample, error)
+
 	SpawnHostLink(ctx context.Context, obj *model.APITask) (*string, error)
 
 	TaskLogs(ctx context.Context, obj *model.APITask) (*TaskLogs, error)
@@ -2828,6 +2946,8 @@ type VersionResolver interface {
 	BuildVariantStats(ctx context.Context, obj *model.APIVersion, options BuildVariantOptions) ([]*task.GroupedTaskStatusCount, error)
 	ChildVersions(ctx context.Context, obj *model.APIVersion) ([]*model.APIVersion, error)
 	Cost(ctx context.Context, obj *model.APIVersion) (*cost.Cost, error)
+	Coverage(ctx context.Context, obj *model.APIVersion) (*model.APIVersionCoverage, error)
+	CriticalPath(ctx context.Context, obj *model.APIVersion) (*model.APIVersionCriticalPath, error)
 
 	ExternalLinksForMetadata(ctx context.Context, obj *model.APIVersion) ([]*ExternalLinkForMetadata, error)
 
@@ -3970,6 +4090,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.BucketsConfig.RetryFailedLogMoveMaxJobsPerRun(childComplexity), true
+	case "BucketsConfig.taskFilesBucket":
+		if e.complexity.BucketsConfig.TaskFilesBucket == nil {
+			break
+		}
+
+		return e.complexity.BucketsConfig.TaskFilesBucket(childComplexity), true
 	case "BucketsConfig.testResultsBucket":
 		if e.complexity.BucketsConfig.TestResultsBucket == nil {
 			break
@@ -4072,6 +4198,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CedarConfig.DBURL(childComplexity), true
 
+	case "ChatWebhookSubscriber.contentType":
+		if e.complexity.ChatWebhookSubscriber.ContentType == nil {
+			break
+		}
+
+		return e.complexity.ChatWebhookSubscriber.ContentType(childComplexity), true
+	case "ChatWebhookSubscriber.template":
+		if e.complexity.ChatWebhookSubscriber.Template == nil {
+			break
+		}
+
+		return e.complexity.ChatWebhookSubscriber.Template(childComplexity), true
+	case "ChatWebhookSubscriber.url":
+		if e.complexity.ChatWebhookSubscriber.URL == nil {
+			break
+		}
+
+		return e.complexity.ChatWebhookSubscriber.URL(childComplexity), true
+
 	case "ChildPatchAlias.alias":
 		if e.complexity.ChildPatchAlias.Alias == nil {
 			break
@@ -4292,6 +4437,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CostData.SavingsPlanRate(childComplexity), true
 
+	case "CriticalPathTask.buildVariant":
+		if e.complexity.CriticalPathTask.BuildVariant == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.BuildVariant(childComplexity), true
+	case "CriticalPathTask.dependencyWait":
+		if e.complexity.CriticalPathTask.DependencyWait == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.DependencyWait(childComplexity), true
+	case "CriticalPathTask.displayName":
+		if e.complexity.CriticalPathTask.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.DisplayName(childComplexity), true
+	case "CriticalPathTask.duration":
+		if e.complexity.CriticalPathTask.Duration == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.Duration(childComplexity), true
+	case "CriticalPathTask.durationEstimated":
+		if e.complexity.CriticalPathTask.DurationEstimated == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.DurationEstimated(childComplexity), true
+	case "CriticalPathTask.queueWait":
+		if e.complexity.CriticalPathTask.QueueWait == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.QueueWait(childComplexity), true
+	case "CriticalPathTask.status":
+		if e.complexity.CriticalPathTask.Status == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.Status(childComplexity), true
+	case "CriticalPathTask.taskId":
+		if e.complexity.CriticalPathTask.TaskID == nil {
+			break
+		}
+
+		return e.complexity.CriticalPathTask.TaskID(childComplexity), true
+
 	case "DebugSpawnHostsConfig.setupScript":
 		if e.complexity.DebugSpawnHostsConfig.SetupScript == nil {
 			break
@@ -4804,6 +4998,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.FailingCommand.FullDisplayName(childComplexity), true
 
+	case "FairShareWeight.name":
+		if e.complexity.FairShareWeight.Name == nil {
+			break
+		}
+
+		return e.complexity.FairShareWeight.Name(childComplexity), true
+	case "FairShareWeight.projects":
+		if e.complexity.FairShareWeight.Projects == nil {
+			break
+		}
+
+		return e.complexity.FairShareWeight.Projects(childComplexity), true
+	case "FairShareWeight.weight":
+		if e.complexity.FairShareWeight.Weight == nil {
+			break
+		}
+
+		return e.complexity.FairShareWeight.Weight(childComplexity), true
+
 	case "File.associatedLinks":
 		if e.complexity.File.AssociatedLinks == nil {
 			break
@@ -4835,6 +5048,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.File.Visibility(childComplexity), true
 
+	case "FileCoverageDelta.baseCoveredLines":
+		if e.complexity.FileCoverageDelta.BaseCoveredLines == nil {
+			break
+		}
+
+		return e.complexity.FileCoverageDelta.BaseCoveredLines(childComplexity), true
+	case "FileCoverageDelta.basePercent":
+		if e.complexity.FileCoverageDelta.BasePercent == nil {
+			break
+		}
+
+		return e.complexity.FileCoverageDelta.BasePercent(childComplexity), true
+	case "FileCoverageDelta.baseTotalLines":
+		if e.complexity.FileCoverageDelta.BaseTotalLines == nil {
+			break
+		}
+
+		return e.complexity.FileCoverageDelta.BaseTotalLines(childComplexity), true
+	case "FileCoverageDelta.coveredLines":
+		if e.complexity.FileCoverageDelta.CoveredLines == nil {
+			break
+		}
+
+		return e.complexity.FileCoverageDelta.CoveredLines(childComplexity), true
+	case "FileCoverageDelta.delta":
+		if e.complexity.FileCoverageDelta.Delta == nil {
+			break
+		}
+
+		return e.complexity.FileCoverageDelta.Delta(childComplexity), true
+	case "FileCoverageDelta.name":
+		if e.complexity.FileCoverageDelta.Name == nil {
+			break
+		}
+
+		return e.complexity.FileCoverageDelta.Name(childComplexity), true
+	case "FileCoverageDelta.percent":
+		if e.complexity.FileCoverageDelta.Percent == nil {
+			break
+		}
+
+		return e.complexity.FileCoverageDelta.Percent(childComplexity), true
+	case "FileCoverageDelta.totalLines":
+		if e.complexity.FileCoverageDelta.TotalLines == nil {
+			break
+		}
+
+		return e.complexity.FileCoverageDelta.TotalLines(childComplexity), true
+
 	case "FileDiff.additions":
 		if e.complexity.FileDiff.Additions == nil {
 			break
@@ -7951,6 +8213,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.PlannerSettings.ExpectedRuntimeFactor(childComplexity), true
+	case "PlannerSettings.fairShareWeights":
+		if e.complexity.PlannerSettings.FairShareWeights == nil {
+			break
+		}
+
+		return e.complexity.PlannerSettings.FairShareWeights(childComplexity), true
 	case "PlannerSettings.generateTaskFactor":
 		if e.complexity.PlannerSettings.GenerateTaskFactor == nil {
 			break
@@ -8312,6 +8580,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Project.TaskAnnotationSettings(childComplexity), true
+	case "Project.testReliability":
+		if e.complexity.Project.TestReliability == nil {
+			break
+		}
+
+		args, err := ec.field_Project_testReliability_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Project.TestReliability(childComplexity, args["options"].(TestReliabilityOptions)), true
 	case "Project.testSelection":
 		if e.complexity.Project.TestSelection == nil {
 			break
@@ -8342,6 +8621,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Project.WorkstationConfig(childComplexity), true
+	case "Project.worstTests":
+		if e.complexity.Project.WorstTests == nil {
+			break
+		}
+
+		args, err := ec.field_Project_worstTests_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Project.WorstTests(childComplexity, args["options"].(TestReliabilityOptions)), true
 
 	case "ProjectAlias.alias":
 		if e.complexity.ProjectAlias.Alias == nil {
@@ -9857,6 +10147,61 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ResourceLimits.VirtualMemoryKB(childComplexity), true
 
+	case "ResourceUsageSample.cpuPercent":
+		if e.complexity.ResourceUsageSample.CPUPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.CPUPercent(childComplexity), true
+	case "ResourceUsageSample.command":
+		if e.complexity.ResourceUsageSample.Command == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.Command(childComplexity), true
+	case "ResourceUsageSample.diskReadBytes":
+		if e.complexity.ResourceUsageSample.DiskReadBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.DiskReadBytes(childComplexity), true
+	case "ResourceUsageSample.diskWriteBytes":
+		if e.complexity.ResourceUsageSample.DiskWriteBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.DiskWriteBytes(childComplexity), true
+	case "ResourceUsageSample.memoryPercent":
+		if e.complexity.ResourceUsageSample.MemoryPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.MemoryPercent(childComplexity), true
+	case "ResourceUsageSample.memoryUsedBytes":
+		if e.complexity.ResourceUsageSample.MemoryUsedBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.MemoryUsedBytes(childComplexity), true
+	case "ResourceUsageSample.numProcesses":
+		if e.complexity.ResourceUsageSample.NumProcesses == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.NumProcesses(childComplexity), true
+	case "ResourceUsageSample.time":
+		if e.complexity.ResourceUsageSample.Time == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.Time(childComplexity), true
+	case "ResourceUsageSample.workDirDiskUsedBytes":
+		if e.complexity.ResourceUsageSample.WorkDirDiskUsedBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.WorkDirDiskUsedBytes(childComplexity), true
+
 	case "RestartAdminTasksPayload.numRestartedTasks":
 		if e.complexity.RestartAdminTasksPayload.NumRestartedTasks == nil {
 			break
@@ -10522,6 +10867,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Subnet.SubnetID(childComplexity), true
 
+	case "Subscriber.chatWebhookSubscriber":
+		if e.complexity.Subscriber.ChatWebhookSubscriber == nil {
+			break
+		}
+
+		return e.complexity.Subscriber.ChatWebhookSubscriber(childComplexity), true
 	case "Subscriber.emailSubscriber":
 		if e.complexity.Subscriber.EmailSubscriber == nil {
 			break
@@ -10558,6 +10909,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Subscriber.SlackSubscriber(childComplexity), true
+	case "Subscriber.teamsSubscriber":
+		if e.complexity.Subscriber.TeamsSubscriber == nil {
+			break
+		}
+
+		return e.complexity.Subscriber.TeamsSubscriber(childComplexity), true
 	case "Subscriber.webhookSubscriber":
 		if e.complexity.Subscriber.WebhookSubscriber == nil {
 			break
@@ -11032,6 +11389,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Task.ResetWhenFinished(childComplexity), true
+	case "Task.resourceUsage":
+		if e.complexity.Task.ResourceUsage == nil {
+			break
+		}
+
+		return e.complexity.Task.ResourceUsage(childComplexity), true
 	case "Task.revision":
 		if e.complexity.Task.Revision == nil {
 			break
@@ -11153,6 +11516,79 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TaskAnnotationSettings.FileTicketWebhook(childComplexity), true
 
+	case "TaskCoverageDelta.baseCoveredLines":
+		if e.complexity.TaskCoverageDelta.BaseCoveredLines == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.BaseCoveredLines(childComplexity), true
+	case "TaskCoverageDelta.basePercent":
+		if e.complexity.TaskCoverageDelta.BasePercent == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.BasePercent(childComplexity), true
+	case "TaskCoverageDelta.baseTaskId":
+		if e.complexity.TaskCoverageDelta.BaseTaskID == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.BaseTaskID(childComplexity), true
+	case "TaskCoverageDelta.baseTotalLines":
+		if e.complexity.TaskCoverageDelta.BaseTotalLines == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.BaseTotalLines(childComplexity), true
+	case "TaskCoverageDelta.buildVariant":
+		if e.complexity.TaskCoverageDelta.BuildVariant == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.BuildVariant(childComplexity), true
+	case "TaskCoverageDelta.coveredLines":
+		if e.complexity.TaskCoverageDelta.CoveredLines == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.CoveredLines(childComplexity), true
+	case "TaskCoverageDelta.delta":
+		if e.complexity.TaskCoverageDelta.Delta == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.Delta(childComplexity), true
+	case "TaskCoverageDelta.files":
+		if e.complexity.TaskCoverageDelta.Files == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.Files(childComplexity), true
+	case "TaskCoverageDelta.percent":
+		if e.complexity.TaskCoverageDelta.Percent == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.Percent(childComplexity), true
+	case "TaskCoverageDelta.taskId":
+		if e.complexity.TaskCoverageDelta.TaskID == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.TaskID(childComplexity), true
+	case "TaskCoverageDelta.taskName":
+		if e.complexity.TaskCoverageDelta.TaskName == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.TaskName(childComplexity), true
+	case "TaskCoverageDelta.totalLines":
+		if e.complexity.TaskCoverageDelta.TotalLines == nil {
+			break
+		}
+
+		return e.complexity.TaskCoverageDelta.TotalLines(childComplexity), true
+
 	case "TaskEndDetail.description":
 		if e.complexity.TaskEndDetail.Description == nil {
 			break
@@ -11816,6 +12252,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TaskTestResultSample.TotalTestCount(childComplexity), true
 
+	case "TeamsSubscriber.url":
+		if e.complexity.TeamsSubscriber.URL == nil {
+			break
+		}
+
+		return e.complexity.TeamsSubscriber.URL(childComplexity), true
+
 	case "TestLog.lineNum":
 		if e.complexity.TestLog.LineNum == nil {
 			break
@@ -11878,6 +12321,73 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TestQuarantineEntry.TestName(childComplexity), true
 
+	case "TestReliability.buildVariant":
+		if e.complexity.TestReliability.BuildVariant == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.BuildVariant(childComplexity), true
+	case "TestReliability.date":
+		if e.complexity.TestReliability.Date == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.Date(childComplexity), true
+	case "TestReliability.maxSuccessRate":
+		if e.complexity.TestReliability.MaxSuccessRate == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.MaxSuccessRate(childComplexity), true
+	case "TestReliability.numFail":
+		if e.complexity.TestReliability.NumFail == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.NumFail(childComplexity), true
+	case "TestReliability.numPass":
+		if e.complexity.TestReliability.NumPass == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.NumPass(childComplexity), true
+	case "TestReliability.numTotal":
+		if e.complexity.TestReliability.NumTotal == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.NumTotal(childComplexity), true
+	case "TestReliability.p50DurationPass":
+		if e.complexity.TestReliability.P50DurationPass == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.P50DurationPass(childComplexity), true
+	case "TestReliability.p95DurationPass":
+		if e.complexity.TestReliability.P95DurationPass == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.P95DurationPass(childComplexity), true
+	case "TestReliability.successRate":
+		if e.complexity.TestReliability.SuccessRate == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.SuccessRate(childComplexity), true
+	case "TestReliability.taskName":
+		if e.complexity.TestReliability.TaskName == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.TaskName(childComplexity), true
+	case "TestReliability.testName":
+		if e.complexity.TestReliability.TestName == nil {
+			break
+		}
+
+		return e.complexity.TestReliability.TestName(childComplexity), true
+
 	case "TestResult.baseStatus":
 		if e.complexity.TestResult.BaseStatus == nil {
 			break
@@ -12577,12 +13087,24 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Version.Cost(childComplexity), true
+	case "Version.coverage":
+		if e.complexity.Version.Coverage == nil {
+			break
+		}
+
+		return e.complexity.Version.Coverage(childComplexity), true
 	case "Version.createTime":
 		if e.complexity.Version.CreateTime == nil {
 			break
 		}
 
 		return e.complexity.Version.CreateTime(childComplexity), true
+	case "Version.criticalPath":
+		if e.complexity.Version.CriticalPath == nil {
+			break
+		}
+
+		return e.complexity.Version.CriticalPath(childComplexity), true
 	case "Version.errors":
 		if e.complexity.Version.Errors == nil {
 			break
@@ -12790,6 +13312,110 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Version.Warnings(childComplexity), true
 
+	case "VersionCoverage.baseCoveredLines":
+		if e.complexity.VersionCoverage.BaseCoveredLines == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.BaseCoveredLines(childComplexity), true
+	case "VersionCoverage.baseOnlyTasks":
+		if e.complexity.VersionCoverage.BaseOnlyTasks == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.BaseOnlyTasks(childComplexity), true
+	case "VersionCoverage.basePercent":
+		if e.complexity.VersionCoverage.BasePercent == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.BasePercent(childComplexity), true
+	case "VersionCoverage.baseTotalLines":
+		if e.complexity.VersionCoverage.BaseTotalLines == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.BaseTotalLines(childComplexity), true
+	case "VersionCoverage.baseVersionId":
+		if e.complexity.VersionCoverage.BaseVersionID == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.BaseVersionID(childComplexity), true
+	case "VersionCoverage.coveredLines":
+		if e.complexity.VersionCoverage.CoveredLines == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.CoveredLines(childComplexity), true
+	case "VersionCoverage.delta":
+		if e.complexity.VersionCoverage.Delta == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.Delta(childComplexity), true
+	case "VersionCoverage.percent":
+		if e.complexity.VersionCoverage.Percent == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.Percent(childComplexity), true
+	case "VersionCoverage.tasks":
+		if e.complexity.VersionCoverage.Tasks == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.Tasks(childComplexity), true
+	case "VersionCoverage.totalLines":
+		if e.complexity.VersionCoverage.TotalLines == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.TotalLines(childComplexity), true
+	case "VersionCoverage.versionId":
+		if e.complexity.VersionCoverage.VersionID == nil {
+			break
+		}
+
+		return e.complexity.VersionCoverage.VersionID(childComplexity), true
+
+	case "VersionCriticalPath.makespan":
+		if e.complexity.VersionCriticalPath.Makespan == nil {
+			break
+		}
+
+		return e.complexity.VersionCriticalPath.Makespan(childComplexity), true
+	case "VersionCriticalPath.minimumMakespan":
+		if e.complexity.VersionCriticalPath.MinimumMakespan == nil {
+			break
+		}
+
+		return e.complexity.VersionCriticalPath.MinimumMakespan(childComplexity), true
+	case "VersionCriticalPath.tasks":
+		if e.complexity.VersionCriticalPath.Tasks == nil {
+			break
+		}
+
+		return e.complexity.VersionCriticalPath.Tasks(childComplexity), true
+	case "VersionCriticalPath.totalDependencyWait":
+		if e.complexity.VersionCriticalPath.TotalDependencyWait == nil {
+			break
+		}
+
+		return e.complexity.VersionCriticalPath.TotalDependencyWait(childComplexity), true
+	case "VersionCriticalPath.totalQueueWait":
+		if e.complexity.VersionCriticalPath.TotalQueueWait == nil {
+			break
+		}
+
+		return e.complexity.VersionCriticalPath.TotalQueueWait(childComplexity), true
+	case "VersionCriticalPath.versionId":
+		if e.complexity.VersionCriticalPath.VersionID == nil {
+			break
+		}
+
+		return e.complexity.VersionCriticalPath.VersionID(childComplexity), true
+
 	case "VersionLite.activated":
 		if e.complexity.VersionLite.Activated == nil {
 			break
@@ -13190,6 +13816,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.WebhookHeader.Value(childComplexity), true
 
+	case "WebhookSubscriber.format":
+		if e.complexity.WebhookSubscriber.Format == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscriber.Format(childComplexity), true
 	case "WebhookSubscriber.headers":
 		if e.complexity.WebhookSubscriber.Headers == nil {
 			break
@@ -13281,6 +13913,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputBuildBaronSettingsInput,
 		ec.unmarshalInputBuildVariantOptions,
 		ec.unmarshalInputCedarConfigInput,
+		ec.unmarshalInputChatWebhookSubscriberInput,
 		ec.unmarshalInputCloudProviderConfigInput,
 		ec.unmarshalInputCommitQueueParamsInput,
 		ec.unmarshalInputContainerPoolInput,
@@ -13311,6 +13944,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputExpansionInput,
 		ec.unmarshalInputExternalLinkInput,
 		ec.unmarshalInputFWSConfigInput,
+		ec.unmarshalInputFairShareWeightInput,
 		ec.unmarshalInputFinderSettingsInput,
 		ec.unmarshalInputGitHubAuthConfigInput,
 		ec.unmarshalInputGitHubCheckRunConfigInput,
@@ -13421,8 +14055,10 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputTaskLimitsConfigInput,
 		ec.unmarshalInputTaskPriority,
 		ec.unmarshalInputTaskSpecifierInput,
+		ec.unmarshalInputTeamsSubscriberInput,
 		ec.unmarshalInputTestFilter,
 		ec.unmarshalInputTestFilterOptions,
+		ec.unmarshalInputTestReliabilityOptions,
 		ec.unmarshalInputTestSelectionConfigInput,
 		ec.unmarshalInputTestSelectionSettingsInput,
 		ec.unmarshalInputTestSortOptions,
@@ -15888,6 +16524,28 @@ func (ec *executionContext) field_Project_patches_args(ctx context.Context, rawA
 	return args, nil
 }
 
+func (ec *executionContext) field_Project_testReliability_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "options", ec.unmarshalNTestReliabilityOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTestReliabilityOptions)
+	if err != nil {
+		return nil, err
+	}
+	args["options"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Project_worstTests_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "options", ec.unmarshalNTestReliabilityOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTestReliabilityOptions)
+	if err != nil {
+		return nil, err
+	}
+	args["options"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -18838,6 +19496,8 @@ func (ec *executionContext) fieldContext_AdminSettings_buckets(_ context.Context
 				return ec.fieldContext_BucketsConfig_retryFailedLogMoveMaxJobsPerRun(ctx, field)
 			case "testResultsBucket":
 				return ec.fieldContext_BucketsConfig_testResultsBucket(ctx, field)
+			case "taskFilesBucket":
+				return ec.fieldContext_BucketsConfig_taskFilesBucket(ctx, field)
 			case "internalBuckets":
 				return ec.fieldContext_BucketsConfig_internalBuckets(ctx, field)
 			case "credentials":
@@ -20766,6 +21426,8 @@ func (ec *executionContext) fieldContext_AdminTasksToRestartPayload_tasksToResta
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -23470,6 +24132,55 @@ func (ec *executionContext) fieldContext_BucketsConfig_testResultsBucket(_ conte
 	return fc, nil
 }
 
+func (ec *executionContext) _BucketsConfig_taskFilesBucket(ctx context.Context, field graphql.CollectedField, obj *model.APIBucketsConfig) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BucketsConfig_taskFilesBucket,
+		func(ctx context.Context) (any, error) {
+			return obj.TaskFilesBucket, nil
+		},
+		nil,
+		ec.marshalOBucketConfig2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIBucketConfig,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_BucketsConfig_taskFilesBucket(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BucketsConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_BucketConfig_name(ctx, field)
+			case "testResultsPrefix":
+				return ec.fieldContext_BucketConfig_testResultsPrefix(ctx, field)
+			case "roleARN":
+				return ec.fieldContext_BucketConfig_roleARN(ctx, field)
+			case "type":
+				return ec.fieldContext_BucketConfig_type(ctx, field)
+			case "expirationDays":
+				return ec.fieldContext_BucketConfig_expirationDays(ctx, field)
+			case "transitionToIADays":
+				return ec.fieldContext_BucketConfig_transitionToIADays(ctx, field)
+			case "transitionToGlacierDays":
+				return ec.fieldContext_BucketConfig_transitionToGlacierDays(ctx, field)
+			case "lifecycleLastSyncedAt":
+				return ec.fieldContext_BucketConfig_lifecycleLastSyncedAt(ctx, field)
+			case "lifecycleSyncError":
+				return ec.fieldContext_BucketConfig_lifecycleSyncError(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BucketConfig", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _BucketsConfig_internalBuckets(ctx context.Context, field graphql.CollectedField, obj *model.APIBucketsConfig) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -24005,6 +24716,93 @@ func (ec *executionContext) fieldContext_CedarConfig_dbName(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _ChatWebhookSubscriber_contentType(ctx context.Context, field graphql.CollectedField, obj *model.APIChatWebhookSubscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChatWebhookSubscriber_contentType,
+		func(ctx context.Context) (any, error) {
+			return obj.ContentType, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChatWebhookSubscriber_contentType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChatWebhookSubscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChatWebhookSubscriber_template(ctx context.Context, field graphql.CollectedField, obj *model.APIChatWebhookSubscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChatWebhookSubscriber_template,
+		func(ctx context.Context) (any, error) {
+			return obj.Template, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChatWebhookSubscriber_template(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChatWebhookSubscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ChatWebhookSubscriber_url(ctx context.Context, field graphql.CollectedField, obj *model.APIChatWebhookSubscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ChatWebhookSubscriber_url,
+		func(ctx context.Context) (any, error) {
+			return obj.URL, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ChatWebhookSubscriber_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ChatWebhookSubscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ChildPatchAlias_alias(ctx context.Context, field graphql.CollectedField, obj *model.APIChildPatchAlias) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -25084,6 +25882,238 @@ func (ec *executionContext) fieldContext_CostData_savingsPlanRate(_ context.Cont
 	return fc, nil
 }
 
+func (ec *executionContext) _CriticalPathTask_buildVariant(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_buildVariant,
+		func(ctx context.Context) (any, error) {
+			return obj.BuildVariant, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_buildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_dependencyWait(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_dependencyWait,
+		func(ctx context.Context) (any, error) {
+			return obj.DependencyWait, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_dependencyWait(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_displayName(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_displayName,
+		func(ctx context.Context) (any, error) {
+			return obj.DisplayName, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_duration(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_duration,
+		func(ctx context.Context) (any, error) {
+			return obj.Duration, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_durationEstimated(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_durationEstimated,
+		func(ctx context.Context) (any, error) {
+			return obj.DurationEstimated, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_durationEstimated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_queueWait(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_queueWait,
+		func(ctx context.Context) (any, error) {
+			return obj.QueueWait, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_queueWait(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_status(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CriticalPathTask_taskId(ctx context.Context, field graphql.CollectedField, obj *model.APICriticalPathTask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CriticalPathTask_taskId,
+		func(ctx context.Context) (any, error) {
+			return obj.TaskID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CriticalPathTask_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CriticalPathTask",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _DebugSpawnHostsConfig_setupScript(ctx context.Context, field graphql.CollectedField, obj *model.APIDebugSpawnHostsConfig) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -26137,6 +27167,8 @@ func (ec *executionContext) fieldContext_Distro_plannerSettings(_ context.Contex
 				return ec.fieldContext_PlannerSettings_commitQueueFactor(ctx, field)
 			case "expectedRuntimeFactor":
 				return ec.fieldContext_PlannerSettings_expectedRuntimeFactor(ctx, field)
+			case "fairShareWeights":
+				return ec.fieldContext_PlannerSettings_fairShareWeights(ctx, field)
 			case "generateTaskFactor":
 				return ec.fieldContext_PlannerSettings_generateTaskFactor(ctx, field)
 			case "numDependentsFactor":
@@ -27597,6 +28629,93 @@ func (ec *executionContext) fieldContext_FailingCommand_failureMetadataTags(_ co
 	return fc, nil
 }
 
+func (ec *executionContext) _FairShareWeight_name(ctx context.Context, field graphql.CollectedField, obj *model.APIFairShareWeight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FairShareWeight_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FairShareWeight_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FairShareWeight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FairShareWeight_projects(ctx context.Context, field graphql.CollectedField, obj *model.APIFairShareWeight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FairShareWeight_projects,
+		func(ctx context.Context) (any, error) {
+			return obj.Projects, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FairShareWeight_projects(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FairShareWeight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FairShareWeight_weight(ctx context.Context, field graphql.CollectedField, obj *model.APIFairShareWeight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FairShareWeight_weight,
+		func(ctx context.Context) (any, error) {
+			return obj.Weight, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FairShareWeight_weight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FairShareWeight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _File_link(ctx context.Context, field graphql.CollectedField, obj *model.APIFile) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -27748,6 +28867,238 @@ func (ec *executionContext) fieldContext_File_associatedLinks(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _FileCoverageDelta_name(ctx context.Context, field graphql.CollectedField, obj *model.APIFileCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FileCoverageDelta_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FileCoverageDelta_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileCoverageDelta_basePercent(ctx context.Context, field graphql.CollectedField, obj *model.APIFileCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FileCoverageDelta_basePercent,
+		func(ctx context.Context) (any, error) {
+			return obj.BasePercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FileCoverageDelta_basePercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileCoverageDelta_baseCoveredLines(ctx context.Context, field graphql.CollectedField, obj *model.APIFileCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FileCoverageDelta_baseCoveredLines,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseCoveredLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FileCoverageDelta_baseCoveredLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileCoverageDelta_baseTotalLines(ctx context.Context, field graphql.CollectedField, obj *model.APIFileCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FileCoverageDelta_baseTotalLines,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseTotalLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FileCoverageDelta_baseTotalLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileCoverageDelta_coveredLines(ctx context.Context, field graphql.CollectedField, obj *model.APIFileCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FileCoverageDelta_coveredLines,
+		func(ctx context.Context) (any, error) {
+			return obj.CoveredLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FileCoverageDelta_coveredLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileCoverageDelta_delta(ctx context.Context, field graphql.CollectedField, obj *model.APIFileCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FileCoverageDelta_delta,
+		func(ctx context.Context) (any, error) {
+			return obj.Delta, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FileCoverageDelta_delta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileCoverageDelta_percent(ctx context.Context, field graphql.CollectedField, obj *model.APIFileCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FileCoverageDelta_percent,
+		func(ctx context.Context) (any, error) {
+			return obj.Percent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FileCoverageDelta_percent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FileCoverageDelta_totalLines(ctx context.Context, field graphql.CollectedField, obj *model.APIFileCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FileCoverageDelta_totalLines,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FileCoverageDelta_totalLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FileCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _FileDiff_additions(ctx context.Context, field graphql.CollectedField, obj *model.FileDiff) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -29622,6 +30973,8 @@ func (ec *executionContext) fieldContext_GroupedBuildVariant_tasks(_ context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -29977,12 +31330,16 @@ func (ec *executionContext) fieldContext_GroupedProjects_projects(_ context.Cont
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -33185,6 +34542,8 @@ func (ec *executionContext) fieldContext_Image_latestTask(_ context.Context, fie
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -35607,6 +36966,10 @@ func (ec *executionContext) fieldContext_MainlineCommitVersion_rolledUpVersions(
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -35720,6 +37083,10 @@ func (ec *executionContext) fieldContext_MainlineCommitVersion_version(_ context
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -37562,12 +38929,16 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToNewRepo(ctx con
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -37711,12 +39082,16 @@ func (ec *executionContext) fieldContext_Mutation_attachProjectToRepo(ctx contex
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -37860,12 +39235,16 @@ func (ec *executionContext) fieldContext_Mutation_createProject(ctx context.Cont
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -38009,12 +39388,16 @@ func (ec *executionContext) fieldContext_Mutation_copyProject(ctx context.Contex
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -38326,12 +39709,16 @@ func (ec *executionContext) fieldContext_Mutation_detachProjectFromRepo(ctx cont
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -39301,6 +40688,8 @@ func (ec *executionContext) fieldContext_Mutation_abortTask(ctx context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -39528,6 +40917,8 @@ func (ec *executionContext) fieldContext_Mutation_overrideTaskDependencies(ctx c
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -39755,6 +41146,8 @@ func (ec *executionContext) fieldContext_Mutation_restartTask(ctx context.Contex
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -39982,6 +41375,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleTasks(ctx context.Cont
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -40209,6 +41604,8 @@ func (ec *executionContext) fieldContext_Mutation_setTaskPriority(ctx context.Co
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -40436,6 +41833,8 @@ func (ec *executionContext) fieldContext_Mutation_setTaskPriorities(ctx context.
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -40663,6 +42062,8 @@ func (ec *executionContext) fieldContext_Mutation_unscheduleTask(ctx context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -41028,6 +42429,8 @@ func (ec *executionContext) fieldContext_Mutation_quarantineTask(ctx context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -41255,6 +42658,8 @@ func (ec *executionContext) fieldContext_Mutation_unquarantineTask(ctx context.C
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -41534,12 +42939,16 @@ func (ec *executionContext) fieldContext_Mutation_addFavoriteProject(ctx context
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -41800,12 +43209,16 @@ func (ec *executionContext) fieldContext_Mutation_removeFavoriteProject(ctx cont
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -42135,6 +43548,10 @@ func (ec *executionContext) fieldContext_Mutation_restartVersions(ctx context.Co
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -42386,6 +43803,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleUndispatchedBaseTasks(
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -45178,12 +46597,16 @@ func (ec *executionContext) fieldContext_Patch_projectMetadata(_ context.Context
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -45586,6 +47009,10 @@ func (ec *executionContext) fieldContext_Patch_versionFull(_ context.Context, fi
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -46965,6 +48392,43 @@ func (ec *executionContext) fieldContext_PlannerSettings_expectedRuntimeFactor(_
 	return fc, nil
 }
 
+func (ec *executionContext) _PlannerSettings_fairShareWeights(ctx context.Context, field graphql.CollectedField, obj *model.APIPlannerSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlannerSettings_fairShareWeights,
+		func(ctx context.Context) (any, error) {
+			return obj.FairShareWeights, nil
+		},
+		nil,
+		ec.marshalNFairShareWeight2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFairShareWeightᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlannerSettings_fairShareWeights(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlannerSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_FairShareWeight_name(ctx, field)
+			case "projects":
+				return ec.fieldContext_FairShareWeight_projects(ctx, field)
+			case "weight":
+				return ec.fieldContext_FairShareWeight_weight(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FairShareWeight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PlannerSettings_generateTaskFactor(ctx context.Context, field graphql.CollectedField, obj *model.APIPlannerSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -48852,6 +50316,71 @@ func (ec *executionContext) fieldContext_Project_testSelection(_ context.Context
 	return fc, nil
 }
 
+func (ec *executionContext) _Project_testReliability(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Project_testReliability,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Project().TestReliability(ctx, obj, fc.Args["options"].(TestReliabilityOptions))
+		},
+		nil,
+		ec.marshalNTestReliability2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestReliabilityᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Project_testReliability(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Project",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "buildVariant":
+				return ec.fieldContext_TestReliability_buildVariant(ctx, field)
+			case "date":
+				return ec.fieldContext_TestReliability_date(ctx, field)
+			case "maxSuccessRate":
+				return ec.fieldContext_TestReliability_maxSuccessRate(ctx, field)
+			case "numFail":
+				return ec.fieldContext_TestReliability_numFail(ctx, field)
+			case "numPass":
+				return ec.fieldContext_TestReliability_numPass(ctx, field)
+			case "numTotal":
+				return ec.fieldContext_TestReliability_numTotal(ctx, field)
+			case "p50DurationPass":
+				return ec.fieldContext_TestReliability_p50DurationPass(ctx, field)
+			case "p95DurationPass":
+				return ec.fieldContext_TestReliability_p95DurationPass(ctx, field)
+			case "successRate":
+				return ec.fieldContext_TestReliability_successRate(ctx, field)
+			case "taskName":
+				return ec.fieldContext_TestReliability_taskName(ctx, field)
+			case "testName":
+				return ec.fieldContext_TestReliability_testName(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestReliability", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Project_testReliability_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Project_triggers(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -48965,6 +50494,71 @@ func (ec *executionContext) fieldContext_Project_workstationConfig(_ context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _Project_worstTests(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Project_worstTests,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Project().WorstTests(ctx, obj, fc.Args["options"].(TestReliabilityOptions))
+		},
+		nil,
+		ec.marshalNTestReliability2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestReliabilityᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Project_worstTests(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Project",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "buildVariant":
+				return ec.fieldContext_TestReliability_buildVariant(ctx, field)
+			case "date":
+				return ec.fieldContext_TestReliability_date(ctx, field)
+			case "maxSuccessRate":
+				return ec.fieldContext_TestReliability_maxSuccessRate(ctx, field)
+			case "numFail":
+				return ec.fieldContext_TestReliability_numFail(ctx, field)
+			case "numPass":
+				return ec.fieldContext_TestReliability_numPass(ctx, field)
+			case "numTotal":
+				return ec.fieldContext_TestReliability_numTotal(ctx, field)
+			case "p50DurationPass":
+				return ec.fieldContext_TestReliability_p50DurationPass(ctx, field)
+			case "p95DurationPass":
+				return ec.fieldContext_TestReliability_p95DurationPass(ctx, field)
+			case "successRate":
+				return ec.fieldContext_TestReliability_successRate(ctx, field)
+			case "taskName":
+				return ec.fieldContext_TestReliability_taskName(ctx, field)
+			case "testName":
+				return ec.fieldContext_TestReliability_testName(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestReliability", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Project_worstTests_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ProjectAlias_id(ctx context.Context, field graphql.CollectedField, obj *model.APIProjectAlias) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -49882,12 +51476,16 @@ func (ec *executionContext) fieldContext_ProjectEventSettings_projectRef(_ conte
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -51490,12 +53088,16 @@ func (ec *executionContext) fieldContext_ProjectSettings_projectRef(_ context.Co
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -53306,12 +54908,16 @@ func (ec *executionContext) fieldContext_Query_project(ctx context.Context, fiel
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -53966,6 +55572,8 @@ func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field g
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -54193,6 +55801,8 @@ func (ec *executionContext) fieldContext_Query_taskAllExecutions(ctx context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -54813,6 +56423,10 @@ func (ec *executionContext) fieldContext_Query_version(ctx context.Context, fiel
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -57922,6 +59536,267 @@ func (ec *executionContext) fieldContext_ResourceLimits_virtualMemoryKb(_ contex
 	return fc, nil
 }
 
+func (ec *executionContext) _ResourceUsageSample_command(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_command,
+		func(ctx context.Context) (any, error) {
+			return obj.Command, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_command(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsageSample_cpuPercent(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_cpuPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.CPUPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_cpuPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsageSample_diskReadBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_diskReadBytes,
+		func(ctx context.Context) (any, error) {
+			return obj.DiskReadBytes, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_diskReadBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsageSample_diskWriteBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_diskWriteBytes,
+		func(ctx context.Context) (any, error) {
+			return obj.DiskWriteBytes, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_diskWriteBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsageSample_memoryPercent(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_memoryPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.MemoryPercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_memoryPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsageSample_memoryUsedBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_memoryUsedBytes,
+		func(ctx context.Context) (any, error) {
+			return obj.MemoryUsedBytes, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_memoryUsedBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsageSample_numProcesses(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_numProcesses,
+		func(ctx context.Context) (any, error) {
+			return obj.NumProcesses, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_numProcesses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsageSample_time(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_time,
+		func(ctx context.Context) (any, error) {
+			return obj.Time, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceUsageSample_workDirDiskUsedBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSample) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ResourceUsageSample_workDirDiskUsedBytes,
+		func(ctx context.Context) (any, error) {
+			return obj.WorkDirDiskUsedBytes, nil
+		},
+		nil,
+		ec.marshalNInt2int64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ResourceUsageSample_workDirDiskUsedBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceUsageSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _RestartAdminTasksPayload_numRestartedTasks(ctx context.Context, field graphql.CollectedField, obj *RestartAdminTasksPayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -61296,6 +63171,43 @@ func (ec *executionContext) fieldContext_Subnet_subnetId(_ context.Context, fiel
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscriber_chatWebhookSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscriber_chatWebhookSubscriber,
+		func(ctx context.Context) (any, error) {
+			return obj.ChatWebhookSubscriber, nil
+		},
+		nil,
+		ec.marshalOChatWebhookSubscriber2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChatWebhookSubscriber,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscriber_chatWebhookSubscriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "contentType":
+				return ec.fieldContext_ChatWebhookSubscriber_contentType(ctx, field)
+			case "template":
+				return ec.fieldContext_ChatWebhookSubscriber_template(ctx, field)
+			case "url":
+				return ec.fieldContext_ChatWebhookSubscriber_url(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChatWebhookSubscriber", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Subscriber_emailSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -61494,6 +63406,39 @@ func (ec *executionContext) fieldContext_Subscriber_slackSubscriber(_ context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscriber_teamsSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscriber_teamsSubscriber,
+		func(ctx context.Context) (any, error) {
+			return obj.TeamsSubscriber, nil
+		},
+		nil,
+		ec.marshalOTeamsSubscriber2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITeamsSubscriber,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscriber_teamsSubscriber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "url":
+				return ec.fieldContext_TeamsSubscriber_url(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TeamsSubscriber", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Subscriber_webhookSubscriber(ctx context.Context, field graphql.CollectedField, obj *Subscriber) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -61518,6 +63463,8 @@ func (ec *executionContext) fieldContext_Subscriber_webhookSubscriber(_ context.
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "format":
+				return ec.fieldContext_WebhookSubscriber_format(ctx, field)
 			case "headers":
 				return ec.fieldContext_WebhookSubscriber_headers(ctx, field)
 			case "secret":
@@ -61561,6 +63508,8 @@ func (ec *executionContext) fieldContext_SubscriberWrapper_subscriber(_ context.
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "chatWebhookSubscriber":
+				return ec.fieldContext_Subscriber_chatWebhookSubscriber(ctx, field)
 			case "emailSubscriber":
 				return ec.fieldContext_Subscriber_emailSubscriber(ctx, field)
 			case "githubCheckSubscriber":
@@ -61573,6 +63522,8 @@ func (ec *executionContext) fieldContext_SubscriberWrapper_subscriber(_ context.
 				return ec.fieldContext_Subscriber_jiraIssueSubscriber(ctx, field)
 			case "slackSubscriber":
 				return ec.fieldContext_Subscriber_slackSubscriber(ctx, field)
+			case "teamsSubscriber":
+				return ec.fieldContext_Subscriber_teamsSubscriber(ctx, field)
 			case "webhookSubscriber":
 				return ec.fieldContext_Subscriber_webhookSubscriber(ctx, field)
 			}
@@ -62076,6 +64027,8 @@ func (ec *executionContext) fieldContext_Task_baseTask(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -62996,6 +64949,8 @@ func (ec *executionContext) fieldContext_Task_displayTask(_ context.Context, fie
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -63400,6 +65355,8 @@ func (ec *executionContext) fieldContext_Task_executionTasksFull(ctx context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -63835,6 +65792,8 @@ func (ec *executionContext) fieldContext_Task_generator(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -64321,6 +66280,8 @@ func (ec *executionContext) fieldContext_Task_nextTask(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -64536,6 +66497,8 @@ func (ec *executionContext) fieldContext_Task_nextTaskCompleted(_ context.Contex
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -64751,6 +66714,8 @@ func (ec *executionContext) fieldContext_Task_nextTaskFailing(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -64966,6 +66931,8 @@ func (ec *executionContext) fieldContext_Task_nextTaskPassing(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -65373,6 +67340,8 @@ func (ec *executionContext) fieldContext_Task_prevTask(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -65589,6 +67558,8 @@ func (ec *executionContext) fieldContext_Task_prevTaskCompleted(ctx context.Cont
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -65815,6 +67786,8 @@ func (ec *executionContext) fieldContext_Task_prevTaskFailing(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -66030,6 +68003,8 @@ func (ec *executionContext) fieldContext_Task_prevTaskPassing(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -66228,12 +68203,16 @@ func (ec *executionContext) fieldContext_Task_project(_ context.Context, field g
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -66328,6 +68307,55 @@ func (ec *executionContext) fieldContext_Task_resetWhenFinished(_ context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Task_resourceUsage(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Task_resourceUsage,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Task().ResourceUsage(ctx, obj)
+		},
+		nil,
+		ec.marshalNResourceUsageSample2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSampleᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Task_resourceUsage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Task",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "command":
+				return ec.fieldContext_ResourceUsageSample_command(ctx, field)
+			case "cpuPercent":
+				return ec.fieldContext_ResourceUsageSample_cpuPercent(ctx, field)
+			case "diskReadBytes":
+				return ec.fieldContext_ResourceUsageSample_diskReadBytes(ctx, field)
+			case "diskWriteBytes":
+				return ec.fieldContext_ResourceUsageSample_diskWriteBytes(ctx, field)
+			case "memoryPercent":
+				return ec.fieldContext_ResourceUsageSample_memoryPercent(ctx, field)
+			case "memoryUsedBytes":
+				return ec.fieldContext_ResourceUsageSample_memoryUsedBytes(ctx, field)
+			case "numProcesses":
+				return ec.fieldContext_ResourceUsageSample_numProcesses(ctx, field)
+			case "time":
+				return ec.fieldContext_ResourceUsageSample_time(ctx, field)
+			case "workDirDiskUsedBytes":
+				return ec.fieldContext_ResourceUsageSample_workDirDiskUsedBytes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceUsageSample", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Task_revision(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -67042,6 +69070,10 @@ func (ec *executionContext) fieldContext_Task_versionMetadata(_ context.Context,
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -67146,6 +69178,372 @@ func (ec *executionContext) fieldContext_TaskAnnotationSettings_fileTicketWebhoo
 	return fc, nil
 }
 
+func (ec *executionContext) _TaskCoverageDelta_baseTaskId(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_baseTaskId,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseTaskID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_baseTaskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_basePercent(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_basePercent,
+		func(ctx context.Context) (any, error) {
+			return obj.BasePercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_basePercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_baseCoveredLines(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_baseCoveredLines,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseCoveredLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_baseCoveredLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_baseTotalLines(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_baseTotalLines,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseTotalLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_baseTotalLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_buildVariant(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_buildVariant,
+		func(ctx context.Context) (any, error) {
+			return obj.BuildVariant, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_buildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_coveredLines(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_coveredLines,
+		func(ctx context.Context) (any, error) {
+			return obj.CoveredLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_coveredLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_delta(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_delta,
+		func(ctx context.Context) (any, error) {
+			return obj.Delta, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_delta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_files(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_files,
+		func(ctx context.Context) (any, error) {
+			return obj.Files, nil
+		},
+		nil,
+		ec.marshalNFileCoverageDelta2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFileCoverageDeltaᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_files(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_FileCoverageDelta_name(ctx, field)
+			case "basePercent":
+				return ec.fieldContext_FileCoverageDelta_basePercent(ctx, field)
+			case "baseCoveredLines":
+				return ec.fieldContext_FileCoverageDelta_baseCoveredLines(ctx, field)
+			case "baseTotalLines":
+				return ec.fieldContext_FileCoverageDelta_baseTotalLines(ctx, field)
+			case "coveredLines":
+				return ec.fieldContext_FileCoverageDelta_coveredLines(ctx, field)
+			case "delta":
+				return ec.fieldContext_FileCoverageDelta_delta(ctx, field)
+			case "percent":
+				return ec.fieldContext_FileCoverageDelta_percent(ctx, field)
+			case "totalLines":
+				return ec.fieldContext_FileCoverageDelta_totalLines(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FileCoverageDelta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_percent(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_percent,
+		func(ctx context.Context) (any, error) {
+			return obj.Percent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_percent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_taskId(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_taskId,
+		func(ctx context.Context) (any, error) {
+			return obj.TaskID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_taskName(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_taskName,
+		func(ctx context.Context) (any, error) {
+			return obj.TaskName, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_taskName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskCoverageDelta_totalLines(ctx context.Context, field graphql.CollectedField, obj *model.APITaskCoverageDelta) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TaskCoverageDelta_totalLines,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TaskCoverageDelta_totalLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskCoverageDelta",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TaskEndDetail_description(ctx context.Context, field graphql.CollectedField, obj *model.ApiTaskEndDetail) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -68342,6 +70740,8 @@ func (ec *executionContext) fieldContext_TaskHistory_tasks(_ context.Context, fi
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -70597,6 +72997,35 @@ func (ec *executionContext) fieldContext_TaskTestResultSample_totalTestCount(_ c
 	return fc, nil
 }
 
+func (ec *executionContext) _TeamsSubscriber_url(ctx context.Context, field graphql.CollectedField, obj *model.APITeamsSubscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TeamsSubscriber_url,
+		func(ctx context.Context) (any, error) {
+			return obj.URL, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TeamsSubscriber_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TeamsSubscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TestLog_lineNum(ctx context.Context, field graphql.CollectedField, obj *model.TestLogs) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -70887,6 +73316,325 @@ func (ec *executionContext) fieldContext_TestQuarantineEntry_isManuallyQuarantin
 	return fc, nil
 }
 
+func (ec *executionContext) _TestReliability_buildVariant(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_buildVariant,
+		func(ctx context.Context) (any, error) {
+			return obj.BuildVariant, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_buildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_date(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_date,
+		func(ctx context.Context) (any, error) {
+			return obj.Date, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_maxSuccessRate(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_maxSuccessRate,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxSuccessRate, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_maxSuccessRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_numFail(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_numFail,
+		func(ctx context.Context) (any, error) {
+			return obj.NumFail, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_numFail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_numPass(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_numPass,
+		func(ctx context.Context) (any, error) {
+			return obj.NumPass, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_numPass(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_numTotal(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_numTotal,
+		func(ctx context.Context) (any, error) {
+			return obj.NumTotal, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_numTotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_p50DurationPass(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_p50DurationPass,
+		func(ctx context.Context) (any, error) {
+			return obj.P50DurationPass, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_p50DurationPass(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_p95DurationPass(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_p95DurationPass,
+		func(ctx context.Context) (any, error) {
+			return obj.P95DurationPass, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_p95DurationPass(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_successRate(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_successRate,
+		func(ctx context.Context) (any, error) {
+			return obj.SuccessRate, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_successRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_taskName(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_taskName,
+		func(ctx context.Context) (any, error) {
+			return obj.TaskName, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_taskName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestReliability_testName(ctx context.Context, field graphql.CollectedField, obj *model.APITestReliability) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TestReliability_testName,
+		func(ctx context.Context) (any, error) {
+			return obj.TestName, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TestReliability_testName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestReliability",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TestResult_id(ctx context.Context, field graphql.CollectedField, obj *model.APITest) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -72955,6 +75703,8 @@ func (ec *executionContext) fieldContext_UpstreamProject_task(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -73102,6 +75852,10 @@ func (ec *executionContext) fieldContext_UpstreamProject_version(_ context.Conte
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -74470,6 +77224,10 @@ func (ec *executionContext) fieldContext_Version_baseVersion(_ context.Context,
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -74710,6 +77468,10 @@ func (ec *executionContext) fieldContext_Version_childVersions(_ context.Context
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -74828,6 +77590,102 @@ func (ec *executionContext) fieldContext_Version_cost(_ context.Context, field g
 	return fc, nil
 }
 
+func (ec *executionContext) _Version_coverage(ctx context.Context, field graphql.CollectedField, obj *model.APIVersion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Version_coverage,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Version().Coverage(ctx, obj)
+		},
+		nil,
+		ec.marshalOVersionCoverage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersionCoverage,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Version_coverage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Version",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "versionId":
+				return ec.fieldContext_VersionCoverage_versionId(ctx, field)
+			case "baseVersionId":
+				return ec.fieldContext_VersionCoverage_baseVersionId(ctx, field)
+			case "baseOnlyTasks":
+				return ec.fieldContext_VersionCoverage_baseOnlyTasks(ctx, field)
+			case "basePercent":
+				return ec.fieldContext_VersionCoverage_basePercent(ctx, field)
+			case "baseCoveredLines":
+				return ec.fieldContext_VersionCoverage_baseCoveredLines(ctx, field)
+			case "baseTotalLines":
+				return ec.fieldContext_VersionCoverage_baseTotalLines(ctx, field)
+			case "coveredLines":
+				return ec.fieldContext_VersionCoverage_coveredLines(ctx, field)
+			case "delta":
+				return ec.fieldContext_VersionCoverage_delta(ctx, field)
+			case "percent":
+				return ec.fieldContext_VersionCoverage_percent(ctx, field)
+			case "tasks":
+				return ec.fieldContext_VersionCoverage_tasks(ctx, field)
+			case "totalLines":
+				return ec.fieldContext_VersionCoverage_totalLines(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VersionCoverage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Version_criticalPath(ctx context.Context, field graphql.CollectedField, obj *model.APIVersion) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Version_criticalPath,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Version().CriticalPath(ctx, obj)
+		},
+		nil,
+		ec.marshalOVersionCriticalPath2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersionCriticalPath,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Version_criticalPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Version",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "versionId":
+				return ec.fieldContext_VersionCriticalPath_versionId(ctx, field)
+			case "makespan":
+				return ec.fieldContext_VersionCriticalPath_makespan(ctx, field)
+			case "minimumMakespan":
+				return ec.fieldContext_VersionCriticalPath_minimumMakespan(ctx, field)
+			case "tasks":
+				return ec.fieldContext_VersionCriticalPath_tasks(ctx, field)
+			case "totalDependencyWait":
+				return ec.fieldContext_VersionCriticalPath_totalDependencyWait(ctx, field)
+			case "totalQueueWait":
+				return ec.fieldContext_VersionCriticalPath_totalQueueWait(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VersionCriticalPath", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Version_createTime(ctx context.Context, field graphql.CollectedField, obj *model.APIVersion) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -75447,6 +78305,10 @@ func (ec *executionContext) fieldContext_Version_previousVersion(_ context.Conte
 				return ec.fieldContext_Version_childVersions(ctx, field)
 			case "cost":
 				return ec.fieldContext_Version_cost(ctx, field)
+			case "coverage":
+				return ec.fieldContext_Version_coverage(ctx, field)
+			case "criticalPath":
+				return ec.fieldContext_Version_criticalPath(ctx, field)
 			case "createTime":
 				return ec.fieldContext_Version_createTime(ctx, field)
 			case "ingestTime":
@@ -75640,12 +78502,16 @@ func (ec *executionContext) fieldContext_Version_projectMetadata(_ context.Conte
 				return ec.fieldContext_Project_taskAnnotationSettings(ctx, field)
 			case "testSelection":
 				return ec.fieldContext_Project_testSelection(ctx, field)
+			case "testReliability":
+				return ec.fieldContext_Project_testReliability(ctx, field)
 			case "triggers":
 				return ec.fieldContext_Project_triggers(ctx, field)
 			case "versionControlEnabled":
 				return ec.fieldContext_Project_versionControlEnabled(ctx, field)
 			case "workstationConfig":
 				return ec.fieldContext_Project_workstationConfig(ctx, field)
+			case "worstTests":
+				return ec.fieldContext_Project_worstTests(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Project", field.Name)
 		},
@@ -76179,6 +79045,569 @@ func (ec *executionContext) fieldContext_Version_warnings(_ context.Context, fie
 	return fc, nil
 }
 
+func (ec *executionContext) _VersionCoverage_versionId(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_versionId,
+		func(ctx context.Context) (any, error) {
+			return obj.VersionID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_versionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_baseVersionId(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_baseVersionId,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseVersionID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_baseVersionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_baseOnlyTasks(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_baseOnlyTasks,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseOnlyTasks, nil
+		},
+		nil,
+		ec.marshalNTaskCoverageDelta2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCoverageDeltaᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_baseOnlyTasks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "baseTaskId":
+				return ec.fieldContext_TaskCoverageDelta_baseTaskId(ctx, field)
+			case "basePercent":
+				return ec.fieldContext_TaskCoverageDelta_basePercent(ctx, field)
+			case "baseCoveredLines":
+				return ec.fieldContext_TaskCoverageDelta_baseCoveredLines(ctx, field)
+			case "baseTotalLines":
+				return ec.fieldContext_TaskCoverageDelta_baseTotalLines(ctx, field)
+			case "buildVariant":
+				return ec.fieldContext_TaskCoverageDelta_buildVariant(ctx, field)
+			case "coveredLines":
+				return ec.fieldContext_TaskCoverageDelta_coveredLines(ctx, field)
+			case "delta":
+				return ec.fieldContext_TaskCoverageDelta_delta(ctx, field)
+			case "files":
+				return ec.fieldContext_TaskCoverageDelta_files(ctx, field)
+			case "percent":
+				return ec.fieldContext_TaskCoverageDelta_percent(ctx, field)
+			case "taskId":
+				return ec.fieldContext_TaskCoverageDelta_taskId(ctx, field)
+			case "taskName":
+				return ec.fieldContext_TaskCoverageDelta_taskName(ctx, field)
+			case "totalLines":
+				return ec.fieldContext_TaskCoverageDelta_totalLines(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TaskCoverageDelta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_basePercent(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_basePercent,
+		func(ctx context.Context) (any, error) {
+			return obj.BasePercent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_basePercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_baseCoveredLines(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_baseCoveredLines,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseCoveredLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_baseCoveredLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_baseTotalLines(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_baseTotalLines,
+		func(ctx context.Context) (any, error) {
+			return obj.BaseTotalLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_baseTotalLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_coveredLines(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_coveredLines,
+		func(ctx context.Context) (any, error) {
+			return obj.CoveredLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_coveredLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_delta(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_delta,
+		func(ctx context.Context) (any, error) {
+			return obj.Delta, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_delta(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_percent(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_percent,
+		func(ctx context.Context) (any, error) {
+			return obj.Percent, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_percent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_tasks(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_tasks,
+		func(ctx context.Context) (any, error) {
+			return obj.Tasks, nil
+		},
+		nil,
+		ec.marshalNTaskCoverageDelta2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCoverageDeltaᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_tasks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "baseTaskId":
+				return ec.fieldContext_TaskCoverageDelta_baseTaskId(ctx, field)
+			case "basePercent":
+				return ec.fieldContext_TaskCoverageDelta_basePercent(ctx, field)
+			case "baseCoveredLines":
+				return ec.fieldContext_TaskCoverageDelta_baseCoveredLines(ctx, field)
+			case "baseTotalLines":
+				return ec.fieldContext_TaskCoverageDelta_baseTotalLines(ctx, field)
+			case "buildVariant":
+				return ec.fieldContext_TaskCoverageDelta_buildVariant(ctx, field)
+			case "coveredLines":
+				return ec.fieldContext_TaskCoverageDelta_coveredLines(ctx, field)
+			case "delta":
+				return ec.fieldContext_TaskCoverageDelta_delta(ctx, field)
+			case "files":
+				return ec.fieldContext_TaskCoverageDelta_files(ctx, field)
+			case "percent":
+				return ec.fieldContext_TaskCoverageDelta_percent(ctx, field)
+			case "taskId":
+				return ec.fieldContext_TaskCoverageDelta_taskId(ctx, field)
+			case "taskName":
+				return ec.fieldContext_TaskCoverageDelta_taskName(ctx, field)
+			case "totalLines":
+				return ec.fieldContext_TaskCoverageDelta_totalLines(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TaskCoverageDelta", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCoverage_totalLines(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCoverage_totalLines,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalLines, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCoverage_totalLines(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCriticalPath_versionId(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCriticalPath_versionId,
+		func(ctx context.Context) (any, error) {
+			return obj.VersionID, nil
+		},
+		nil,
+		ec.marshalNString2ᚖstring,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCriticalPath_versionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCriticalPath_makespan(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCriticalPath_makespan,
+		func(ctx context.Context) (any, error) {
+			return obj.Makespan, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCriticalPath_makespan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCriticalPath_minimumMakespan(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCriticalPath_minimumMakespan,
+		func(ctx context.Context) (any, error) {
+			return obj.MinimumMakespan, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCriticalPath_minimumMakespan(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCriticalPath_tasks(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCriticalPath_tasks,
+		func(ctx context.Context) (any, error) {
+			return obj.Tasks, nil
+		},
+		nil,
+		ec.marshalNCriticalPathTask2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPathTaskᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCriticalPath_tasks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "buildVariant":
+				return ec.fieldContext_CriticalPathTask_buildVariant(ctx, field)
+			case "dependencyWait":
+				return ec.fieldContext_CriticalPathTask_dependencyWait(ctx, field)
+			case "displayName":
+				return ec.fieldContext_CriticalPathTask_displayName(ctx, field)
+			case "duration":
+				return ec.fieldContext_CriticalPathTask_duration(ctx, field)
+			case "durationEstimated":
+				return ec.fieldContext_CriticalPathTask_durationEstimated(ctx, field)
+			case "queueWait":
+				return ec.fieldContext_CriticalPathTask_queueWait(ctx, field)
+			case "status":
+				return ec.fieldContext_CriticalPathTask_status(ctx, field)
+			case "taskId":
+				return ec.fieldContext_CriticalPathTask_taskId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CriticalPathTask", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCriticalPath_totalDependencyWait(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCriticalPath_totalDependencyWait,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalDependencyWait, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCriticalPath_totalDependencyWait(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersionCriticalPath_totalQueueWait(ctx context.Context, field graphql.CollectedField, obj *model.APIVersionCriticalPath) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VersionCriticalPath_totalQueueWait,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalQueueWait, nil
+		},
+		nil,
+		ec.marshalNDuration2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDuration,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VersionCriticalPath_totalQueueWait(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersionCriticalPath",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Duration does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _VersionLite_id(ctx context.Context, field graphql.CollectedField, obj *model1.Version) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -77355,6 +80784,8 @@ func (ec *executionContext) fieldContext_VersionTasks_data(_ context.Context, fi
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -78636,6 +82067,35 @@ func (ec *executionContext) fieldContext_WebhookHeader_value(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _WebhookSubscriber_format(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookSubscriber) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebhookSubscriber_format,
+		func(ctx context.Context) (any, error) {
+			return obj.Format, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebhookSubscriber_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebhookSubscriber",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _WebhookSubscriber_headers(ctx context.Context, field graphql.CollectedField, obj *model.APIWebhookSubscriber) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -81945,7 +85405,7 @@ func (ec *executionContext) unmarshalInputBucketsConfigInput(ctx context.Context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"logBucket", "logBucketLongRetention", "logBucketFailedTasks", "longRetentionProjects", "retryFailedLogMoveLookbackDays", "retryFailedLogMoveLookbackMonths", "retryFailedLogMoveMaxJobsPerRun", "testResultsBucket", "internalBuckets", "credentials"}
+	fieldsInOrder := [...]string{"logBucket", "logBucketLongRetention", "logBucketFailedTasks", "longRetentionProjects", "retryFailedLogMoveLookbackDays", "retryFailedLogMoveLookbackMonths", "retryFailedLogMoveMaxJobsPerRun", "testResultsBucket", "taskFilesBucket", "internalBuckets", "credentials"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -82008,6 +85468,13 @@ func (ec *executionContext) unmarshalInputBucketsConfigInput(ctx context.Context
 				return it, err
 			}
 			it.TestResultsBucket = data
+		case "taskFilesBucket":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("taskFilesBucket"))
+			data, err := ec.unmarshalOBucketConfigInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIBucketConfig(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TaskFilesBucket = data
 		case "internalBuckets":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("internalBuckets"))
 			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
@@ -82192,6 +85659,51 @@ func (ec *executionContext) unmarshalInputCedarConfigInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputChatWebhookSubscriberInput(ctx context.Context, obj any) (model.APIChatWebhookSubscriber, error) {
+	var it model.APIChatWebhookSubscriber
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["contentType"]; !present {
+		asMap["contentType"] = ""
+	}
+
+	fieldsInOrder := [...]string{"contentType", "template", "url"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "contentType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contentType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ContentType = data
+		case "template":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("template"))
+			data, err := ec.unmarshalNString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Template = data
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputCloudProviderConfigInput(ctx context.Context, obj any) (model.APICloudProviders, error) {
 	var it model.APICloudProviders
 	asMap := map[string]any{}
@@ -83798,6 +87310,47 @@ func (ec *executionContext) unmarshalInputFWSConfigInput(ctx context.Context, ob
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputFairShareWeightInput(ctx context.Context, obj any) (model.APIFairShareWeight, error) {
+	var it model.APIFairShareWeight
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "projects", "weight"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "projects":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projects"))
+			data, err := ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Projects = data
+		case "weight":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weight"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Weight = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputFinderSettingsInput(ctx context.Context, obj any) (model.APIFinderSettings, error) {
 	var it model.APIFinderSettings
 	asMap := map[string]any{}
@@ -86300,7 +89853,7 @@ func (ec *executionContext) unmarshalInputPlannerSettingsInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"commitQueueFactor", "expectedRuntimeFactor", "generateTaskFactor", "groupVersions", "mainlineTimeInQueueFactor", "mergeQueueTargetTime", "numDependentsFactor", "patchFactor", "patchTimeInQueueFactor", "targetTime", "version"}
+	fieldsInOrder := [...]string{"commitQueueFactor", "expectedRuntimeFactor", "fairShareWeights", "generateTaskFactor", "groupVersions", "mainlineTimeInQueueFactor", "mergeQueueTargetTime", "numDependentsFactor", "patchFactor", "patchTimeInQueueFactor", "targetTime", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -86321,6 +89874,13 @@ func (ec *executionContext) unmarshalInputPlannerSettingsInput(ctx context.Conte
 				return it, err
 			}
 			it.ExpectedRuntimeFactor = data
+		case "fairShareWeights":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fairShareWeights"))
+			data, err := ec.unmarshalOFairShareWeightInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFairShareWeightᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FairShareWeights = data
 		case "generateTaskFactor":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("generateTaskFactor"))
 			data, err := ec.unmarshalNInt2int64(ctx, v)
@@ -89816,7 +93376,7 @@ func (ec *executionContext) unmarshalInputSubscriberInput(ctx context.Context, o
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"target", "type", "webhookSubscriber", "jiraIssueSubscriber"}
+	fieldsInOrder := [...]string{"target", "type", "webhookSubscriber", "jiraIssueSubscriber", "teamsSubscriber", "chatWebhookSubscriber"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -89853,6 +93413,20 @@ func (ec *executionContext) unmarshalInputSubscriberInput(ctx context.Context, o
 				return it, err
 			}
 			it.JiraIssueSubscriber = data
+		case "teamsSubscriber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamsSubscriber"))
+			data, err := ec.unmarshalOTeamsSubscriberInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITeamsSubscriber(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TeamsSubscriber = data
+		case "chatWebhookSubscriber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chatWebhookSubscriber"))
+			data, err := ec.unmarshalOChatWebhookSubscriberInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChatWebhookSubscriber(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ChatWebhookSubscriber = data
 		}
 	}
 
@@ -90459,6 +94033,33 @@ func (ec *executionContext) unmarshalInputTaskSpecifierInput(ctx context.Context
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputTeamsSubscriberInput(ctx context.Context, obj any) (model.APITeamsSubscriber, error) {
+	var it model.APITeamsSubscriber
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"url"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputTestFilter(ctx context.Context, obj any) (TestFilter, error) {
 	var it TestFilter
 	asMap := map[string]any{}
@@ -90562,6 +94163,82 @@ func (ec *executionContext) unmarshalInputTestFilterOptions(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputTestReliabilityOptions(ctx context.Context, obj any) (TestReliabilityOptions, error) {
+	var it TestReliabilityOptions
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"afterDate", "beforeDate", "buildVariants", "limit", "requesters", "significance", "tasks", "tests"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "afterDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("afterDate"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AfterDate = data
+		case "beforeDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("beforeDate"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BeforeDate = data
+		case "buildVariants":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("buildVariants"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BuildVariants = data
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "requesters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requesters"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Requesters = data
+		case "significance":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("significance"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Significance = data
+		case "tasks":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tasks"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tasks = data
+		case "tests":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tests"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tests = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputTestSelectionConfigInput(ctx context.Context, obj any) (model.APITestSelectionConfig, error) {
 	var it model.APITestSelectionConfig
 	asMap := map[string]any{}
@@ -91890,13 +95567,20 @@ func (ec *executionContext) unmarshalInputWebhookSubscriberInput(ctx context.Con
 		asMap["timeoutMs"] = 0
 	}
 
-	fieldsInOrder := [...]string{"headers", "secret", "url", "retries", "minDelayMs", "timeoutMs"}
+	fieldsInOrder := [...]string{"format", "headers", "secret", "url", "retries", "minDelayMs", "timeoutMs"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
+		case "format":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Format = data
 		case "headers":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("headers"))
 			data, err := ec.unmarshalNWebhookHeaderInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIWebhookHeaderᚄ(ctx, v)
@@ -93282,6 +96966,8 @@ func (ec *executionContext) _BucketsConfig(ctx context.Context, sel ast.Selectio
 			out.Values[i] = ec._BucketsConfig_retryFailedLogMoveMaxJobsPerRun(ctx, field, obj)
 		case "testResultsBucket":
 			out.Values[i] = ec._BucketsConfig_testResultsBucket(ctx, field, obj)
+		case "taskFilesBucket":
+			out.Values[i] = ec._BucketsConfig_taskFilesBucket(ctx, field, obj)
 		case "internalBuckets":
 			out.Values[i] = ec._BucketsConfig_internalBuckets(ctx, field, obj)
 		case "credentials":
@@ -93548,6 +97234,55 @@ func (ec *executionContext) _CedarConfig(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
+var chatWebhookSubscriberImplementors = []string{"ChatWebhookSubscriber"}
+
+func (ec *executionContext) _ChatWebhookSubscriber(ctx context.Context, sel ast.SelectionSet, obj *model.APIChatWebhookSubscriber) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chatWebhookSubscriberImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChatWebhookSubscriber")
+		case "contentType":
+			out.Values[i] = ec._ChatWebhookSubscriber_contentType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "template":
+			out.Values[i] = ec._ChatWebhookSubscriber_template(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "url":
+			out.Values[i] = ec._ChatWebhookSubscriber_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var childPatchAliasImplementors = []string{"ChildPatchAlias"}
 
 func (ec *executionContext) _ChildPatchAlias(ctx context.Context, sel ast.SelectionSet, obj *model.APIChildPatchAlias) graphql.Marshaler {
@@ -94009,21 +97744,95 @@ func (ec *executionContext) _CostConfig(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var costDataImplementors = []string{"CostData"}
+var costDataImplementors = []string{"CostData"}
+
+func (ec *executionContext) _CostData(ctx context.Context, sel ast.SelectionSet, obj *model.APICostData) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, costDataImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CostData")
+		case "onDemandRate":
+			out.Values[i] = ec._CostData_onDemandRate(ctx, field, obj)
+		case "savingsPlanRate":
+			out.Values[i] = ec._CostData_savingsPlanRate(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var criticalPathTaskImplementors = []string{"CriticalPathTask"}
 
-func (ec *executionContext) _CostData(ctx context.Context, sel ast.SelectionSet, obj *model.APICostData) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, costDataImplementors)
+func (ec *executionContext) _CriticalPathTask(ctx context.Context, sel ast.SelectionSet, obj *model.APICriticalPathTask) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, criticalPathTaskImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CostData")
-		case "onDemandRate":
-			out.Values[i] = ec._CostData_onDemandRate(ctx, field, obj)
-		case "savingsPlanRate":
-			out.Values[i] = ec._CostData_savingsPlanRate(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CriticalPathTask")
+		case "buildVariant":
+			out.Values[i] = ec._CriticalPathTask_buildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dependencyWait":
+			out.Values[i] = ec._CriticalPathTask_dependencyWait(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "displayName":
+			out.Values[i] = ec._CriticalPathTask_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "duration":
+			out.Values[i] = ec._CriticalPathTask_duration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "durationEstimated":
+			out.Values[i] = ec._CriticalPathTask_durationEstimated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queueWait":
+			out.Values[i] = ec._CriticalPathTask_queueWait(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._CriticalPathTask_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskId":
+			out.Values[i] = ec._CriticalPathTask_taskId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -95024,163 +98833,286 @@ func (ec *executionContext) _ExternalLink(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var externalLinkForMetadataImplementors = []string{"ExternalLinkForMetadata"}
+var externalLinkForMetadataImplementors = []string{"ExternalLinkForMetadata"}
+
+func (ec *executionContext) _ExternalLinkForMetadata(ctx context.Context, sel ast.SelectionSet, obj *ExternalLinkForMetadata) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, externalLinkForMetadataImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExternalLinkForMetadata")
+		case "url":
+			out.Values[i] = ec._ExternalLinkForMetadata_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "displayName":
+			out.Values[i] = ec._ExternalLinkForMetadata_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fWSConfigImplementors = []string{"FWSConfig"}
+
+func (ec *executionContext) _FWSConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIFWSConfig) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fWSConfigImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FWSConfig")
+		case "url":
+			out.Values[i] = ec._FWSConfig_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var failingCommandImplementors = []string{"FailingCommand"}
+
+func (ec *executionContext) _FailingCommand(ctx context.Context, sel ast.SelectionSet, obj *model.APIFailingCommand) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, failingCommandImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FailingCommand")
+		case "fullDisplayName":
+			out.Values[i] = ec._FailingCommand_fullDisplayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "failureMetadataTags":
+			out.Values[i] = ec._FailingCommand_failureMetadataTags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fairShareWeightImplementors = []string{"FairShareWeight"}
+
+func (ec *executionContext) _FairShareWeight(ctx context.Context, sel ast.SelectionSet, obj *model.APIFairShareWeight) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fairShareWeightImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FairShareWeight")
+		case "name":
+			out.Values[i] = ec._FairShareWeight_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "projects":
+			out.Values[i] = ec._FairShareWeight_projects(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "weight":
+			out.Values[i] = ec._FairShareWeight_weight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fileImplementors = []string{"File"}
+
+func (ec *executionContext) _File(ctx context.Context, sel ast.SelectionSet, obj *model.APIFile) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fileImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("File")
+		case "link":
+			out.Values[i] = ec._File_link(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._File_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "urlParsley":
+			out.Values[i] = ec._File_urlParsley(ctx, field, obj)
+		case "visibility":
+			out.Values[i] = ec._File_visibility(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "associatedLinks":
+			out.Values[i] = ec._File_associatedLinks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fileCoverageDeltaImplementors = []string{"FileCoverageDelta"}
 
-func (ec *executionContext) _ExternalLinkForMetadata(ctx context.Context, sel ast.SelectionSet, obj *ExternalLinkForMetadata) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, externalLinkForMetadataImplementors)
+func (ec *executionContext) _FileCoverageDelta(ctx context.Context, sel ast.SelectionSet, obj *model.APIFileCoverageDelta) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fileCoverageDeltaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ExternalLinkForMetadata")
-		case "url":
-			out.Values[i] = ec._ExternalLinkForMetadata_url(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("FileCoverageDelta")
+		case "name":
+			out.Values[i] = ec._FileCoverageDelta_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "displayName":
-			out.Values[i] = ec._ExternalLinkForMetadata_displayName(ctx, field, obj)
+		case "basePercent":
+			out.Values[i] = ec._FileCoverageDelta_basePercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var fWSConfigImplementors = []string{"FWSConfig"}
-
-func (ec *executionContext) _FWSConfig(ctx context.Context, sel ast.SelectionSet, obj *model.APIFWSConfig) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, fWSConfigImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FWSConfig")
-		case "url":
-			out.Values[i] = ec._FWSConfig_url(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var failingCommandImplementors = []string{"FailingCommand"}
-
-func (ec *executionContext) _FailingCommand(ctx context.Context, sel ast.SelectionSet, obj *model.APIFailingCommand) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, failingCommandImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FailingCommand")
-		case "fullDisplayName":
-			out.Values[i] = ec._FailingCommand_fullDisplayName(ctx, field, obj)
+		case "baseCoveredLines":
+			out.Values[i] = ec._FileCoverageDelta_baseCoveredLines(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "failureMetadataTags":
-			out.Values[i] = ec._FailingCommand_failureMetadataTags(ctx, field, obj)
+		case "baseTotalLines":
+			out.Values[i] = ec._FileCoverageDelta_baseTotalLines(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var fileImplementors = []string{"File"}
-
-func (ec *executionContext) _File(ctx context.Context, sel ast.SelectionSet, obj *model.APIFile) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, fileImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("File")
-		case "link":
-			out.Values[i] = ec._File_link(ctx, field, obj)
+		case "coveredLines":
+			out.Values[i] = ec._FileCoverageDelta_coveredLines(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._File_name(ctx, field, obj)
+		case "delta":
+			out.Values[i] = ec._FileCoverageDelta_delta(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "urlParsley":
-			out.Values[i] = ec._File_urlParsley(ctx, field, obj)
-		case "visibility":
-			out.Values[i] = ec._File_visibility(ctx, field, obj)
+		case "percent":
+			out.Values[i] = ec._FileCoverageDelta_percent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "associatedLinks":
-			out.Values[i] = ec._File_associatedLinks(ctx, field, obj)
+		case "totalLines":
+			out.Values[i] = ec._FileCoverageDelta_totalLines(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -101309,6 +105241,11 @@ func (ec *executionContext) _PlannerSettings(ctx context.Context, sel ast.Select
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "fairShareWeights":
+			out.Values[i] = ec._PlannerSettings_fairShareWeights(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "generateTaskFactor":
 			out.Values[i] = ec._PlannerSettings_generateTaskFactor(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -101679,6 +105616,42 @@ func (ec *executionContext) _Project(ctx context.Context, sel ast.SelectionSet,
 			}
 		case "testSelection":
 			out.Values[i] = ec._Project_testSelection(ctx, field, obj)
+		case "testReliability":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Project_testReliability(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "triggers":
 			out.Values[i] = ec._Project_triggers(ctx, field, obj)
 		case "versionControlEnabled":
@@ -101688,6 +105661,42 @@ func (ec *executionContext) _Project(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "worstTests":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Project_worstTests(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -104653,39 +108662,112 @@ func (ec *executionContext) _RepotrackerError(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var resourceLimitsImplementors = []string{"ResourceLimits"}
+var resourceLimitsImplementors = []string{"ResourceLimits"}
+
+func (ec *executionContext) _ResourceLimits(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceLimits) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceLimitsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ResourceLimits")
+		case "lockedMemoryKb":
+			out.Values[i] = ec._ResourceLimits_lockedMemoryKb(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numFiles":
+			out.Values[i] = ec._ResourceLimits_numFiles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numProcesses":
+			out.Values[i] = ec._ResourceLimits_numProcesses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numTasks":
+			out.Values[i] = ec._ResourceLimits_numTasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "virtualMemoryKb":
+			out.Values[i] = ec._ResourceLimits_virtualMemoryKb(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var resourceUsageSampleImplementors = []string{"ResourceUsageSample"}
 
-func (ec *executionContext) _ResourceLimits(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceLimits) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, resourceLimitsImplementors)
+func (ec *executionContext) _ResourceUsageSample(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceUsageSample) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceUsageSampleImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ResourceLimits")
-		case "lockedMemoryKb":
-			out.Values[i] = ec._ResourceLimits_lockedMemoryKb(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ResourceUsageSample")
+		case "command":
+			out.Values[i] = ec._ResourceUsageSample_command(ctx, field, obj)
+		case "cpuPercent":
+			out.Values[i] = ec._ResourceUsageSample_cpuPercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numFiles":
-			out.Values[i] = ec._ResourceLimits_numFiles(ctx, field, obj)
+		case "diskReadBytes":
+			out.Values[i] = ec._ResourceUsageSample_diskReadBytes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numProcesses":
-			out.Values[i] = ec._ResourceLimits_numProcesses(ctx, field, obj)
+		case "diskWriteBytes":
+			out.Values[i] = ec._ResourceUsageSample_diskWriteBytes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numTasks":
-			out.Values[i] = ec._ResourceLimits_numTasks(ctx, field, obj)
+		case "memoryPercent":
+			out.Values[i] = ec._ResourceUsageSample_memoryPercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "virtualMemoryKb":
-			out.Values[i] = ec._ResourceLimits_virtualMemoryKb(ctx, field, obj)
+		case "memoryUsedBytes":
+			out.Values[i] = ec._ResourceUsageSample_memoryUsedBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numProcesses":
+			out.Values[i] = ec._ResourceUsageSample_numProcesses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "time":
+			out.Values[i] = ec._ResourceUsageSample_time(ctx, field, obj)
+		case "workDirDiskUsedBytes":
+			out.Values[i] = ec._ResourceUsageSample_workDirDiskUsedBytes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -106094,6 +110176,8 @@ func (ec *executionContext) _Subscriber(ctx context.Context, sel ast.SelectionSe
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Subscriber")
+		case "chatWebhookSubscriber":
+			out.Values[i] = ec._Subscriber_chatWebhookSubscriber(ctx, field, obj)
 		case "emailSubscriber":
 			out.Values[i] = ec._Subscriber_emailSubscriber(ctx, field, obj)
 		case "githubCheckSubscriber":
@@ -106106,6 +110190,8 @@ func (ec *executionContext) _Subscriber(ctx context.Context, sel ast.SelectionSe
 			out.Values[i] = ec._Subscriber_jiraIssueSubscriber(ctx, field, obj)
 		case "slackSubscriber":
 			out.Values[i] = ec._Subscriber_slackSubscriber(ctx, field, obj)
+		case "teamsSubscriber":
+			out.Values[i] = ec._Subscriber_teamsSubscriber(ctx, field, obj)
 		case "webhookSubscriber":
 			out.Values[i] = ec._Subscriber_webhookSubscriber(ctx, field, obj)
 		default:
@@ -107761,6 +111847,42 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "resourceUsage":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Task_resourceUsage(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "revision":
 			out.Values[i] = ec._Task_revision(ctx, field, obj)
 		case "scheduledTime":
@@ -108133,6 +112255,94 @@ func (ec *executionContext) _TaskAnnotationSettings(ctx context.Context, sel ast
 	return out
 }
 
+var taskCoverageDeltaImplementors = []string{"TaskCoverageDelta"}
+
+func (ec *executionContext) _TaskCoverageDelta(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskCoverageDelta) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskCoverageDeltaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TaskCoverageDelta")
+		case "baseTaskId":
+			out.Values[i] = ec._TaskCoverageDelta_baseTaskId(ctx, field, obj)
+		case "basePercent":
+			out.Values[i] = ec._TaskCoverageDelta_basePercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "baseCoveredLines":
+			out.Values[i] = ec._TaskCoverageDelta_baseCoveredLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "baseTotalLines":
+			out.Values[i] = ec._TaskCoverageDelta_baseTotalLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "buildVariant":
+			out.Values[i] = ec._TaskCoverageDelta_buildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "coveredLines":
+			out.Values[i] = ec._TaskCoverageDelta_coveredLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delta":
+			out.Values[i] = ec._TaskCoverageDelta_delta(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "files":
+			out.Values[i] = ec._TaskCoverageDelta_files(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "percent":
+			out.Values[i] = ec._TaskCoverageDelta_percent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskId":
+			out.Values[i] = ec._TaskCoverageDelta_taskId(ctx, field, obj)
+		case "taskName":
+			out.Values[i] = ec._TaskCoverageDelta_taskName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalLines":
+			out.Values[i] = ec._TaskCoverageDelta_totalLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var taskEndDetailImplementors = []string{"TaskEndDetail"}
 
 func (ec *executionContext) _TaskEndDetail(ctx context.Context, sel ast.SelectionSet, obj *model.ApiTaskEndDetail) graphql.Marshaler {
@@ -109420,6 +113630,45 @@ func (ec *executionContext) _TaskTestResultSample(ctx context.Context, sel ast.S
 	return out
 }
 
+var teamsSubscriberImplementors = []string{"TeamsSubscriber"}
+
+func (ec *executionContext) _TeamsSubscriber(ctx context.Context, sel ast.SelectionSet, obj *model.APITeamsSubscriber) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamsSubscriberImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TeamsSubscriber")
+		case "url":
+			out.Values[i] = ec._TeamsSubscriber_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var testLogImplementors = []string{"TestLog"}
 
 func (ec *executionContext) _TestLog(ctx context.Context, sel ast.SelectionSet, obj *model.TestLogs) graphql.Marshaler {
@@ -109514,6 +113763,95 @@ func (ec *executionContext) _TestQuarantineEntry(ctx context.Context, sel ast.Se
 	return out
 }
 
+var testReliabilityImplementors = []string{"TestReliability"}
+
+func (ec *executionContext) _TestReliability(ctx context.Context, sel ast.SelectionSet, obj *model.APITestReliability) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, testReliabilityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TestReliability")
+		case "buildVariant":
+			out.Values[i] = ec._TestReliability_buildVariant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "date":
+			out.Values[i] = ec._TestReliability_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maxSuccessRate":
+			out.Values[i] = ec._TestReliability_maxSuccessRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numFail":
+			out.Values[i] = ec._TestReliability_numFail(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numPass":
+			out.Values[i] = ec._TestReliability_numPass(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numTotal":
+			out.Values[i] = ec._TestReliability_numTotal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "p50DurationPass":
+			out.Values[i] = ec._TestReliability_p50DurationPass(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "p95DurationPass":
+			out.Values[i] = ec._TestReliability_p95DurationPass(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "successRate":
+			out.Values[i] = ec._TestReliability_successRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskName":
+			out.Values[i] = ec._TestReliability_taskName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testName":
+			out.Values[i] = ec._TestReliability_testName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var testResultImplementors = []string{"TestResult"}
 
 func (ec *executionContext) _TestResult(ctx context.Context, sel ast.SelectionSet, obj *model.APITest) graphql.Marshaler {
@@ -110842,12 +115180,78 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "branch":
-			out.Values[i] = ec._Version_branch(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+		case "branch":
+			out.Values[i] = ec._Version_branch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "buildVariants":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Version_buildVariants(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "buildVariantStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Version_buildVariantStats(ctx, field, obj)
+				return res
 			}
-		case "buildVariants":
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "childVersions":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -110856,7 +115260,7 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Version_buildVariants(ctx, field, obj)
+				res = ec._Version_childVersions(ctx, field, obj)
 				return res
 			}
 
@@ -110880,7 +115284,7 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "buildVariantStats":
+		case "cost":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -110889,7 +115293,7 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Version_buildVariantStats(ctx, field, obj)
+				res = ec._Version_cost(ctx, field, obj)
 				return res
 			}
 
@@ -110913,7 +115317,7 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "childVersions":
+		case "coverage":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -110922,7 +115326,7 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Version_childVersions(ctx, field, obj)
+				res = ec._Version_coverage(ctx, field, obj)
 				return res
 			}
 
@@ -110946,7 +115350,7 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "cost":
+		case "criticalPath":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -110955,7 +115359,7 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Version_cost(ctx, field, obj)
+				res = ec._Version_criticalPath(ctx, field, obj)
 				return res
 			}
 
@@ -111673,6 +116077,156 @@ func (ec *executionContext) _Version(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var versionCoverageImplementors = []string{"VersionCoverage"}
+
+func (ec *executionContext) _VersionCoverage(ctx context.Context, sel ast.SelectionSet, obj *model.APIVersionCoverage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, versionCoverageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VersionCoverage")
+		case "versionId":
+			out.Values[i] = ec._VersionCoverage_versionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "baseVersionId":
+			out.Values[i] = ec._VersionCoverage_baseVersionId(ctx, field, obj)
+		case "baseOnlyTasks":
+			out.Values[i] = ec._VersionCoverage_baseOnlyTasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "basePercent":
+			out.Values[i] = ec._VersionCoverage_basePercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "baseCoveredLines":
+			out.Values[i] = ec._VersionCoverage_baseCoveredLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "baseTotalLines":
+			out.Values[i] = ec._VersionCoverage_baseTotalLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "coveredLines":
+			out.Values[i] = ec._VersionCoverage_coveredLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delta":
+			out.Values[i] = ec._VersionCoverage_delta(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "percent":
+			out.Values[i] = ec._VersionCoverage_percent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tasks":
+			out.Values[i] = ec._VersionCoverage_tasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalLines":
+			out.Values[i] = ec._VersionCoverage_totalLines(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var versionCriticalPathImplementors = []string{"VersionCriticalPath"}
+
+func (ec *executionContext) _VersionCriticalPath(ctx context.Context, sel ast.SelectionSet, obj *model.APIVersionCriticalPath) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, versionCriticalPathImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VersionCriticalPath")
+		case "versionId":
+			out.Values[i] = ec._VersionCriticalPath_versionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "makespan":
+			out.Values[i] = ec._VersionCriticalPath_makespan(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "minimumMakespan":
+			out.Values[i] = ec._VersionCriticalPath_minimumMakespan(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tasks":
+			out.Values[i] = ec._VersionCriticalPath_tasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalDependencyWait":
+			out.Values[i] = ec._VersionCriticalPath_totalDependencyWait(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalQueueWait":
+			out.Values[i] = ec._VersionCriticalPath_totalQueueWait(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var versionLiteImplementors = []string{"VersionLite"}
 
 func (ec *executionContext) _VersionLite(ctx context.Context, sel ast.SelectionSet, obj *model1.Version) graphql.Marshaler {
@@ -112577,6 +117131,8 @@ func (ec *executionContext) _WebhookSubscriber(ctx context.Context, sel ast.Sele
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("WebhookSubscriber")
+		case "format":
+			out.Values[i] = ec._WebhookSubscriber_format(ctx, field, obj)
 		case "headers":
 			out.Values[i] = ec._WebhookSubscriber_headers(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -113806,6 +118362,54 @@ func (ec *executionContext) unmarshalNCreateProjectInput2githubᚗcomᚋevergree
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNCriticalPathTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPathTask(ctx context.Context, sel ast.SelectionSet, v model.APICriticalPathTask) graphql.Marshaler {
+	return ec._CriticalPathTask(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCriticalPathTask2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPathTaskᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APICriticalPathTask) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCriticalPathTask2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPICriticalPathTask(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNCursorParams2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐCursorParams(ctx context.Context, v any) (*CursorParams, error) {
 	res, err := ec.unmarshalInputCursorParams(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
@@ -114267,7 +118871,70 @@ func (ec *executionContext) marshalNExternalLinkForMetadata2ᚕᚖgithubᚗcom
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNExternalLinkForMetadata2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐExternalLinkForMetadata(ctx, sel, v[i])
+			ret[i] = ec.marshalNExternalLinkForMetadata2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐExternalLinkForMetadata(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNExternalLinkForMetadata2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐExternalLinkForMetadata(ctx context.Context, sel ast.SelectionSet, v *ExternalLinkForMetadata) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ExternalLinkForMetadata(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNExternalLinkInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIExternalLink(ctx context.Context, v any) (model.APIExternalLink, error) {
+	res, err := ec.unmarshalInputExternalLinkInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFailingCommand2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFailingCommand(ctx context.Context, sel ast.SelectionSet, v model.APIFailingCommand) graphql.Marshaler {
+	return ec._FailingCommand(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFailingCommand2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFailingCommandᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIFailingCommand) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFailingCommand2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFailingCommand(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -114287,26 +118954,11 @@ func (ec *executionContext) marshalNExternalLinkForMetadata2ᚕᚖgithubᚗcom
 	return ret
 }
 
-func (ec *executionContext) marshalNExternalLinkForMetadata2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐExternalLinkForMetadata(ctx context.Context, sel ast.SelectionSet, v *ExternalLinkForMetadata) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._ExternalLinkForMetadata(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNExternalLinkInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIExternalLink(ctx context.Context, v any) (model.APIExternalLink, error) {
-	res, err := ec.unmarshalInputExternalLinkInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNFairShareWeight2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFairShareWeight(ctx context.Context, sel ast.SelectionSet, v model.APIFairShareWeight) graphql.Marshaler {
+	return ec._FairShareWeight(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNFailingCommand2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFailingCommand(ctx context.Context, sel ast.SelectionSet, v model.APIFailingCommand) graphql.Marshaler {
-	return ec._FailingCommand(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNFailingCommand2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFailingCommandᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIFailingCommand) graphql.Marshaler {
+func (ec *executionContext) marshalNFairShareWeight2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFairShareWeightᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIFairShareWeight) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -114330,7 +118982,7 @@ func (ec *executionContext) marshalNFailingCommand2ᚕgithubᚗcomᚋevergreen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFailingCommand2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFailingCommand(ctx, sel, v[i])
+			ret[i] = ec.marshalNFairShareWeight2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFairShareWeight(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -114350,6 +119002,11 @@ func (ec *executionContext) marshalNFailingCommand2ᚕgithubᚗcomᚋevergreen
 	return ret
 }
 
+func (ec *executionContext) unmarshalNFairShareWeightInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFairShareWeight(ctx context.Context, v any) (model.APIFairShareWeight, error) {
+	res, err := ec.unmarshalInputFairShareWeightInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNFeedbackRule2ᚖstring(ctx context.Context, v any) (*string, error) {
 	tmp, err := graphql.UnmarshalString(v)
 	res := unmarshalNFeedbackRule2ᚖstring[tmp]
@@ -114396,6 +119053,54 @@ func (ec *executionContext) marshalNFile2ᚖgithubᚗcomᚋevergreenᚑciᚋever
 	return ec._File(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNFileCoverageDelta2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFileCoverageDelta(ctx context.Context, sel ast.SelectionSet, v model.APIFileCoverageDelta) graphql.Marshaler {
+	return ec._FileCoverageDelta(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFileCoverageDelta2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFileCoverageDeltaᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIFileCoverageDelta) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFileCoverageDelta2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFileCoverageDelta(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNFileDiff2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐFileDiff(ctx context.Context, sel ast.SelectionSet, v model.FileDiff) graphql.Marshaler {
 	return ec._FileDiff(ctx, sel, &v)
 }
@@ -117410,6 +122115,60 @@ func (ec *executionContext) unmarshalNResourceLimitsInput2githubᚗcomᚋevergre
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNResourceUsageSample2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSampleᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APIResourceUsageSample) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNResourceUsageSample2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSample(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNResourceUsageSample2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSample(ctx context.Context, sel ast.SelectionSet, v *model.APIResourceUsageSample) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ResourceUsageSample(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNRestartAdminTasksOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐRestartOptions(ctx context.Context, v any) (model1.RestartOptions, error) {
 	res, err := ec.unmarshalInputRestartAdminTasksOptions(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -118042,6 +122801,54 @@ func (ec *executionContext) marshalNTaskAnnotationSettings2githubᚗcomᚋevergr
 	return ec._TaskAnnotationSettings(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalNTaskCoverageDelta2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCoverageDelta(ctx context.Context, sel ast.SelectionSet, v model.APITaskCoverageDelta) graphql.Marshaler {
+	return ec._TaskCoverageDelta(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTaskCoverageDelta2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCoverageDeltaᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APITaskCoverageDelta) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTaskCoverageDelta2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskCoverageDelta(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNTaskEventLogData2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐTaskEventData(ctx context.Context, sel ast.SelectionSet, v *model.TaskEventData) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -118508,6 +123315,65 @@ func (ec *executionContext) marshalNTestQuarantineEntry2ᚕgithubᚗcomᚋevergr
 	return ret
 }
 
+func (ec *executionContext) marshalNTestReliability2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestReliabilityᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APITestReliability) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTestReliability2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestReliability(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTestReliability2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestReliability(ctx context.Context, sel ast.SelectionSet, v *model.APITestReliability) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TestReliability(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNTestReliabilityOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTestReliabilityOptions(ctx context.Context, v any) (TestReliabilityOptions, error) {
+	res, err := ec.unmarshalInputTestReliabilityOptions(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNTestResult2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITest(ctx context.Context, sel ast.SelectionSet, v model.APITest) graphql.Marshaler {
 	return ec._TestResult(ctx, sel, &v)
 }
@@ -119772,6 +124638,21 @@ func (ec *executionContext) unmarshalOCedarConfigInput2ᚖgithubᚗcomᚋevergre
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOChatWebhookSubscriber2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChatWebhookSubscriber(ctx context.Context, sel ast.SelectionSet, v *model.APIChatWebhookSubscriber) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ChatWebhookSubscriber(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOChatWebhookSubscriberInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChatWebhookSubscriber(ctx context.Context, v any) (*model.APIChatWebhookSubscriber, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputChatWebhookSubscriberInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOChildPatchAlias2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIChildPatchAliasᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIChildPatchAlias) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -120194,6 +125075,24 @@ func (ec *executionContext) unmarshalOFWSConfigInput2ᚖgithubᚗcomᚋevergreen
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOFairShareWeightInput2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFairShareWeightᚄ(ctx context.Context, v any) ([]model.APIFairShareWeight, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.APIFairShareWeight, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNFairShareWeightInput2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIFairShareWeight(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOFeedbackRule2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -123126,6 +128025,21 @@ func (ec *executionContext) marshalOTaskTestResultSample2ᚕᚖgithubᚗcomᚋev
 	return ret
 }
 
+func (ec *executionContext) marshalOTeamsSubscriber2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITeamsSubscriber(ctx context.Context, sel ast.SelectionSet, v *model.APITeamsSubscriber) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TeamsSubscriber(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOTeamsSubscriberInput2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITeamsSubscriber(ctx context.Context, v any) (*model.APITeamsSubscriber, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputTeamsSubscriberInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOTestFilterOptions2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐTestFilterOptions(ctx context.Context, v any) (*TestFilterOptions, error) {
 	if v == nil {
 		return nil, nil
@@ -123421,6 +128335,20 @@ func (ec *executionContext) marshalOVersion2ᚖgithubᚗcomᚋevergreenᚑciᚋe
 	return ec._Version(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOVersionCoverage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersionCoverage(ctx context.Context, sel ast.SelectionSet, v *model.APIVersionCoverage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._VersionCoverage(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOVersionCriticalPath2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIVersionCriticalPath(ctx context.Context, sel ast.SelectionSet, v *model.APIVersionCriticalPath) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._VersionCriticalPath(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOVersionLite2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚐVersionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.Version) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -553,12 +553,14 @@ type SpawnVolumeInput struct {
 }
 
 type Subscriber struct {
+	ChatWebhookSubscriber *model.APIChatWebhookSubscriber `json:"chatWebhookSubscriber,omitempty"`
 	EmailSubscriber       *string                         `json:"emailSubscriber,omitempty"`
 	GithubCheckSubscriber *model.APIGithubCheckSubscriber `json:"githubCheckSubscriber,omitempty"`
 	GithubPRSubscriber    *model.APIGithubPRSubscriber    `json:"githubPRSubscriber,omitempty"`
 	JiraCommentSubscriber *string                         `json:"jiraCommentSubscriber,omitempty"`
 	JiraIssueSubscriber   *model.APIJIRAIssueSubscriber   `json:"jiraIssueSubscriber,omitempty"`
 	SlackSubscriber       *string                         `json:"slackSubscriber,omitempty"`
+	TeamsSubscriber       *model.APITeamsSubscriber       `json:"teamsSubscriber,omitempty"`
 	WebhookSubscriber     *model.APIWebhookSubscriber     `json:"webhookSubscriber,omitempty"`
 }
 
@@ -676,6 +678,19 @@ type TestFilterOptions struct {
 	Page                *int               `json:"page,omitempty"`
 }
 
+// TestReliabilityOptions is the input to the testReliability and worstTests fields of a project.
+// Dates are rounded down to the UTC day. The range defaults to the 7 days up to and including today.
+type TestReliabilityOptions struct {
+	AfterDate     *time.Time `json:"afterDate,omitempty"`
+	BeforeDate    *time.Time `json:"beforeDate,omitempty"`
+	BuildVariants []string   `json:"buildVariants,omitempty"`
+	Limit         *int       `json:"limit,omitempty"`
+	Requesters    []string   `json:"requesters,omitempty"`
+	Significance  *float64   `json:"significance,omitempty"`
+	Tasks         []string   `json:"tasks,omitempty"`
+	Tests         []string   `json:"tests,omitempty"`
+}
+
 // TestSortOptions is an input for the task.Tests query.
 // It's used to define sort criteria for test results of a task.
 type TestSortOptions struct {
@@ -295,6 +295,11 @@ func (r *mutationResolver) SaveDistro(ctx context.Context, opts SaveDistroInput)
 	if oldDistro == nil {
 		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("distro '%s' not found", d.Id))
 	}
+	// Fair-share weights are optional in the input, so keep the existing
+	// weights if they weren't sent rather than clearing them.
+	if opts.Distro.PlannerSettings.FairShareWeights == nil {
+		d.PlannerSettings.FairShareWeights = oldDistro.PlannerSettings.FairShareWeights
+	}
 
 	settings, err := evergreen.GetConfig(ctx)
 	if err != nil {
@@ -2,9 +2,11 @@ package graphql
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/parsley"
+	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/utility"
 )
@@ -29,6 +31,34 @@ func (r *projectResolver) Patches(ctx context.Context, obj *restModel.APIProject
 	return &Patches{}, nil
 }
 
+// TestReliability is the resolver for the testReliability field.
+func (r *projectResolver) TestReliability(ctx context.Context, obj *restModel.APIProjectRef, options TestReliabilityOptions) ([]*restModel.APITestReliability, error) {
+	projectID := utility.FromStringPtr(obj.Id)
+	filter, err := buildTestReliabilityFilter(projectID, options, testReliabilityDefaultLimit)
+	if err != nil {
+		return nil, InputValidationError.Send(ctx, err.Error())
+	}
+	scores, err := data.GetDailyTestReliabilityScores(ctx, filter)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting test reliability for project '%s': %s", projectID, err.Error()))
+	}
+	return toAPITestReliabilityPtrs(scores), nil
+}
+
+// WorstTests is the resolver for the worstTests field.
+func (r *projectResolver) WorstTests(ctx context.Context, obj *restModel.APIProjectRef, options TestReliabilityOptions) ([]*restModel.APITestReliability, error) {
+	projectID := utility.FromStringPtr(obj.Id)
+	filter, err := buildTestReliabilityFilter(projectID, options, worstTestsDefaultLimit)
+	if err != nil {
+		return nil, InputValidationError.Send(ctx, err.Error())
+	}
+	scores, err := data.GetWorstTestReliabilityScores(ctx, filter)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting worst tests for project '%s': %s", projectID, err.Error()))
+	}
+	return toAPITestReliabilityPtrs(scores), nil
+}
+
 // IsFavorite is the resolver for the isFavorite field.
 func (r *projectLiteResolver) IsFavorite(ctx context.Context, obj *model.ProjectRef) (bool, error) {
 	usr := mustHaveUser(ctx)
@@ -14,6 +14,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/cost"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
@@ -739,6 +740,26 @@ func (r *taskResolver) Project(ctx context.Context, obj *restModel.APITask) (*re
 	return &apiProjectRef, nil
 }
 
+// ResourceUsage is the resolver for the resourceUsage field.
+func (r *taskResolver) ResourceUsage(ctx context.Context, obj *restModel.APITask) ([]*restModel.APIResourceUsageSample, error) {
+	taskID := utility.FromStringPtr(obj.Id)
+	usage, err := resourceusage.FindOne(ctx, resourceusage.ByTaskIDAndExecution(taskID, obj.Execution))
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding resource usage for task '%s' with execution %d: %s", taskID, obj.Execution, err.Error()))
+	}
+	if usage == nil {
+		return []*restModel.APIResourceUsageSample{}, nil
+	}
+
+	samples := make([]*restModel.APIResourceUsageSample, 0, len(usage.Samples))
+	for _, s := range usage.Samples {
+		sample := &restModel.APIResourceUsageSample{}
+		sample.BuildFromService(s)
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
 // SpawnHostLink is the resolver for the spawnHostLink field.
 func (r *taskResolver) SpawnHostLink(ctx context.Context, obj *restModel.APITask) (*string, error) {
 	hostID := utility.FromStringPtr(obj.HostId)
@@ -26,8 +26,11 @@ import (
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/parsley"
 	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/reliability"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/taskstats"
 	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/model/teststats"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
@@ -1742,3 +1745,60 @@ func buildQuarantineMutationResponse(ctx context.Context, t *task.Task, testName
 	}
 	return apiTest, nil
 }
+
+const (
+	testReliabilityDefaultLimit = 1000
+	worstTestsDefaultLimit      = 100
+	testReliabilityDefaultDays  = 7
+)
+
+// buildTestReliabilityFilter converts the GraphQL test reliability options
+// into a filter for the given project. The dates are rounded down to the UTC
+// day and the range defaults to the last week, including today.
+func buildTestReliabilityFilter(projectID string, opts TestReliabilityOptions, defaultLimit int) (reliability.TestReliabilityFilter, error) {
+	beforeDate := utility.GetUTCDay(time.Now()).Add(24 * time.Hour)
+	if opts.BeforeDate != nil {
+		beforeDate = utility.GetUTCDay(*opts.BeforeDate)
+	}
+	afterDate := beforeDate.Add(-testReliabilityDefaultDays * 24 * time.Hour)
+	if opts.AfterDate != nil {
+		afterDate = utility.GetUTCDay(*opts.AfterDate)
+	}
+	requesters := opts.Requesters
+	if len(requesters) == 0 {
+		requesters = []string{evergreen.RepotrackerVersionRequester}
+	}
+
+	filter := reliability.TestReliabilityFilter{
+		StatsFilter: teststats.StatsFilter{
+			Project:       projectID,
+			Requesters:    requesters,
+			AfterDate:     afterDate,
+			BeforeDate:    beforeDate,
+			Tests:         opts.Tests,
+			Tasks:         opts.Tasks,
+			BuildVariants: opts.BuildVariants,
+			Limit:         utility.FromIntPtr(opts.Limit),
+			Sort:          taskstats.SortEarliestFirst,
+		},
+		Significance: reliability.DefaultSignificance,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+	if opts.Significance != nil {
+		filter.Significance = *opts.Significance
+	}
+	if err := filter.ValidateForTestReliability(); err != nil {
+		return filter, errors.Wrap(err, "invalid test reliability options")
+	}
+	return filter, nil
+}
+
+func toAPITestReliabilityPtrs(scores []restModel.APITestReliability) []*restModel.APITestReliability {
+	ptrs := make([]*restModel.APITestReliability, 0, len(scores))
+	for i := range scores {
+		ptrs = append(ptrs, &scores[i])
+	}
+	return ptrs
+}
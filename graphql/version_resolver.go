@@ -144,6 +144,33 @@ func (r *versionResolver) Cost(ctx context.Context, obj *restModel.APIVersion) (
 	return &rounded, nil
 }
 
+// Coverage is the resolver for the coverage field.
+func (r *versionResolver) Coverage(ctx context.Context, obj *restModel.APIVersion) (*restModel.APIVersionCoverage, error) {
+	versionID := utility.FromStringPtr(obj.Id)
+	delta, err := data.GetVersionCoverageDelta(ctx, versionID)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("getting coverage for version '%s': %s", versionID, err.Error()))
+	}
+	if len(delta.Tasks) == 0 {
+		return nil, nil
+	}
+	apiCoverage := &restModel.APIVersionCoverage{}
+	apiCoverage.BuildFromService(*delta)
+	return apiCoverage, nil
+}
+
+// CriticalPath is the resolver for the criticalPath field.
+func (r *versionResolver) CriticalPath(ctx context.Context, obj *restModel.APIVersion) (*restModel.APIVersionCriticalPath, error) {
+	versionID := utility.FromStringPtr(obj.Id)
+	path, err := task.GetVersionCriticalPath(ctx, versionID)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("computing critical path for version '%s': %s", versionID, err.Error()))
+	}
+	apiPath := &restModel.APIVersionCriticalPath{}
+	apiPath.BuildFromService(versionID, *path)
+	return apiPath, nil
+}
+
 // ExternalLinksForMetadata is the resolver for the externalLinksForMetadata field.
 func (r *versionResolver) ExternalLinksForMetadata(ctx context.Context, obj *restModel.APIVersion) ([]*ExternalLinkForMetadata, error) {
 	projectID := utility.FromStringPtr(obj.Project)
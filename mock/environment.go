@@ -348,6 +348,12 @@ func (e *Environment) SetSender(key evergreen.SenderKey, s send.Sender) error {
 	return nil
 }
 
+func (e *Environment) GetRootSender(key evergreen.SenderKey) (send.Sender, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.InternalSender, nil
+}
+
 func (e *Environment) RegisterCloser(name string, background bool, closer func(context.Context) error) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
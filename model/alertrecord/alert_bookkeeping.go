@@ -25,6 +25,8 @@ const (
 	TaskFailTransitionId     = "task_transition_failure"
 	FirstRegressionInVersion = "first_regression_in_version"
 	taskRegressionByTest     = "task-regression-by-test"
+
+	taskQueueLatencyTemplate = "task_queue_latency_%dmin"
 )
 
 // Host triggers
@@ -213,6 +215,17 @@ func FindByMostRecentAlertableInstanceType(ctx context.Context, hostID string) (
 	return FindOne(ctx, db.Query(q).Sort([]string{"-" + AlertTimeKey}).Limit(1))
 }
 
+// FindByTaskQueueLatency finds the most recent alert record for the task
+// waiting in the queue for longer than the threshold that was sent at or
+// after the given time.
+func FindByTaskQueueLatency(ctx context.Context, taskID string, thresholdMins int, since time.Time) (*AlertRecord, error) {
+	q := subscriptionIDQuery(legacyAlertsSubscription)
+	q[TypeKey] = fmt.Sprintf(taskQueueLatencyTemplate, thresholdMins)
+	q[TaskIdKey] = taskID
+	q[AlertTimeKey] = bson.M{"$gte": since}
+	return FindOne(ctx, db.Query(q).Sort([]string{"-" + AlertTimeKey}).Limit(1))
+}
+
 func InsertNewTaskRegressionByTestRecord(ctx context.Context, subscriptionID, taskID, testName, taskDisplayName, variant, projectID string, revision int) error {
 	record := AlertRecord{
 		Id:                  mgobson.NewObjectId(),
@@ -283,3 +296,20 @@ func InsertNewAlertableInstanceTypeRecord(ctx context.Context, hostID string) er
 
 	return errors.Wrapf(record.Insert(ctx), "inserting alert record '%s'", alertableInstanceTypeWarning)
 }
+
+// InsertNewTaskQueueLatencyRecord inserts a new alert record for a task that
+// has waited in the queue for longer than the threshold.
+func InsertNewTaskQueueLatencyRecord(ctx context.Context, taskID, projectID, versionID string, thresholdMins int) error {
+	alertType := fmt.Sprintf(taskQueueLatencyTemplate, thresholdMins)
+	record := AlertRecord{
+		Id:             mgobson.NewObjectId(),
+		SubscriptionID: legacyAlertsSubscription,
+		Type:           alertType,
+		TaskId:         taskID,
+		ProjectId:      projectID,
+		VersionId:      versionID,
+		AlertTime:      time.Now(),
+	}
+
+	return errors.Wrapf(record.Insert(ctx), "inserting alert record '%s'", alertType)
+}
@@ -0,0 +1,265 @@
+package coverage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const Collection = "task_coverage"
+
+// Coverage report formats that can be parsed by the coverage.parse command.
+const (
+	FormatLCOV      = "lcov"
+	FormatCobertura = "cobertura"
+	FormatGoCover   = "gocover"
+)
+
+// ValidFormats are all the coverage report formats that can be parsed.
+var ValidFormats = []string{FormatLCOV, FormatCobertura, FormatGoCover}
+
+// FileCoverage is the line coverage of a single source file.
+type FileCoverage struct {
+	// Name is the path of the source file as it appears in the coverage
+	// report.
+	Name string `bson:"name" json:"name"`
+	// CoveredLines is the number of executable lines that ran at least once.
+	CoveredLines int `bson:"covered_lines" json:"covered_lines"`
+	// TotalLines is the number of executable lines in the file.
+	TotalLines int `bson:"total_lines" json:"total_lines"`
+}
+
+// Percent returns the percentage of the file's executable lines that are
+// covered.
+func (f FileCoverage) Percent() float64 {
+	return percent(f.CoveredLines, f.TotalLines)
+}
+
+// TaskCoverage is the coverage reported by a single task execution.
+type TaskCoverage struct {
+	ID           string         `bson:"_id" json:"id"`
+	TaskID       string         `bson:"task_id" json:"task_id"`
+	Execution    int            `bson:"execution" json:"execution"`
+	TaskName     string         `bson:"task_name" json:"task_name"`
+	BuildVariant string         `bson:"build_variant" json:"build_variant"`
+	Version      string         `bson:"version" json:"version"`
+	Project      string         `bson:"project" json:"project"`
+	Requester    string         `bson:"requester" json:"requester"`
+	Files        []FileCoverage `bson:"files" json:"files"`
+	CoveredLines int            `bson:"covered_lines" json:"covered_lines"`
+	TotalLines   int            `bson:"total_lines" json:"total_lines"`
+	CreateTime   time.Time      `bson:"create_time" json:"create_time"`
+}
+
+// TaskCoverageID returns the ID of the coverage document for the given task
+// execution.
+func TaskCoverageID(taskID string, execution int) string {
+	return fmt.Sprintf("%s_%d", taskID, execution)
+}
+
+// Percent returns the percentage of the task's executable lines that are
+// covered.
+func (c *TaskCoverage) Percent() float64 {
+	return percent(c.CoveredLines, c.TotalLines)
+}
+
+// FileCoverageDelta is the change in coverage of a single source file between
+// a task and the same task in the base version.
+type FileCoverageDelta struct {
+	Name             string `json:"name"`
+	CoveredLines     int    `json:"covered_lines"`
+	TotalLines       int    `json:"total_lines"`
+	BaseCoveredLines int    `json:"base_covered_lines"`
+	BaseTotalLines   int    `json:"base_total_lines"`
+}
+
+// Percent returns the file's coverage percentage in the version.
+func (d FileCoverageDelta) Percent() float64 { return percent(d.CoveredLines, d.TotalLines) }
+
+// BasePercent returns the file's coverage percentage in the base version.
+func (d FileCoverageDelta) BasePercent() float64 {
+	return percent(d.BaseCoveredLines, d.BaseTotalLines)
+}
+
+// Delta returns the change in the file's coverage percentage.
+func (d FileCoverageDelta) Delta() float64 { return d.Percent() - d.BasePercent() }
+
+// TaskCoverageDelta is the change in coverage of a task between a version and
+// its base version. Tasks are matched by build variant and display name.
+type TaskCoverageDelta struct {
+	TaskName         string `json:"task_name"`
+	BuildVariant     string `json:"build_variant"`
+	TaskID           string `json:"task_id"`
+	BaseTaskID       string `json:"base_task_id"`
+	CoveredLines     int    `json:"covered_lines"`
+	TotalLines       int    `json:"total_lines"`
+	BaseCoveredLines int    `json:"base_covered_lines"`
+	BaseTotalLines   int    `json:"base_total_lines"`
+	// Files contains only the files whose coverage changed.
+	Files []FileCoverageDelta `json:"files"`
+}
+
+// Percent returns the task's coverage percentage in the version.
+func (d TaskCoverageDelta) Percent() float64 { return percent(d.CoveredLines, d.TotalLines) }
+
+// BasePercent returns the task's coverage percentage in the base version.
+func (d TaskCoverageDelta) BasePercent() float64 {
+	return percent(d.BaseCoveredLines, d.BaseTotalLines)
+}
+
+// Delta returns the change in the task's coverage percentage.
+func (d TaskCoverageDelta) Delta() float64 { return d.Percent() - d.BasePercent() }
+
+// VersionCoverageDelta is the change in coverage between a version and its
+// base version. The version totals are the sums of the totals of each task
+// that reported coverage in both versions, so that a version that runs a
+// different set of tasks than its base version doesn't appear to change
+// coverage. If the base version didn't report any coverage, the totals are
+// over all of the version's tasks.
+type VersionCoverageDelta struct {
+	Version          string `json:"version"`
+	BaseVersion      string `json:"base_version"`
+	CoveredLines     int    `json:"covered_lines"`
+	TotalLines       int    `json:"total_lines"`
+	BaseCoveredLines int    `json:"base_covered_lines"`
+	BaseTotalLines   int    `json:"base_total_lines"`
+	// Tasks contains the tasks that reported coverage in the version.
+	Tasks []TaskCoverageDelta `json:"tasks"`
+	// BaseOnlyTasks contains the tasks that reported coverage in the base
+	// version but not in the version.
+	BaseOnlyTasks []TaskCoverageDelta `json:"base_only_tasks"`
+}
+
+// Percent returns the version's coverage percentage.
+func (d VersionCoverageDelta) Percent() float64 { return percent(d.CoveredLines, d.TotalLines) }
+
+// BasePercent returns the base version's coverage percentage.
+func (d VersionCoverageDelta) BasePercent() float64 {
+	return percent(d.BaseCoveredLines, d.BaseTotalLines)
+}
+
+// Delta returns the change in the version's coverage percentage.
+func (d VersionCoverageDelta) Delta() float64 { return d.Percent() - d.BasePercent() }
+
+// Compare computes the change in coverage between the coverage reported by a
+// version's tasks and the coverage reported by its base version's tasks. Only
+// the latest execution of each task is considered.
+func Compare(versionID, baseVersionID string, current, base []TaskCoverage) VersionCoverageDelta {
+	delta := VersionCoverageDelta{
+		Version:       versionID,
+		BaseVersion:   baseVersionID,
+		Tasks:         []TaskCoverageDelta{},
+		BaseOnlyTasks: []TaskCoverageDelta{},
+	}
+
+	currentByKey := latestExecutionsByTaskKey(current)
+	baseByKey := latestExecutionsByTaskKey(base)
+
+	keys := make([]taskKey, 0, len(currentByKey)+len(baseByKey))
+	for key := range currentByKey {
+		keys = append(keys, key)
+	}
+	for key := range baseByKey {
+		if _, ok := currentByKey[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].buildVariant != keys[j].buildVariant {
+			return keys[i].buildVariant < keys[j].buildVariant
+		}
+		return keys[i].taskName < keys[j].taskName
+	})
+
+	for _, key := range keys {
+		current, inCurrent := currentByKey[key]
+		base, inBase := baseByKey[key]
+		taskDelta := compareTask(key, current, base)
+		if !inCurrent {
+			delta.BaseOnlyTasks = append(delta.BaseOnlyTasks, taskDelta)
+			continue
+		}
+		delta.Tasks = append(delta.Tasks, taskDelta)
+		if !inBase && len(baseByKey) > 0 {
+			continue
+		}
+		delta.CoveredLines += taskDelta.CoveredLines
+		delta.TotalLines += taskDelta.TotalLines
+		delta.BaseCoveredLines += taskDelta.BaseCoveredLines
+		delta.BaseTotalLines += taskDelta.BaseTotalLines
+	}
+
+	return delta
+}
+
+type taskKey struct {
+	buildVariant string
+	taskName     string
+}
+
+func latestExecutionsByTaskKey(coverage []TaskCoverage) map[taskKey]*TaskCoverage {
+	latest := map[taskKey]*TaskCoverage{}
+	for i := range coverage {
+		key := taskKey{buildVariant: coverage[i].BuildVariant, taskName: coverage[i].TaskName}
+		if existing, ok := latest[key]; ok && existing.Execution >= coverage[i].Execution {
+			continue
+		}
+		latest[key] = &coverage[i]
+	}
+	return latest
+}
+
+func compareTask(key taskKey, current, base *TaskCoverage) TaskCoverageDelta {
+	delta := TaskCoverageDelta{
+		TaskName:     key.taskName,
+		BuildVariant: key.buildVariant,
+		Files:        []FileCoverageDelta{},
+	}
+
+	files := map[string]*FileCoverageDelta{}
+	getFile := func(name string) *FileCoverageDelta {
+		if f, ok := files[name]; ok {
+			return f
+		}
+		files[name] = &FileCoverageDelta{Name: name}
+		return files[name]
+	}
+
+	if current != nil {
+		delta.TaskID = current.TaskID
+		delta.CoveredLines = current.CoveredLines
+		delta.TotalLines = current.TotalLines
+		for _, f := range current.Files {
+			fileDelta := getFile(f.Name)
+			fileDelta.CoveredLines = f.CoveredLines
+			fileDelta.TotalLines = f.TotalLines
+		}
+	}
+	if base != nil {
+		delta.BaseTaskID = base.TaskID
+		delta.BaseCoveredLines = base.CoveredLines
+		delta.BaseTotalLines = base.TotalLines
+		for _, f := range base.Files {
+			fileDelta := getFile(f.Name)
+			fileDelta.BaseCoveredLines = f.CoveredLines
+			fileDelta.BaseTotalLines = f.TotalLines
+		}
+	}
+
+	for _, f := range files {
+		if f.CoveredLines == f.BaseCoveredLines && f.TotalLines == f.BaseTotalLines {
+			continue
+		}
+		delta.Files = append(delta.Files, *f)
+	}
+	sort.Slice(delta.Files, func(i, j int) bool { return delta.Files[i].Name < delta.Files[j].Name })
+
+	return delta
+}
+
+func percent(covered, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(covered) / float64(total)
+}
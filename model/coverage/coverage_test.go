@@ -0,0 +1,190 @@
+package coverage
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/db"
+	_ "github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddFiles(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+	ctx := t.Context()
+
+	c := &TaskCoverage{
+		TaskID:       "t1",
+		Execution:    1,
+		TaskName:     "unit",
+		BuildVariant: "ubuntu",
+		Version:      "v1",
+	}
+	require.NoError(t, c.AddFiles(ctx, []FileCoverage{
+		{Name: "b.go", CoveredLines: 1, TotalLines: 4},
+		{Name: "a.go", CoveredLines: 2, TotalLines: 2},
+	}))
+
+	c = &TaskCoverage{
+		TaskID:       "t1",
+		Execution:    1,
+		TaskName:     "unit",
+		BuildVariant: "ubuntu",
+		Version:      "v1",
+	}
+	require.NoError(t, c.AddFiles(ctx, []FileCoverage{
+		{Name: "b.go", CoveredLines: 3, TotalLines: 4},
+		{Name: "c.go", CoveredLines: 0, TotalLines: 1},
+	}))
+
+	dbCoverage, err := FindOne(ctx, ByTaskIDAndExecution("t1", 1))
+	require.NoError(t, err)
+	require.NotNil(t, dbCoverage)
+	assert.Equal(t, TaskCoverageID("t1", 1), dbCoverage.ID)
+	assert.Equal(t, []FileCoverage{
+		{Name: "a.go", CoveredLines: 2, TotalLines: 2},
+		{Name: "b.go", CoveredLines: 3, TotalLines: 4},
+		{Name: "c.go", CoveredLines: 0, TotalLines: 1},
+	}, dbCoverage.Files)
+	assert.Equal(t, 5, dbCoverage.CoveredLines)
+	assert.Equal(t, 7, dbCoverage.TotalLines)
+
+	versionCoverage, err := Find(ctx, ByVersion("v1"))
+	require.NoError(t, err)
+	assert.Len(t, versionCoverage, 1)
+
+	otherExecution, err := FindOne(ctx, ByTaskIDAndExecution("t1", 0))
+	require.NoError(t, err)
+	assert.Nil(t, otherExecution)
+
+	t.Run("ConcurrentUploadsKeepEveryFile", func(t *testing.T) {
+		const numUploads = 10
+		var wg sync.WaitGroup
+		for i := range numUploads {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c := &TaskCoverage{TaskID: "t2", TaskName: "unit", BuildVariant: "ubuntu", Version: "v1"}
+				assert.NoError(t, c.AddFiles(ctx, []FileCoverage{{Name: fmt.Sprintf("file%d.go", i), CoveredLines: 1, TotalLines: 2}}))
+			}()
+		}
+		wg.Wait()
+
+		dbCoverage, err := FindOne(ctx, ByTaskIDAndExecution("t2", 0))
+		require.NoError(t, err)
+		require.NotNil(t, dbCoverage)
+		assert.Len(t, dbCoverage.Files, numUploads)
+		assert.Equal(t, numUploads, dbCoverage.CoveredLines)
+		assert.Equal(t, 2*numUploads, dbCoverage.TotalLines)
+	})
+}
+
+func TestCompare(t *testing.T) {
+	t.Run("ComputesDeltasForMatchingTasks", func(t *testing.T) {
+		current := []TaskCoverage{
+			{
+				TaskID:       "patch_unit",
+				TaskName:     "unit",
+				BuildVariant: "ubuntu",
+				Files: []FileCoverage{
+					{Name: "a.go", CoveredLines: 4, TotalLines: 4},
+					{Name: "b.go", CoveredLines: 1, TotalLines: 4},
+				},
+				CoveredLines: 5,
+				TotalLines:   8,
+			},
+		}
+		base := []TaskCoverage{
+			{
+				TaskID:       "base_unit",
+				TaskName:     "unit",
+				BuildVariant: "ubuntu",
+				Files: []FileCoverage{
+					{Name: "a.go", CoveredLines: 2, TotalLines: 4},
+					{Name: "b.go", CoveredLines: 1, TotalLines: 4},
+				},
+				CoveredLines: 3,
+				TotalLines:   8,
+			},
+		}
+
+		delta := Compare("patch", "base", current, base)
+		assert.Equal(t, "patch", delta.Version)
+		assert.Equal(t, "base", delta.BaseVersion)
+		assert.Equal(t, 5, delta.CoveredLines)
+		assert.Equal(t, 3, delta.BaseCoveredLines)
+		assert.InDelta(t, 62.5, delta.Percent(), 0.001)
+		assert.InDelta(t, 37.5, delta.BasePercent(), 0.001)
+		assert.InDelta(t, 25, delta.Delta(), 0.001)
+
+		require.Len(t, delta.Tasks, 1)
+		taskDelta := delta.Tasks[0]
+		assert.Equal(t, "patch_unit", taskDelta.TaskID)
+		assert.Equal(t, "base_unit", taskDelta.BaseTaskID)
+		require.Len(t, taskDelta.Files, 1, "only files with changed coverage should be included")
+		assert.Equal(t, "a.go", taskDelta.Files[0].Name)
+		assert.InDelta(t, 50, taskDelta.Files[0].Delta(), 0.001)
+	})
+	t.Run("UsesLatestExecution", func(t *testing.T) {
+		current := []TaskCoverage{
+			{TaskID: "t", Execution: 0, TaskName: "unit", BuildVariant: "ubuntu", CoveredLines: 1, TotalLines: 2},
+			{TaskID: "t", Execution: 2, TaskName: "unit", BuildVariant: "ubuntu", CoveredLines: 2, TotalLines: 2},
+			{TaskID: "t", Execution: 1, TaskName: "unit", BuildVariant: "ubuntu", CoveredLines: 0, TotalLines: 2},
+		}
+
+		delta := Compare("v", "", current, nil)
+		require.Len(t, delta.Tasks, 1)
+		assert.Equal(t, 2, delta.Tasks[0].CoveredLines)
+		assert.Equal(t, 2, delta.CoveredLines)
+	})
+	t.Run("IncludesTasksMissingFromEitherVersion", func(t *testing.T) {
+		current := []TaskCoverage{
+			{TaskID: "new", TaskName: "new_task", BuildVariant: "ubuntu", CoveredLines: 1, TotalLines: 1},
+		}
+		base := []TaskCoverage{
+			{TaskID: "old", TaskName: "old_task", BuildVariant: "ubuntu", CoveredLines: 1, TotalLines: 2},
+		}
+
+		delta := Compare("v", "base", current, base)
+		require.Len(t, delta.Tasks, 1)
+		assert.Equal(t, "new_task", delta.Tasks[0].TaskName)
+		assert.Empty(t, delta.Tasks[0].BaseTaskID)
+		assert.InDelta(t, 100, delta.Tasks[0].Delta(), 0.001)
+		require.Len(t, delta.BaseOnlyTasks, 1)
+		assert.Equal(t, "old_task", delta.BaseOnlyTasks[0].TaskName)
+		assert.Empty(t, delta.BaseOnlyTasks[0].TaskID)
+		assert.InDelta(t, -50, delta.BaseOnlyTasks[0].Delta(), 0.001)
+	})
+	t.Run("TotalsOnlyIncludeTasksInBothVersions", func(t *testing.T) {
+		current := []TaskCoverage{
+			{TaskID: "patch_unit", TaskName: "unit", BuildVariant: "ubuntu", CoveredLines: 6, TotalLines: 10},
+			{TaskID: "patch_new", TaskName: "new", BuildVariant: "ubuntu", CoveredLines: 0, TotalLines: 10},
+		}
+		base := []TaskCoverage{
+			{TaskID: "base_unit", TaskName: "unit", BuildVariant: "ubuntu", CoveredLines: 5, TotalLines: 10},
+			{TaskID: "base_integration", TaskName: "integration", BuildVariant: "ubuntu", CoveredLines: 10, TotalLines: 10},
+		}
+
+		delta := Compare("patch", "base", current, base)
+		assert.Equal(t, 6, delta.CoveredLines)
+		assert.Equal(t, 10, delta.TotalLines)
+		assert.Equal(t, 5, delta.BaseCoveredLines)
+		assert.Equal(t, 10, delta.BaseTotalLines)
+		assert.InDelta(t, 10, delta.Delta(), 0.001, "tasks that only ran in one version should not change the version's coverage")
+		assert.Len(t, delta.Tasks, 2)
+		require.Len(t, delta.BaseOnlyTasks, 1)
+		assert.Equal(t, "base_integration", delta.BaseOnlyTasks[0].BaseTaskID)
+	})
+	t.Run("ReturnsZeroPercentWithoutLines", func(t *testing.T) {
+		delta := Compare("v", "", nil, nil)
+		assert.Empty(t, delta.Tasks)
+		assert.Empty(t, delta.BaseOnlyTasks)
+		assert.Zero(t, delta.Percent())
+		assert.Zero(t, delta.Delta())
+	})
+}
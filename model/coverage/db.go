@@ -0,0 +1,107 @@
+package coverage
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	IDKey           = bsonutil.MustHaveTag(TaskCoverage{}, "ID")
+	TaskIDKey       = bsonutil.MustHaveTag(TaskCoverage{}, "TaskID")
+	ExecutionKey    = bsonutil.MustHaveTag(TaskCoverage{}, "Execution")
+	TaskNameKey     = bsonutil.MustHaveTag(TaskCoverage{}, "TaskName")
+	BuildVariantKey = bsonutil.MustHaveTag(TaskCoverage{}, "BuildVariant")
+	VersionKey      = bsonutil.MustHaveTag(TaskCoverage{}, "Version")
+	ProjectKey      = bsonutil.MustHaveTag(TaskCoverage{}, "Project")
+	RequesterKey    = bsonutil.MustHaveTag(TaskCoverage{}, "Requester")
+	FilesKey        = bsonutil.MustHaveTag(TaskCoverage{}, "Files")
+	CoveredLinesKey = bsonutil.MustHaveTag(TaskCoverage{}, "CoveredLines")
+	TotalLinesKey   = bsonutil.MustHaveTag(TaskCoverage{}, "TotalLines")
+	CreateTimeKey   = bsonutil.MustHaveTag(TaskCoverage{}, "CreateTime")
+
+	FileNameKey         = bsonutil.MustHaveTag(FileCoverage{}, "Name")
+	FileCoveredLinesKey = bsonutil.MustHaveTag(FileCoverage{}, "CoveredLines")
+	FileTotalLinesKey   = bsonutil.MustHaveTag(FileCoverage{}, "TotalLines")
+)
+
+// ByTaskIDAndExecution returns a query for the coverage of the given task
+// execution.
+func ByTaskIDAndExecution(taskID string, execution int) db.Q {
+	return db.Query(bson.M{IDKey: TaskCoverageID(taskID, execution)})
+}
+
+// ByVersion returns a query for the coverage of all tasks in the given version.
+func ByVersion(versionID string) db.Q {
+	return db.Query(bson.M{VersionKey: versionID})
+}
+
+// FindOne gets one TaskCoverage for the given query.
+func FindOne(ctx context.Context, query db.Q) (*TaskCoverage, error) {
+	c := &TaskCoverage{}
+	err := db.FindOneQ(ctx, Collection, query, c)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return c, err
+}
+
+// Find gets every TaskCoverage matching the given query.
+func Find(ctx context.Context, query db.Q) ([]TaskCoverage, error) {
+	coverage := []TaskCoverage{}
+	err := db.FindAllQ(ctx, Collection, query, &coverage)
+	return coverage, err
+}
+
+// AddFiles merges the given file coverage into the stored coverage for the
+// task execution, creating the coverage document if it does not exist yet.
+// Files that were already reported are replaced by the newer coverage. The
+// merge is done in a single update so that concurrent uploads for the same
+// task execution don't overwrite each other's files.
+func (c *TaskCoverage) AddFiles(ctx context.Context, files []FileCoverage) error {
+	byName := make(map[string]FileCoverage, len(files))
+	for _, f := range files {
+		byName[f.Name] = f
+	}
+	names := make([]string, 0, len(byName))
+	newFiles := make([]FileCoverage, 0, len(byName))
+	for name, f := range byName {
+		names = append(names, name)
+		newFiles = append(newFiles, f)
+	}
+
+	c.ID = TaskCoverageID(c.TaskID, c.Execution)
+	keptFiles := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": []any{"$" + FilesKey, []any{}}},
+		"as":    "file",
+		"cond":  bson.M{"$not": bson.M{"$in": []any{"$$file." + FileNameKey, names}}},
+	}}
+	update := []bson.M{
+		{"$set": bson.M{
+			TaskIDKey:       c.TaskID,
+			ExecutionKey:    c.Execution,
+			TaskNameKey:     c.TaskName,
+			BuildVariantKey: c.BuildVariant,
+			VersionKey:      c.Version,
+			ProjectKey:      c.Project,
+			RequesterKey:    c.Requester,
+			CreateTimeKey:   bson.M{"$ifNull": []any{"$" + CreateTimeKey, time.Now()}},
+			FilesKey: bson.M{"$sortArray": bson.M{
+				"input":  bson.M{"$concatArrays": []any{keptFiles, bson.M{"$literal": newFiles}}},
+				"sortBy": bson.M{FileNameKey: 1},
+			}},
+		}},
+		{"$set": bson.M{
+			CoveredLinesKey: bson.M{"$sum": "$" + FilesKey + "." + FileCoveredLinesKey},
+			TotalLinesKey:   bson.M{"$sum": "$" + FilesKey + "." + FileTotalLinesKey},
+		}},
+	}
+
+	_, err := db.Upsert(ctx, Collection, bson.M{IDKey: c.ID}, update)
+	return errors.Wrap(err, "upserting task coverage")
+}
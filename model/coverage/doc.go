@@ -0,0 +1,3 @@
+// Package coverage models the code coverage reported by tasks and the changes
+// in coverage between a version and its base version.
+package coverage
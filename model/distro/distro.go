@@ -321,10 +321,27 @@ type PlannerSettings struct {
 	GenerateTaskFactor        int64         `bson:"generate_task_factor" json:"generate_task_factor" mapstructure:"generate_task_factor"`
 	NumDependentsFactor       float64       `bson:"num_dependents_factor" json:"num_dependents_factor" mapstructure:"num_dependents_factor"`
 	StepbackTaskFactor        int64         `bson:"stepback_task_factor" json:"stepback_task_factor" mapstructure:"stepback_task_factor"`
+	// FairShareWeights divide the distro's hosts between projects, so that no
+	// single project can take all of them while others are waiting. Projects
+	// without a fair-share weight are not limited.
+	FairShareWeights []FairShareWeight `bson:"fair_share_weights,omitempty" json:"fair_share_weights,omitempty" mapstructure:"fair_share_weights,omitempty"`
 
 	maxDurationPerHost time.Duration
 }
 
+// FairShareWeight gives a project, or a group of projects, a share of the
+// distro's hosts. Among the shares that have tasks running or waiting in the
+// distro, each one is entitled to a fraction of the distro's maximum hosts
+// that is proportional to its weight.
+type FairShareWeight struct {
+	// Name identifies the share.
+	Name string `bson:"name" json:"name" mapstructure:"name"`
+	// Projects are the IDs of the projects whose tasks count against the share.
+	Projects []string `bson:"projects" json:"projects" mapstructure:"projects"`
+	// Weight is the share's weight relative to the distro's other shares.
+	Weight int `bson:"weight" json:"weight" mapstructure:"weight"`
+}
+
 type DispatcherSettings struct {
 	Version string `bson:"version" json:"version" mapstructure:"version"`
 }
@@ -778,6 +795,7 @@ func (d *Distro) GetResolvedPlannerSettings(s *evergreen.Settings) (PlannerSetti
 		ExpectedRuntimeFactor:     ps.ExpectedRuntimeFactor,
 		GenerateTaskFactor:        ps.GenerateTaskFactor,
 		NumDependentsFactor:       ps.NumDependentsFactor,
+		FairShareWeights:          ps.FairShareWeights,
 		maxDurationPerHost:        evergreen.MaxDurationPerDistroHost,
 	}
 
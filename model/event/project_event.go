@@ -5,6 +5,8 @@ func init() {
 	registry.setUnexpirable(EventResourceTypeProject, EventTypeProjectAdded)
 	registry.setUnexpirable(EventResourceTypeProject, EventTypeProjectAttachedToRepo)
 	registry.setUnexpirable(EventResourceTypeProject, EventTypeProjectDetachedFromRepo)
+	registry.setUnexpirable(EventResourceTypeProject, EventTypeProjectTestAutoQuarantined)
+	registry.setUnexpirable(EventResourceTypeProject, EventTypeProjectTestAutoUnquarantined)
 }
 
 const (
@@ -13,4 +15,7 @@ const (
 	EventTypeProjectAdded            = "PROJECT_ADDED"
 	EventTypeProjectAttachedToRepo   = "PROJECT_ATTACHED_TO_REPO"
 	EventTypeProjectDetachedFromRepo = "PROJECT_DETACHED_FROM_REPO"
+
+	EventTypeProjectTestAutoQuarantined   = "PROJECT_TEST_AUTO_QUARANTINED"
+	EventTypeProjectTestAutoUnquarantined = "PROJECT_TEST_AUTO_UNQUARANTINED"
 )
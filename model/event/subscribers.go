@@ -1,8 +1,12 @@
 package event
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"mime"
 	"strings"
+	"text/template"
 
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/util"
@@ -20,6 +24,8 @@ const (
 	EvergreenWebhookSubscriberType  = "evergreen-webhook"
 	EmailSubscriberType             = "email"
 	SlackSubscriberType             = "slack"
+	TeamsSubscriberType             = "teams"
+	ChatWebhookSubscriberType       = "chat-webhook"
 	SubscriberTypeNone              = "none"
 	RunChildPatchSubscriberType     = "run-child-patch"
 
@@ -27,9 +33,23 @@ const (
 	// webhook subscriber's authorization token.
 	WebhookAuthorizationHeader = "Authorization"
 
+	// WebhookFormatEvergreen is the webhook format that posts Evergreen's own
+	// REST model of the object. It's the default.
+	WebhookFormatEvergreen = "evergreen"
+	// WebhookFormatCloudEvents is the webhook format that posts a CloudEvents
+	// 1.0 event in structured mode, with the REST model as its data.
+	WebhookFormatCloudEvents = "cloudevents"
+
 	webhookRetryLimit    = 10
 	webhookMinDelayLimit = 10000
 	webhookTimeoutLimit  = 30000
+
+	// chatWebhookTemplateLimit is the maximum length of a chat webhook
+	// subscriber's template.
+	chatWebhookTemplateLimit = 16 * 1024
+	// DefaultChatWebhookContentType is the content type of chat webhook
+	// messages that don't set one.
+	DefaultChatWebhookContentType = "application/json"
 )
 
 var SubscriberTypes = []string{
@@ -41,6 +61,8 @@ var SubscriberTypes = []string{
 	EvergreenWebhookSubscriberType,
 	EmailSubscriberType,
 	SlackSubscriberType,
+	TeamsSubscriberType,
+	ChatWebhookSubscriberType,
 	RunChildPatchSubscriberType,
 }
 
@@ -79,6 +101,10 @@ func (s *Subscriber) SetBSON(raw mgobson.Raw) error {
 		s.Target = &WebhookSubscriber{}
 	case JIRAIssueSubscriberType:
 		s.Target = &JIRAIssueSubscriber{}
+	case TeamsSubscriberType:
+		s.Target = &TeamsSubscriber{}
+	case ChatWebhookSubscriberType:
+		s.Target = &ChatWebhookSubscriber{}
 	case JIRACommentSubscriberType, EmailSubscriberType, SlackSubscriberType:
 		str := ""
 		s.Target = &str
@@ -122,6 +148,14 @@ func (s *Subscriber) Validate() error {
 		catcher.Add(v.validate())
 	case *WebhookSubscriber:
 		catcher.Add(v.validate())
+	case TeamsSubscriber:
+		catcher.Add(v.validate())
+	case *TeamsSubscriber:
+		catcher.Add(v.validate())
+	case ChatWebhookSubscriber:
+		catcher.Add(v.validate())
+	case *ChatWebhookSubscriber:
+		catcher.Add(v.validate())
 	}
 
 	return catcher.Resolve()
@@ -137,6 +171,9 @@ type WebhookSubscriber struct {
 	MinDelayMS                   int             `bson:"min_delay_ms"`
 	TimeoutMS                    int             `bson:"timeout_ms"`
 	Headers                      []WebhookHeader `bson:"headers"`
+	// Format is the format of the webhook's body. If it's empty, the body is
+	// in the Evergreen format.
+	Format string `bson:"format,omitempty"`
 }
 
 type WebhookHeader struct {
@@ -157,6 +194,11 @@ func (s Subscriber) toWebhookSubscriber() *WebhookSubscriber {
 	return ws
 }
 
+// IsCloudEvents returns whether the webhook posts CloudEvents.
+func (s *WebhookSubscriber) IsCloudEvents() bool {
+	return s.Format == WebhookFormatCloudEvents
+}
+
 func (s *WebhookSubscriber) String() string {
 	if len(s.URL) == 0 {
 		return "NIL_URL"
@@ -169,6 +211,8 @@ func (s *WebhookSubscriber) validate() error {
 	catcher.Add(util.ValidateWebhookURL(s.URL))
 	catcher.AddWhen(len(s.Secret) == 0, errors.New("secret cannot be empty"))
 
+	catcher.ErrorfWhen(!utility.StringSliceContains([]string{"", WebhookFormatEvergreen, WebhookFormatCloudEvents}, s.Format), "invalid webhook format '%s'", s.Format)
+
 	catcher.AddWhen(s.Retries < 0, errors.New("retries cannot be negative"))
 	catcher.AddWhen(s.Retries > webhookRetryLimit, errors.Errorf("cannot retry more than %d times", webhookRetryLimit))
 
@@ -211,6 +255,107 @@ func (s *WebhookSubscriber) setHeader(key, value string) {
 	s.Headers = append(s.Headers, WebhookHeader{Key: key, Value: value})
 }
 
+// TeamsSubscriber posts notifications as Adaptive Cards to a Microsoft Teams
+// incoming webhook.
+type TeamsSubscriber struct {
+	URL string `bson:"url"`
+}
+
+func (s *TeamsSubscriber) String() string {
+	if len(s.URL) == 0 {
+		return "NIL_URL"
+	}
+	return s.URL
+}
+
+func (s *TeamsSubscriber) validate() error {
+	return errors.Wrap(util.ValidateWebhookURL(s.URL), "invalid Teams webhook URL")
+}
+
+// ChatWebhookSubscriber posts notifications to a chat service's incoming
+// webhook. The message body is rendered from a user-supplied Go text template
+// that is executed with ChatWebhookTemplateData.
+type ChatWebhookSubscriber struct {
+	URL      string `bson:"url"`
+	Template string `bson:"template"`
+	// ContentType is the content type of the rendered message. It defaults
+	// to DefaultChatWebhookContentType.
+	ContentType string `bson:"content_type,omitempty"`
+}
+
+func (s *ChatWebhookSubscriber) String() string {
+	if len(s.URL) == 0 {
+		return "NIL_URL"
+	}
+	return s.URL
+}
+
+func (s *ChatWebhookSubscriber) validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(util.ValidateWebhookURL(s.URL), "invalid chat webhook URL")
+	catcher.NewWhen(s.Template == "", "chat webhook template cannot be empty")
+	catcher.ErrorfWhen(len(s.Template) > chatWebhookTemplateLimit, "chat webhook template cannot be longer than %d characters", chatWebhookTemplateLimit)
+	if s.ContentType != "" {
+		_, _, err := mime.ParseMediaType(s.ContentType)
+		catcher.Wrapf(err, "invalid chat webhook content type '%s'", s.ContentType)
+	}
+	if !catcher.HasErrors() {
+		_, err := s.Render(ChatWebhookTemplateData{})
+		catcher.Wrap(err, "invalid chat webhook template")
+	}
+
+	return catcher.Resolve()
+}
+
+// ChatWebhookTemplateData is the data that a chat webhook subscriber's
+// template is executed with.
+type ChatWebhookTemplateData struct {
+	// Object is the type of object that the notification is about, such as
+	// task or version.
+	Object         string
+	ID             string
+	DisplayName    string
+	Project        string
+	Status         string
+	Description    string
+	URL            string
+	Trigger        string
+	EventID        string
+	SubscriptionID string
+	// FailedTests are the names of the failed tests, if the notification is
+	// about a task.
+	FailedTests []string
+}
+
+var chatWebhookTemplateFuncs = template.FuncMap{
+	// json quotes and escapes a value so it can be put in a JSON body.
+	"json": func(v any) (string, error) {
+		out, err := json.Marshal(v)
+		return string(out), err
+	},
+}
+
+// Render executes the subscriber's template with the data.
+func (s *ChatWebhookSubscriber) Render(data ChatWebhookTemplateData) ([]byte, error) {
+	tmpl, err := template.New("chat-webhook").Funcs(chatWebhookTemplateFuncs).Parse(s.Template)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing template")
+	}
+	buf := &bytes.Buffer{}
+	if err = tmpl.Execute(buf, data); err != nil {
+		return nil, errors.Wrap(err, "executing template")
+	}
+	return buf.Bytes(), nil
+}
+
+// GetContentType returns the content type of the rendered message.
+func (s *ChatWebhookSubscriber) GetContentType() string {
+	if s.ContentType == "" {
+		return DefaultChatWebhookContentType
+	}
+	return s.ContentType
+}
+
 type JIRAIssueSubscriber struct {
 	Project   string `bson:"project"`
 	IssueType string `bson:"issue_type"`
@@ -300,3 +445,17 @@ func NewSlackSubscriber(t string) Subscriber {
 		Target: t,
 	}
 }
+
+func NewTeamsSubscriber(s TeamsSubscriber) Subscriber {
+	return Subscriber{
+		Type:   TeamsSubscriberType,
+		Target: s,
+	}
+}
+
+func NewChatWebhookSubscriber(s ChatWebhookSubscriber) Subscriber {
+	return Subscriber{
+		Type:   ChatWebhookSubscriberType,
+		Target: s,
+	}
+}
@@ -203,6 +203,98 @@ func TestValidate(t *testing.T) {
 			},
 			errorExpected: false,
 		},
+		"WebhookInvalidFormat": {
+			s: Subscriber{
+				Type: EvergreenWebhookSubscriberType,
+				Target: WebhookSubscriber{
+					URL:    "https://evergreen.mongodb.com",
+					Secret: []byte("shh"),
+					Format: "xml",
+				},
+			},
+			errorExpected: true,
+		},
+		"ValidCloudEventsWebhook": {
+			s: Subscriber{
+				Type: EvergreenWebhookSubscriberType,
+				Target: WebhookSubscriber{
+					URL:    "https://evergreen.mongodb.com",
+					Secret: []byte("shh"),
+					Format: WebhookFormatCloudEvents,
+				},
+			},
+			errorExpected: false,
+		},
+		"TeamsMissingURL": {
+			s: Subscriber{
+				Type:   TeamsSubscriberType,
+				Target: TeamsSubscriber{},
+			},
+			errorExpected: true,
+		},
+		"TeamsURLWithPrivateIP": {
+			s: Subscriber{
+				Type:   TeamsSubscriberType,
+				Target: &TeamsSubscriber{URL: "https://10.0.0.1"},
+			},
+			errorExpected: true,
+		},
+		"ValidTeams": {
+			s: Subscriber{
+				Type:   TeamsSubscriberType,
+				Target: &TeamsSubscriber{URL: "https://example.webhook.office.com/webhookb2/abc"},
+			},
+			errorExpected: false,
+		},
+		"ChatWebhookMissingTemplate": {
+			s: Subscriber{
+				Type:   ChatWebhookSubscriberType,
+				Target: ChatWebhookSubscriber{URL: "https://chat.example.com/hooks/abc"},
+			},
+			errorExpected: true,
+		},
+		"ChatWebhookMalformedTemplate": {
+			s: Subscriber{
+				Type: ChatWebhookSubscriberType,
+				Target: ChatWebhookSubscriber{
+					URL:      "https://chat.example.com/hooks/abc",
+					Template: `{"text": {{ .DisplayName }`,
+				},
+			},
+			errorExpected: true,
+		},
+		"ChatWebhookTemplateWithUnknownField": {
+			s: Subscriber{
+				Type: ChatWebhookSubscriberType,
+				Target: ChatWebhookSubscriber{
+					URL:      "https://chat.example.com/hooks/abc",
+					Template: `{"text": {{ json .Secret }}}`,
+				},
+			},
+			errorExpected: true,
+		},
+		"ChatWebhookInvalidContentType": {
+			s: Subscriber{
+				Type: ChatWebhookSubscriberType,
+				Target: ChatWebhookSubscriber{
+					URL:         "https://chat.example.com/hooks/abc",
+					Template:    `{"text": {{ json .DisplayName }}}`,
+					ContentType: "not a content type",
+				},
+			},
+			errorExpected: true,
+		},
+		"ValidChatWebhook": {
+			s: Subscriber{
+				Type: ChatWebhookSubscriberType,
+				Target: &ChatWebhookSubscriber{
+					URL:         "https://chat.example.com/hooks/abc",
+					Template:    `{"text": {{ json (printf "%s is %s" .DisplayName .Status) }}}`,
+					ContentType: "application/json; charset=utf-8",
+				},
+			},
+			errorExpected: false,
+		},
 		"WebhookWithDuplicateHeadersIsInvalid": {
 			s: Subscriber{
 				Type: EvergreenWebhookSubscriberType,
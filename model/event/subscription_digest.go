@@ -0,0 +1,108 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DigestIntervalHourly sends held notifications at the top of every hour.
+	DigestIntervalHourly = "hourly"
+	// DigestIntervalDaily sends held notifications once a day.
+	DigestIntervalDaily = "daily"
+)
+
+// DigestSettings configures a subscription to hold its notifications and
+// send them together as a single summary instead of one message per event.
+type DigestSettings struct {
+	// Interval is how often the digest is sent.
+	Interval string `bson:"interval"`
+	// Timezone is the IANA time zone that DailyHour and the quiet hours are
+	// in. It defaults to UTC.
+	Timezone string `bson:"timezone,omitempty"`
+	// DailyHour is the hour of the day (0-23) that daily digests are sent.
+	DailyHour int `bson:"daily_hour,omitempty"`
+	// QuietHoursStart and QuietHoursEnd are the hours of the day (0-23)
+	// during which no digest is sent. Digests that would be sent during
+	// quiet hours are sent when they end instead. The range may wrap past
+	// midnight, and equal values mean there are no quiet hours.
+	QuietHoursStart int `bson:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   int `bson:"quiet_hours_end,omitempty"`
+}
+
+// Validate checks that the digest settings are valid for the given
+// subscriber type.
+func (d *DigestSettings) Validate(subscriberType string) error {
+	catcher := grip.NewBasicCatcher()
+	if subscriberType != EmailSubscriberType && subscriberType != SlackSubscriberType {
+		catcher.Errorf("digests are not supported for subscriber type '%s'", subscriberType)
+	}
+	if d.Interval != DigestIntervalHourly && d.Interval != DigestIntervalDaily {
+		catcher.Errorf("'%s' is not a valid digest interval", d.Interval)
+	}
+	if _, err := d.location(); err != nil {
+		catcher.Wrapf(err, "invalid digest timezone '%s'", d.Timezone)
+	}
+	catcher.ErrorfWhen(!isValidHour(d.DailyHour), "digest daily hour %d must be between 0 and 23", d.DailyHour)
+	catcher.ErrorfWhen(!isValidHour(d.QuietHoursStart), "quiet hours start %d must be between 0 and 23", d.QuietHoursStart)
+	catcher.ErrorfWhen(!isValidHour(d.QuietHoursEnd), "quiet hours end %d must be between 0 and 23", d.QuietHoursEnd)
+	return catcher.Resolve()
+}
+
+func isValidHour(hour int) bool {
+	return hour >= 0 && hour <= 23
+}
+
+func (d *DigestSettings) location() (*time.Location, error) {
+	if d.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(d.Timezone)
+	return loc, errors.WithStack(err)
+}
+
+// NextSendTime returns the first time after now that a digest with these
+// settings should be sent.
+func (d *DigestSettings) NextSendTime(now time.Time) (time.Time, error) {
+	loc, err := d.location()
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "loading digest timezone '%s'", d.Timezone)
+	}
+	local := now.In(loc)
+
+	var next time.Time
+	switch d.Interval {
+	case DigestIntervalHourly:
+		next = time.Date(local.Year(), local.Month(), local.Day(), local.Hour()+1, 0, 0, 0, loc)
+	case DigestIntervalDaily:
+		next = time.Date(local.Year(), local.Month(), local.Day(), d.DailyHour, 0, 0, 0, loc)
+		if !next.After(local) {
+			next = time.Date(local.Year(), local.Month(), local.Day()+1, d.DailyHour, 0, 0, 0, loc)
+		}
+	default:
+		return time.Time{}, errors.Errorf("'%s' is not a valid digest interval", d.Interval)
+	}
+
+	if d.inQuietHours(next.Hour()) {
+		end := time.Date(next.Year(), next.Month(), next.Day(), d.QuietHoursEnd, 0, 0, 0, loc)
+		if end.Before(next) {
+			end = time.Date(next.Year(), next.Month(), next.Day()+1, d.QuietHoursEnd, 0, 0, 0, loc)
+		}
+		next = end
+	}
+
+	return next.UTC(), nil
+}
+
+// inQuietHours returns whether the hour of the day is within the quiet hours.
+func (d *DigestSettings) inQuietHours(hour int) bool {
+	if d.QuietHoursStart == d.QuietHoursEnd {
+		return false
+	}
+	if d.QuietHoursStart < d.QuietHoursEnd {
+		return hour >= d.QuietHoursStart && hour < d.QuietHoursEnd
+	}
+	return hour >= d.QuietHoursStart || hour < d.QuietHoursEnd
+}
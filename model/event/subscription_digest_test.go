@@ -0,0 +1,110 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestSettingsValidate(t *testing.T) {
+	t.Run("ValidSettings", func(t *testing.T) {
+		settings := DigestSettings{
+			Interval:        DigestIntervalDaily,
+			Timezone:        "America/New_York",
+			DailyHour:       9,
+			QuietHoursStart: 22,
+			QuietHoursEnd:   7,
+		}
+		assert.NoError(t, settings.Validate(EmailSubscriberType))
+		assert.NoError(t, settings.Validate(SlackSubscriberType))
+	})
+	t.Run("InvalidInterval", func(t *testing.T) {
+		settings := DigestSettings{Interval: "weekly"}
+		assert.Error(t, settings.Validate(EmailSubscriberType))
+	})
+	t.Run("InvalidTimezone", func(t *testing.T) {
+		settings := DigestSettings{Interval: DigestIntervalHourly, Timezone: "Mars/Olympus_Mons"}
+		assert.Error(t, settings.Validate(EmailSubscriberType))
+	})
+	t.Run("InvalidHours", func(t *testing.T) {
+		settings := DigestSettings{Interval: DigestIntervalDaily, DailyHour: 24}
+		assert.Error(t, settings.Validate(EmailSubscriberType))
+		settings = DigestSettings{Interval: DigestIntervalHourly, QuietHoursStart: -1}
+		assert.Error(t, settings.Validate(EmailSubscriberType))
+	})
+	t.Run("UnsupportedSubscriberType", func(t *testing.T) {
+		settings := DigestSettings{Interval: DigestIntervalHourly}
+		assert.Error(t, settings.Validate(JIRACommentSubscriberType))
+	})
+}
+
+func TestDigestSettingsNextSendTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	for tName, tCase := range map[string]struct {
+		settings DigestSettings
+		now      time.Time
+		expected time.Time
+	}{
+		"HourlySendsAtNextHour": {
+			settings: DigestSettings{Interval: DigestIntervalHourly},
+			now:      time.Date(2026, 3, 2, 14, 25, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC),
+		},
+		"HourlyAtTopOfHourSendsAtNextHour": {
+			settings: DigestSettings{Interval: DigestIntervalHourly},
+			now:      time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC),
+		},
+		"DailySendsLaterToday": {
+			settings: DigestSettings{Interval: DigestIntervalDaily, DailyHour: 17},
+			now:      time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 2, 17, 0, 0, 0, time.UTC),
+		},
+		"DailySendsTomorrowAfterDailyHour": {
+			settings: DigestSettings{Interval: DigestIntervalDaily, DailyHour: 9},
+			now:      time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC),
+		},
+		"DailyUsesTimezone": {
+			settings: DigestSettings{Interval: DigestIntervalDaily, Timezone: "America/New_York", DailyHour: 9},
+			now:      time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 2, 9, 0, 0, 0, newYork).UTC(),
+		},
+		"HourlyDuringQuietHoursWaitsUntilEnd": {
+			settings: DigestSettings{Interval: DigestIntervalHourly, Timezone: "America/New_York", QuietHoursStart: 22, QuietHoursEnd: 7},
+			now:      time.Date(2026, 3, 2, 23, 30, 0, 0, newYork),
+			expected: time.Date(2026, 3, 3, 7, 0, 0, 0, newYork).UTC(),
+		},
+		"HourlyBeforeMidnightQuietHoursWaitsUntilNextDay": {
+			settings: DigestSettings{Interval: DigestIntervalHourly, QuietHoursStart: 21, QuietHoursEnd: 6},
+			now:      time.Date(2026, 3, 2, 20, 30, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 3, 6, 0, 0, 0, time.UTC),
+		},
+		"HourlyOutsideQuietHoursIsUnaffected": {
+			settings: DigestSettings{Interval: DigestIntervalHourly, QuietHoursStart: 22, QuietHoursEnd: 7},
+			now:      time.Date(2026, 3, 2, 12, 30, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 2, 13, 0, 0, 0, time.UTC),
+		},
+		"DailyDuringQuietHoursWaitsUntilEnd": {
+			settings: DigestSettings{Interval: DigestIntervalDaily, DailyHour: 2, QuietHoursStart: 0, QuietHoursEnd: 8},
+			now:      time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 3, 8, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			next, err := tCase.settings.NextSendTime(tCase.now)
+			require.NoError(t, err)
+			assert.True(t, tCase.expected.Equal(next), "expected %s but got %s", tCase.expected, next)
+		})
+	}
+
+	t.Run("InvalidInterval", func(t *testing.T) {
+		settings := DigestSettings{Interval: "weekly"}
+		_, err := settings.NextSendTime(time.Now())
+		assert.Error(t, err)
+	})
+}
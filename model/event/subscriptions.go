@@ -23,20 +23,28 @@ import (
 
 const (
 	SubscriptionsCollection = "subscriptions"
+
+	// SubscriptionDisableThreshold is the number of the subscription's
+	// notifications in a row that can run out of attempts to be sent before
+	// the subscription is disabled.
+	SubscriptionDisableThreshold = 3
 )
 
 var (
-	subscriptionIDKey             = bsonutil.MustHaveTag(Subscription{}, "ID")
-	subscriptionResourceTypeKey   = bsonutil.MustHaveTag(Subscription{}, "ResourceType")
-	subscriptionTriggerKey        = bsonutil.MustHaveTag(Subscription{}, "Trigger")
-	subscriptionSelectorsKey      = bsonutil.MustHaveTag(Subscription{}, "Selectors")
-	subscriptionRegexSelectorsKey = bsonutil.MustHaveTag(Subscription{}, "RegexSelectors")
-	subscriptionFilterKey         = bsonutil.MustHaveTag(Subscription{}, "Filter")
-	subscriptionSubscriberKey     = bsonutil.MustHaveTag(Subscription{}, "Subscriber")
-	subscriptionOwnerKey          = bsonutil.MustHaveTag(Subscription{}, "Owner")
-	subscriptionOwnerTypeKey      = bsonutil.MustHaveTag(Subscription{}, "OwnerType")
-	subscriptionTriggerDataKey    = bsonutil.MustHaveTag(Subscription{}, "TriggerData")
-	subscriptionLastUpdatedKey    = bsonutil.MustHaveTag(Subscription{}, "LastUpdated")
+	subscriptionIDKey               = bsonutil.MustHaveTag(Subscription{}, "ID")
+	subscriptionResourceTypeKey     = bsonutil.MustHaveTag(Subscription{}, "ResourceType")
+	subscriptionTriggerKey          = bsonutil.MustHaveTag(Subscription{}, "Trigger")
+	subscriptionSelectorsKey        = bsonutil.MustHaveTag(Subscription{}, "Selectors")
+	subscriptionRegexSelectorsKey   = bsonutil.MustHaveTag(Subscription{}, "RegexSelectors")
+	subscriptionFilterKey           = bsonutil.MustHaveTag(Subscription{}, "Filter")
+	subscriptionSubscriberKey       = bsonutil.MustHaveTag(Subscription{}, "Subscriber")
+	subscriptionOwnerKey            = bsonutil.MustHaveTag(Subscription{}, "Owner")
+	subscriptionOwnerTypeKey        = bsonutil.MustHaveTag(Subscription{}, "OwnerType")
+	subscriptionTriggerDataKey      = bsonutil.MustHaveTag(Subscription{}, "TriggerData")
+	subscriptionLastUpdatedKey      = bsonutil.MustHaveTag(Subscription{}, "LastUpdated")
+	subscriptionDigestKey           = bsonutil.MustHaveTag(Subscription{}, "Digest")
+	subscriptionDeliveryFailuresKey = bsonutil.MustHaveTag(Subscription{}, "DeliveryFailures")
+	subscriptionDisabledAtKey       = bsonutil.MustHaveTag(Subscription{}, "DisabledAt")
 
 	filterObjectKey       = bsonutil.MustHaveTag(Filter{}, "Object")
 	filterIDKey           = bsonutil.MustHaveTag(Filter{}, "ID")
@@ -48,6 +56,7 @@ var (
 	filterBuildVariantKey = bsonutil.MustHaveTag(Filter{}, "BuildVariant")
 	filterInVersionKey    = bsonutil.MustHaveTag(Filter{}, "InVersion")
 	filterInBuildKey      = bsonutil.MustHaveTag(Filter{}, "InBuild")
+	filterDistroKey       = bsonutil.MustHaveTag(Filter{}, "Distro")
 )
 
 type OwnerType string
@@ -63,6 +72,7 @@ const (
 	VersionPercentChangeKey                          = "version-percent-change"
 	TestRegexKey                                     = "test-regex"
 	RenotifyIntervalKey                              = "renotify-interval"
+	TaskQueueLatencyKey                              = "task-queue-latency-mins"
 	GeneralSubscriptionPatchOutcome                  = "patch-outcome"
 	GeneralSubscriptionPatchFirstFailure             = "patch-first-failure"
 	GeneralSubscriptionBuildBreak                    = "build-break"
@@ -94,6 +104,9 @@ const (
 	TriggerTaskStarted               = "task-started"
 	TriggerSpawnHostIdle             = "spawn-host-idle"
 	TriggerAlertableInstanceType     = "alertable-instance-type"
+	// TriggerTaskQueueLatency indicates that a scheduled task has waited in
+	// its distro's queue for longer than the subscription's threshold.
+	TriggerTaskQueueLatency = "task-queue-latency"
 )
 
 type Subscription struct {
@@ -108,6 +121,16 @@ type Subscription struct {
 	Owner          string            `bson:"owner"`
 	TriggerData    map[string]string `bson:"trigger_data,omitempty"`
 	LastUpdated    time.Time         `bson:"last_updated,omitempty"`
+	// Digest, if set, holds the subscription's notifications and sends them
+	// together in a periodic summary.
+	Digest *DigestSettings `bson:"digest,omitempty"`
+	// DeliveryFailures is the number of the subscription's notifications in a
+	// row that ran out of attempts to be sent.
+	DeliveryFailures int `bson:"delivery_failures,omitempty"`
+	// DisabledAt is set when the subscription is disabled because its
+	// notifications keep failing to be sent. Disabled subscriptions don't
+	// create notifications. Saving the subscription again enables it.
+	DisabledAt time.Time `bson:"disabled_at,omitempty"`
 }
 
 type unmarshalSubscription struct {
@@ -121,6 +144,10 @@ type unmarshalSubscription struct {
 	OwnerType      OwnerType         `bson:"owner_type"`
 	Owner          string            `bson:"owner"`
 	TriggerData    map[string]string `bson:"trigger_data,omitempty"`
+	Digest         *DigestSettings   `bson:"digest,omitempty"`
+
+	DeliveryFailures int       `bson:"delivery_failures,omitempty"`
+	DisabledAt       time.Time `bson:"disabled_at,omitempty"`
 }
 
 func (d *Subscription) UnmarshalBSON(in []byte) error {
@@ -144,6 +171,9 @@ func (s *Subscription) SetBSON(raw mgobson.Raw) error {
 	s.Owner = temp.Owner
 	s.OwnerType = temp.OwnerType
 	s.TriggerData = temp.TriggerData
+	s.Digest = temp.Digest
+	s.DeliveryFailures = temp.DeliveryFailures
+	s.DisabledAt = temp.DisabledAt
 
 	return nil
 }
@@ -175,6 +205,7 @@ type Attributes struct {
 	BuildVariant []string
 	InVersion    []string
 	InBuild      []string
+	Distro       []string
 }
 
 func (a *Attributes) filterQuery() bson.M {
@@ -201,6 +232,7 @@ func (a *Attributes) filterQuery() bson.M {
 		filterBuildVariantKey: filterForAttribute(a.BuildVariant),
 		filterInVersionKey:    filterForAttribute(a.InVersion),
 		filterInBuildKey:      filterForAttribute(a.InBuild),
+		filterDistroKey:       filterForAttribute(a.Distro),
 	}
 }
 
@@ -235,6 +267,9 @@ func (a *Attributes) isUnset() bool {
 	if len(a.InBuild) > 0 {
 		return false
 	}
+	if len(a.Distro) > 0 {
+		return false
+	}
 
 	return true
 }
@@ -272,6 +307,9 @@ func (a *Attributes) ToSelectorMap() map[string][]string {
 	if len(a.InBuild) > 0 {
 		selectorMap[SelectorInBuild] = a.InBuild
 	}
+	if len(a.Distro) > 0 {
+		selectorMap[SelectorDistro] = a.Distro
+	}
 
 	return selectorMap
 }
@@ -298,6 +336,8 @@ func (a *Attributes) valuesForSelector(selector string) ([]string, error) {
 		return a.InVersion, nil
 	case SelectorInBuild:
 		return a.InBuild, nil
+	case SelectorDistro:
+		return a.Distro, nil
 	default:
 		return nil, errors.Errorf("unknown selector '%s'", selector)
 	}
@@ -315,6 +355,7 @@ type Filter struct {
 	BuildVariant string `bson:"build_variant,omitempty"`
 	InVersion    string `bson:"in_version,omitempty"`
 	InBuild      string `bson:"in_build,omitempty"`
+	Distro       string `bson:"distro,omitempty"`
 }
 
 func (f *Filter) setFieldFromSelector(selector Selector) error {
@@ -339,6 +380,8 @@ func (f *Filter) setFieldFromSelector(selector Selector) error {
 		f.InVersion = selector.Data
 	case SelectorInBuild:
 		f.InBuild = selector.Data
+	case SelectorDistro:
+		f.Distro = selector.Data
 	default:
 		return errors.Errorf("unknown selector type '%s'", selector.Type)
 	}
@@ -384,6 +427,7 @@ const (
 	SelectorBuildVariant = "build-variant"
 	SelectorInVersion    = "in-version"
 	SelectorInBuild      = "in-build"
+	SelectorDistro       = "distro"
 )
 
 // FindSubscriptionsByAttributes finds all subscriptions of matching resourceType, and whose
@@ -393,7 +437,10 @@ func FindSubscriptionsByAttributes(ctx context.Context, resourceType string, eve
 		return nil, nil
 	}
 
-	query := bson.M{subscriptionResourceTypeKey: resourceType}
+	query := bson.M{
+		subscriptionResourceTypeKey: resourceType,
+		subscriptionDisabledAtKey:   bson.M{"$exists": false},
+	}
 	// A subscription filter specifies the event attributes it should match.
 	// If the subscription's filter specifies a field then it must match one of the corresponding trigger attribute's values.
 	for field, filter := range eventAttributes.filterQuery() {
@@ -523,6 +570,9 @@ func (s *Subscription) Upsert(ctx context.Context) error {
 	if !utility.IsZeroTime(s.LastUpdated) {
 		update[subscriptionLastUpdatedKey] = s.LastUpdated
 	}
+	if s.Digest != nil {
+		update[subscriptionDigestKey] = s.Digest
+	}
 
 	// note: this prevents changing the owner of an existing subscription, which is desired
 	c, err := db.Replace(ctx, SubscriptionsCollection, bson.M{
@@ -563,6 +613,20 @@ func FindSubscriptionByID(ctx context.Context, id string) (*Subscription, error)
 // load secret values from Parameter Store.
 func dbFindSubscriptionByID(ctx context.Context, id string) (*Subscription, error) {
 	out := Subscription{}
+	err := db.FindOneQ(ctx, SubscriptionsCollection, db.Query(bySubscriptionID(id)), &out)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching subcription by ID")
+	}
+
+	return &out, nil
+}
+
+// bySubscriptionID matches the subscription with the ID, which may be stored
+// as either a string or an ObjectId.
+func bySubscriptionID(id string) bson.M {
 	query := bson.M{
 		subscriptionIDKey: id,
 	}
@@ -576,15 +640,47 @@ func dbFindSubscriptionByID(ctx context.Context, id string) (*Subscription, erro
 			},
 		}
 	}
-	err := db.FindOneQ(ctx, SubscriptionsCollection, db.Query(query), &out)
-	if adb.ResultsNotFound(err) {
-		return nil, nil
-	}
+	return query
+}
+
+// RecordSubscriptionDeliveryFailure records that one of the subscription's
+// notifications ran out of attempts to be sent. Once
+// SubscriptionDisableThreshold notifications in a row have failed, the
+// subscription is disabled and returned. It returns nil if the subscription
+// wasn't disabled by this failure.
+func RecordSubscriptionDeliveryFailure(ctx context.Context, id string, now time.Time) (*Subscription, error) {
+	if _, err := db.UpdateAll(ctx, SubscriptionsCollection, bySubscriptionID(id), bson.M{
+		"$inc": bson.M{subscriptionDeliveryFailuresKey: 1},
+	}); err != nil {
+		return nil, errors.Wrapf(err, "recording delivery failure for subscription '%s'", id)
+	}
+
+	query := bySubscriptionID(id)
+	query[subscriptionDeliveryFailuresKey] = bson.M{"$gte": SubscriptionDisableThreshold}
+	query[subscriptionDisabledAtKey] = bson.M{"$exists": false}
+	info, err := db.UpdateAll(ctx, SubscriptionsCollection, query, bson.M{
+		"$set": bson.M{subscriptionDisabledAtKey: now.Truncate(time.Millisecond)},
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "fetching subcription by ID")
+		return nil, errors.Wrapf(err, "disabling subscription '%s'", id)
+	}
+	if info.Updated == 0 {
+		return nil, nil
 	}
 
-	return &out, nil
+	return dbFindSubscriptionByID(ctx, id)
+}
+
+// ResetSubscriptionDeliveryFailures clears the subscription's count of failed
+// notifications after one of its notifications is sent.
+func ResetSubscriptionDeliveryFailures(ctx context.Context, id string) error {
+	query := bySubscriptionID(id)
+	query[subscriptionDeliveryFailuresKey] = bson.M{"$exists": true}
+	_, err := db.UpdateAll(ctx, SubscriptionsCollection, query, bson.M{
+		"$unset": bson.M{subscriptionDeliveryFailuresKey: 1},
+	})
+
+	return errors.Wrapf(err, "resetting delivery failures for subscription '%s'", id)
 }
 
 func RemoveSubscription(ctx context.Context, id string) error {
@@ -677,6 +773,9 @@ func (s *Subscription) Validate() error {
 	catcher.Add(s.ValidateSelectors())
 	catcher.Add(s.runCustomValidation())
 	catcher.Add(s.Subscriber.Validate())
+	if s.Digest != nil {
+		catcher.Wrap(s.Digest.Validate(s.Subscriber.Type), "invalid digest settings")
+	}
 	return catcher.Resolve()
 }
 
@@ -707,6 +806,10 @@ func (s *Subscription) runCustomValidation() error {
 	if renotifyInterval, ok := s.TriggerData[RenotifyIntervalKey]; ok {
 		catcher.Wrap(validatePositiveInt(renotifyInterval), "invalid renotify interval")
 	}
+	if s.Trigger == TriggerTaskQueueLatency {
+		threshold, err := strconv.Atoi(s.TriggerData[TaskQueueLatencyKey])
+		catcher.ErrorfWhen(err != nil || threshold <= 0, "trigger '%s' requires a positive number of minutes for '%s'", TriggerTaskQueueLatency, TaskQueueLatencyKey)
+	}
 	return catcher.Resolve()
 }
 
@@ -771,6 +874,31 @@ func (s *Subscription) String() string {
 	return out.String()
 }
 
+// FindTaskQueueLatencyFilters returns the filters of the enabled task queue
+// latency subscriptions, grouped by their thresholds in minutes.
+func FindTaskQueueLatencyFilters(ctx context.Context) (map[int][]Filter, error) {
+	query := db.Query(bson.M{
+		subscriptionResourceTypeKey: ResourceTypeTask,
+		subscriptionTriggerKey:      TriggerTaskQueueLatency,
+		subscriptionDisabledAtKey:   bson.M{"$exists": false},
+	}).WithFields(subscriptionTriggerDataKey, subscriptionFilterKey)
+	subscriptions := []Subscription{}
+	if err := db.FindAllQ(ctx, SubscriptionsCollection, query, &subscriptions); err != nil {
+		return nil, errors.Wrap(err, "finding task queue latency subscriptions")
+	}
+
+	filtersByThreshold := map[int][]Filter{}
+	for _, sub := range subscriptions {
+		threshold, err := strconv.Atoi(sub.TriggerData[TaskQueueLatencyKey])
+		if err != nil || threshold <= 0 {
+			continue
+		}
+		filtersByThreshold[threshold] = append(filtersByThreshold[threshold], sub.Filter)
+	}
+
+	return filtersByThreshold, nil
+}
+
 func FindSubscriptionsByOwner(ctx context.Context, owner string, ownerType OwnerType) ([]Subscription, error) {
 	if len(owner) == 0 {
 		return nil, nil
@@ -1,6 +1,7 @@
 package event
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -194,6 +195,7 @@ func (s *subscriptionsSuite) TestAttributesFilterQuery() {
 			filterBuildVariantKey: nil,
 			filterInVersionKey:    nil,
 			filterInBuildKey:      nil,
+			filterDistroKey:       nil,
 		}, a.filterQuery())
 	})
 
@@ -222,6 +224,7 @@ func (s *subscriptionsSuite) TestAttributesFilterQuery() {
 			filterBuildVariantKey: nil,
 			filterInVersionKey:    nil,
 			filterInBuildKey:      nil,
+			filterDistroKey:       nil,
 		}, a.filterQuery())
 	})
 }
@@ -315,6 +318,161 @@ func (s *subscriptionsSuite) TestFindSubscriptionsByAttributes() {
 	})
 }
 
+func (s *subscriptionsSuite) TestFindSubscriptionsByDistro() {
+	sub := Subscription{
+		ID:           "distro-sub",
+		ResourceType: ResourceTypeTask,
+		Trigger:      TriggerTaskQueueLatency,
+		Selectors:    []Selector{{Type: SelectorDistro, Data: "distro1"}},
+		Filter:       Filter{Distro: "distro1"},
+		Subscriber: Subscriber{
+			Type:   EmailSubscriberType,
+			Target: "someone@example.com",
+		},
+		Owner:       "me",
+		OwnerType:   OwnerTypePerson,
+		TriggerData: map[string]string{TaskQueueLatencyKey: "30"},
+	}
+	s.Require().NoError(sub.Upsert(s.T().Context()))
+
+	subs, err := FindSubscriptionsByAttributes(s.T().Context(), ResourceTypeTask, Attributes{
+		Project: []string{"project"},
+		Distro:  []string{"distro1"},
+	})
+	s.NoError(err)
+	s.Require().Len(subs, 1)
+	s.Equal(sub.ID, subs[0].ID)
+
+	subs, err = FindSubscriptionsByAttributes(s.T().Context(), ResourceTypeTask, Attributes{
+		Project: []string{"project"},
+		Distro:  []string{"distro2"},
+	})
+	s.NoError(err)
+	s.Empty(subs)
+}
+
+func (s *subscriptionsSuite) TestFindTaskQueueLatencyFilters() {
+	s.Run("NoSubscriptions", func() {
+		filters, err := FindTaskQueueLatencyFilters(s.T().Context())
+		s.NoError(err)
+		s.Empty(filters)
+	})
+
+	s.Run("GroupsFiltersByThreshold", func() {
+		for i, tc := range []struct {
+			threshold string
+			filter    Filter
+		}{
+			{threshold: "60", filter: Filter{Project: "project1"}},
+			{threshold: "15", filter: Filter{Distro: "distro"}},
+			{threshold: "60", filter: Filter{Project: "project2"}},
+			{threshold: "invalid", filter: Filter{Project: "project3"}},
+		} {
+			sub := Subscription{
+				ID:           fmt.Sprintf("queue-latency-%d", i),
+				ResourceType: ResourceTypeTask,
+				Trigger:      TriggerTaskQueueLatency,
+				Filter:       tc.filter,
+				Subscriber: Subscriber{
+					Type:   EmailSubscriberType,
+					Target: "someone@example.com",
+				},
+				Owner:       "project",
+				OwnerType:   OwnerTypeProject,
+				TriggerData: map[string]string{TaskQueueLatencyKey: tc.threshold},
+			}
+			s.Require().NoError(sub.Upsert(s.T().Context()))
+		}
+		disabled := Subscription{
+			ID:           "disabled",
+			ResourceType: ResourceTypeTask,
+			Trigger:      TriggerTaskQueueLatency,
+			Filter:       Filter{Project: "disabled"},
+			Subscriber: Subscriber{
+				Type:   EmailSubscriberType,
+				Target: "someone@example.com",
+			},
+			Owner:       "project",
+			OwnerType:   OwnerTypeProject,
+			TriggerData: map[string]string{TaskQueueLatencyKey: "15"},
+		}
+		s.Require().NoError(disabled.Upsert(s.T().Context()))
+		s.Require().NoError(db.UpdateId(s.T().Context(), SubscriptionsCollection, disabled.ID, bson.M{"$set": bson.M{subscriptionDisabledAtKey: time.Now()}}))
+
+		filters, err := FindTaskQueueLatencyFilters(s.T().Context())
+		s.NoError(err)
+		s.Len(filters, 2)
+		s.ElementsMatch([]Filter{{Project: "project1"}, {Project: "project2"}}, filters[60])
+		s.ElementsMatch([]Filter{{Distro: "distro"}}, filters[15])
+	})
+}
+
+func (s *subscriptionsSuite) TestValidateTaskQueueLatency() {
+	sub := Subscription{
+		ResourceType: ResourceTypeTask,
+		Trigger:      TriggerTaskQueueLatency,
+		Selectors:    []Selector{{Type: SelectorDistro, Data: "distro"}},
+		Filter:       Filter{Distro: "distro"},
+		Subscriber: Subscriber{
+			Type:   EmailSubscriberType,
+			Target: "someone@example.com",
+		},
+		Owner:     "me",
+		OwnerType: OwnerTypePerson,
+	}
+	s.Error(sub.Validate(), "threshold is required")
+
+	sub.TriggerData = map[string]string{TaskQueueLatencyKey: "0"}
+	s.Error(sub.Validate(), "threshold must be positive")
+
+	sub.TriggerData = map[string]string{TaskQueueLatencyKey: "45"}
+	s.NoError(sub.Validate())
+}
+
+func (s *subscriptionsSuite) TestRecordSubscriptionDeliveryFailure() {
+	id := s.subscriptions[3].ID
+	attributes := Attributes{Object: []string{"somethingspecial"}}
+	for i := 1; i < SubscriptionDisableThreshold; i++ {
+		disabled, err := RecordSubscriptionDeliveryFailure(s.T().Context(), id, s.now)
+		s.Require().NoError(err)
+		s.Nil(disabled)
+	}
+
+	s.Require().NoError(ResetSubscriptionDeliveryFailures(s.T().Context(), id))
+	sub, err := FindSubscriptionByID(s.T().Context(), id)
+	s.Require().NoError(err)
+	s.Require().NotNil(sub)
+	s.Zero(sub.DeliveryFailures)
+
+	for i := 1; i < SubscriptionDisableThreshold; i++ {
+		disabled, err := RecordSubscriptionDeliveryFailure(s.T().Context(), id, s.now)
+		s.Require().NoError(err)
+		s.Nil(disabled)
+	}
+	disabled, err := RecordSubscriptionDeliveryFailure(s.T().Context(), id, s.now)
+	s.Require().NoError(err)
+	s.Require().NotNil(disabled)
+	s.Equal(id, disabled.ID)
+	s.Equal(SubscriptionDisableThreshold, disabled.DeliveryFailures)
+	s.True(s.now.Equal(disabled.DisabledAt))
+
+	// The subscription is only returned by the failure that disabled it.
+	disabled, err = RecordSubscriptionDeliveryFailure(s.T().Context(), id, s.now)
+	s.Require().NoError(err)
+	s.Nil(disabled)
+
+	subs, err := FindSubscriptionsByAttributes(s.T().Context(), "type2", attributes)
+	s.Require().NoError(err)
+	s.Require().Len(subs, 1)
+	s.Equal(s.subscriptions[4].ID, subs[0].ID)
+
+	// Saving the subscription again re-enables it.
+	s.Require().NoError(s.subscriptions[3].Upsert(s.T().Context()))
+	subs, err = FindSubscriptionsByAttributes(s.T().Context(), "type2", attributes)
+	s.Require().NoError(err)
+	s.Len(subs, 2)
+}
+
 func (s *subscriptionsSuite) TestFilterRegexSelectors() {
 	eventAttributes := Attributes{
 		Object: []string{"apple"},
@@ -15,6 +15,7 @@ func init() {
 	registry.AllowSubscription(ResourceTypeTask, TaskStarted)
 	registry.AllowSubscription(ResourceTypeTask, TaskFinished)
 	registry.AllowSubscription(ResourceTypeTask, TaskBlocked)
+	registry.AllowSubscription(ResourceTypeTask, TaskQueueLatencyExceeded)
 }
 
 const (
@@ -35,6 +36,7 @@ const (
 	TaskPriorityChanged        = "TASK_PRIORITY_CHANGED"
 	TaskJiraAlertCreated       = "TASK_JIRA_ALERT_CREATED"
 	TaskDependenciesOverridden = "TASK_DEPENDENCIES_OVERRIDDEN"
+	TaskQueueLatencyExceeded   = "TASK_QUEUE_LATENCY_EXCEEDED"
 )
 
 // implements Data
@@ -48,6 +50,10 @@ type TaskEventData struct {
 
 	Timestamp time.Time `bson:"ts,omitempty" json:"timestamp,omitempty"`
 	Priority  int64     `bson:"pri,omitempty" json:"priority,omitempty"`
+
+	// QueueLatencyMins is the threshold, in minutes, that the task's time in
+	// the queue exceeded.
+	QueueLatencyMins int `bson:"queue_latency_mins,omitempty" json:"queue_latency_mins,omitempty"`
 }
 
 func logTaskEvent(ctx context.Context, taskId string, eventType string, eventData TaskEventData) {
@@ -222,3 +228,10 @@ func LogTaskDependenciesOverridden(ctx context.Context, taskId string, execution
 	logTaskEvent(ctx, taskId, TaskDependenciesOverridden,
 		TaskEventData{Execution: execution, UserId: userID})
 }
+
+// LogTaskQueueLatencyExceeded updates the DB with an event for a scheduled
+// task that has waited in the queue for longer than the threshold.
+func LogTaskQueueLatencyExceeded(ctx context.Context, taskId string, execution int, thresholdMins int) {
+	logTaskEvent(ctx, taskId, TaskQueueLatencyExceeded,
+		TaskEventData{Execution: execution, QueueLatencyMins: thresholdMins})
+}
@@ -0,0 +1,103 @@
+package flakytest
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	IDKey              = bsonutil.MustHaveTag(AutoQuarantinedTest{}, "ID")
+	ProjectKey         = bsonutil.MustHaveTag(AutoQuarantinedTest{}, "Project")
+	TestKeyKey         = bsonutil.MustHaveTag(AutoQuarantinedTest{}, "TestKey")
+	QuarantinedKey     = bsonutil.MustHaveTag(AutoQuarantinedTest{}, "Quarantined")
+	UnquarantinedAtKey = bsonutil.MustHaveTag(AutoQuarantinedTest{}, "UnquarantinedAt")
+	StableStreakKey    = bsonutil.MustHaveTag(AutoQuarantinedTest{}, "StableStreak")
+	LastCheckedAtKey   = bsonutil.MustHaveTag(AutoQuarantinedTest{}, "LastCheckedAt")
+)
+
+// ByID returns a query for the auto-quarantined test with the given ID.
+func ByID(id string) db.Q {
+	return db.Query(bson.M{IDKey: id})
+}
+
+// ByProject returns a query for all auto-quarantined tests in the project.
+func ByProject(project string) db.Q {
+	return db.Query(bson.M{ProjectKey: project})
+}
+
+// QuarantinedByProject returns a query for the tests in the project that are
+// still automatically quarantined.
+func QuarantinedByProject(project string) db.Q {
+	return db.Query(bson.M{
+		ProjectKey:     project,
+		QuarantinedKey: true,
+	})
+}
+
+// FindOne gets one AutoQuarantinedTest for the given query.
+func FindOne(ctx context.Context, query db.Q) (*AutoQuarantinedTest, error) {
+	t := &AutoQuarantinedTest{}
+	err := db.FindOneQ(ctx, Collection, query, t)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// Find gets every AutoQuarantinedTest matching the given query.
+func Find(ctx context.Context, query db.Q) ([]AutoQuarantinedTest, error) {
+	tests := []AutoQuarantinedTest{}
+	err := db.FindAllQ(ctx, Collection, query, &tests)
+	return tests, err
+}
+
+// Upsert inserts the auto-quarantined test or replaces the existing record
+// for the same test, such as when a previously unquarantined test is
+// quarantined again.
+func (t *AutoQuarantinedTest) Upsert(ctx context.Context) error {
+	t.ID = AutoQuarantinedTestID(t.Project, t.TestKey)
+	_, err := db.Replace(ctx, Collection, bson.M{IDKey: t.ID}, t)
+	return errors.Wrap(err, "upserting auto-quarantined test")
+}
+
+// UpdateStableStreak sets the test's stable streak and the finish time of the
+// latest task checked for it.
+func (t *AutoQuarantinedTest) UpdateStableStreak(ctx context.Context, streak int, lastCheckedAt time.Time) error {
+	if err := db.Update(ctx, Collection,
+		bson.M{IDKey: t.ID},
+		bson.M{"$set": bson.M{
+			StableStreakKey:  streak,
+			LastCheckedAtKey: lastCheckedAt,
+		}},
+	); err != nil {
+		return errors.Wrap(err, "updating stable streak")
+	}
+
+	t.StableStreak = streak
+	t.LastCheckedAt = lastCheckedAt
+	return nil
+}
+
+// MarkUnquarantined records that the test is no longer quarantined.
+func (t *AutoQuarantinedTest) MarkUnquarantined(ctx context.Context) error {
+	now := time.Now()
+	if err := db.Update(ctx, Collection,
+		bson.M{IDKey: t.ID},
+		bson.M{"$set": bson.M{
+			QuarantinedKey:     false,
+			UnquarantinedAtKey: now,
+		}},
+	); err != nil {
+		return errors.Wrap(err, "marking test unquarantined")
+	}
+
+	t.Quarantined = false
+	t.UnquarantinedAt = now
+	return nil
+}
@@ -0,0 +1,4 @@
+// Package flakytest detects tests that flip between passing and failing across
+// executions of the same task and tracks the tests that have been
+// automatically quarantined because of it.
+package flakytest
@@ -0,0 +1,213 @@
+package flakytest
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+)
+
+const Collection = "auto_quarantined_tests"
+
+// TestKey identifies a test within a task in a build variant.
+type TestKey struct {
+	BuildVariant string `bson:"build_variant" json:"build_variant"`
+	TaskName     string `bson:"task_name" json:"task_name"`
+	TestName     string `bson:"test_name" json:"test_name"`
+}
+
+// TaskRun is every execution of a single task, so the executions all ran the
+// same task at the same revision.
+type TaskRun struct {
+	BuildVariant string
+	TaskName     string
+	// FinishTime is when the latest execution of the task finished.
+	FinishTime time.Time
+	// Executions are the test results of each execution of the task, ordered
+	// by execution.
+	Executions [][]testresult.TestResult
+}
+
+// Score summarizes how often a test flipped between passing and failing when
+// the same task ran multiple times.
+type Score struct {
+	TestKey
+	// Runs is the number of task runs in which the test ran in more than one
+	// execution.
+	Runs int
+	// FlakyRuns is the number of task runs in which the test both passed and
+	// failed.
+	FlakyRuns int
+	// Flips is the number of times the test's outcome changed from one
+	// execution to the next.
+	Flips int
+	// Transitions is the number of pairs of consecutive executions in which
+	// the test ran.
+	Transitions int
+}
+
+// Flakiness returns the fraction of consecutive executions in which the test's
+// outcome flipped, from 0 for a test whose outcome never changed to 1 for a
+// test whose outcome changed every time it was rerun.
+func (s Score) Flakiness() float64 {
+	if s.Transitions == 0 {
+		return 0
+	}
+	return float64(s.Flips) / float64(s.Transitions)
+}
+
+// ScoreTests scores the flakiness of every test that ran in more than one
+// execution of any of the given task runs. Scores are sorted by build variant,
+// task name and test name.
+func ScoreTests(runs []TaskRun) []Score {
+	scores := map[TestKey]*Score{}
+	for _, run := range runs {
+		outcomes := map[string][]bool{}
+		for _, results := range run.Executions {
+			for testName, passed := range executionOutcomes(results) {
+				outcomes[testName] = append(outcomes[testName], passed)
+			}
+		}
+
+		for testName, testOutcomes := range outcomes {
+			if len(testOutcomes) < 2 {
+				continue
+			}
+
+			key := TestKey{BuildVariant: run.BuildVariant, TaskName: run.TaskName, TestName: testName}
+			score, ok := scores[key]
+			if !ok {
+				score = &Score{TestKey: key}
+				scores[key] = score
+			}
+
+			score.Runs++
+			var passed, failed bool
+			for i, outcome := range testOutcomes {
+				passed = passed || outcome
+				failed = failed || !outcome
+				if i == 0 {
+					continue
+				}
+				score.Transitions++
+				if outcome != testOutcomes[i-1] {
+					score.Flips++
+				}
+			}
+			if passed && failed {
+				score.FlakyRuns++
+			}
+		}
+	}
+
+	sorted := make([]Score, 0, len(scores))
+	for _, score := range scores {
+		sorted = append(sorted, *score)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].BuildVariant != sorted[j].BuildVariant {
+			return sorted[i].BuildVariant < sorted[j].BuildVariant
+		}
+		if sorted[i].TaskName != sorted[j].TaskName {
+			return sorted[i].TaskName < sorted[j].TaskName
+		}
+		return sorted[i].TestName < sorted[j].TestName
+	})
+
+	return sorted
+}
+
+// ScoreTestSince scores the flakiness of a single test using only the task
+// runs that finished after the given time.
+func ScoreTestSince(runs []TaskRun, key TestKey, since time.Time) Score {
+	var recentRuns []TaskRun
+	for _, run := range runs {
+		if run.BuildVariant == key.BuildVariant && run.TaskName == key.TaskName && run.FinishTime.After(since) {
+			recentRuns = append(recentRuns, run)
+		}
+	}
+	for _, score := range ScoreTests(recentRuns) {
+		if score.TestKey == key {
+			return score
+		}
+	}
+	return Score{TestKey: key}
+}
+
+// executionOutcomes returns whether each test in a single execution passed.
+// Skipped tests are left out. A test that reported multiple results in the
+// same execution is considered to have failed if any of its results failed.
+func executionOutcomes(results []testresult.TestResult) map[string]bool {
+	outcomes := map[string]bool{}
+	for _, result := range results {
+		passed, ok := TestPassed(result.Status)
+		if !ok {
+			continue
+		}
+		if prev, seen := outcomes[result.TestName]; seen {
+			passed = passed && prev
+		}
+		outcomes[result.TestName] = passed
+	}
+	return outcomes
+}
+
+// TestPassed returns whether a test result status is a pass. It returns false
+// for ok if the status is neither a pass nor a failure, such as a skipped test.
+func TestPassed(status string) (passed bool, ok bool) {
+	switch status {
+	case evergreen.TestSucceededStatus:
+		return true, true
+	case evergreen.TestFailedStatus, evergreen.TestSilentlyFailedStatus, evergreen.TestTimedOutStatus:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// AutoQuarantinedTest is a test that was automatically quarantined because it
+// was detected as flaky. The record is kept after the test is unquarantined so
+// that the flips that got it quarantined don't get it quarantined again.
+type AutoQuarantinedTest struct {
+	ID      string  `bson:"_id" json:"id"`
+	Project string  `bson:"project" json:"project"`
+	TestKey TestKey `bson:"test_key" json:"test_key"`
+	// Quarantined is whether the test is still quarantined.
+	Quarantined bool `bson:"quarantined" json:"quarantined"`
+	// Flakiness is the test's flakiness score when it was quarantined.
+	Flakiness       float64   `bson:"flakiness" json:"flakiness"`
+	QuarantinedAt   time.Time `bson:"quarantined_at" json:"quarantined_at"`
+	UnquarantinedAt time.Time `bson:"unquarantined_at,omitempty" json:"unquarantined_at,omitempty"`
+	// StableStreak is the number of consecutive runs the test has passed
+	// since it was quarantined.
+	StableStreak int `bson:"stable_streak" json:"stable_streak"`
+	// LastCheckedAt is the finish time of the latest task that was checked
+	// for the test's stable streak.
+	LastCheckedAt time.Time `bson:"last_checked_at" json:"last_checked_at"`
+}
+
+// AutoQuarantinedTestID returns the ID of the auto-quarantined test record for
+// the given test in the project.
+func AutoQuarantinedTestID(project string, key TestKey) string {
+	hash := sha1.New()
+	_, _ = io.WriteString(hash, project)
+	_, _ = io.WriteString(hash, key.BuildVariant)
+	_, _ = io.WriteString(hash, key.TaskName)
+	_, _ = io.WriteString(hash, key.TestName)
+
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+// RecordResult updates the test's stable streak with the outcome of one run
+// of the test.
+func (t *AutoQuarantinedTest) RecordResult(passed bool) {
+	if passed {
+		t.StableStreak++
+	} else {
+		t.StableStreak = 0
+	}
+}
@@ -0,0 +1,199 @@
+package flakytest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	_ "github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeResults(statuses map[string]string) []testresult.TestResult {
+	results := make([]testresult.TestResult, 0, len(statuses))
+	for name, status := range statuses {
+		results = append(results, testresult.TestResult{TestName: name, Status: status})
+	}
+	return results
+}
+
+func TestScoreTests(t *testing.T) {
+	t.Run("ScoresFlipsAcrossExecutions", func(t *testing.T) {
+		runs := []TaskRun{
+			{
+				BuildVariant: "ubuntu",
+				TaskName:     "unit",
+				Executions: [][]testresult.TestResult{
+					makeResults(map[string]string{"flaky": evergreen.TestFailedStatus, "stable": evergreen.TestSucceededStatus, "broken": evergreen.TestFailedStatus}),
+					makeResults(map[string]string{"flaky": evergreen.TestSucceededStatus, "stable": evergreen.TestSucceededStatus, "broken": evergreen.TestFailedStatus}),
+					makeResults(map[string]string{"flaky": evergreen.TestFailedStatus, "stable": evergreen.TestSucceededStatus, "broken": evergreen.TestTimedOutStatus}),
+				},
+			},
+		}
+
+		scores := ScoreTests(runs)
+		require.Len(t, scores, 3)
+
+		assert.Equal(t, "broken", scores[0].TestName)
+		assert.Zero(t, scores[0].Flips)
+		assert.Zero(t, scores[0].FlakyRuns)
+		assert.Zero(t, scores[0].Flakiness())
+
+		assert.Equal(t, "flaky", scores[1].TestName)
+		assert.Equal(t, "ubuntu", scores[1].BuildVariant)
+		assert.Equal(t, "unit", scores[1].TaskName)
+		assert.Equal(t, 1, scores[1].Runs)
+		assert.Equal(t, 1, scores[1].FlakyRuns)
+		assert.Equal(t, 2, scores[1].Flips)
+		assert.Equal(t, 2, scores[1].Transitions)
+		assert.InDelta(t, 1, scores[1].Flakiness(), 0.001)
+
+		assert.Equal(t, "stable", scores[2].TestName)
+		assert.Zero(t, scores[2].Flakiness())
+	})
+	t.Run("CombinesRunsOfTheSameTask", func(t *testing.T) {
+		runs := []TaskRun{
+			{
+				BuildVariant: "ubuntu",
+				TaskName:     "unit",
+				Executions: [][]testresult.TestResult{
+					makeResults(map[string]string{"test": evergreen.TestFailedStatus}),
+					makeResults(map[string]string{"test": evergreen.TestSucceededStatus}),
+				},
+			},
+			{
+				BuildVariant: "ubuntu",
+				TaskName:     "unit",
+				Executions: [][]testresult.TestResult{
+					makeResults(map[string]string{"test": evergreen.TestSucceededStatus}),
+					makeResults(map[string]string{"test": evergreen.TestSucceededStatus}),
+					makeResults(map[string]string{"test": evergreen.TestSucceededStatus}),
+				},
+			},
+		}
+
+		scores := ScoreTests(runs)
+		require.Len(t, scores, 1)
+		assert.Equal(t, 2, scores[0].Runs)
+		assert.Equal(t, 1, scores[0].FlakyRuns)
+		assert.Equal(t, 1, scores[0].Flips)
+		assert.Equal(t, 3, scores[0].Transitions)
+		assert.InDelta(t, 1.0/3, scores[0].Flakiness(), 0.001)
+	})
+	t.Run("IgnoresSkippedTestsAndSingleExecutions", func(t *testing.T) {
+		runs := []TaskRun{
+			{
+				BuildVariant: "ubuntu",
+				TaskName:     "unit",
+				Executions: [][]testresult.TestResult{
+					makeResults(map[string]string{"skipped": evergreen.TestSkippedStatus, "once": evergreen.TestFailedStatus}),
+					makeResults(map[string]string{"skipped": evergreen.TestSucceededStatus}),
+				},
+			},
+		}
+
+		assert.Empty(t, ScoreTests(runs))
+	})
+	t.Run("TreatsAnyFailureInAnExecutionAsFailed", func(t *testing.T) {
+		runs := []TaskRun{
+			{
+				BuildVariant: "ubuntu",
+				TaskName:     "unit",
+				Executions: [][]testresult.TestResult{
+					{
+						{TestName: "test", Status: evergreen.TestFailedStatus},
+						{TestName: "test", Status: evergreen.TestSucceededStatus},
+					},
+					{
+						{TestName: "test", Status: evergreen.TestSucceededStatus},
+					},
+				},
+			},
+		}
+
+		scores := ScoreTests(runs)
+		require.Len(t, scores, 1)
+		assert.Equal(t, 1, scores[0].Flips)
+	})
+}
+
+func TestScoreTestSince(t *testing.T) {
+	now := time.Now()
+	flakyRun := func(finishTime time.Time) TaskRun {
+		return TaskRun{
+			BuildVariant: "ubuntu",
+			TaskName:     "unit",
+			FinishTime:   finishTime,
+			Executions: [][]testresult.TestResult{
+				makeResults(map[string]string{"test": evergreen.TestFailedStatus}),
+				makeResults(map[string]string{"test": evergreen.TestSucceededStatus}),
+			},
+		}
+	}
+	runs := []TaskRun{flakyRun(now.Add(-2 * time.Hour)), flakyRun(now)}
+	key := TestKey{BuildVariant: "ubuntu", TaskName: "unit", TestName: "test"}
+
+	assert.Equal(t, 2, ScoreTestSince(runs, key, now.Add(-3*time.Hour)).Flips)
+	assert.Equal(t, 1, ScoreTestSince(runs, key, now.Add(-time.Hour)).Flips)
+
+	score := ScoreTestSince(runs, key, now)
+	assert.Equal(t, key, score.TestKey)
+	assert.Zero(t, score.Flips)
+}
+
+func TestAutoQuarantinedTest(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+	ctx := t.Context()
+
+	quarantinedTest := &AutoQuarantinedTest{
+		Project:       "project",
+		TestKey:       TestKey{BuildVariant: "ubuntu", TaskName: "unit", TestName: "test"},
+		Quarantined:   true,
+		Flakiness:     0.5,
+		QuarantinedAt: time.Now(),
+	}
+	require.NoError(t, quarantinedTest.Upsert(ctx))
+	assert.Equal(t, AutoQuarantinedTestID("project", quarantinedTest.TestKey), quarantinedTest.ID)
+
+	quarantined, err := Find(ctx, QuarantinedByProject("project"))
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	assert.Equal(t, quarantinedTest.TestKey, quarantined[0].TestKey)
+
+	quarantinedTest.RecordResult(true)
+	quarantinedTest.RecordResult(true)
+	checkedAt := time.Now().Round(time.Millisecond)
+	require.NoError(t, quarantinedTest.UpdateStableStreak(ctx, quarantinedTest.StableStreak, checkedAt))
+	require.NoError(t, quarantinedTest.MarkUnquarantined(ctx))
+
+	dbTest, err := FindOne(ctx, ByID(quarantinedTest.ID))
+	require.NoError(t, err)
+	require.NotNil(t, dbTest)
+	assert.False(t, dbTest.Quarantined)
+	assert.False(t, dbTest.UnquarantinedAt.IsZero())
+	assert.Equal(t, 2, dbTest.StableStreak)
+	assert.True(t, checkedAt.Equal(dbTest.LastCheckedAt))
+
+	quarantined, err = Find(ctx, QuarantinedByProject("project"))
+	require.NoError(t, err)
+	assert.Empty(t, quarantined)
+
+	all, err := Find(ctx, ByProject("project"))
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+}
+
+func TestRecordResult(t *testing.T) {
+	quarantinedTest := AutoQuarantinedTest{}
+	quarantinedTest.RecordResult(true)
+	quarantinedTest.RecordResult(true)
+	assert.Equal(t, 2, quarantinedTest.StableStreak)
+	quarantinedTest.RecordResult(false)
+	assert.Zero(t, quarantinedTest.StableStreak)
+}
@@ -303,6 +303,23 @@ func CountActiveHostsInDistro(ctx context.Context, distroID string) (int, error)
 	return num, errors.Wrap(err, "counting active task hosts in distro")
 }
 
+// CountRunningTasksByProject returns the number of up task hosts in the
+// distro that are running a task, keyed by the project of the running task.
+func CountRunningTasksByProject(ctx context.Context, distroID string) (map[string]int, error) {
+	query := ByDistroIDs(distroID)
+	query[RunningTaskKey] = bson.M{"$exists": true}
+	hosts, err := Find(ctx, query, options.Find().SetProjection(bson.M{RunningTaskProjectKey: 1}))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding hosts running tasks in distro")
+	}
+
+	counts := map[string]int{}
+	for _, h := range hosts {
+		counts[h.RunningTaskProject]++
+	}
+	return counts, nil
+}
+
 // CountHostsCanRunTasksByDistro returns the number of hosts per distro that
 // can accept and run tasks, using a single aggregation over all distros at
 // once. The returned map is keyed by distro ID.
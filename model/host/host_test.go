@@ -1142,6 +1142,29 @@ func TestNumHostsByTaskSpec(t *testing.T) {
 	})
 }
 
+func TestCountRunningTasksByProject(t *testing.T) {
+	require.NoError(t, db.Clear(Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(Collection))
+	}()
+
+	hosts := []Host{
+		{Id: "h1", Distro: distro.Distro{Id: "d1"}, Status: evergreen.HostRunning, StartedBy: evergreen.User, RunningTask: "t1", RunningTaskProject: "p1"},
+		{Id: "h2", Distro: distro.Distro{Id: "d1"}, Status: evergreen.HostRunning, StartedBy: evergreen.User, RunningTask: "t2", RunningTaskProject: "p1"},
+		{Id: "h3", Distro: distro.Distro{Id: "d1"}, Status: evergreen.HostRunning, StartedBy: evergreen.User, RunningTask: "t3", RunningTaskProject: "p2"},
+		{Id: "idle", Distro: distro.Distro{Id: "d1"}, Status: evergreen.HostRunning, StartedBy: evergreen.User},
+		{Id: "other_distro", Distro: distro.Distro{Id: "d2"}, Status: evergreen.HostRunning, StartedBy: evergreen.User, RunningTask: "t4", RunningTaskProject: "p1"},
+		{Id: "terminated", Distro: distro.Distro{Id: "d1"}, Status: evergreen.HostTerminated, StartedBy: evergreen.User, RunningTask: "t5", RunningTaskProject: "p1"},
+	}
+	for _, h := range hosts {
+		require.NoError(t, h.Insert(t.Context()))
+	}
+
+	counts, err := CountRunningTasksByProject(t.Context(), "d1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"p1": 2, "p2": 1}, counts)
+}
+
 func TestUpsert(t *testing.T) {
 	ctx := t.Context()
 
@@ -26,6 +26,10 @@ var (
 	subscriberKey = bsonutil.MustHaveTag(Notification{}, "Subscriber")
 	sentAtKey     = bsonutil.MustHaveTag(Notification{}, "SentAt")
 	errorKey      = bsonutil.MustHaveTag(Notification{}, "Error")
+	digestKey     = bsonutil.MustHaveTag(Notification{}, "Digest")
+	retryKey      = bsonutil.MustHaveTag(Notification{}, "Retry")
+
+	subscriberTypeKey = bsonutil.MustHaveTag(event.Subscriber{}, "Type")
 )
 
 type unmarshalNotification struct {
@@ -33,9 +37,12 @@ type unmarshalNotification struct {
 	Subscriber event.Subscriber `bson:"subscriber"`
 	Payload    mgobson.Raw      `bson:"payload"`
 
-	SentAt   time.Time            `bson:"sent_at,omitempty"`
-	Error    string               `bson:"error,omitempty"`
-	Metadata NotificationMetadata `bson:"metadata,omitempty"`
+	SentAt         time.Time            `bson:"sent_at,omitempty"`
+	Error          string               `bson:"error,omitempty"`
+	Metadata       NotificationMetadata `bson:"metadata,omitempty"`
+	Digest         *DigestInfo          `bson:"digest,omitempty"`
+	SubscriptionID string               `bson:"subscription_id,omitempty"`
+	Retry          *RetryInfo           `bson:"retry,omitempty"`
 }
 
 func (d *Notification) UnmarshalBSON(in []byte) error {
@@ -49,7 +56,7 @@ func (n *Notification) SetBSON(raw mgobson.Raw) error {
 	}
 
 	switch temp.Subscriber.Type {
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.ChatWebhookSubscriberType:
 		n.Payload = &util.EvergreenWebhook{}
 
 	case event.EmailSubscriberType:
@@ -81,6 +88,9 @@ func (n *Notification) SetBSON(raw mgobson.Raw) error {
 	n.SentAt = temp.SentAt
 	n.Error = temp.Error
 	n.Metadata = temp.Metadata
+	n.Digest = temp.Digest
+	n.SubscriptionID = temp.SubscriptionID
+	n.Retry = temp.Retry
 
 	return nil
 }
@@ -122,9 +132,11 @@ func FindByEventID(ctx context.Context, id string) ([]Notification, error) {
 	return notifications, err
 }
 
+// FindUnprocessed finds the notifications that haven't been sent, excluding
+// notifications held for a digest, waiting to be retried, or dead lettered.
 func FindUnprocessed(ctx context.Context) ([]Notification, error) {
 	notifications := []Notification{}
-	err := db.FindAllQ(ctx, Collection, db.Query(bson.M{sentAtKey: bson.M{"$exists": false}}), &notifications)
+	err := db.FindAllQ(ctx, Collection, db.Query(unprocessedQuery(time.Now())), &notifications)
 
 	return notifications, errors.Wrap(err, "finding unprocessed notifications")
 }
@@ -0,0 +1,244 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"html/template"
+	"maps"
+	"slices"
+	"strings"
+	ttemplate "text/template"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// DigestInfo describes a notification that is held to be sent as part of a
+// subscriber's digest rather than on its own.
+type DigestInfo struct {
+	SubscriptionID string    `bson:"subscription_id"`
+	SendAfter      time.Time `bson:"send_after"`
+	Project        string    `bson:"project,omitempty"`
+	Version        string    `bson:"version,omitempty"`
+	// Summary is the one line description of the notification that appears
+	// in the digest.
+	Summary string `bson:"summary"`
+	// DigestID is the ID of the digest notification that the notification
+	// was sent in.
+	DigestID string `bson:"digest_id,omitempty"`
+}
+
+var (
+	digestSendAfterKey = bsonutil.MustHaveTag(DigestInfo{}, "SendAfter")
+	digestIDKey        = bsonutil.MustHaveTag(DigestInfo{}, "DigestID")
+)
+
+const (
+	digestNotificationIDPrefix = "digest-"
+	digestUnknownProject       = "(no project)"
+	digestUnknownVersion       = "(no version)"
+)
+
+// HoldForDigest marks the notification to be held until the next time the
+// subscription's digest is sent.
+func (n *Notification) HoldForDigest(subscriptionID string, settings *event.DigestSettings, attributes event.Attributes, now time.Time) error {
+	sendAfter, err := settings.NextSendTime(now)
+	if err != nil {
+		return errors.Wrap(err, "getting next digest send time")
+	}
+	summary, err := n.digestSummary()
+	if err != nil {
+		return errors.Wrap(err, "getting digest summary")
+	}
+
+	info := DigestInfo{
+		SubscriptionID: subscriptionID,
+		SendAfter:      sendAfter,
+		Summary:        summary,
+	}
+	if len(attributes.Project) > 0 {
+		info.Project = attributes.Project[0]
+	}
+	if len(attributes.InVersion) > 0 {
+		info.Version = attributes.InVersion[0]
+	} else if slices.Contains(attributes.Object, event.ObjectVersion) && len(attributes.ID) > 0 {
+		info.Version = attributes.ID[0]
+	}
+	n.Digest = &info
+
+	return nil
+}
+
+// digestSummary returns the line that describes the notification in a digest.
+func (n *Notification) digestSummary() (string, error) {
+	switch payload := n.Payload.(type) {
+	case *message.Email:
+		return payload.Subject, nil
+	case *SlackPayload:
+		return payload.Body, nil
+	default:
+		return "", errors.Errorf("digests are not supported for payload type %T", n.Payload)
+	}
+}
+
+// FindDueForDigest finds the held notifications whose digests are due to be
+// sent.
+func FindDueForDigest(ctx context.Context, now time.Time) ([]Notification, error) {
+	notifications := []Notification{}
+	err := db.FindAllQ(ctx, Collection, db.Query(bson.M{
+		sentAtKey: bson.M{"$exists": false},
+		bsonutil.GetDottedKeyName(digestKey, digestSendAfterKey): bson.M{"$lte": now},
+	}), &notifications)
+
+	return notifications, errors.Wrap(err, "finding notifications due for digest")
+}
+
+// MarkSentInDigest marks the held notifications as sent in the digest
+// notification with the given ID.
+func MarkSentInDigest(ctx context.Context, ids []string, digestID string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := db.UpdateAll(ctx, Collection,
+		bson.M{idKey: bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{
+			sentAtKey: time.Now().Truncate(time.Millisecond),
+			bsonutil.GetDottedKeyName(digestKey, digestIDKey): digestID,
+		}},
+	)
+
+	return errors.Wrap(err, "marking notifications as sent in digest")
+}
+
+type digestVersion struct {
+	Version   string
+	Summaries []string
+}
+
+type digestProject struct {
+	Project  string
+	Versions []digestVersion
+}
+
+type digestTemplateData struct {
+	Count    int
+	Projects []digestProject
+}
+
+const digestEmailBodyTemplateString = `<html>
+<head>
+</head>
+<body>
+<p>Evergreen held {{ .Count }} notification(s) for you since your last digest.</p>
+{{ range .Projects }}<h2>{{ .Project }}</h2>
+{{ range .Versions }}<h3>Version {{ .Version }}</h3>
+<ul>
+{{ range .Summaries }}<li>{{ . }}</li>
+{{ end }}</ul>
+{{ end }}{{ end }}</body>
+</html>
+`
+
+const digestSlackTemplateString = `*Evergreen digest: {{ .Count }} notification(s)*
+{{ range .Projects }}
+*{{ .Project }}*
+{{ range .Versions }}Version {{ .Version }}
+{{ range .Summaries }}• {{ . }}
+{{ end }}{{ end }}{{ end }}`
+
+var (
+	digestEmailBodyTemplate = template.Must(template.New("digestemail").Parse(digestEmailBodyTemplateString))
+	digestSlackTemplate     = ttemplate.Must(ttemplate.New("digestslack").Parse(digestSlackTemplateString))
+)
+
+// MakeDigest creates a single notification for the subscriber that
+// summarizes the held notifications, grouped by project and version. The
+// digest's ID is derived from the held notifications, so making a digest of
+// the same notifications again produces the same ID.
+func MakeDigest(subscriber event.Subscriber, held []Notification) (*Notification, error) {
+	if len(held) == 0 {
+		return nil, errors.New("cannot make a digest with no notifications")
+	}
+
+	ids := make([]string, 0, len(held))
+	for _, n := range held {
+		ids = append(ids, n.ID)
+	}
+	slices.Sort(ids)
+	id := fmt.Sprintf("%s%x", digestNotificationIDPrefix, sha256.Sum256([]byte(strings.Join(ids, "\n"))))
+
+	data := makeDigestTemplateData(held)
+	buf := &bytes.Buffer{}
+	var payload any
+	switch subscriber.Type {
+	case event.EmailSubscriberType:
+		if err := digestEmailBodyTemplate.Execute(buf, data); err != nil {
+			return nil, errors.Wrap(err, "executing digest email template")
+		}
+		payload = &message.Email{
+			Subject: fmt.Sprintf("Evergreen: %d notification(s) in your digest", data.Count),
+			Body:    buf.String(),
+			Headers: map[string][]string{
+				"X-Entity-Ref-Id": {id},
+			},
+		}
+	case event.SlackSubscriberType:
+		if err := digestSlackTemplate.Execute(buf, data); err != nil {
+			return nil, errors.Wrap(err, "executing digest Slack template")
+		}
+		payload = &SlackPayload{Body: buf.String()}
+	default:
+		return nil, errors.Errorf("digests are not supported for subscriber type '%s'", subscriber.Type)
+	}
+
+	digest := &Notification{
+		ID:         id,
+		Subscriber: subscriber,
+		Payload:    payload,
+	}
+	if held[0].Digest != nil {
+		digest.SubscriptionID = held[0].Digest.SubscriptionID
+	}
+
+	return digest, nil
+}
+
+func makeDigestTemplateData(held []Notification) digestTemplateData {
+	summaries := map[string]map[string][]string{}
+	for _, n := range held {
+		project, version, summary := digestUnknownProject, digestUnknownVersion, ""
+		if n.Digest != nil {
+			if n.Digest.Project != "" {
+				project = n.Digest.Project
+			}
+			if n.Digest.Version != "" {
+				version = n.Digest.Version
+			}
+			summary = n.Digest.Summary
+		}
+		if summaries[project] == nil {
+			summaries[project] = map[string][]string{}
+		}
+		summaries[project][version] = append(summaries[project][version], summary)
+	}
+
+	data := digestTemplateData{Count: len(held)}
+	for _, project := range slices.Sorted(maps.Keys(summaries)) {
+		p := digestProject{Project: project}
+		for _, version := range slices.Sorted(maps.Keys(summaries[project])) {
+			versionSummaries := summaries[project][version]
+			slices.Sort(versionSummaries)
+			p.Versions = append(p.Versions, digestVersion{Version: version, Summaries: versionSummaries})
+		}
+		data.Projects = append(data.Projects, p)
+	}
+
+	return data
+}
@@ -0,0 +1,179 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeHeldNotification(id, project, version, summary string, sendAfter time.Time) Notification {
+	return Notification{
+		ID: id,
+		Subscriber: event.Subscriber{
+			Type:   event.SlackSubscriberType,
+			Target: "#evergreen",
+		},
+		Payload: &SlackPayload{Body: summary},
+		Digest: &DigestInfo{
+			SubscriptionID: "subscription",
+			SendAfter:      sendAfter,
+			Project:        project,
+			Version:        version,
+			Summary:        summary,
+		},
+	}
+}
+
+func TestHoldForDigest(t *testing.T) {
+	now := time.Date(2026, 3, 2, 14, 25, 0, 0, time.UTC)
+	settings := &event.DigestSettings{Interval: event.DigestIntervalHourly}
+
+	t.Run("UsesEmailSubjectAndVersionOfTask", func(t *testing.T) {
+		n := Notification{
+			ID:      "email-notification",
+			Payload: &message.Email{Subject: "Task 'compile' failed", Body: "<html></html>"},
+		}
+		require.NoError(t, n.HoldForDigest("subscription", settings, event.Attributes{
+			Object:    []string{event.ObjectTask},
+			ID:        []string{"task"},
+			Project:   []string{"mci"},
+			InVersion: []string{"version"},
+		}, now))
+		require.NotNil(t, n.Digest)
+		assert.Equal(t, "subscription", n.Digest.SubscriptionID)
+		assert.Equal(t, time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC), n.Digest.SendAfter)
+		assert.Equal(t, "mci", n.Digest.Project)
+		assert.Equal(t, "version", n.Digest.Version)
+		assert.Equal(t, "Task 'compile' failed", n.Digest.Summary)
+	})
+	t.Run("UsesSlackBodyAndIDOfVersion", func(t *testing.T) {
+		n := Notification{
+			ID:      "slack-notification",
+			Payload: &SlackPayload{Body: "Version failed"},
+		}
+		require.NoError(t, n.HoldForDigest("subscription", settings, event.Attributes{
+			Object:  []string{event.ObjectVersion},
+			ID:      []string{"version"},
+			Project: []string{"mci"},
+		}, now))
+		require.NotNil(t, n.Digest)
+		assert.Equal(t, "version", n.Digest.Version)
+		assert.Equal(t, "Version failed", n.Digest.Summary)
+	})
+	t.Run("FailsForUnsupportedPayload", func(t *testing.T) {
+		n := Notification{
+			ID:      "jira-notification",
+			Payload: &message.JiraIssue{},
+		}
+		assert.Error(t, n.HoldForDigest("subscription", settings, event.Attributes{}, now))
+		assert.Nil(t, n.Digest)
+	})
+}
+
+func TestMakeDigest(t *testing.T) {
+	now := time.Now()
+	held := []Notification{
+		makeHeldNotification("3", "spruce", "v3", "Task 'lint' failed", now),
+		makeHeldNotification("1", "mci", "v2", "Task 'test' failed", now),
+		makeHeldNotification("2", "mci", "v1", "Task 'compile' failed", now),
+		makeHeldNotification("4", "mci", "v1", "Task 'build' failed", now),
+	}
+
+	t.Run("GroupsByProjectAndVersion", func(t *testing.T) {
+		data := makeDigestTemplateData(held)
+		assert.Equal(t, 4, data.Count)
+		require.Len(t, data.Projects, 2)
+		assert.Equal(t, "mci", data.Projects[0].Project)
+		assert.Equal(t, []digestVersion{
+			{Version: "v1", Summaries: []string{"Task 'build' failed", "Task 'compile' failed"}},
+			{Version: "v2", Summaries: []string{"Task 'test' failed"}},
+		}, data.Projects[0].Versions)
+		assert.Equal(t, "spruce", data.Projects[1].Project)
+	})
+	t.Run("Slack", func(t *testing.T) {
+		subscriber := event.Subscriber{Type: event.SlackSubscriberType, Target: "#evergreen"}
+		digest, err := MakeDigest(subscriber, held)
+		require.NoError(t, err)
+		assert.Nil(t, digest.Digest)
+		assert.Equal(t, subscriber, digest.Subscriber)
+		payload, ok := digest.Payload.(*SlackPayload)
+		require.True(t, ok)
+		assert.Contains(t, payload.Body, "4 notification(s)")
+		assert.Contains(t, payload.Body, "*mci*\nVersion v1\n• Task 'build' failed\n• Task 'compile' failed\nVersion v2\n")
+	})
+	t.Run("Email", func(t *testing.T) {
+		subscriber := event.Subscriber{Type: event.EmailSubscriberType, Target: "a@example.com"}
+		digest, err := MakeDigest(subscriber, held)
+		require.NoError(t, err)
+		payload, ok := digest.Payload.(*message.Email)
+		require.True(t, ok)
+		assert.Equal(t, "Evergreen: 4 notification(s) in your digest", payload.Subject)
+		assert.Contains(t, payload.Body, "<h2>mci</h2>")
+		assert.Contains(t, payload.Body, "<li>Task &#39;compile&#39; failed</li>")
+	})
+	t.Run("IDDependsOnlyOnHeldNotifications", func(t *testing.T) {
+		subscriber := event.Subscriber{Type: event.SlackSubscriberType, Target: "#evergreen"}
+		digest, err := MakeDigest(subscriber, held)
+		require.NoError(t, err)
+		reordered, err := MakeDigest(subscriber, []Notification{held[3], held[2], held[1], held[0]})
+		require.NoError(t, err)
+		assert.Equal(t, digest.ID, reordered.ID)
+		fewer, err := MakeDigest(subscriber, held[1:])
+		require.NoError(t, err)
+		assert.NotEqual(t, digest.ID, fewer.ID)
+	})
+	t.Run("FailsForUnsupportedSubscriber", func(t *testing.T) {
+		_, err := MakeDigest(event.Subscriber{Type: event.JIRACommentSubscriberType, Target: "ABC-123"}, held)
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithoutNotifications", func(t *testing.T) {
+		_, err := MakeDigest(event.Subscriber{Type: event.SlackSubscriberType, Target: "#evergreen"}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestDigestQueries(t *testing.T) {
+	require.NoError(t, db.Clear(Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(Collection))
+	}()
+
+	now := time.Now().Truncate(time.Millisecond)
+	due := makeHeldNotification("due", "mci", "v1", "due", now.Add(-time.Minute))
+	notDue := makeHeldNotification("not-due", "mci", "v1", "not due", now.Add(time.Hour))
+	unheld := makeHeldNotification("unheld", "mci", "v1", "unheld", now)
+	unheld.Digest = nil
+	require.NoError(t, InsertMany(t.Context(), due, notDue, unheld))
+
+	unprocessed, err := FindUnprocessed(t.Context())
+	require.NoError(t, err)
+	require.Len(t, unprocessed, 1)
+	assert.Equal(t, "unheld", unprocessed[0].ID)
+
+	stats, err := CollectUnsentNotificationStats(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Slack)
+
+	dueForDigest, err := FindDueForDigest(t.Context(), now)
+	require.NoError(t, err)
+	require.Len(t, dueForDigest, 1)
+	assert.Equal(t, "due", dueForDigest[0].ID)
+	require.NotNil(t, dueForDigest[0].Digest)
+	assert.Equal(t, "due", dueForDigest[0].Digest.Summary)
+
+	require.NoError(t, MarkSentInDigest(t.Context(), []string{"due"}, "digest-id"))
+	dueForDigest, err = FindDueForDigest(t.Context(), now)
+	require.NoError(t, err)
+	assert.Empty(t, dueForDigest)
+
+	n, err := Find(t.Context(), "due")
+	require.NoError(t, err)
+	require.NotNil(t, n)
+	assert.NotZero(t, n.SentAt)
+	assert.Equal(t, "digest-id", n.Digest.DigestID)
+}
@@ -54,6 +54,13 @@ type Notification struct {
 	SentAt   time.Time            `bson:"sent_at,omitempty"`
 	Error    string               `bson:"error,omitempty"`
 	Metadata NotificationMetadata `bson:"metadata,omitempty"`
+	// Digest is set if the notification is held to be sent in a digest.
+	Digest *DigestInfo `bson:"digest,omitempty"`
+	// SubscriptionID is the ID of the subscription that created the
+	// notification.
+	SubscriptionID string `bson:"subscription_id,omitempty"`
+	// Retry is set if sending the notification has failed.
+	Retry *RetryInfo `bson:"retry,omitempty"`
 }
 
 type NotificationMetadata struct {
@@ -66,7 +73,7 @@ type NotificationMetadata struct {
 // notification from the evergreen environment
 func (n *Notification) SenderKey() (evergreen.SenderKey, error) {
 	switch n.Subscriber.Type {
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.ChatWebhookSubscriberType:
 		return evergreen.SenderEvergreenWebhook, nil
 
 	case event.EmailSubscriberType:
@@ -117,6 +124,36 @@ func (n *Notification) Composer(ctx context.Context) (message.Composer, error) {
 
 		return util.NewWebhookMessage(*payload), nil
 
+	case event.TeamsSubscriberType:
+		sub, ok := n.Subscriber.Target.(*event.TeamsSubscriber)
+		if !ok {
+			return nil, errors.New("teams subscriber is invalid")
+		}
+
+		payload, ok := n.Payload.(*util.EvergreenWebhook)
+		if !ok || payload == nil {
+			return nil, errors.New("teams payload is invalid")
+		}
+
+		payload.URL = sub.URL
+		payload.NotificationID = n.ID
+		return util.NewChatWebhookMessage(*payload), nil
+
+	case event.ChatWebhookSubscriberType:
+		sub, ok := n.Subscriber.Target.(*event.ChatWebhookSubscriber)
+		if !ok {
+			return nil, errors.New("chat-webhook subscriber is invalid")
+		}
+
+		payload, ok := n.Payload.(*util.EvergreenWebhook)
+		if !ok || payload == nil {
+			return nil, errors.New("chat-webhook payload is invalid")
+		}
+
+		payload.URL = sub.URL
+		payload.NotificationID = n.ID
+		return util.NewChatWebhookMessage(*payload), nil
+
 	case event.EmailSubscriberType:
 		sub, ok := n.Subscriber.Target.(*string)
 		if !ok {
@@ -235,10 +272,15 @@ func (n *Notification) MarkSent(ctx context.Context) error {
 			sentAtKey: n.SentAt,
 		},
 	}
+	if n.Error != "" {
+		// Clear the error from the last failed attempt.
+		update["$unset"] = bson.M{errorKey: 1}
+	}
 
 	if err := db.UpdateId(ctx, Collection, n.ID, update); err != nil {
 		return errors.Wrap(err, "marking notification as sent")
 	}
+	n.Error = ""
 
 	return nil
 }
@@ -304,18 +346,27 @@ type NotificationStats struct {
 	EvergreenWebhook  int `json:"evergreen_webhook" bson:"evergreen_webhook" yaml:"evergreen_webhook"`
 	Email             int `json:"email" bson:"email" yaml:"email"`
 	Slack             int `json:"slack" bson:"slack" yaml:"slack"`
+	Teams             int `json:"teams" bson:"teams" yaml:"teams"`
+	ChatWebhook       int `json:"chat_webhook" bson:"chat_webhook" yaml:"chat_webhook"`
 	GithubCheck       int `json:"github_check" bson:"github_check" yaml:"github_check"`
 	GithubMerge       int `json:"github_merge" bson:"github_merge" yaml:"github_merge"`
 }
 
 func CollectUnsentNotificationStats(ctx context.Context) (*NotificationStats, error) {
-	const subscriberTypeKey = "type"
 	pipeline := []bson.M{
 		{
 			"$match": bson.M{
 				sentAtKey: bson.M{
 					"$exists": false,
 				},
+				// Notifications held for a digest are not waiting to be sent.
+				digestKey: bson.M{
+					"$exists": false,
+				},
+				// Neither are dead lettered notifications.
+				bsonutil.GetDottedKeyName(retryKey, retryDeadLetteredAtKey): bson.M{
+					"$exists": false,
+				},
 			},
 		},
 		{
@@ -365,6 +416,12 @@ func CollectUnsentNotificationStats(ctx context.Context) (*NotificationStats, er
 		case event.SlackSubscriberType:
 			nStats.Slack = data.Count
 
+		case event.TeamsSubscriberType:
+			nStats.Teams = data.Count
+
+		case event.ChatWebhookSubscriberType:
+			nStats.ChatWebhook = data.Count
+
 		default:
 			grip.Error(ctx, message.Fields{
 				"message": fmt.Sprintf("unknown subscriber '%s'", data.Key),
@@ -0,0 +1,197 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// RetryInfo tracks the failed attempts to send a notification.
+type RetryInfo struct {
+	// Attempts is the number of times that sending the notification failed.
+	Attempts int `bson:"attempts"`
+	// NextAttemptAt is when sending the notification is next retried.
+	NextAttemptAt time.Time `bson:"next_attempt_at,omitempty"`
+	// DeadLetteredAt is when the notification ran out of attempts. Dead
+	// lettered notifications aren't retried unless an admin replays them.
+	DeadLetteredAt time.Time `bson:"dead_lettered_at,omitempty"`
+}
+
+var (
+	retryNextAttemptAtKey  = bsonutil.MustHaveTag(RetryInfo{}, "NextAttemptAt")
+	retryDeadLetteredAtKey = bsonutil.MustHaveTag(RetryInfo{}, "DeadLetteredAt")
+)
+
+const (
+	defaultMaxSendAttempts = 3
+	retryBaseDelay         = time.Minute
+	retryMaxDelay          = 2 * time.Hour
+)
+
+// maxSendAttempts is the number of times that a notification is attempted
+// before it's dead lettered. Webhooks are usually down for longer than the
+// other services, so they get more attempts, whereas GitHub statuses and Jira
+// comments are stale soon after they're created.
+var maxSendAttempts = map[string]int{
+	event.EvergreenWebhookSubscriberType:  8,
+	event.TeamsSubscriberType:             8,
+	event.ChatWebhookSubscriberType:       8,
+	event.SlackSubscriberType:             6,
+	event.EmailSubscriberType:             6,
+	event.JIRAIssueSubscriberType:         4,
+	event.JIRACommentSubscriberType:       4,
+	event.GithubPullRequestSubscriberType: 4,
+	event.GithubCheckSubscriberType:       4,
+	event.GithubMergeSubscriberType:       4,
+}
+
+// MaxSendAttempts returns the number of times that a notification for the
+// subscriber type is attempted before it's dead lettered.
+func MaxSendAttempts(subscriberType string) int {
+	if attempts, ok := maxSendAttempts[subscriberType]; ok {
+		return attempts
+	}
+	return defaultMaxSendAttempts
+}
+
+// retryDelay returns how long to wait before retrying a notification that
+// has failed the given number of times. The delay doubles with every attempt.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
+
+// IsDeadLettered returns whether the notification ran out of attempts.
+func (n *Notification) IsDeadLettered() bool {
+	return n.Retry != nil && !n.Retry.DeadLetteredAt.IsZero()
+}
+
+// MarkSendFailed records a failed attempt to send the notification. The
+// notification is scheduled to be retried with exponential backoff until it
+// has been attempted MaxSendAttempts times, at which point it's dead lettered.
+func (n *Notification) MarkSendFailed(ctx context.Context, sendErr error, now time.Time) error {
+	if sendErr == nil {
+		return nil
+	}
+	if len(n.ID) == 0 {
+		return errors.New("notification has no ID")
+	}
+
+	info := RetryInfo{}
+	if n.Retry != nil {
+		info.Attempts = n.Retry.Attempts
+	}
+	info.Attempts++
+	now = now.Truncate(time.Millisecond)
+	if info.Attempts >= MaxSendAttempts(n.Subscriber.Type) {
+		info.DeadLetteredAt = now
+	} else {
+		info.NextAttemptAt = now.Add(retryDelay(info.Attempts))
+	}
+
+	errMsg := sendErr.Error()
+	update := bson.M{
+		"$set": bson.M{
+			retryKey: info,
+			errorKey: errMsg,
+		},
+	}
+	if err := db.UpdateId(ctx, Collection, n.ID, update); err != nil {
+		return errors.Wrap(err, "recording failed attempt to send notification")
+	}
+	n.Retry = &info
+	n.Error = errMsg
+
+	return nil
+}
+
+// DeadLetterFilter selects dead lettered notifications. The zero value
+// selects all of them.
+type DeadLetterFilter struct {
+	// IDs, if set, selects only the notifications with these IDs.
+	IDs []string
+	// SubscriberType, if set, selects only the notifications for this type of
+	// subscriber.
+	SubscriberType string
+}
+
+func (f DeadLetterFilter) query() bson.M {
+	q := bson.M{
+		bsonutil.GetDottedKeyName(retryKey, retryDeadLetteredAtKey): bson.M{"$exists": true},
+	}
+	if len(f.IDs) > 0 {
+		q[idKey] = bson.M{"$in": f.IDs}
+	}
+	if f.SubscriberType != "" {
+		q[bsonutil.GetDottedKeyName(subscriberKey, subscriberTypeKey)] = f.SubscriberType
+	}
+	return q
+}
+
+// FindDeadLettered finds the dead lettered notifications that match the
+// filter, most recently dead lettered first. A limit of 0 returns all of them.
+func FindDeadLettered(ctx context.Context, filter DeadLetterFilter, limit int) ([]Notification, error) {
+	q := db.Query(filter.query()).Sort([]string{"-" + bsonutil.GetDottedKeyName(retryKey, retryDeadLetteredAtKey)})
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	notifications := []Notification{}
+	err := db.FindAllQ(ctx, Collection, q, &notifications)
+
+	return notifications, errors.Wrap(err, "finding dead lettered notifications")
+}
+
+// ReplayDeadLettered resets the dead lettered notifications that match the
+// filter so that they're sent again with a full set of attempts. It returns
+// the number of notifications that were replayed.
+func ReplayDeadLettered(ctx context.Context, filter DeadLetterFilter) (int, error) {
+	info, err := db.UpdateAll(ctx, Collection, filter.query(), bson.M{
+		"$unset": bson.M{
+			retryKey: 1,
+			errorKey: 1,
+		},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "replaying dead lettered notifications")
+	}
+
+	return info.Updated, nil
+}
+
+// PurgeDeadLettered deletes the dead lettered notifications that match the
+// filter. It returns the number of notifications that were deleted.
+func PurgeDeadLettered(ctx context.Context, filter DeadLetterFilter) (int, error) {
+	res, err := evergreen.GetEnvironment().DB().Collection(Collection).DeleteMany(ctx, filter.query())
+	if err != nil {
+		return 0, errors.Wrap(err, "purging dead lettered notifications")
+	}
+
+	return int(res.DeletedCount), nil
+}
+
+// unprocessedQuery matches the notifications that are waiting to be sent.
+// Notifications held for a digest, waiting for their next retry, or dead
+// lettered are not.
+func unprocessedQuery(now time.Time) bson.M {
+	return bson.M{
+		sentAtKey: bson.M{"$exists": false},
+		digestKey: bson.M{"$exists": false},
+		bsonutil.GetDottedKeyName(retryKey, retryDeadLetteredAtKey): bson.M{"$exists": false},
+		"$or": []bson.M{
+			{bsonutil.GetDottedKeyName(retryKey, retryNextAttemptAtKey): bson.M{"$exists": false}},
+			{bsonutil.GetDottedKeyName(retryKey, retryNextAttemptAtKey): bson.M{"$lte": now}},
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeRetryNotification(id, subscriberType string) Notification {
+	n := Notification{
+		ID: id,
+		Subscriber: event.Subscriber{
+			Type:   subscriberType,
+			Target: &event.WebhookSubscriber{URL: "https://example.com", Secret: []byte("shh")},
+		},
+		Payload: &util.EvergreenWebhook{Body: []byte("payload")},
+	}
+	if subscriberType == event.TeamsSubscriberType {
+		n.Subscriber.Target = &event.TeamsSubscriber{URL: "https://example.com"}
+	}
+
+	return n
+}
+
+func TestMaxSendAttempts(t *testing.T) {
+	assert.Equal(t, 8, MaxSendAttempts(event.EvergreenWebhookSubscriberType))
+	assert.Equal(t, 6, MaxSendAttempts(event.SlackSubscriberType))
+	assert.Equal(t, 4, MaxSendAttempts(event.GithubCheckSubscriberType))
+	assert.Equal(t, defaultMaxSendAttempts, MaxSendAttempts(event.RunChildPatchSubscriberType))
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, retryDelay(1))
+	assert.Equal(t, 2*time.Minute, retryDelay(2))
+	assert.Equal(t, 4*time.Minute, retryDelay(3))
+	assert.Equal(t, 64*time.Minute, retryDelay(7))
+	assert.Equal(t, retryMaxDelay, retryDelay(8))
+	assert.Equal(t, retryMaxDelay, retryDelay(100))
+}
+
+func TestMarkSendFailed(t *testing.T) {
+	require.NoError(t, db.Clear(Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(Collection))
+	}()
+
+	now := time.Now().Truncate(time.Millisecond)
+	n := makeRetryNotification("n", event.TeamsSubscriberType)
+	require.NoError(t, InsertMany(t.Context(), n))
+
+	for attempt := 1; attempt < MaxSendAttempts(n.Subscriber.Type); attempt++ {
+		require.NoError(t, n.MarkSendFailed(t.Context(), errors.New("connection refused"), now))
+		require.NotNil(t, n.Retry)
+		assert.Equal(t, attempt, n.Retry.Attempts)
+		assert.False(t, n.IsDeadLettered())
+		assert.True(t, now.Add(retryDelay(attempt)).Equal(n.Retry.NextAttemptAt))
+
+		dbNotification, err := Find(t.Context(), n.ID)
+		require.NoError(t, err)
+		require.NotNil(t, dbNotification)
+		require.NotNil(t, dbNotification.Retry)
+		assert.Equal(t, attempt, dbNotification.Retry.Attempts)
+		assert.Equal(t, "connection refused", dbNotification.Error)
+		assert.Zero(t, dbNotification.SentAt)
+	}
+
+	require.NoError(t, n.MarkSendFailed(t.Context(), errors.New("connection refused"), now))
+	assert.True(t, n.IsDeadLettered())
+	assert.Zero(t, n.Retry.NextAttemptAt)
+
+	dbNotification, err := Find(t.Context(), n.ID)
+	require.NoError(t, err)
+	require.NotNil(t, dbNotification)
+	assert.True(t, dbNotification.IsDeadLettered())
+	assert.Equal(t, MaxSendAttempts(n.Subscriber.Type), dbNotification.Retry.Attempts)
+}
+
+func TestRetryQueries(t *testing.T) {
+	require.NoError(t, db.Clear(Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(Collection))
+	}()
+
+	now := time.Now().Truncate(time.Millisecond)
+	fresh := makeRetryNotification("fresh", event.EvergreenWebhookSubscriberType)
+	dueForRetry := makeRetryNotification("due-for-retry", event.EvergreenWebhookSubscriberType)
+	dueForRetry.Retry = &RetryInfo{Attempts: 1, NextAttemptAt: now.Add(-time.Minute)}
+	waitingForRetry := makeRetryNotification("waiting-for-retry", event.EvergreenWebhookSubscriberType)
+	waitingForRetry.Retry = &RetryInfo{Attempts: 2, NextAttemptAt: now.Add(time.Hour)}
+	deadWebhook := makeRetryNotification("dead-webhook", event.EvergreenWebhookSubscriberType)
+	deadWebhook.Retry = &RetryInfo{Attempts: 8, DeadLetteredAt: now.Add(-time.Hour)}
+	deadWebhook.Error = "connection refused"
+	deadTeams := makeRetryNotification("dead-teams", event.TeamsSubscriberType)
+	deadTeams.Retry = &RetryInfo{Attempts: 8, DeadLetteredAt: now}
+	require.NoError(t, InsertMany(t.Context(), fresh, dueForRetry, waitingForRetry, deadWebhook, deadTeams))
+
+	t.Run("FindUnprocessedExcludesWaitingAndDeadLettered", func(t *testing.T) {
+		unprocessed, err := FindUnprocessed(t.Context())
+		require.NoError(t, err)
+		ids := []string{}
+		for _, n := range unprocessed {
+			ids = append(ids, n.ID)
+		}
+		assert.ElementsMatch(t, []string{"fresh", "due-for-retry"}, ids)
+	})
+	t.Run("StatsExcludeDeadLettered", func(t *testing.T) {
+		stats, err := CollectUnsentNotificationStats(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3, stats.EvergreenWebhook)
+		assert.Zero(t, stats.Teams)
+	})
+	t.Run("FindDeadLettered", func(t *testing.T) {
+		deadLettered, err := FindDeadLettered(t.Context(), DeadLetterFilter{}, 0)
+		require.NoError(t, err)
+		require.Len(t, deadLettered, 2)
+		assert.Equal(t, "dead-teams", deadLettered[0].ID)
+		assert.Equal(t, "dead-webhook", deadLettered[1].ID)
+
+		deadLettered, err = FindDeadLettered(t.Context(), DeadLetterFilter{}, 1)
+		require.NoError(t, err)
+		require.Len(t, deadLettered, 1)
+		assert.Equal(t, "dead-teams", deadLettered[0].ID)
+
+		deadLettered, err = FindDeadLettered(t.Context(), DeadLetterFilter{SubscriberType: event.EvergreenWebhookSubscriberType}, 0)
+		require.NoError(t, err)
+		require.Len(t, deadLettered, 1)
+		assert.Equal(t, "dead-webhook", deadLettered[0].ID)
+	})
+	t.Run("ReplayDeadLettered", func(t *testing.T) {
+		count, err := ReplayDeadLettered(t.Context(), DeadLetterFilter{IDs: []string{"dead-webhook", "fresh"}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		n, err := Find(t.Context(), "dead-webhook")
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		assert.Nil(t, n.Retry)
+		assert.Empty(t, n.Error)
+
+		unprocessed, err := FindUnprocessed(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, unprocessed, 3)
+	})
+	t.Run("PurgeDeadLettered", func(t *testing.T) {
+		count, err := PurgeDeadLettered(t.Context(), DeadLetterFilter{SubscriberType: event.TeamsSubscriberType})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		n, err := Find(t.Context(), "dead-teams")
+		require.NoError(t, err)
+		assert.Nil(t, n)
+
+		count, err = PurgeDeadLettered(t.Context(), DeadLetterFilter{})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
@@ -0,0 +1,204 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/pkg/errors"
+)
+
+// PatchPreview describes the tasks that a patch would create if it were
+// finalized, along with estimates of how long they would take and how much
+// they would cost.
+type PatchPreview struct {
+	Tasks []PatchPreviewTask
+	// TotalExpectedDuration is the sum of the expected durations of all tasks
+	// that have recent runtime history.
+	TotalExpectedDuration time.Duration
+	// TotalPredictedCost is the sum of the predicted adjusted costs of all
+	// tasks that could be priced.
+	TotalPredictedCost float64
+	// NumTasksWithoutDuration is the number of tasks that are excluded from
+	// TotalExpectedDuration because they have no recent runtime history.
+	NumTasksWithoutDuration int
+	// NumTasksWithoutCost is the number of tasks that are excluded from
+	// TotalPredictedCost because there is neither cost history nor distro
+	// pricing available for them.
+	NumTasksWithoutCost int
+	// CostHidden indicates that costs are hidden for the patch's project, so
+	// no cost estimates are included.
+	CostHidden bool
+}
+
+// PatchPreviewTask is a single task that a patch would create.
+type PatchPreviewTask struct {
+	BuildVariant string
+	DisplayName  string
+	// IsDependency indicates that the task was not selected directly, but is
+	// included because a selected task depends on it.
+	IsDependency bool
+	// Distro is the distro that the task would run on.
+	Distro string
+	// ExpectedDuration is the average runtime of the task over the last week.
+	// It is zero if the task has no recent runtime history.
+	ExpectedDuration time.Duration
+	// PredictedCost is the predicted adjusted cost of the task. It is zero if
+	// the cost could not be predicted.
+	PredictedCost float64
+}
+
+// hasPatchSelection returns whether the patch specifies which tasks and
+// variants to run, as opposed to only having an already-resolved list of
+// variant tasks (e.g. when reusing a previous patch's definition).
+func hasPatchSelection(p *patch.Patch) bool {
+	return len(p.BuildVariants) > 0 || len(p.Tasks) > 0 ||
+		len(p.RegexBuildVariants) > 0 || len(p.RegexTasks) > 0 ||
+		len(p.AliasesToResolve()) > 0
+}
+
+// PreviewPatch resolves the patch's variant and task selection against the
+// patched project the same way that processing a patch intent does and
+// estimates the runtime and cost of the resulting tasks. Tasks that are only
+// included because other tasks depend on them are marked as dependencies. If
+// the patch has no selection, its already-resolved variant tasks are used.
+func PreviewPatch(ctx context.Context, settings *evergreen.Settings, project *Project, p *patch.Patch) (*PatchPreview, error) {
+	var pairs []TVPair
+	explicitPairs := map[TVPair]bool{}
+	if hasPatchSelection(p) {
+		_, _, selectedVTs := project.ResolvePatchVTs(ctx, p, p.GetRequester(), p.AliasesToResolve(), false, "")
+		for _, pair := range VariantTasksToTVPairs(selectedVTs).ExecTasks {
+			explicitPairs[pair] = true
+		}
+		_, _, allVTs := project.ResolvePatchVTs(ctx, p, p.GetRequester(), p.AliasesToResolve(), true, "")
+		pairs = VariantTasksToTVPairs(allVTs).ExecTasks
+	} else {
+		pairs = VariantTasksToTVPairs(p.VariantsTasks).ExecTasks
+		for _, pair := range pairs {
+			explicitPairs[pair] = true
+		}
+	}
+
+	preview := &PatchPreview{
+		Tasks:      make([]PatchPreviewTask, 0, len(pairs)),
+		CostHidden: slices.Contains(settings.Cost.HiddenCostProjects, p.Project),
+	}
+	for _, pair := range pairs {
+		preview.Tasks = append(preview.Tasks, PatchPreviewTask{
+			BuildVariant: pair.Variant,
+			DisplayName:  pair.TaskName,
+			IsDependency: !explicitPairs[pair],
+			Distro:       project.findDistroForVariantTask(pair.Variant, pair.TaskName),
+		})
+	}
+
+	if err := preview.estimateDurations(ctx, p.Project); err != nil {
+		return nil, errors.Wrap(err, "estimating task durations")
+	}
+	if !preview.CostHidden {
+		if err := preview.estimateCosts(ctx, settings.Cost, p.Project); err != nil {
+			return nil, errors.Wrap(err, "estimating task costs")
+		}
+	}
+
+	return preview, nil
+}
+
+// findDistroForVariantTask returns the primary distro that the task would run
+// on in the given build variant.
+func (p *Project) findDistroForVariantTask(variant, taskName string) string {
+	if bvt := p.FindTaskForVariant(taskName, variant); bvt != nil && len(bvt.RunOn) > 0 {
+		return bvt.RunOn[0]
+	}
+	if pt := p.FindProjectTask(taskName); pt != nil && len(pt.RunOn) > 0 {
+		return pt.RunOn[0]
+	}
+	if bv := p.FindBuildVariant(variant); bv != nil && len(bv.RunOn) > 0 {
+		return bv.RunOn[0]
+	}
+	return ""
+}
+
+func (pp *PatchPreview) estimateDurations(ctx context.Context, projectID string) error {
+	durationsByVariant := map[string]map[string]time.Duration{}
+	for i, t := range pp.Tasks {
+		durations, ok := durationsByVariant[t.BuildVariant]
+		if !ok {
+			stats, err := task.GetExpectedDurationsForVariant(ctx, projectID, t.BuildVariant)
+			if err != nil {
+				return err
+			}
+			durations = make(map[string]time.Duration, len(stats))
+			for name, s := range stats {
+				durations[name] = s.Average
+			}
+			durationsByVariant[t.BuildVariant] = durations
+		}
+
+		pp.Tasks[i].ExpectedDuration = durations[t.DisplayName]
+		if pp.Tasks[i].ExpectedDuration == 0 {
+			pp.NumTasksWithoutDuration++
+			continue
+		}
+		pp.TotalExpectedDuration += pp.Tasks[i].ExpectedDuration
+	}
+	return nil
+}
+
+// estimateCosts predicts each task's cost from the costs of its recent runs.
+// Tasks without cost history fall back to pricing their expected duration
+// with their distro's rates.
+func (pp *PatchPreview) estimateCosts(ctx context.Context, financeConfig evergreen.CostConfig, projectID string) error {
+	tasks := make(task.Tasks, 0, len(pp.Tasks))
+	for i, t := range pp.Tasks {
+		tasks = append(tasks, &task.Task{
+			Id:           previewTaskID(i),
+			Project:      projectID,
+			BuildVariant: t.BuildVariant,
+			DisplayName:  t.DisplayName,
+			Activated:    true,
+		})
+	}
+	predictions, err := task.ComputePredictedCostsForTasks(ctx, tasks)
+	if err != nil {
+		return errors.Wrap(err, "computing predicted costs")
+	}
+
+	distroCosts := map[string]*distro.CostData{}
+	for i, t := range pp.Tasks {
+		predicted := predictions[previewTaskID(i)].AdjustedTotal()
+		if predicted == 0 && t.ExpectedDuration > 0 && t.Distro != "" && financeConfig.IsConfigured() {
+			costData, ok := distroCosts[t.Distro]
+			if !ok {
+				d, err := distro.FindOneId(ctx, t.Distro)
+				if err != nil {
+					return errors.Wrapf(err, "finding distro '%s'", t.Distro)
+				}
+				if d != nil && d.CostData.IsConfigured() {
+					costData = &d.CostData
+				}
+				distroCosts[t.Distro] = costData
+			}
+			if costData != nil {
+				predicted = task.CalculateTaskCost(t.ExpectedDuration.Seconds(), *costData, financeConfig).AdjustedTotal()
+			}
+		}
+
+		pp.Tasks[i].PredictedCost = predicted
+		if predicted == 0 {
+			pp.NumTasksWithoutCost++
+			continue
+		}
+		pp.TotalPredictedCost += predicted
+	}
+	return nil
+}
+
+func previewTaskID(i int) string {
+	return fmt.Sprintf("patch_preview_%d", i)
+}
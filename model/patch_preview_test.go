@@ -0,0 +1,43 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasPatchSelection(t *testing.T) {
+	assert.False(t, hasPatchSelection(&patch.Patch{
+		VariantsTasks: []patch.VariantTasks{{Variant: "bv", Tasks: []string{"t1"}}},
+	}), "already-resolved variant tasks should not count as a selection")
+	assert.True(t, hasPatchSelection(&patch.Patch{BuildVariants: []string{"bv"}}))
+	assert.True(t, hasPatchSelection(&patch.Patch{RegexTasks: []string{"^t"}}))
+	assert.True(t, hasPatchSelection(&patch.Patch{Alias: "alias"}))
+}
+
+func TestFindDistroForVariantTask(t *testing.T) {
+	p := &Project{
+		Tasks: []ProjectTask{
+			{Name: "t1"},
+			{Name: "t2", RunOn: []string{"task_distro"}},
+			{Name: "t3"},
+		},
+		BuildVariants: []BuildVariant{
+			{
+				Name:  "bv",
+				RunOn: []string{"variant_distro"},
+				Tasks: []BuildVariantTaskUnit{
+					{Name: "t1", Variant: "bv"},
+					{Name: "t2", Variant: "bv"},
+					{Name: "t3", Variant: "bv", RunOn: []string{"bvt_distro"}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, "variant_distro", p.findDistroForVariantTask("bv", "t1"))
+	assert.Equal(t, "task_distro", p.findDistroForVariantTask("bv", "t2"))
+	assert.Equal(t, "bvt_distro", p.findDistroForVariantTask("bv", "t3"))
+	assert.Empty(t, p.findDistroForVariantTask("nonexistent", "t1"))
+}
@@ -1210,6 +1210,10 @@ func PopulateExpansions(ctx context.Context, t *task.Task, h *host.Host, knownHo
 		expansions.Put("trigger_branch", upstreamProject.Branch)
 	}
 
+	if err = putDependencyOutputExpansions(ctx, t, expansions); err != nil {
+		return nil, errors.Wrap(err, "adding dependency outputs")
+	}
+
 	v, err := VersionFindOneId(ctx, t.Version)
 	if err != nil {
 		return nil, errors.Wrap(err, "finding version")
@@ -1273,6 +1277,37 @@ func PopulateExpansions(ctx context.Context, t *task.Task, h *host.Host, knownHo
 	return expansions, nil
 }
 
+// putDependencyOutputExpansions adds the structured outputs set by the
+// task's dependencies as expansions namespaced by the dependency's name. An
+// output from a dependency in the same build variant is available as
+// <task>.<output>, and one from a dependency in another build variant is
+// available as <build_variant>.<task>.<output>.
+func putDependencyOutputExpansions(ctx context.Context, t *task.Task, expansions util.Expansions) error {
+	if len(t.DependsOn) == 0 {
+		return nil
+	}
+
+	depIDs := make([]string, 0, len(t.DependsOn))
+	for _, dep := range t.DependsOn {
+		depIDs = append(depIDs, dep.TaskId)
+	}
+	deps, err := task.FindWithFields(ctx, task.ByIds(depIDs), task.DisplayNameKey, task.BuildVariantKey, task.StructuredOutputsKey)
+	if err != nil {
+		return errors.Wrap(err, "finding dependencies")
+	}
+
+	for _, dep := range deps {
+		namespace := dep.DisplayName
+		if dep.BuildVariant != t.BuildVariant {
+			namespace = fmt.Sprintf("%s.%s", dep.BuildVariant, dep.DisplayName)
+		}
+		for key, output := range dep.StructuredOutputs {
+			expansions.Put(fmt.Sprintf("%s.%s", namespace, key), output.Value)
+		}
+	}
+	return nil
+}
+
 func (p *Project) GetVariantMappings() map[string]string {
 	mappings := make(map[string]string)
 	for _, buildVariant := range p.BuildVariants {
@@ -122,6 +122,26 @@ type ProjectChangeEvent struct {
 	User   string               `bson:"user" json:"user"`
 	Before ProjectSettingsEvent `bson:"before" json:"before"`
 	After  ProjectSettingsEvent `bson:"after" json:"after"`
+	// TestQuarantine is set for events that record a test being
+	// automatically quarantined or unquarantined.
+	TestQuarantine *TestQuarantineChange `bson:"test_quarantine,omitempty" json:"test_quarantine,omitempty"`
+}
+
+// TestQuarantineChange describes a test whose quarantine state was changed
+// automatically.
+type TestQuarantineChange struct {
+	BuildVariant string `bson:"build_variant" json:"build_variant"`
+	TaskName     string `bson:"task_name" json:"task_name"`
+	TestName     string `bson:"test_name" json:"test_name"`
+	Quarantined  bool   `bson:"quarantined" json:"quarantined"`
+	// Flakiness is the test's flakiness score when it was quarantined.
+	Flakiness float64 `bson:"flakiness,omitempty" json:"flakiness,omitempty"`
+	// Flips is the number of times the test flipped between passing and
+	// failing when it was quarantined.
+	Flips int `bson:"flips,omitempty" json:"flips,omitempty"`
+	// StableStreak is the number of consecutive passing runs after which the
+	// test was unquarantined.
+	StableStreak int `bson:"stable_streak,omitempty" json:"stable_streak,omitempty"`
 }
 
 // RedactSecrets redacts project secrets from a project change event. Project
@@ -466,6 +486,16 @@ func LogProjectAdded(ctx context.Context, projectId, username string) error {
 	return LogProjectEvent(ctx, event.EventTypeProjectAdded, projectId, ProjectChangeEvent{User: username})
 }
 
+// LogProjectTestQuarantineChanged logs an event recording that a test in the
+// project was automatically quarantined or unquarantined.
+func LogProjectTestQuarantineChanged(ctx context.Context, projectId, username string, change TestQuarantineChange) error {
+	eventType := event.EventTypeProjectTestAutoUnquarantined
+	if change.Quarantined {
+		eventType = event.EventTypeProjectTestAutoQuarantined
+	}
+	return LogProjectEvent(ctx, eventType, projectId, ProjectChangeEvent{User: username, TestQuarantine: &change})
+}
+
 // GetAndLogProjectModified retrieves the project settings before and after some change, and logs an event for the modification.
 func GetAndLogProjectModified(ctx context.Context, id, userId string, isRepo bool, before *ProjectSettings) error {
 	after, err := GetProjectSettingsById(ctx, id, isRepo)
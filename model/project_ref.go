@@ -145,6 +145,12 @@ type ProjectRef struct {
 	// Test selection settings
 	TestSelection TestSelectionSettings `bson:"test_selection,omitempty" json:"test_selection,omitzero" yaml:"test_selection,omitempty"`
 
+	// FlakyTestPolicy configures automatic detection and quarantine of flaky tests.
+	FlakyTestPolicy FlakyTestPolicy `bson:"flaky_test_policy,omitempty" json:"flaky_test_policy,omitzero" yaml:"flaky_test_policy,omitempty"`
+
+	// SecretDetection configures scanning task logs for credentials to redact.
+	SecretDetection SecretDetectionSettings `bson:"secret_detection,omitempty" json:"secret_detection,omitzero" yaml:"secret_detection,omitempty"`
+
 	// RunEveryMainlineCommit indicates that the project should activate the versions for all mainline commits.
 	// This goes against Evergreen's optimization of only activating the latest commit in a series of mainline commits.
 	// This is used for projects that use tasks on mainline commits to trigger downstream processes, like deployments.
@@ -496,6 +502,81 @@ type TestSelectionSettings struct {
 	MainlineDefaultEnabled *bool `bson:"mainline_default_enabled,omitempty" json:"mainline_default_enabled,omitzero" yaml:"mainline_default_enabled,omitempty"`
 }
 
+// FlakyTestPolicy configures how tests that flip between passing and failing
+// across executions of the same task are detected and automatically
+// quarantined. Zero values use the defaults.
+type FlakyTestPolicy struct {
+	// AutoQuarantineEnabled indicates whether tests detected as flaky are
+	// automatically quarantined in the test selection service.
+	AutoQuarantineEnabled *bool `bson:"auto_quarantine_enabled,omitempty" json:"auto_quarantine_enabled,omitempty" yaml:"auto_quarantine_enabled,omitempty"`
+	// FlakinessThreshold is the minimum flakiness score, between 0 and 1, for
+	// a test to be quarantined.
+	FlakinessThreshold float64 `bson:"flakiness_threshold,omitempty" json:"flakiness_threshold,omitempty" yaml:"flakiness_threshold,omitempty"`
+	// MinFlips is the minimum number of times a test must flip between
+	// passing and failing for it to be quarantined.
+	MinFlips int `bson:"min_flips,omitempty" json:"min_flips,omitempty" yaml:"min_flips,omitempty"`
+	// LookbackDays is the number of days of task executions to scan for
+	// flaky tests.
+	LookbackDays int `bson:"lookback_days,omitempty" json:"lookback_days,omitempty" yaml:"lookback_days,omitempty"`
+	// StableStreak is the number of consecutive passing runs after which an
+	// automatically quarantined test is unquarantined.
+	StableStreak int `bson:"stable_streak,omitempty" json:"stable_streak,omitempty" yaml:"stable_streak,omitempty"`
+}
+
+const (
+	defaultFlakinessThreshold = 0.3
+	defaultFlakyTestMinFlips  = 2
+	defaultFlakyTestLookback  = 7
+	defaultStableStreak       = 10
+)
+
+// GetFlakinessThreshold returns the minimum flakiness score for a test to be
+// quarantined.
+func (p *FlakyTestPolicy) GetFlakinessThreshold() float64 {
+	if p.FlakinessThreshold <= 0 {
+		return defaultFlakinessThreshold
+	}
+	return p.FlakinessThreshold
+}
+
+// GetMinFlips returns the minimum number of flips for a test to be
+// quarantined.
+func (p *FlakyTestPolicy) GetMinFlips() int {
+	if p.MinFlips <= 0 {
+		return defaultFlakyTestMinFlips
+	}
+	return p.MinFlips
+}
+
+// GetLookbackPeriod returns how far back to scan task executions for flaky
+// tests.
+func (p *FlakyTestPolicy) GetLookbackPeriod() time.Duration {
+	days := p.LookbackDays
+	if days <= 0 {
+		days = defaultFlakyTestLookback
+	}
+	return time.Duration(days) * utility.Day
+}
+
+// GetStableStreak returns the number of consecutive passing runs after which
+// an automatically quarantined test is unquarantined.
+func (p *FlakyTestPolicy) GetStableStreak() int {
+	if p.StableStreak <= 0 {
+		return defaultStableStreak
+	}
+	return p.StableStreak
+}
+
+// Validate checks that the policy's settings are within their valid ranges.
+func (p *FlakyTestPolicy) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(p.FlakinessThreshold < 0 || p.FlakinessThreshold > 1, "flakiness threshold must be between 0 and 1")
+	catcher.NewWhen(p.MinFlips < 0, "minimum flips cannot be negative")
+	catcher.NewWhen(p.LookbackDays < 0, "lookback days cannot be negative")
+	catcher.NewWhen(p.StableStreak < 0, "stable streak cannot be negative")
+	return catcher.Resolve()
+}
+
 var (
 	// bson fields for the ProjectRef struct
 	ProjectRefIdKey                                 = bsonutil.MustHaveTag(ProjectRef{}, "Id")
@@ -676,6 +757,13 @@ func (p *ProjectRef) IsTestSelectionMainlineDefaultEnabled() bool {
 	return utility.FromBoolPtr(p.TestSelection.MainlineDefaultEnabled)
 }
 
+// IsFlakyTestAutoQuarantineEnabled returns whether flaky tests in the project
+// should be automatically quarantined. Quarantine is a test selection feature,
+// so test selection must also be allowed in the project.
+func (p *ProjectRef) IsFlakyTestAutoQuarantineEnabled() bool {
+	return p.IsTestSelectionAllowed() && utility.FromBoolPtr(p.FlakyTestPolicy.AutoQuarantineEnabled)
+}
+
 // IsTestSelectionFilteringEnabled returns whether test selection may filter
 // tests for a task. Patch requesters may use test selection whenever the task
 // is enabled. Mainline commits additionally require the mainline project
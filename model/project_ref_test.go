@@ -4276,6 +4276,39 @@ func TestIsTestSelectionFilteringEnabled(t *testing.T) {
 	}
 }
 
+func TestFlakyTestPolicy(t *testing.T) {
+	t.Run("AutoQuarantineRequiresTestSelection", func(t *testing.T) {
+		ref := ProjectRef{FlakyTestPolicy: FlakyTestPolicy{AutoQuarantineEnabled: utility.TruePtr()}}
+		assert.False(t, ref.IsFlakyTestAutoQuarantineEnabled())
+		ref.TestSelection.Allowed = utility.TruePtr()
+		assert.True(t, ref.IsFlakyTestAutoQuarantineEnabled())
+		ref.FlakyTestPolicy.AutoQuarantineEnabled = utility.FalsePtr()
+		assert.False(t, ref.IsFlakyTestAutoQuarantineEnabled())
+	})
+	t.Run("ZeroValuesUseDefaults", func(t *testing.T) {
+		policy := FlakyTestPolicy{}
+		assert.Equal(t, defaultFlakinessThreshold, policy.GetFlakinessThreshold())
+		assert.Equal(t, defaultFlakyTestMinFlips, policy.GetMinFlips())
+		assert.Equal(t, defaultFlakyTestLookback*utility.Day, policy.GetLookbackPeriod())
+		assert.Equal(t, defaultStableStreak, policy.GetStableStreak())
+	})
+	t.Run("SetValuesOverrideDefaults", func(t *testing.T) {
+		policy := FlakyTestPolicy{FlakinessThreshold: 0.5, MinFlips: 4, LookbackDays: 3, StableStreak: 20}
+		assert.Equal(t, 0.5, policy.GetFlakinessThreshold())
+		assert.Equal(t, 4, policy.GetMinFlips())
+		assert.Equal(t, 3*utility.Day, policy.GetLookbackPeriod())
+		assert.Equal(t, 20, policy.GetStableStreak())
+	})
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, (&FlakyTestPolicy{}).Validate())
+		assert.NoError(t, (&FlakyTestPolicy{FlakinessThreshold: 1, MinFlips: 1, LookbackDays: 1, StableStreak: 1}).Validate())
+		assert.Error(t, (&FlakyTestPolicy{FlakinessThreshold: 1.5}).Validate())
+		assert.Error(t, (&FlakyTestPolicy{MinFlips: -1}).Validate())
+		assert.Error(t, (&FlakyTestPolicy{LookbackDays: -1}).Validate())
+		assert.Error(t, (&FlakyTestPolicy{StableStreak: -1}).Validate())
+	})
+}
+
 // The settings UI never sends artifact credentials, so no section write may include them.
 func TestArtifactCredentialsSurviveProjectSettingsWrites(t *testing.T) {
 	credentials := ArtifactCredentialSettings{AWSKeyVarName: "aws_key", AWSSecretVarName: "aws_secret"}
@@ -598,6 +598,67 @@ func TestPopulateExpansions(t *testing.T) {
 	assert.Equal(upstreamProject.Branch, expansions.Get("trigger_branch"))
 }
 
+func TestPopulateExpansionsDependencyOutputs(t *testing.T) {
+	require.NoError(t, db.ClearCollections(VersionCollection, ProjectRefCollection, task.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(VersionCollection, ProjectRefCollection, task.Collection))
+	}()
+	ctx := t.Context()
+
+	require.NoError(t, (&ProjectRef{Id: "mci", Identifier: "mci"}).Insert(ctx))
+	require.NoError(t, (&Version{Id: "v1", Requester: evergreen.RepotrackerVersionRequester}).Insert(ctx))
+	compile := task.Task{
+		Id:           "compile",
+		DisplayName:  "compile",
+		BuildVariant: "bv1",
+		Version:      "v1",
+		StructuredOutputs: map[string]task.StructuredOutput{
+			"build_id": {Type: task.StructuredOutputTypeString, Value: "abc123"},
+			"num_bins": {Type: task.StructuredOutputTypeInt, Value: "3"},
+		},
+	}
+	require.NoError(t, compile.Insert(ctx))
+	lint := task.Task{
+		Id:           "lint",
+		DisplayName:  "lint",
+		BuildVariant: "bv2",
+		Version:      "v1",
+		StructuredOutputs: map[string]task.StructuredOutput{
+			"passed": {Type: task.StructuredOutputTypeBool, Value: "true"},
+		},
+	}
+	require.NoError(t, lint.Insert(ctx))
+	unrelated := task.Task{
+		Id:           "unrelated",
+		DisplayName:  "unrelated",
+		BuildVariant: "bv1",
+		Version:      "v1",
+		StructuredOutputs: map[string]task.StructuredOutput{
+			"secret": {Type: task.StructuredOutputTypeString, Value: "nope"},
+		},
+	}
+	require.NoError(t, unrelated.Insert(ctx))
+
+	taskDoc := &task.Task{
+		Id:           "test",
+		DisplayName:  "test",
+		BuildVariant: "bv1",
+		Version:      "v1",
+		Project:      "mci",
+		DependsOn: []task.Dependency{
+			{TaskId: compile.Id},
+			{TaskId: lint.Id},
+		},
+	}
+	expansions, err := PopulateExpansions(ctx, taskDoc, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", expansions.Get("compile.build_id"))
+	assert.Equal(t, "3", expansions.Get("compile.num_bins"))
+	assert.Equal(t, "true", expansions.Get("bv2.lint.passed"))
+	assert.False(t, expansions.Exists("lint.passed"), "outputs from other build variants should be namespaced by build variant")
+	assert.False(t, expansions.Exists("unrelated.secret"), "outputs from tasks that are not dependencies should not be included")
+}
+
 func TestPopulateExpansionsChildPatch(t *testing.T) {
 	require := require.New(t)
 	assert := assert.New(t)
@@ -0,0 +1,144 @@
+package reliability
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/teststats"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// TestReliabilityFilter represents search parameters when querying test
+// statistics.
+type TestReliabilityFilter struct {
+	teststats.StatsFilter
+	Significance float64
+}
+
+// ValidateForTestReliability validates that the filter is valid for use with
+// test stats.
+func (f *TestReliabilityFilter) ValidateForTestReliability() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(f.Validate())
+	if f.Significance > MaxSignificanceLimit || f.Significance < MinSignificanceLimit {
+		catcher.New("invalid significance")
+	}
+	return catcher.Resolve()
+}
+
+//////////////////////////////
+// Test Reliability Querying //
+//////////////////////////////
+
+// TestReliability represents test execution statistics.
+type TestReliability struct {
+	TestName        string
+	TaskName        string
+	BuildVariant    string
+	Date            time.Time
+	NumTotal        int
+	NumPass         int
+	NumFail         int
+	P50DurationPass float64
+	P95DurationPass float64
+	// SuccessRate is the lower bound of the Wilson score interval of the
+	// test's pass rate.
+	SuccessRate float64
+	// MaxSuccessRate is the upper bound of the Wilson score interval of the
+	// test's pass rate. The worst tests are ranked by it so that tests with
+	// too few runs to be confident about are not reported as unreliable.
+	MaxSuccessRate float64
+	Z              float64
+	LastUpdate     time.Time
+}
+
+// newTestReliability creates a TestReliability struct from the test stats and
+// calculates the success rates using the z score.
+func newTestReliability(testStat teststats.TestStats, z float64) TestReliability {
+	low, _, high := wilsonScoreInterval(testStat.NumPass, testStat.NumTotal, z)
+	return TestReliability{
+		TestName:        testStat.TestName,
+		TaskName:        testStat.TaskName,
+		BuildVariant:    testStat.BuildVariant,
+		Date:            testStat.Date,
+		NumTotal:        testStat.NumTotal,
+		NumPass:         testStat.NumPass,
+		NumFail:         testStat.NumFail,
+		P50DurationPass: testStat.P50DurationPass,
+		P95DurationPass: testStat.P95DurationPass,
+		SuccessRate:     math.Ceil(low*100) / 100,
+		MaxSuccessRate:  math.Ceil(high*100) / 100,
+		Z:               z,
+		LastUpdate:      testStat.LastUpdate,
+	}
+}
+
+// GetDailyTestReliabilityScores queries the precomputed daily test statistics
+// using a filter and calculates the success reliability score of each test for
+// each day from the Wilson confidence interval.
+func GetDailyTestReliabilityScores(ctx context.Context, filter TestReliabilityFilter) ([]TestReliability, error) {
+	if err := filter.ValidateForTestReliability(); err != nil {
+		return nil, errors.Wrap(err, "invalid stats filter")
+	}
+	testStats, err := teststats.GetDailyTestStats(ctx, filter.StatsFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting daily test statistics")
+	}
+
+	z := significanceToZ(filter.Significance)
+	scores := make([]TestReliability, len(testStats))
+	for i, testStat := range testStats {
+		scores[i] = newTestReliability(testStat, z)
+	}
+	return scores, nil
+}
+
+// worstTestCandidatesPerResult is how many tests are scored for each of the
+// worst tests that are returned. Scoring every test in a large project is too
+// expensive, so only the tests with the highest failure rates are scored.
+const worstTestCandidatesPerResult = 10
+
+// GetWorstTestReliabilityScores queries the precomputed daily test statistics
+// using a filter, calculates the success reliability score of each test over
+// the whole date range and returns up to the filter's limit of tests, least
+// reliable first. Only the tests with the highest failure rates, up to
+// worstTestCandidatesPerResult times the limit, are scored.
+func GetWorstTestReliabilityScores(ctx context.Context, filter TestReliabilityFilter) ([]TestReliability, error) {
+	if err := filter.ValidateForTestReliability(); err != nil {
+		return nil, errors.Wrap(err, "invalid stats filter")
+	}
+	testStats, err := teststats.GetTestStatsForPeriod(ctx, filter.StatsFilter, filter.Limit*worstTestCandidatesPerResult)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting test statistics")
+	}
+
+	z := significanceToZ(filter.Significance)
+	scores := make([]TestReliability, len(testStats))
+	for i, testStat := range testStats {
+		scores[i] = newTestReliability(testStat, z)
+	}
+	sortWorstFirst(scores)
+	if len(scores) > filter.Limit {
+		scores = scores[:filter.Limit]
+	}
+	return scores, nil
+}
+
+// sortWorstFirst sorts the test reliability scores so that the tests that
+// are most confidently unreliable come first.
+func sortWorstFirst(scores []TestReliability) {
+	slices.SortFunc(scores, func(a, b TestReliability) int {
+		return cmp.Or(
+			cmp.Compare(a.MaxSuccessRate, b.MaxSuccessRate),
+			cmp.Compare(a.SuccessRate, b.SuccessRate),
+			cmp.Compare(b.NumFail, a.NumFail),
+			cmp.Compare(a.BuildVariant, b.BuildVariant),
+			cmp.Compare(a.TaskName, b.TaskName),
+			cmp.Compare(a.TestName, b.TestName),
+		)
+	})
+}
@@ -0,0 +1,63 @@
+package reliability
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/teststats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWilsonScoreInterval(t *testing.T) {
+	z := significanceToZ(DefaultSignificance)
+
+	low, p, high := wilsonScoreInterval(0, 0, z)
+	assert.Zero(t, low)
+	assert.Zero(t, p)
+	assert.Zero(t, high)
+
+	low, p, high = wilsonScoreInterval(50, 100, z)
+	assert.InDelta(t, 0.5, p, 0.001)
+	assert.InDelta(t, 0.404, low, 0.001)
+	assert.InDelta(t, 0.596, high, 0.001)
+
+	low, p, high = wilsonScoreInterval(1, 1, z)
+	assert.InDelta(t, 1, p, 0.001)
+	assert.Less(t, low, 0.5, "a single run should not give a confident lower bound")
+	assert.InDelta(t, 1, high, 0.001)
+}
+
+func TestNewTestReliability(t *testing.T) {
+	score := newTestReliability(teststats.TestStats{
+		TestName:        "test",
+		TaskName:        "unit",
+		BuildVariant:    "ubuntu",
+		NumTotal:        100,
+		NumPass:         50,
+		NumFail:         50,
+		P50DurationPass: 1.5,
+		P95DurationPass: 3,
+	}, significanceToZ(DefaultSignificance))
+	assert.Equal(t, "test", score.TestName)
+	assert.Equal(t, 50, score.NumFail)
+	assert.InDelta(t, 0.41, score.SuccessRate, 0.001)
+	assert.InDelta(t, 0.60, score.MaxSuccessRate, 0.001)
+	assert.InDelta(t, 3, score.P95DurationPass, 0.001)
+}
+
+func TestSortWorstFirst(t *testing.T) {
+	z := significanceToZ(DefaultSignificance)
+	scores := []TestReliability{
+		newTestReliability(teststats.TestStats{TestName: "rarely_run", NumTotal: 1, NumPass: 1}, z),
+		newTestReliability(teststats.TestStats{TestName: "stable", NumTotal: 100, NumPass: 100}, z),
+		newTestReliability(teststats.TestStats{TestName: "flaky", NumTotal: 100, NumPass: 80, NumFail: 20}, z),
+		newTestReliability(teststats.TestStats{TestName: "broken", NumTotal: 100, NumFail: 100}, z),
+	}
+
+	sortWorstFirst(scores)
+	require.Len(t, scores, 4)
+	assert.Equal(t, "broken", scores[0].TestName)
+	assert.Equal(t, "flaky", scores[1].TestName)
+	assert.Equal(t, "rarely_run", scores[2].TestName, "tests with few runs should not be ranked as unreliable")
+	assert.Equal(t, "stable", scores[3].TestName)
+}
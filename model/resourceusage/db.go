@@ -0,0 +1,46 @@
+package resourceusage
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	IDKey         = bsonutil.MustHaveTag(TaskResourceUsage{}, "ID")
+	TaskIDKey     = bsonutil.MustHaveTag(TaskResourceUsage{}, "TaskID")
+	ExecutionKey  = bsonutil.MustHaveTag(TaskResourceUsage{}, "Execution")
+	SamplesKey    = bsonutil.MustHaveTag(TaskResourceUsage{}, "Samples")
+	CreateTimeKey = bsonutil.MustHaveTag(TaskResourceUsage{}, "CreateTime")
+)
+
+// ByTaskIDAndExecution returns a query for the resource usage of the given
+// task execution.
+func ByTaskIDAndExecution(taskID string, execution int) db.Q {
+	return db.Query(bson.M{IDKey: TaskResourceUsageID(taskID, execution)})
+}
+
+// FindOne gets one TaskResourceUsage for the given query.
+func FindOne(ctx context.Context, query db.Q) (*TaskResourceUsage, error) {
+	u := &TaskResourceUsage{}
+	err := db.FindOneQ(ctx, Collection, query, u)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return u, err
+}
+
+// Upsert stores the resource usage for the task execution, replacing any
+// resource usage previously stored for it.
+func (u *TaskResourceUsage) Upsert(ctx context.Context) error {
+	u.ID = TaskResourceUsageID(u.TaskID, u.Execution)
+	u.CreateTime = time.Now()
+
+	_, err := db.Replace(ctx, Collection, bson.M{IDKey: u.ID}, u)
+	return errors.Wrap(err, "upserting task resource usage")
+}
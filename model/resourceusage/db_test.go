@@ -0,0 +1,54 @@
+package resourceusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	_ "github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsert(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+	ctx := t.Context()
+
+	sampleTime := time.Now().Round(time.Millisecond)
+	usage := &TaskResourceUsage{
+		TaskID:    "t1",
+		Execution: 1,
+		Samples: []Sample{
+			{Time: sampleTime, CPUPercent: 10, MemoryPercent: 20},
+			{Time: sampleTime.Add(time.Second), Command: "shell.exec", MemoryPercent: 90, NumProcesses: 5},
+		},
+	}
+	require.NoError(t, usage.Upsert(ctx))
+
+	found, err := FindOne(ctx, ByTaskIDAndExecution("t1", 1))
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "t1_1", found.ID)
+	require.Len(t, found.Samples, 2)
+	assert.True(t, sampleTime.Equal(found.Samples[0].Time))
+	assert.Equal(t, "shell.exec", found.Samples[1].Command)
+	assert.Equal(t, 5, found.Samples[1].NumProcesses)
+
+	t.Run("ReplacesExistingUsage", func(t *testing.T) {
+		usage.Samples = usage.Samples[:1]
+		require.NoError(t, usage.Upsert(ctx))
+
+		found, err := FindOne(ctx, ByTaskIDAndExecution("t1", 1))
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Len(t, found.Samples, 1)
+	})
+	t.Run("OtherExecutionNotFound", func(t *testing.T) {
+		found, err := FindOne(ctx, ByTaskIDAndExecution("t1", 0))
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
@@ -0,0 +1,3 @@
+// Package resourceusage models the time series of host resource usage that
+// the agent records while running a task.
+package resourceusage
@@ -0,0 +1,51 @@
+package resourceusage
+
+import (
+	"fmt"
+	"time"
+)
+
+const Collection = "task_resource_usage"
+
+// Sample is a snapshot of the host's resource usage while a task was running.
+type Sample struct {
+	// Time is when the sample was taken.
+	Time time.Time `bson:"time" json:"time"`
+	// Command is the full display name of the command that was running when
+	// the sample was taken, if any.
+	Command string `bson:"command,omitempty" json:"command,omitempty"`
+	// CPUPercent is the percentage of the host's total CPU in use.
+	CPUPercent float64 `bson:"cpu_percent" json:"cpu_percent"`
+	// MemoryPercent is the percentage of the host's memory in use.
+	MemoryPercent float64 `bson:"memory_percent" json:"memory_percent"`
+	// MemoryUsedBytes is the amount of the host's memory in use.
+	MemoryUsedBytes uint64 `bson:"memory_used_bytes" json:"memory_used_bytes"`
+	// DiskReadBytes is the number of bytes read from all disks since the
+	// previous sample.
+	DiskReadBytes uint64 `bson:"disk_read_bytes" json:"disk_read_bytes"`
+	// DiskWriteBytes is the number of bytes written to all disks since the
+	// previous sample.
+	DiskWriteBytes uint64 `bson:"disk_write_bytes" json:"disk_write_bytes"`
+	// WorkDirDiskUsedBytes is the total size of the files in the task's
+	// working directory. It is recomputed less often than the other fields,
+	// so it can lag behind the time of the sample.
+	WorkDirDiskUsedBytes uint64 `bson:"work_dir_disk_used_bytes" json:"work_dir_disk_used_bytes"`
+	// NumProcesses is the number of processes running on the host.
+	NumProcesses int `bson:"num_processes" json:"num_processes"`
+}
+
+// TaskResourceUsage is the resource usage time series recorded for a single
+// task execution.
+type TaskResourceUsage struct {
+	ID         string    `bson:"_id" json:"id"`
+	TaskID     string    `bson:"task_id" json:"task_id"`
+	Execution  int       `bson:"execution" json:"execution"`
+	Samples    []Sample  `bson:"samples" json:"samples"`
+	CreateTime time.Time `bson:"create_time" json:"create_time"`
+}
+
+// TaskResourceUsageID returns the ID of the resource usage document for the
+// given task execution.
+func TaskResourceUsageID(taskID string, execution int) string {
+	return fmt.Sprintf("%s_%d", taskID, execution)
+}
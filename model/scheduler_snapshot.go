@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/distro"
+)
+
+// SchedulerSnapshot is a record of a distro's task queue and hosts at a point
+// in time. It contains what the scheduler's planner and host allocator use to
+// order the queue and decide how many hosts to start, so that it can be
+// replayed offline to see how different planner and host allocator settings
+// would have handled the same traffic.
+type SchedulerSnapshot struct {
+	DistroID   string    `json:"distro_id"`
+	CapturedAt time.Time `json:"captured_at"`
+	// Provider is the distro's cloud provider.
+	Provider string `json:"provider"`
+	// DispatcherVersion is the distro's dispatcher version, which determines
+	// whether tasks with unmet dependencies need hosts.
+	DispatcherVersion string `json:"dispatcher_version"`
+	// PlannerSettings are the distro's planner settings, resolved against the
+	// admin settings when the snapshot was captured.
+	PlannerSettings distro.PlannerSettings `json:"planner_settings"`
+	// HostAllocatorSettings are the distro's host allocator settings, resolved
+	// against the admin settings when the snapshot was captured.
+	HostAllocatorSettings distro.HostAllocatorSettings `json:"host_allocator_settings"`
+	// Tasks are the tasks that were waiting to run in the distro.
+	Tasks []SchedulerSnapshotTask `json:"tasks"`
+	// Hosts are the distro's hosts that were up.
+	Hosts []SchedulerSnapshotHost `json:"hosts"`
+}
+
+// SchedulerSnapshotTask is a task in a scheduler snapshot.
+type SchedulerSnapshotTask struct {
+	ID                  string    `json:"id"`
+	DisplayName         string    `json:"display_name"`
+	BuildVariant        string    `json:"build_variant"`
+	Project             string    `json:"project"`
+	Version             string    `json:"version"`
+	Requester           string    `json:"requester"`
+	TaskGroup           string    `json:"task_group,omitempty"`
+	TaskGroupMaxHosts   int       `json:"task_group_max_hosts,omitempty"`
+	TaskGroupOrder      int       `json:"task_group_order,omitempty"`
+	Priority            int64     `json:"priority"`
+	ActivatedBy         string    `json:"activated_by,omitempty"`
+	GenerateTask        bool      `json:"generate_task,omitempty"`
+	NumDependents       int       `json:"num_dependents,omitempty"`
+	ActivatedTime       time.Time `json:"activated_time"`
+	IngestTime          time.Time `json:"ingest_time"`
+	ScheduledTime       time.Time `json:"scheduled_time"`
+	DependenciesMetTime time.Time `json:"dependencies_met_time"`
+	// StartTime is when the task started running, if it's running.
+	StartTime time.Time `json:"start_time"`
+	// DependsOn are the IDs of the task's unfinished dependencies that are
+	// also in the snapshot. Dependencies outside of the snapshot are assumed
+	// to be met.
+	DependsOn              []string      `json:"depends_on,omitempty"`
+	ExpectedDuration       time.Duration `json:"expected_duration_ns"`
+	ExpectedDurationStdDev time.Duration `json:"expected_duration_std_dev_ns"`
+}
+
+// SchedulerSnapshotHost is a host in a scheduler snapshot.
+type SchedulerSnapshotHost struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	// RunningTask is the task that the host was running, if any.
+	RunningTask *SchedulerSnapshotTask `json:"running_task,omitempty"`
+}
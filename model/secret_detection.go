@@ -0,0 +1,64 @@
+package model
+
+import (
+	"regexp"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// SecretDetectionSettings configures the agent to scan task logs for values
+// that look like credentials and redact them before they are uploaded, in
+// addition to the project's private variables.
+type SecretDetectionSettings struct {
+	// Enabled indicates whether task logs are scanned for secrets.
+	Enabled *bool `bson:"enabled,omitempty" json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// HighEntropyDisabled turns off the detection of long random-looking
+	// tokens, which is the detector most prone to false positives. Well-known
+	// credential shapes and the project's own patterns are still redacted.
+	HighEntropyDisabled bool `bson:"high_entropy_disabled,omitempty" json:"high_entropy_disabled,omitempty" yaml:"high_entropy_disabled,omitempty"`
+	// Patterns are additional project-specific secret patterns to redact.
+	Patterns []SecretDetectionPattern `bson:"patterns,omitempty" json:"patterns,omitempty" yaml:"patterns,omitempty"`
+}
+
+// SecretDetectionPattern is a project-defined regular expression matching a
+// secret.
+type SecretDetectionPattern struct {
+	// Name identifies the type of secret in the redacted logs and in the
+	// task's redaction summary.
+	Name string `bson:"name" json:"name" yaml:"name"`
+	// Regex is the regular expression matching the secret. The whole match is
+	// redacted.
+	Regex string `bson:"regex" json:"regex" yaml:"regex"`
+}
+
+// IsEnabled returns whether task logs should be scanned for secrets.
+func (s *SecretDetectionSettings) IsEnabled() bool {
+	return utility.FromBoolPtr(s.Enabled)
+}
+
+// Validate checks that every pattern is named uniquely and compiles.
+func (s *SecretDetectionSettings) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	names := map[string]bool{}
+	for i, p := range s.Patterns {
+		if p.Name == "" {
+			catcher.Errorf("secret pattern at index %d must have a name", i)
+		} else if names[p.Name] {
+			catcher.Errorf("secret pattern name '%s' is used more than once", p.Name)
+		}
+		names[p.Name] = true
+
+		if p.Regex == "" {
+			catcher.Errorf("secret pattern '%s' must have a regex", p.Name)
+			continue
+		}
+		if re, err := regexp.Compile(p.Regex); err != nil {
+			catcher.Wrapf(err, "compiling regex for secret pattern '%s'", p.Name)
+		} else if re.MatchString("") {
+			catcher.Errorf("regex for secret pattern '%s' must not match an empty string", p.Name)
+		}
+	}
+	return errors.Wrap(catcher.Resolve(), "invalid secret detection settings")
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretDetectionSettingsValidate(t *testing.T) {
+	for name, test := range map[string]struct {
+		patterns []SecretDetectionPattern
+		isValid  bool
+	}{
+		"NoPatterns": {isValid: true},
+		"ValidPatterns": {
+			patterns: []SecretDetectionPattern{
+				{Name: "internal_token", Regex: `itk-[0-9]{6}`},
+				{Name: "service_password", Regex: `svcpw_[A-Za-z0-9]+`},
+			},
+			isValid: true,
+		},
+		"MissingName": {
+			patterns: []SecretDetectionPattern{{Regex: `itk-[0-9]{6}`}},
+		},
+		"DuplicateName": {
+			patterns: []SecretDetectionPattern{
+				{Name: "internal_token", Regex: `itk-[0-9]{6}`},
+				{Name: "internal_token", Regex: `itk2-[0-9]{6}`},
+			},
+		},
+		"MissingRegex": {
+			patterns: []SecretDetectionPattern{{Name: "internal_token"}},
+		},
+		"InvalidRegex": {
+			patterns: []SecretDetectionPattern{{Name: "internal_token", Regex: `itk-[0-9`}},
+		},
+		"RegexMatchesEmptyString": {
+			patterns: []SecretDetectionPattern{{Name: "internal_token", Regex: `[0-9]*`}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			settings := SecretDetectionSettings{Patterns: test.patterns}
+			if test.isValid {
+				assert.NoError(t, settings.Validate())
+			} else {
+				assert.Error(t, settings.Validate())
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// CriticalPath is the longest chain of dependent tasks in a version. No matter
+// how much capacity is available, the version can't finish faster than it
+// takes to run the tasks on its critical path one after the other, so
+// shortening one of those tasks is what reduces the version's wall-clock time.
+type CriticalPath struct {
+	// Tasks are the tasks on the critical path, ordered from the first task
+	// to run to the last.
+	Tasks []CriticalPathTask
+	// MinimumMakespan is the sum of the durations of the tasks on the
+	// critical path. It is the fastest the version could finish if every task
+	// started as soon as its dependencies finished.
+	MinimumMakespan time.Duration
+	// Makespan is the actual wall-clock time from when the first task was
+	// scheduled until the last task finished, or until now if the version
+	// has unfinished tasks.
+	Makespan time.Duration
+	// TotalQueueWait is the total time that tasks on the critical path spent
+	// waiting for a host after their dependencies were met.
+	TotalQueueWait time.Duration
+	// TotalDependencyWait is the total time that tasks on the critical path
+	// spent waiting for their dependencies after they were scheduled.
+	TotalDependencyWait time.Duration
+}
+
+// CriticalPathTask is a single task on a version's critical path.
+type CriticalPathTask struct {
+	TaskID       string
+	DisplayName  string
+	BuildVariant string
+	Status       string
+	// Duration is how long the task ran. For tasks that haven't finished, it
+	// is the larger of its expected duration and how long it has been running
+	// so far.
+	Duration time.Duration
+	// DurationEstimated indicates that the task hasn't finished, so Duration
+	// is an estimate.
+	DurationEstimated bool
+	// QueueWait is the time between the task's dependencies being met and
+	// the task starting.
+	QueueWait time.Duration
+	// DependencyWait is the time between the task being scheduled and its
+	// dependencies being met.
+	DependencyWait time.Duration
+}
+
+// GetVersionCriticalPath computes the critical path of the version's
+// activated tasks, excluding tasks that are blocked and will never run.
+// Finished tasks are weighted by how long they actually ran and unfinished
+// tasks by their expected duration.
+func GetVersionCriticalPath(ctx context.Context, versionID string) (*CriticalPath, error) {
+	tasks, err := FindWithFields(ctx, ByVersion(versionID),
+		IdKey,
+		DisplayNameKey,
+		BuildVariantKey,
+		ProjectKey,
+		StatusKey,
+		ActivatedKey,
+		DisplayOnlyKey,
+		DependsOnKey,
+		OverrideDependenciesKey,
+		ScheduledTimeKey,
+		ActivatedTimeKey,
+		DependenciesMetTimeKey,
+		StartTimeKey,
+		FinishTimeKey,
+		TimeTakenKey,
+		ExpectedDurationKey,
+		ExpectedDurationStddevKey,
+		DurationPredictionKey,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting tasks for version '%s'", versionID)
+	}
+
+	expectedDuration := func(t *Task) time.Duration {
+		return t.FetchExpectedDuration(ctx).Average
+	}
+	return computeCriticalPath(tasks, time.Now(), expectedDuration)
+}
+
+func computeCriticalPath(tasks []Task, now time.Time, expectedDuration func(*Task) time.Duration) (*CriticalPath, error) {
+	var runnable []Task
+	for _, t := range tasks {
+		if t.Activated && !t.DisplayOnly && (t.IsFinished() || !t.Blocked()) {
+			runnable = append(runnable, t)
+		}
+	}
+
+	path := &CriticalPath{Makespan: versionMakespan(runnable, now)}
+	if len(runnable) == 0 {
+		return path, nil
+	}
+
+	tasksByID := make(map[string]*Task, len(runnable))
+	pathTasks := make(map[string]CriticalPathTask, len(runnable))
+	for i := range runnable {
+		t := &runnable[i]
+		tasksByID[t.Id] = t
+		pathTasks[t.Id] = newCriticalPathTask(t, now, expectedDuration)
+	}
+
+	// In the transposed graph, depended on tasks sort before the tasks that
+	// depend on them, so every task's dependencies are visited before it.
+	g := taskDependencyGraph(runnable, true)
+	sorted, err := g.TopologicalStableSort()
+	if err != nil {
+		return nil, errors.Wrap(err, "sorting tasks by dependencies")
+	}
+
+	longest := make(map[string]time.Duration, len(sorted))
+	previous := make(map[string]string, len(sorted))
+	var last string
+	for _, node := range sorted {
+		if _, ok := tasksByID[node.ID]; !ok {
+			continue
+		}
+		var longestDep string
+		for _, edge := range g.EdgesIntoTask(node) {
+			dep := edge.From.ID
+			if _, ok := longest[dep]; !ok {
+				continue
+			}
+			if longestDep == "" || longest[dep] > longest[longestDep] || (longest[dep] == longest[longestDep] && dep < longestDep) {
+				longestDep = dep
+			}
+		}
+
+		longest[node.ID] = pathTasks[node.ID].Duration
+		if longestDep != "" {
+			longest[node.ID] += longest[longestDep]
+			previous[node.ID] = longestDep
+		}
+		if last == "" || longest[node.ID] > longest[last] || (longest[node.ID] == longest[last] && node.ID < last) {
+			last = node.ID
+		}
+	}
+	if last == "" {
+		return path, nil
+	}
+
+	path.MinimumMakespan = longest[last]
+	for id := last; id != ""; id = previous[id] {
+		pt := pathTasks[id]
+		path.Tasks = append([]CriticalPathTask{pt}, path.Tasks...)
+		path.TotalQueueWait += pt.QueueWait
+		path.TotalDependencyWait += pt.DependencyWait
+	}
+
+	return path, nil
+}
+
+func newCriticalPathTask(t *Task, now time.Time, expectedDuration func(*Task) time.Duration) CriticalPathTask {
+	pt := CriticalPathTask{
+		TaskID:       t.Id,
+		DisplayName:  t.DisplayName,
+		BuildVariant: t.BuildVariant,
+		Status:       t.Status,
+	}
+
+	started := !utility.IsZeroTime(t.StartTime)
+	switch {
+	case t.IsFinished():
+		if started && !utility.IsZeroTime(t.FinishTime) {
+			pt.Duration = t.FinishTime.Sub(t.StartTime)
+		} else {
+			pt.Duration = t.TimeTaken
+		}
+	case started:
+		pt.Duration = max(expectedDuration(t), now.Sub(t.StartTime))
+		pt.DurationEstimated = true
+	default:
+		pt.Duration = expectedDuration(t)
+		pt.DurationEstimated = true
+	}
+
+	scheduled := t.ScheduledTime
+	if utility.IsZeroTime(scheduled) {
+		scheduled = t.ActivatedTime
+	}
+	if utility.IsZeroTime(scheduled) {
+		return pt
+	}
+
+	depsMet := t.DependenciesMetTime
+	if utility.IsZeroTime(depsMet) {
+		if len(t.DependsOn) > 0 && !started {
+			// The task is still blocked on its dependencies.
+			pt.DependencyWait = positiveDuration(now.Sub(scheduled))
+			return pt
+		}
+		depsMet = scheduled
+	}
+	if depsMet.Before(scheduled) {
+		depsMet = scheduled
+	}
+	pt.DependencyWait = depsMet.Sub(scheduled)
+
+	switch {
+	case started:
+		pt.QueueWait = positiveDuration(t.StartTime.Sub(depsMet))
+	case !t.IsFinished():
+		pt.QueueWait = positiveDuration(now.Sub(depsMet))
+	}
+
+	return pt
+}
+
+// versionMakespan returns the time from when the first task was scheduled
+// until the last task finished, or until now if any task is unfinished.
+func versionMakespan(tasks []Task, now time.Time) time.Duration {
+	var first, last time.Time
+	for _, t := range tasks {
+		scheduled := t.ScheduledTime
+		if utility.IsZeroTime(scheduled) {
+			scheduled = t.ActivatedTime
+		}
+		if !utility.IsZeroTime(scheduled) && (first.IsZero() || scheduled.Before(first)) {
+			first = scheduled
+		}
+
+		end := now
+		if t.IsFinished() {
+			end = t.FinishTime
+		}
+		if !utility.IsZeroTime(end) && end.After(last) {
+			last = end
+		}
+	}
+	if first.IsZero() {
+		return 0
+	}
+	return positiveDuration(last.Sub(first))
+}
+
+func positiveDuration(d time.Duration) time.Duration {
+	return max(d, 0)
+}
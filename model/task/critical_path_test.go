@@ -0,0 +1,150 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeCriticalPath(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	noExpectedDuration := func(*Task) time.Duration { return 0 }
+
+	// compile (10m) -> unit (20m) -> e2e (30m) is longer than
+	// compile (10m) -> lint (5m), even though lint waited longer in the queue.
+	tasks := []Task{
+		{
+			Id:            "compile",
+			DisplayName:   "compile",
+			BuildVariant:  "bv",
+			Status:        evergreen.TaskSucceeded,
+			Activated:     true,
+			ScheduledTime: at(0),
+			StartTime:     at(2),
+			FinishTime:    at(12),
+		},
+		{
+			Id:                  "unit",
+			DisplayName:         "unit",
+			BuildVariant:        "bv",
+			Status:              evergreen.TaskSucceeded,
+			Activated:           true,
+			DependsOn:           []Dependency{{TaskId: "compile"}},
+			ScheduledTime:       at(0),
+			DependenciesMetTime: at(12),
+			StartTime:           at(13),
+			FinishTime:          at(33),
+		},
+		{
+			Id:                  "lint",
+			DisplayName:         "lint",
+			BuildVariant:        "bv",
+			Status:              evergreen.TaskSucceeded,
+			Activated:           true,
+			DependsOn:           []Dependency{{TaskId: "compile"}},
+			ScheduledTime:       at(0),
+			DependenciesMetTime: at(12),
+			StartTime:           at(40),
+			FinishTime:          at(45),
+		},
+		{
+			Id:                  "e2e",
+			DisplayName:         "e2e",
+			BuildVariant:        "bv",
+			Status:              evergreen.TaskFailed,
+			Activated:           true,
+			DependsOn:           []Dependency{{TaskId: "unit"}},
+			ScheduledTime:       at(0),
+			DependenciesMetTime: at(33),
+			StartTime:           at(38),
+			FinishTime:          at(68),
+		},
+		{
+			Id:           "inactive",
+			DisplayName:  "inactive",
+			BuildVariant: "bv",
+			DependsOn:    []Dependency{{TaskId: "e2e"}},
+		},
+	}
+
+	t.Run("FinishedVersion", func(t *testing.T) {
+		path, err := computeCriticalPath(tasks, at(100), noExpectedDuration)
+		require.NoError(t, err)
+
+		require.Len(t, path.Tasks, 3)
+		assert.Equal(t, "compile", path.Tasks[0].TaskID)
+		assert.Equal(t, "unit", path.Tasks[1].TaskID)
+		assert.Equal(t, "e2e", path.Tasks[2].TaskID)
+
+		assert.Equal(t, 60*time.Minute, path.MinimumMakespan)
+		assert.Equal(t, 68*time.Minute, path.Makespan, "makespan should end when the last task finished")
+
+		assert.Equal(t, 2*time.Minute, path.Tasks[0].QueueWait)
+		assert.Zero(t, path.Tasks[0].DependencyWait)
+		assert.Equal(t, time.Minute, path.Tasks[1].QueueWait)
+		assert.Equal(t, 12*time.Minute, path.Tasks[1].DependencyWait)
+		assert.Equal(t, 5*time.Minute, path.Tasks[2].QueueWait)
+		assert.Equal(t, 33*time.Minute, path.Tasks[2].DependencyWait)
+		assert.Equal(t, 8*time.Minute, path.TotalQueueWait)
+		assert.Equal(t, 45*time.Minute, path.TotalDependencyWait)
+		for _, pt := range path.Tasks {
+			assert.False(t, pt.DurationEstimated)
+		}
+	})
+	t.Run("UnfinishedTasksUseExpectedDuration", func(t *testing.T) {
+		unfinished := append([]Task{}, tasks...)
+		unfinished[3].Status = evergreen.TaskStarted
+		unfinished[3].FinishTime = time.Time{}
+		unfinished[2] = Task{
+			Id:                  "lint",
+			DisplayName:         "lint",
+			BuildVariant:        "bv",
+			Status:              evergreen.TaskUndispatched,
+			Activated:           true,
+			DependsOn:           []Dependency{{TaskId: "compile"}},
+			ScheduledTime:       at(0),
+			DependenciesMetTime: at(12),
+		}
+		expectedDuration := func(t *Task) time.Duration {
+			if t.Id == "lint" {
+				return 2 * time.Hour
+			}
+			return 10 * time.Minute
+		}
+
+		path, err := computeCriticalPath(unfinished, at(50), expectedDuration)
+		require.NoError(t, err)
+
+		require.Len(t, path.Tasks, 2)
+		assert.Equal(t, "compile", path.Tasks[0].TaskID)
+		lint := path.Tasks[1]
+		assert.Equal(t, "lint", lint.TaskID)
+		assert.True(t, lint.DurationEstimated)
+		assert.Equal(t, 2*time.Hour, lint.Duration)
+		assert.Equal(t, 38*time.Minute, lint.QueueWait, "a task that hasn't started should have waited in the queue until now")
+		assert.Equal(t, 130*time.Minute, path.MinimumMakespan)
+		assert.Equal(t, 50*time.Minute, path.Makespan, "makespan should end now if the version is unfinished")
+	})
+	t.Run("RunningTaskUsesElapsedTimeIfLongerThanExpected", func(t *testing.T) {
+		running := append([]Task{}, tasks...)
+		running[3].Status = evergreen.TaskStarted
+		running[3].FinishTime = time.Time{}
+
+		path, err := computeCriticalPath(running, at(98), func(*Task) time.Duration { return time.Minute })
+		require.NoError(t, err)
+		require.Len(t, path.Tasks, 3)
+		assert.Equal(t, 60*time.Minute, path.Tasks[2].Duration)
+		assert.True(t, path.Tasks[2].DurationEstimated)
+	})
+	t.Run("NoActivatedTasks", func(t *testing.T) {
+		path, err := computeCriticalPath([]Task{tasks[4]}, at(100), noExpectedDuration)
+		require.NoError(t, err)
+		assert.Empty(t, path.Tasks)
+		assert.Zero(t, path.MinimumMakespan)
+		assert.Zero(t, path.Makespan)
+	})
+}
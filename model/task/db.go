@@ -95,6 +95,7 @@ var (
 	TaskCostKey                   = bsonutil.MustHaveTag(Task{}, "TaskCost")
 	PredictedTaskCostKey          = bsonutil.MustHaveTag(Task{}, "PredictedTaskCost")
 	S3UsageKey                    = bsonutil.MustHaveTag(Task{}, "S3Usage")
+	StructuredOutputsKey          = bsonutil.MustHaveTag(Task{}, "StructuredOutputs")
 	ExpectedDurationKey           = bsonutil.MustHaveTag(Task{}, "ExpectedDuration")
 	ExpectedDurationStddevKey     = bsonutil.MustHaveTag(Task{}, "ExpectedDurationStdDev")
 	DurationPredictionKey         = bsonutil.MustHaveTag(Task{}, "DurationPrediction")
@@ -1404,6 +1405,80 @@ func FindStuckDispatching(ctx context.Context) ([]Task, error) {
 	return tasks, nil
 }
 
+// ScheduledTaskFilter restricts the tasks returned by
+// FindScheduledButNotStarted. Empty fields match any task.
+type ScheduledTaskFilter struct {
+	TaskID   string
+	Projects []string
+	Version  string
+	BuildID  string
+	Distro   string
+}
+
+// query returns the conditions a task must meet to match the filter.
+func (f ScheduledTaskFilter) query() bson.M {
+	q := bson.M{}
+	if f.TaskID != "" {
+		q[IdKey] = f.TaskID
+	}
+	if len(f.Projects) > 0 {
+		q[ProjectKey] = bson.M{"$in": f.Projects}
+	}
+	if f.Version != "" {
+		q[VersionKey] = f.Version
+	}
+	if f.BuildID != "" {
+		q[BuildIdKey] = f.BuildID
+	}
+	if f.Distro != "" {
+		q[DistroIdKey] = f.Distro
+	}
+	return q
+}
+
+// FindScheduledButNotStarted returns the activated tasks that were scheduled
+// and had their dependencies met at or before the given time, but haven't
+// started yet. Only tasks that match at least one of the filters are returned,
+// so no tasks are returned if there are no filters.
+func FindScheduledButNotStarted(ctx context.Context, waitingSince time.Time, filters []ScheduledTaskFilter) ([]Task, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	query := bson.M{
+		StatusKey:              bson.M{"$in": []string{evergreen.TaskUndispatched, evergreen.TaskDispatched}},
+		ActivatedKey:           true,
+		DisplayOnlyKey:         bson.M{"$ne": true},
+		PriorityKey:            bson.M{"$gt": evergreen.DisabledTaskPriority},
+		ScheduledTimeKey:       bson.M{"$gt": utility.ZeroTime, "$lte": waitingSince},
+		DependenciesMetTimeKey: bson.M{"$gt": utility.ZeroTime, "$lte": waitingSince},
+		StartTimeKey:           utility.ZeroTime,
+	}
+	var or []bson.M
+	for _, f := range filters {
+		q := f.query()
+		if len(q) == 0 {
+			// This filter matches every task, so the others don't restrict
+			// the query any further.
+			or = nil
+			break
+		}
+		or = append(or, q)
+	}
+	if len(or) > 0 {
+		query["$or"] = or
+	}
+
+	tasks, err := FindAll(ctx, db.Query(query).WithFields(IdKey, ExecutionKey, ProjectKey, VersionKey, DistroIdKey, ScheduledTimeKey, DependenciesMetTimeKey))
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "finding scheduled tasks that haven't started")
+	}
+	return tasks, nil
+}
+
 // FindAllTaskIDsFromVersion returns a list of task IDs associated with a version.
 func FindAllTaskIDsFromVersion(ctx context.Context, versionId string) ([]string, error) {
 	q := db.Query(ByVersion(versionId)).WithFields(IdKey)
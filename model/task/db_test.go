@@ -2433,3 +2433,54 @@ func TestGetLargeParserProjectTaskStats(t *testing.T) {
 		assert.Equal(t, 1, byProject["proj-b"])
 	})
 }
+
+func TestFindScheduledButNotStarted(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+	ctx := t.Context()
+
+	now := time.Now()
+	waitStart := now.Add(-time.Hour)
+	for _, tsk := range []Task{
+		{Id: "t1", Project: "p1", DistroId: "d1"},
+		{Id: "t2", Project: "p2", DistroId: "d1"},
+		{Id: "t3", Project: "p2", DistroId: "d2"},
+		{Id: "started", Project: "p1", DistroId: "d1", Status: evergreen.TaskStarted, StartTime: now},
+		{Id: "recent", Project: "p1", DistroId: "d1", ScheduledTime: now},
+	} {
+		if tsk.Status == "" {
+			tsk.Status = evergreen.TaskUndispatched
+		}
+		if tsk.ScheduledTime.IsZero() {
+			tsk.ScheduledTime = waitStart
+		}
+		tsk.DependenciesMetTime = tsk.ScheduledTime
+		tsk.Activated = true
+		require.NoError(t, tsk.Insert(ctx))
+	}
+
+	taskIDs := func(t *testing.T, filters []ScheduledTaskFilter) []string {
+		tasks, err := FindScheduledButNotStarted(ctx, now.Add(-30*time.Minute), filters)
+		require.NoError(t, err)
+		var ids []string
+		for _, tsk := range tasks {
+			ids = append(ids, tsk.Id)
+		}
+		return ids
+	}
+
+	t.Run("NoFiltersMatchNothing", func(t *testing.T) {
+		assert.Empty(t, taskIDs(t, nil))
+	})
+	t.Run("EmptyFilterMatchesAllWaitingTasks", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"t1", "t2", "t3"}, taskIDs(t, []ScheduledTaskFilter{{Projects: []string{"p1"}}, {}}))
+	})
+	t.Run("MatchesAnyFilter", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"t1", "t3"}, taskIDs(t, []ScheduledTaskFilter{{Projects: []string{"p1"}}, {Distro: "d2"}}))
+	})
+	t.Run("MatchesAllFieldsOfFilter", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"t2"}, taskIDs(t, []ScheduledTaskFilter{{Projects: []string{"p2", "p3"}, Distro: "d1"}}))
+	})
+}
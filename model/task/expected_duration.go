@@ -105,3 +105,26 @@ func getExpectedDurationsForWindow(ctx context.Context, name, project, buildVari
 
 	return results, nil
 }
+
+// GetExpectedDurationsForVariant returns the expected duration of each task
+// that completed on the build variant within the last week, keyed by task
+// display name. It does not require existing tasks, so it can be used to
+// estimate tasks that have not been created yet. Tasks without recent history
+// are omitted.
+func GetExpectedDurationsForVariant(ctx context.Context, project, buildVariant string) (map[string]util.DurationStats, error) {
+	vals, err := getExpectedDurationsForWindow(ctx, "", project, buildVariant, time.Now().Add(-taskCompletionEstimateWindow), time.Now())
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting expected durations for build variant '%s'", buildVariant)
+	}
+
+	durations := make(map[string]util.DurationStats, len(vals))
+	for _, val := range vals {
+		if val.ExpectedDuration == 0 {
+			continue
+		}
+		stats := util.DurationStats{Average: time.Duration(val.ExpectedDuration), StdDev: time.Duration(val.StdDev)}
+		durations[val.DisplayName] = stats
+		expectedDurationCache.Add(estimateCacheKey{project: project, buildVariant: buildVariant, taskDisplayName: val.DisplayName}, stats)
+	}
+	return durations, nil
+}
@@ -0,0 +1,89 @@
+package task
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// StructuredOutputType is the type of a structured output's value.
+type StructuredOutputType string
+
+const (
+	StructuredOutputTypeString StructuredOutputType = "string"
+	StructuredOutputTypeInt    StructuredOutputType = "int"
+	StructuredOutputTypeFloat  StructuredOutputType = "float"
+	StructuredOutputTypeBool   StructuredOutputType = "bool"
+
+	// MaxStructuredOutputs is the maximum number of structured outputs a
+	// single task execution can set.
+	MaxStructuredOutputs = 100
+	// maxStructuredOutputValueLength is the maximum length of a structured
+	// output's value. Outputs are stored on the task document, so they're
+	// meant for small values such as IDs and version strings rather than
+	// file contents.
+	maxStructuredOutputValueLength = 4096
+)
+
+// structuredOutputKeyRegex matches valid structured output names. Names are
+// used in expansion names and as document keys, so they cannot contain dots
+// or dollar signs.
+var structuredOutputKeyRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// StructuredOutput is a typed value that a task sets so that the tasks that
+// depend on it can read it. The value is stored in its string form, which is
+// what dependent tasks receive as an expansion.
+type StructuredOutput struct {
+	Type  StructuredOutputType `bson:"type" json:"type"`
+	Value string               `bson:"value" json:"value"`
+}
+
+// ValidateStructuredOutputs checks that the output names are valid and that
+// each value matches its type.
+func ValidateStructuredOutputs(outputs map[string]StructuredOutput) error {
+	catcher := grip.NewBasicCatcher()
+	for key, output := range outputs {
+		catcher.ErrorfWhen(!structuredOutputKeyRegex.MatchString(key), "output name '%s' must only contain letters, numbers, underscores, and dashes", key)
+		catcher.Wrapf(output.validate(), "output '%s'", key)
+	}
+	return catcher.Resolve()
+}
+
+func (o StructuredOutput) validate() error {
+	if len(o.Value) > maxStructuredOutputValueLength {
+		return errors.Errorf("value cannot be longer than %d characters", maxStructuredOutputValueLength)
+	}
+
+	var err error
+	switch o.Type {
+	case StructuredOutputTypeString:
+	case StructuredOutputTypeInt:
+		_, err = strconv.ParseInt(o.Value, 10, 64)
+	case StructuredOutputTypeFloat:
+		_, err = strconv.ParseFloat(o.Value, 64)
+	case StructuredOutputTypeBool:
+		_, err = strconv.ParseBool(o.Value)
+	default:
+		return errors.Errorf("unrecognized type '%s'", o.Type)
+	}
+	return errors.Wrapf(err, "value '%s' is not a valid %s", o.Value, o.Type)
+}
+
+// SetStructuredOutputs adds the outputs to the task execution's structured
+// outputs, replacing any outputs that were already set with the same names.
+func SetStructuredOutputs(ctx context.Context, taskID string, execution int, outputs map[string]StructuredOutput) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	set := bson.M{}
+	for key, output := range outputs {
+		set[bsonutil.GetDottedKeyName(StructuredOutputsKey, key)] = output
+	}
+	return errors.Wrap(UpdateOne(ctx, ByIdAndExecution(taskID, execution), bson.M{"$set": set}), "setting structured outputs")
+}
@@ -0,0 +1,97 @@
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestValidateStructuredOutputs(t *testing.T) {
+	t.Run("AcceptsValidOutputs", func(t *testing.T) {
+		assert.NoError(t, ValidateStructuredOutputs(map[string]StructuredOutput{
+			"build_id":  {Type: StructuredOutputTypeString, Value: "abc123"},
+			"num-files": {Type: StructuredOutputTypeInt, Value: "-42"},
+			"ratio":     {Type: StructuredOutputTypeFloat, Value: "0.5"},
+			"published": {Type: StructuredOutputTypeBool, Value: "true"},
+		}))
+	})
+	t.Run("RejectsInvalidNames", func(t *testing.T) {
+		for _, key := range []string{"", "a.b", "$set", "has space"} {
+			assert.Error(t, ValidateStructuredOutputs(map[string]StructuredOutput{
+				key: {Type: StructuredOutputTypeString, Value: "value"},
+			}), key)
+		}
+	})
+	t.Run("RejectsValuesThatDoNotMatchType", func(t *testing.T) {
+		for outputType, value := range map[StructuredOutputType]string{
+			StructuredOutputTypeInt:   "1.5",
+			StructuredOutputTypeFloat: "one",
+			StructuredOutputTypeBool:  "yes please",
+		} {
+			assert.Error(t, ValidateStructuredOutputs(map[string]StructuredOutput{
+				"key": {Type: outputType, Value: value},
+			}), outputType)
+		}
+	})
+	t.Run("RejectsUnknownType", func(t *testing.T) {
+		assert.Error(t, ValidateStructuredOutputs(map[string]StructuredOutput{
+			"key": {Type: "list", Value: "[]"},
+		}))
+	})
+	t.Run("RejectsLongValues", func(t *testing.T) {
+		assert.Error(t, ValidateStructuredOutputs(map[string]StructuredOutput{
+			"key": {Type: StructuredOutputTypeString, Value: strings.Repeat("a", maxStructuredOutputValueLength+1)},
+		}))
+	})
+}
+
+func TestSetStructuredOutputs(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+	ctx := t.Context()
+
+	tsk := &Task{Id: "t1", Execution: 1}
+	require.NoError(t, tsk.Insert(ctx))
+
+	require.NoError(t, SetStructuredOutputs(ctx, tsk.Id, tsk.Execution, map[string]StructuredOutput{
+		"build_id": {Type: StructuredOutputTypeString, Value: "abc"},
+		"count":    {Type: StructuredOutputTypeInt, Value: "1"},
+	}))
+	require.NoError(t, SetStructuredOutputs(ctx, tsk.Id, tsk.Execution, map[string]StructuredOutput{
+		"count": {Type: StructuredOutputTypeInt, Value: "2"},
+	}))
+
+	dbTask, err := FindOneId(ctx, tsk.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbTask)
+	assert.Equal(t, map[string]StructuredOutput{
+		"build_id": {Type: StructuredOutputTypeString, Value: "abc"},
+		"count":    {Type: StructuredOutputTypeInt, Value: "2"},
+	}, dbTask.StructuredOutputs)
+
+	t.Run("IgnoresOtherExecution", func(t *testing.T) {
+		assert.Error(t, SetStructuredOutputs(ctx, tsk.Id, 0, map[string]StructuredOutput{
+			"count": {Type: StructuredOutputTypeInt, Value: "3"},
+		}))
+	})
+	t.Run("ClearedOnReset", func(t *testing.T) {
+		require.NoError(t, UpdateOne(ctx, ByIdAndExecution(tsk.Id, tsk.Execution), bson.M{"$set": bson.M{
+			StatusKey:   evergreen.TaskSucceeded,
+			CanResetKey: true,
+		}}))
+		require.NoError(t, dbTask.Reset(ctx, "user"))
+		assert.Empty(t, dbTask.StructuredOutputs)
+
+		dbTask, err := FindOneId(ctx, tsk.Id)
+		require.NoError(t, err)
+		require.NotNil(t, dbTask)
+		assert.Empty(t, dbTask.StructuredOutputs)
+	})
+}
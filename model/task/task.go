@@ -263,6 +263,9 @@ type Task struct {
 	TaskCost cost.Cost `bson:"cost,omitempty" json:"cost,omitempty"`
 	// S3Usage tracks S3 API usage for cost calculation
 	S3Usage s3usage.S3Usage `bson:"s3_usage,omitempty" json:"s3_usage,omitempty"`
+	// StructuredOutputs are the typed values the task set for the tasks that
+	// depend on it, keyed by output name.
+	StructuredOutputs map[string]StructuredOutput `bson:"structured_outputs,omitempty" json:"structured_outputs,omitempty"`
 	// WaitSinceDependenciesMet is populated in GetDistroQueueInfo, used for host allocation
 	WaitSinceDependenciesMet time.Duration `bson:"wait_since_dependencies_met,omitempty" json:"wait_since_dependencies_met,omitempty"`
 
@@ -1399,6 +1402,19 @@ func (t *Task) EstimatedFinishTime(now time.Time) time.Time {
 	return t.LastHeartbeat
 }
 
+// QueueWaitStart returns when the task started waiting in the queue, which is
+// the later of when it was scheduled and when its dependencies were met. It
+// returns the zero time if the task isn't waiting to run.
+func (t *Task) QueueWaitStart() time.Time {
+	if utility.IsZeroTime(t.ScheduledTime) || utility.IsZeroTime(t.DependenciesMetTime) {
+		return time.Time{}
+	}
+	if t.DependenciesMetTime.After(t.ScheduledTime) {
+		return t.DependenciesMetTime
+	}
+	return t.ScheduledTime
+}
+
 func (t *Task) MarkSystemFailed(ctx context.Context, description string) error {
 	t.FinishTime = t.EstimatedFinishTime(time.Now())
 	t.Details = GetSystemFailureDetails(description)
@@ -2385,6 +2401,7 @@ func resetTaskUpdate(t *Task, caller string, prediction *CostPredictionResult) [
 		t.HasAnnotations = false
 		t.TaskCost = cost.Cost{}
 		t.S3Usage = s3usage.S3Usage{}
+		t.StructuredOutputs = nil
 		if prediction != nil {
 			t.SetPredictedCost(prediction.PredictedCost)
 		}
@@ -2431,6 +2448,7 @@ func resetTaskUpdate(t *Task, caller string, prediction *CostPredictionResult) [
 				HasAnnotationsKey,
 				TaskCostKey,
 				S3UsageKey,
+				StructuredOutputsKey,
 			},
 		},
 		addDisplayStatusCache,
@@ -4027,6 +4045,12 @@ type SortingValueBreakdown struct {
 	TotalValue         int64
 	PriorityBreakdown  PriorityBreakdown
 	RankValueBreakdown RankValueBreakdown
+	// FairShareImpact represents how much the total value was lowered because
+	// the task's project is using more than its fair share of the distro.
+	FairShareImpact int64
+	// OverFairShare indicates that the task's project has used up its fair
+	// share of the distro, counting the tasks ahead of it in the queue.
+	OverFairShare bool
 }
 
 // PriorityBreakdown contains information on how much various factors impacted the custom
@@ -4076,6 +4100,8 @@ const (
 	priorityBreakdownAttributePrefix = "evergreen.priority_breakdown"
 	rankBreakdownAttributePrefix     = "evergreen.rank_breakdown"
 	priorityScaledRankAttribute      = "evergreen.priority_scaled_rank"
+	fairShareImpactAttribute         = "evergreen.fair_share_impact"
+	overFairShareAttribute           = "evergreen.over_fair_share"
 )
 
 // SetSortingValueBreakdownAttributes saves a full breakdown which compartmentalizes each factor that played a role in computing the
@@ -4100,6 +4126,9 @@ func (t *Task) SetSortingValueBreakdownAttributes(ctx context.Context, breakdown
 		attribute.Int64(fmt.Sprintf("%s.stepback", rankBreakdownAttributePrefix), breakdown.RankValueBreakdown.StepbackImpact),
 		attribute.Int64(fmt.Sprintf("%s.num_dependents", rankBreakdownAttributePrefix), breakdown.RankValueBreakdown.NumDependentsImpact),
 		attribute.Int64(fmt.Sprintf("%s.estimated_runtime", rankBreakdownAttributePrefix), breakdown.RankValueBreakdown.EstimatedRuntimeImpact),
+		// Fair share values
+		attribute.Int64(fairShareImpactAttribute, breakdown.FairShareImpact),
+		attribute.Bool(overFairShareAttribute, breakdown.OverFairShare),
 		// Priority percentage values
 		attribute.Float64(fmt.Sprintf("%s.base_priority_pct", priorityBreakdownAttributePrefix), float64(breakdown.PriorityBreakdown.InitialPriorityImpact/breakdown.TotalValue*100)),
 		attribute.Float64(fmt.Sprintf("%s.task_group_pct", priorityBreakdownAttributePrefix), float64(breakdown.PriorityBreakdown.TaskGroupImpact/breakdown.TotalValue*100)),
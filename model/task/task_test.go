@@ -1127,6 +1127,37 @@ func TestEstimatedFinishTime(t *testing.T) {
 	}
 }
 
+func TestQueueWaitStart(t *testing.T) {
+	now := time.Now()
+	for tName, tCase := range map[string]struct {
+		scheduledTime       time.Time
+		dependenciesMetTime time.Time
+		expectedWaitStart   time.Time
+	}{
+		"IsScheduledTimeWhenDependenciesWereMetFirst": {
+			scheduledTime:       now,
+			dependenciesMetTime: now.Add(-time.Hour),
+			expectedWaitStart:   now,
+		},
+		"IsDependenciesMetTimeWhenScheduledFirst": {
+			scheduledTime:       now.Add(-time.Hour),
+			dependenciesMetTime: now,
+			expectedWaitStart:   now,
+		},
+		"IsZeroWhenNotScheduled": {
+			dependenciesMetTime: now,
+		},
+		"IsZeroWhenDependenciesAreNotMet": {
+			scheduledTime: now,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			tsk := Task{ScheduledTime: tCase.scheduledTime, DependenciesMetTime: tCase.dependenciesMetTime}
+			assert.Equal(t, tCase.expectedWaitStart, tsk.QueueWaitStart())
+		})
+	}
+}
+
 func TestMarkEnd(t *testing.T) {
 	ctx := t.Context()
 	t.Cleanup(func() {
@@ -68,6 +68,9 @@ type DistroQueueInfo struct {
 	CountWaitOverThreshold int `bson:"count_wait_over_threshold" json:"count_wait_over_threshold"`
 	// NumQueuedLargeParserProjectTasks is the number of dependency-met tasks in this queue that use S3 for their parser project.
 	NumQueuedLargeParserProjectTasks int `bson:"num_queued_large_parser_project_tasks" json:"num_queued_large_parser_project_tasks"`
+	// CountOverFairShare represents the number of tasks in the queue whose projects are over their fair share of the distro.
+	// They are not counted in ExpectedDuration, since they shouldn't cause new hosts to be spawned.
+	CountOverFairShare int `bson:"count_over_fair_share" json:"count_over_fair_share"`
 	// TaskGroupInfos is a list of info that contains the same information as in this struct, but granularized to be only for tasks in
 	// a specific group (standalone tasks are included as well, denoted by an empty string for the group name)
 	TaskGroupInfos []TaskGroupInfo `bson:"task_group_infos" json:"task_group_infos"`
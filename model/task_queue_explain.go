@@ -0,0 +1,332 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// TaskQueueExplanation describes why a task is or isn't being dispatched.
+type TaskQueueExplanation struct {
+	TaskID    string
+	Status    string
+	Activated bool
+	Priority  int64
+	DistroID  string
+	// Reasons are human-readable explanations for why the task is not
+	// running. It is empty if nothing is known to be holding the task back.
+	Reasons []string
+
+	// InQueue indicates that the task is in a distro's task queue.
+	InQueue bool
+	// QueueDistroID is the distro whose queue the task is in. It differs
+	// from DistroID if the task is only queued for a secondary distro.
+	QueueDistroID string
+	// InSecondaryQueue indicates that the task is in the distro's secondary
+	// queue.
+	InSecondaryQueue bool
+	// QueuePosition is the task's 1-indexed position in the queue. It is
+	// zero if the task is not in a queue.
+	QueuePosition int
+	// QueueLength is the number of tasks in the queue.
+	QueueLength int
+	// QueueLengthWithDependenciesMet is the number of tasks in the queue
+	// whose dependencies are met.
+	QueueLengthWithDependenciesMet int
+	// QueueGeneratedAt is when the scheduler last generated the queue.
+	QueueGeneratedAt time.Time
+	// SortingValueBreakdown is how the scheduler weighed the task when
+	// ordering the queue.
+	SortingValueBreakdown *task.SortingValueBreakdown
+
+	// UnmetDependencies are the task's dependencies that are not yet
+	// satisfied.
+	UnmetDependencies []UnmetTaskDependency
+
+	// DistroDisabled indicates that the distro's queue is disabled.
+	DistroDisabled bool
+	// DistroHosts is the number of active hosts in the distro.
+	DistroHosts int
+	// DistroMaxHosts is the maximum number of hosts the distro is allowed to
+	// have.
+	DistroMaxHosts int
+	// DistroAtMaxHosts indicates that the distro already has its maximum
+	// number of hosts, so no new hosts will be started for the task.
+	DistroAtMaxHosts bool
+
+	// TaskGroup is the name of the task's task group, if any.
+	TaskGroup string
+	// TaskGroupHosts is the number of hosts running the task's task group.
+	TaskGroupHosts int
+	// TaskGroupMaxHosts is the task group's max_hosts.
+	TaskGroupMaxHosts int
+	// TaskGroupAtMaxHosts indicates that the task group is already running
+	// on its maximum number of hosts.
+	TaskGroupAtMaxHosts bool
+
+	// ProjectDisabled indicates that the task's project is disabled.
+	ProjectDisabled bool
+	// ProjectDispatchingDisabled indicates that dispatching is disabled for
+	// the task's project.
+	ProjectDispatchingDisabled bool
+	// TaskDispatchDisabled indicates that task dispatching is disabled for
+	// all of Evergreen.
+	TaskDispatchDisabled bool
+	// SchedulerDisabled indicates that the scheduler is disabled for all of
+	// Evergreen.
+	SchedulerDisabled bool
+	// HostAllocatorDisabled indicates that the host allocator is disabled
+	// for all of Evergreen.
+	HostAllocatorDisabled bool
+}
+
+// UnmetTaskDependency is a dependency that a task is waiting on.
+type UnmetTaskDependency struct {
+	TaskID       string
+	DisplayName  string
+	BuildVariant string
+	Status       string
+	// RequiredStatus is the status that the dependency must finish with.
+	RequiredStatus string
+	// Unattainable indicates that the dependency finished without the
+	// required status, so the task is blocked and will never run.
+	Unattainable bool
+}
+
+// ExplainTaskQueueStatus gathers everything that determines when a task is
+// dispatched: its queue position and sorting value, its dependencies, the
+// capacity of its distro and task group, and any flags that prevent it from
+// being scheduled or dispatched.
+func ExplainTaskQueueStatus(ctx context.Context, settings *evergreen.Settings, t *task.Task) (*TaskQueueExplanation, error) {
+	e := &TaskQueueExplanation{
+		TaskID:            t.Id,
+		Status:            t.Status,
+		Activated:         t.Activated,
+		Priority:          t.Priority,
+		DistroID:          t.DistroId,
+		TaskGroup:         t.TaskGroup,
+		TaskGroupMaxHosts: t.TaskGroupMaxHosts,
+	}
+
+	if t.Status != evergreen.TaskUndispatched {
+		e.addReason("task is not waiting to be dispatched because its status is '%s'", t.Status)
+		return e, nil
+	}
+	if t.DisplayOnly {
+		e.addReason("task is a display task, so it never runs on its own")
+		return e, nil
+	}
+	if !t.Activated {
+		e.addReason("task is unscheduled")
+	}
+	if t.Priority <= evergreen.DisabledTaskPriority {
+		e.addReason("task is disabled because its priority is %d", t.Priority)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(e.explainServiceFlags(ctx), "checking service flags")
+	catcher.Wrap(e.explainProject(ctx, t), "checking project")
+	catcher.Wrap(e.explainDependencies(ctx, t), "checking dependencies")
+	catcher.Wrap(e.explainQueue(ctx, t), "checking task queue")
+	catcher.Wrap(e.explainDistro(ctx, settings, t), "checking distro")
+	catcher.Wrap(e.explainTaskGroup(ctx, t), "checking task group")
+	if err := catcher.Resolve(); err != nil {
+		return nil, errors.Wrapf(err, "explaining queue status for task '%s'", t.Id)
+	}
+
+	return e, nil
+}
+
+func (e *TaskQueueExplanation) addReason(format string, args ...any) {
+	e.Reasons = append(e.Reasons, fmt.Sprintf(format, args...))
+}
+
+func (e *TaskQueueExplanation) explainServiceFlags(ctx context.Context) error {
+	flags, err := evergreen.GetServiceFlags(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting service flags")
+	}
+	e.TaskDispatchDisabled = flags.TaskDispatchDisabled
+	e.SchedulerDisabled = flags.SchedulerDisabled
+	e.HostAllocatorDisabled = flags.HostAllocatorDisabled
+	if e.TaskDispatchDisabled {
+		e.addReason("task dispatching is disabled for all of Evergreen")
+	}
+	if e.SchedulerDisabled {
+		e.addReason("the scheduler is disabled for all of Evergreen")
+	}
+	if e.HostAllocatorDisabled {
+		e.addReason("the host allocator is disabled for all of Evergreen, so no new hosts are being started")
+	}
+	return nil
+}
+
+func (e *TaskQueueExplanation) explainProject(ctx context.Context, t *task.Task) error {
+	pRef, err := FindMergedProjectRef(ctx, t.Project, t.Version, false)
+	if err != nil {
+		return errors.Wrapf(err, "finding project ref '%s'", t.Project)
+	}
+	if pRef == nil {
+		return errors.Errorf("project ref '%s' not found", t.Project)
+	}
+	e.ProjectDisabled = !pRef.Enabled
+	e.ProjectDispatchingDisabled = pRef.IsDispatchingDisabled()
+	if e.ProjectDisabled {
+		e.addReason("project '%s' is disabled", pRef.Identifier)
+	}
+	if e.ProjectDispatchingDisabled {
+		e.addReason("dispatching is disabled for project '%s'", pRef.Identifier)
+	}
+	return nil
+}
+
+func (e *TaskQueueExplanation) explainDependencies(ctx context.Context, t *task.Task) error {
+	if len(t.DependsOn) == 0 || t.OverrideDependencies {
+		return nil
+	}
+
+	depIDs := make([]string, 0, len(t.DependsOn))
+	for _, dep := range t.DependsOn {
+		depIDs = append(depIDs, dep.TaskId)
+	}
+	depTasks, err := task.FindWithFields(ctx, task.ByIds(depIDs), task.IdKey, task.DisplayNameKey, task.BuildVariantKey, task.StatusKey, task.DependsOnKey, task.OverrideDependenciesKey)
+	if err != nil {
+		return errors.Wrap(err, "finding dependencies")
+	}
+	depTasksByID := make(map[string]task.Task, len(depTasks))
+	for _, depTask := range depTasks {
+		depTasksByID[depTask.Id] = depTask
+	}
+
+	for _, dep := range t.DependsOn {
+		depTask, ok := depTasksByID[dep.TaskId]
+		if ok && t.SatisfiesDependency(&depTask) {
+			continue
+		}
+		requiredStatus := dep.Status
+		if requiredStatus == "" {
+			requiredStatus = evergreen.TaskSucceeded
+		}
+		e.UnmetDependencies = append(e.UnmetDependencies, UnmetTaskDependency{
+			TaskID:         dep.TaskId,
+			DisplayName:    depTask.DisplayName,
+			BuildVariant:   depTask.BuildVariant,
+			Status:         depTask.Status,
+			RequiredStatus: requiredStatus,
+			Unattainable:   dep.Unattainable,
+		})
+	}
+
+	if t.Blocked() {
+		e.addReason("task is blocked because a dependency finished without the required status, so it will not run")
+	} else if len(e.UnmetDependencies) > 0 {
+		e.addReason("task is waiting on %d unfinished dependencies", len(e.UnmetDependencies))
+	}
+	return nil
+}
+
+func (e *TaskQueueExplanation) explainQueue(ctx context.Context, t *task.Task) error {
+	queue, err := FindDistroTaskQueue(ctx, t.DistroId)
+	if err != nil && !adb.ResultsNotFound(errors.Cause(err)) {
+		return errors.Wrapf(err, "finding task queue for distro '%s'", t.DistroId)
+	}
+	if e.findInQueue(queue, t.Id, false) {
+		return nil
+	}
+
+	for _, distroID := range t.SecondaryDistros {
+		secondaryQueue, err := FindDistroSecondaryTaskQueue(ctx, distroID)
+		if err != nil && !adb.ResultsNotFound(errors.Cause(err)) {
+			return errors.Wrapf(err, "finding secondary task queue for distro '%s'", distroID)
+		}
+		if e.findInQueue(secondaryQueue, t.Id, true) {
+			return nil
+		}
+	}
+
+	if t.Activated {
+		e.addReason("task is not in any task queue yet; the scheduler adds activated tasks to their distro's queue on its next pass")
+	}
+	return nil
+}
+
+func (e *TaskQueueExplanation) findInQueue(queue TaskQueue, taskID string, secondary bool) bool {
+	for i, item := range queue.Queue {
+		if item.Id != taskID {
+			continue
+		}
+		e.InQueue = true
+		e.QueueDistroID = queue.Distro
+		e.InSecondaryQueue = secondary
+		e.QueuePosition = i + 1
+		e.QueueLength = len(queue.Queue)
+		e.QueueLengthWithDependenciesMet = queue.DistroQueueInfo.LengthWithDependenciesMet
+		e.QueueGeneratedAt = queue.GeneratedAt
+		breakdown := item.SortingValueBreakdown
+		e.SortingValueBreakdown = &breakdown
+		if e.QueuePosition > 1 {
+			e.addReason("task is at position %d of %d in the queue for distro '%s'", e.QueuePosition, e.QueueLength, queue.Distro)
+		}
+		if breakdown.OverFairShare {
+			e.addReason("task's project is using its fair share of distro '%s', so it is deprioritized", queue.Distro)
+		}
+		return true
+	}
+	return false
+}
+
+func (e *TaskQueueExplanation) explainDistro(ctx context.Context, settings *evergreen.Settings, t *task.Task) error {
+	d, err := distro.FindOneId(ctx, t.DistroId)
+	if err != nil {
+		return errors.Wrapf(err, "finding distro '%s'", t.DistroId)
+	}
+	if d == nil {
+		e.addReason("distro '%s' does not exist", t.DistroId)
+		return nil
+	}
+
+	e.DistroDisabled = d.Disabled
+	if e.DistroDisabled {
+		e.addReason("the queue for distro '%s' is disabled", d.Id)
+	}
+
+	has, err := d.GetResolvedHostAllocatorSettings(settings)
+	if err != nil {
+		return errors.Wrapf(err, "resolving host allocator settings for distro '%s'", d.Id)
+	}
+	e.DistroMaxHosts = has.MaximumHosts
+	e.DistroHosts, err = host.CountActiveHostsInDistro(ctx, d.Id)
+	if err != nil {
+		return errors.Wrapf(err, "counting hosts in distro '%s'", d.Id)
+	}
+	e.DistroAtMaxHosts = e.DistroMaxHosts > 0 && e.DistroHosts >= e.DistroMaxHosts
+	if e.DistroAtMaxHosts {
+		e.addReason("distro '%s' is at its maximum of %d hosts, so the task must wait for an existing host to free up", d.Id, e.DistroMaxHosts)
+	}
+	return nil
+}
+
+func (e *TaskQueueExplanation) explainTaskGroup(ctx context.Context, t *task.Task) error {
+	if t.TaskGroup == "" || t.TaskGroupMaxHosts <= 0 {
+		return nil
+	}
+
+	var err error
+	e.TaskGroupHosts, err = host.NumHostsByTaskSpec(ctx, t.TaskGroup, t.BuildVariant, t.Project, t.Version)
+	if err != nil {
+		return errors.Wrapf(err, "counting hosts running task group '%s'", t.TaskGroup)
+	}
+	e.TaskGroupAtMaxHosts = e.TaskGroupHosts >= t.TaskGroupMaxHosts
+	if e.TaskGroupAtMaxHosts {
+		e.addReason("task group '%s' is already running on its max_hosts of %d", t.TaskGroup, t.TaskGroupMaxHosts)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestExplainTaskQueueStatus(t *testing.T) {
+	settings := testutil.TestConfig()
+
+	setup := func(t *testing.T) *task.Task {
+		require.NoError(t, db.ClearCollections(task.Collection, TaskQueuesCollection, TaskSecondaryQueuesCollection, distro.Collection, host.Collection, ProjectRefCollection, evergreen.ConfigCollection))
+
+		pRef := ProjectRef{Id: "project", Identifier: "project", Enabled: true}
+		require.NoError(t, pRef.Insert(t.Context()))
+		d := distro.Distro{Id: "d1", HostAllocatorSettings: distro.HostAllocatorSettings{MaximumHosts: 1}}
+		require.NoError(t, d.Insert(t.Context()))
+
+		dep := task.Task{Id: "dep", DisplayName: "compile", BuildVariant: "bv", Status: evergreen.TaskStarted}
+		require.NoError(t, dep.Insert(t.Context()))
+		tsk := &task.Task{
+			Id:                "t1",
+			DisplayName:       "test",
+			BuildVariant:      "bv",
+			Project:           "project",
+			Version:           "v1",
+			DistroId:          "d1",
+			Status:            evergreen.TaskUndispatched,
+			Activated:         true,
+			TaskGroup:         "tg",
+			TaskGroupMaxHosts: 1,
+			DependsOn:         []task.Dependency{{TaskId: "dep"}},
+		}
+		require.NoError(t, tsk.Insert(t.Context()))
+		return tsk
+	}
+	defer func() {
+		assert.NoError(t, db.ClearCollections(task.Collection, TaskQueuesCollection, TaskSecondaryQueuesCollection, distro.Collection, host.Collection, ProjectRefCollection, evergreen.ConfigCollection))
+	}()
+
+	t.Run("ReportsQueuePositionAndSortingValue", func(t *testing.T) {
+		tsk := setup(t)
+		breakdown := task.SortingValueBreakdown{
+			TotalValue:         100,
+			PriorityBreakdown:  task.PriorityBreakdown{InitialPriorityImpact: 10},
+			RankValueBreakdown: task.RankValueBreakdown{NumDependentsImpact: 5},
+		}
+		queue := []TaskQueueItem{{Id: "other"}, {Id: tsk.Id, SortingValueBreakdown: breakdown}}
+		require.NoError(t, NewTaskQueue("d1", queue, DistroQueueInfo{LengthWithDependenciesMet: 1}).Save(t.Context()))
+
+		e, err := ExplainTaskQueueStatus(t.Context(), settings, tsk)
+		require.NoError(t, err)
+		assert.True(t, e.InQueue)
+		assert.False(t, e.InSecondaryQueue)
+		assert.Equal(t, "d1", e.QueueDistroID)
+		assert.Equal(t, 2, e.QueuePosition)
+		assert.Equal(t, 2, e.QueueLength)
+		assert.Equal(t, 1, e.QueueLengthWithDependenciesMet)
+		require.NotNil(t, e.SortingValueBreakdown)
+		assert.Equal(t, breakdown, *e.SortingValueBreakdown)
+	})
+	t.Run("ReportsOverFairShare", func(t *testing.T) {
+		tsk := setup(t)
+		breakdown := task.SortingValueBreakdown{TotalValue: 10, FairShareImpact: -10, OverFairShare: true}
+		require.NoError(t, NewTaskQueue("d1", []TaskQueueItem{{Id: tsk.Id, SortingValueBreakdown: breakdown}}, DistroQueueInfo{}).Save(t.Context()))
+
+		e, err := ExplainTaskQueueStatus(t.Context(), settings, tsk)
+		require.NoError(t, err)
+		require.NotNil(t, e.SortingValueBreakdown)
+		assert.True(t, e.SortingValueBreakdown.OverFairShare)
+		assert.Contains(t, e.Reasons, "task's project is using its fair share of distro 'd1', so it is deprioritized")
+	})
+	t.Run("ReportsSecondaryQueue", func(t *testing.T) {
+		tsk := setup(t)
+		tsk.SecondaryDistros = []string{"d2"}
+		q := NewTaskQueue("d2", []TaskQueueItem{{Id: tsk.Id}}, DistroQueueInfo{SecondaryQueue: true})
+		require.NoError(t, q.Save(t.Context()))
+
+		e, err := ExplainTaskQueueStatus(t.Context(), settings, tsk)
+		require.NoError(t, err)
+		assert.True(t, e.InQueue)
+		assert.True(t, e.InSecondaryQueue)
+		assert.Equal(t, "d2", e.QueueDistroID)
+		assert.Equal(t, 1, e.QueuePosition)
+	})
+	t.Run("ReportsUnmetDependencies", func(t *testing.T) {
+		tsk := setup(t)
+
+		e, err := ExplainTaskQueueStatus(t.Context(), settings, tsk)
+		require.NoError(t, err)
+		require.Len(t, e.UnmetDependencies, 1)
+		assert.Equal(t, UnmetTaskDependency{
+			TaskID:         "dep",
+			DisplayName:    "compile",
+			BuildVariant:   "bv",
+			Status:         evergreen.TaskStarted,
+			RequiredStatus: evergreen.TaskSucceeded,
+		}, e.UnmetDependencies[0])
+		assert.Contains(t, e.Reasons, "task is waiting on 1 unfinished dependencies")
+		assert.False(t, e.InQueue)
+	})
+	t.Run("IgnoresSatisfiedDependencies", func(t *testing.T) {
+		tsk := setup(t)
+		require.NoError(t, task.UpdateOne(t.Context(), task.ById("dep"), bson.M{"$set": bson.M{task.StatusKey: evergreen.TaskSucceeded}}))
+
+		e, err := ExplainTaskQueueStatus(t.Context(), settings, tsk)
+		require.NoError(t, err)
+		assert.Empty(t, e.UnmetDependencies)
+	})
+	t.Run("ReportsDistroAndTaskGroupAtMaxHosts", func(t *testing.T) {
+		tsk := setup(t)
+		h := host.Host{
+			Id:                      "h1",
+			Distro:                  distro.Distro{Id: "d1"},
+			Status:                  evergreen.HostRunning,
+			StartedBy:               evergreen.User,
+			RunningTask:             "other",
+			RunningTaskGroup:        "tg",
+			RunningTaskBuildVariant: "bv",
+			RunningTaskProject:      "project",
+			RunningTaskVersion:      "v1",
+		}
+		require.NoError(t, h.Insert(t.Context()))
+
+		e, err := ExplainTaskQueueStatus(t.Context(), settings, tsk)
+		require.NoError(t, err)
+		assert.Equal(t, 1, e.DistroHosts)
+		assert.Equal(t, 1, e.DistroMaxHosts)
+		assert.True(t, e.DistroAtMaxHosts)
+		assert.Equal(t, 1, e.TaskGroupHosts)
+		assert.True(t, e.TaskGroupAtMaxHosts)
+	})
+	t.Run("ReportsDisabledAndUnscheduled", func(t *testing.T) {
+		tsk := setup(t)
+		tsk.Activated = false
+		tsk.Priority = evergreen.DisabledTaskPriority
+		require.NoError(t, db.UpdateId(t.Context(), ProjectRefCollection, "project", bson.M{"$set": bson.M{projectRefDispatchingDisabledKey: true}}))
+		require.NoError(t, evergreen.SetServiceFlags(t.Context(), evergreen.ServiceFlags{TaskDispatchDisabled: true}))
+
+		e, err := ExplainTaskQueueStatus(t.Context(), settings, tsk)
+		require.NoError(t, err)
+		assert.True(t, e.ProjectDispatchingDisabled)
+		assert.True(t, e.TaskDispatchDisabled)
+		assert.Contains(t, e.Reasons, "task is unscheduled")
+		assert.Contains(t, e.Reasons, "task is disabled because its priority is -1")
+		assert.Contains(t, e.Reasons, "dispatching is disabled for project 'project'")
+		assert.Contains(t, e.Reasons, "task dispatching is disabled for all of Evergreen")
+	})
+	t.Run("ReturnsEarlyForTasksThatAreNotWaiting", func(t *testing.T) {
+		tsk := setup(t)
+		tsk.Status = evergreen.TaskSucceeded
+
+		e, err := ExplainTaskQueueStatus(t.Context(), settings, tsk)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"task is not waiting to be dispatched because its status is 'success'"}, e.Reasons)
+	})
+}
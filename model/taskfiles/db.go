@@ -0,0 +1,80 @@
+package taskfiles
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	IDKey         = bsonutil.MustHaveTag(TaskFileSet{}, "ID")
+	VersionKey    = bsonutil.MustHaveTag(TaskFileSet{}, "Version")
+	TaskIDKey     = bsonutil.MustHaveTag(TaskFileSet{}, "TaskID")
+	ExecutionKey  = bsonutil.MustHaveTag(TaskFileSet{}, "Execution")
+	NameKey       = bsonutil.MustHaveTag(TaskFileSet{}, "Name")
+	KeyKey        = bsonutil.MustHaveTag(TaskFileSet{}, "Key")
+	SizeBytesKey  = bsonutil.MustHaveTag(TaskFileSet{}, "SizeBytes")
+	CreateTimeKey = bsonutil.MustHaveTag(TaskFileSet{}, "CreateTime")
+)
+
+// ByTaskExecutionAndName returns a query for the file set with the given name
+// published by the task execution.
+func ByTaskExecutionAndName(taskID string, execution int, name string) db.Q {
+	return db.Query(bson.M{IDKey: TaskFileSetID(taskID, execution, name)})
+}
+
+// FindOne gets one TaskFileSet for the given query.
+func FindOne(ctx context.Context, query db.Q) (*TaskFileSet, error) {
+	fs := &TaskFileSet{}
+	err := db.FindOneQ(ctx, Collection, query, fs)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return fs, err
+}
+
+// Upsert stores the file set, replacing any file set with the same name
+// previously published by the task execution.
+func (fs *TaskFileSet) Upsert(ctx context.Context) error {
+	fs.ID = TaskFileSetID(fs.TaskID, fs.Execution, fs.Name)
+	fs.CreateTime = time.Now()
+
+	_, err := db.Replace(ctx, Collection, bson.M{IDKey: fs.ID}, fs)
+	return errors.Wrap(err, "upserting task file set")
+}
+
+// VersionFileSets summarizes the file sets published by tasks in a version.
+type VersionFileSets struct {
+	Version string `bson:"_id"`
+	// OldestCreateTime is when the version's oldest file set was published.
+	OldestCreateTime time.Time `bson:"oldest_create_time"`
+}
+
+// FindVersionsCreatedBefore returns each version with a file set published
+// before the given time, ordered from the oldest file set to the newest.
+func FindVersionsCreatedBefore(ctx context.Context, before time.Time) ([]VersionFileSets, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{CreateTimeKey: bson.M{"$lt": before}}},
+		{"$group": bson.M{
+			"_id":                "$" + VersionKey,
+			"oldest_create_time": bson.M{"$min": "$" + CreateTimeKey},
+		}},
+		{"$sort": bson.M{"oldest_create_time": 1}},
+	}
+	var versions []VersionFileSets
+	if err := db.Aggregate(ctx, Collection, pipeline, &versions); err != nil {
+		return nil, errors.Wrap(err, "aggregating task file sets by version")
+	}
+	return versions, nil
+}
+
+// RemoveByVersion removes all of the file sets published by tasks in the
+// version.
+func RemoveByVersion(ctx context.Context, version string) error {
+	return errors.Wrapf(db.RemoveAll(ctx, Collection, bson.M{VersionKey: version}), "removing task file sets for version '%s'", version)
+}
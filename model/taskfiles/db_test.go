@@ -0,0 +1,104 @@
+package taskfiles
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	_ "github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestUpsert(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+	ctx := t.Context()
+
+	fs := &TaskFileSet{
+		Version:   "v1",
+		TaskID:    "t1",
+		Execution: 1,
+		Name:      "binaries",
+		Key:       Key("v1", "t1", 1, "binaries"),
+		SizeBytes: 100,
+	}
+	require.NoError(t, fs.Upsert(ctx))
+
+	found, err := FindOne(ctx, ByTaskExecutionAndName("t1", 1, "binaries"))
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "t1_1_binaries", found.ID)
+	assert.Equal(t, "v1/t1/1/binaries.tar.gz", found.Key)
+	assert.EqualValues(t, 100, found.SizeBytes)
+	assert.False(t, found.CreateTime.IsZero())
+
+	t.Run("ReplacesExistingFileSet", func(t *testing.T) {
+		fs.SizeBytes = 200
+		require.NoError(t, fs.Upsert(ctx))
+
+		found, err := FindOne(ctx, ByTaskExecutionAndName("t1", 1, "binaries"))
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.EqualValues(t, 200, found.SizeBytes)
+	})
+	t.Run("OtherNameNotFound", func(t *testing.T) {
+		found, err := FindOne(ctx, ByTaskExecutionAndName("t1", 1, "other"))
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
+
+func TestFindVersionsCreatedBeforeAndRemoveByVersion(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+	ctx := t.Context()
+
+	now := time.Now().Round(time.Millisecond)
+	for _, fs := range []TaskFileSet{
+		{ID: "old1", Version: "v1", CreateTime: now.Add(-3 * time.Hour)},
+		{ID: "old2", Version: "v1", CreateTime: now.Add(-2 * time.Hour)},
+		{ID: "new1", Version: "v1", CreateTime: now},
+		{ID: "old3", Version: "v2", CreateTime: now.Add(-4 * time.Hour)},
+		{ID: "new2", Version: "v3", CreateTime: now},
+	} {
+		require.NoError(t, db.Insert(ctx, Collection, fs))
+	}
+
+	versions, err := FindVersionsCreatedBefore(ctx, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "v2", versions[0].Version)
+	assert.True(t, now.Add(-4*time.Hour).Equal(versions[0].OldestCreateTime))
+	assert.Equal(t, "v1", versions[1].Version)
+	assert.True(t, now.Add(-3*time.Hour).Equal(versions[1].OldestCreateTime))
+
+	require.NoError(t, RemoveByVersion(ctx, "v1"))
+	count, err := db.Count(ctx, Collection, bson.M{VersionKey: "v1"})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	count, err = db.Count(ctx, Collection, bson.M{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count, "file sets of other versions should be kept")
+}
+
+func TestVersionPrefix(t *testing.T) {
+	assert.Equal(t, "v1/", VersionPrefix("v1"))
+	assert.True(t, strings.HasPrefix(Key("v1", "t1", 0, "binaries"), VersionPrefix("v1")))
+	assert.False(t, strings.HasPrefix(Key("v10", "t1", 0, "binaries"), VersionPrefix("v1")), "prefix should not match versions whose ID starts with the same characters")
+}
+
+func TestValidateName(t *testing.T) {
+	assert.NoError(t, ValidateName("binaries"))
+	assert.NoError(t, ValidateName("build-output.v2"))
+	assert.Error(t, ValidateName(""))
+	assert.Error(t, ValidateName("a/b"))
+	assert.Error(t, ValidateName(`a\b`))
+	assert.Error(t, ValidateName(".."))
+}
@@ -0,0 +1,3 @@
+// Package taskfiles models the named sets of files that tasks publish for
+// their dependent tasks to fetch.
+package taskfiles
@@ -0,0 +1,114 @@
+package taskfiles
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// presignExpiration is how long presigned URLs for file set archives are
+// valid. It only needs to cover the start of the transfer.
+const presignExpiration = time.Hour
+
+// PresignUpload returns a URL the agent can use to upload the archive with
+// the given key to the task files bucket.
+func PresignUpload(ctx context.Context, buckets evergreen.BucketsConfig, key string) (string, error) {
+	client, err := newPresignClient(ctx, buckets)
+	if err != nil {
+		return "", err
+	}
+	req, err := client.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(buckets.TaskFilesBucket.Name),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(presignExpiration))
+	if err != nil {
+		return "", errors.Wrapf(err, "presigning upload for '%s'", key)
+	}
+	return req.URL, nil
+}
+
+// PresignDownload returns a URL the agent can use to download the archive
+// with the given key from the task files bucket.
+func PresignDownload(ctx context.Context, buckets evergreen.BucketsConfig, key string) (string, error) {
+	client, err := newPresignClient(ctx, buckets)
+	if err != nil {
+		return "", err
+	}
+	req, err := client.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(buckets.TaskFilesBucket.Name),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(presignExpiration))
+	if err != nil {
+		return "", errors.Wrapf(err, "presigning download for '%s'", key)
+	}
+	return req.URL, nil
+}
+
+// RemoveVersion removes all of the version's archives from the task files
+// bucket.
+func RemoveVersion(ctx context.Context, buckets evergreen.BucketsConfig, version string) error {
+	if err := validateBucket(buckets.TaskFilesBucket); err != nil {
+		return err
+	}
+	opts := pail.S3Options{
+		Name:        buckets.TaskFilesBucket.Name,
+		Region:      evergreen.DefaultS3Region,
+		Credentials: pail.CreateAWSStaticCredentials(buckets.Credentials.Key, buckets.Credentials.Secret, ""),
+		Permissions: pail.S3PermissionsPrivate,
+		MaxRetries:  utility.ToIntPtr(10),
+	}
+	if bucket := buckets.TaskFilesBucket; bucket.RoleARN != "" {
+		opts.AssumeRoleARN = bucket.RoleARN
+		if bucket.ExternalID != "" {
+			opts.AssumeRoleOptions = []func(*stscreds.AssumeRoleOptions){
+				func(o *stscreds.AssumeRoleOptions) { o.ExternalID = aws.String(bucket.ExternalID) },
+			}
+		}
+	}
+	b, err := pail.NewS3Bucket(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "creating task files bucket")
+	}
+	return errors.Wrapf(b.RemovePrefix(ctx, VersionPrefix(version)), "removing archives for version '%s'", version)
+}
+
+func validateBucket(bucket evergreen.BucketConfig) error {
+	if bucket.Name == "" {
+		return errors.New("task files bucket is not configured")
+	}
+	if bucket.Type != evergreen.BucketTypeS3 {
+		return errors.Errorf("unsupported task files bucket type '%s'", bucket.Type)
+	}
+	return nil
+}
+
+func newPresignClient(ctx context.Context, buckets evergreen.BucketsConfig) (*s3.PresignClient, error) {
+	bucket := buckets.TaskFilesBucket
+	if err := validateBucket(bucket); err != nil {
+		return nil, err
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(evergreen.DefaultS3Region))
+	if err != nil {
+		return nil, errors.Wrap(err, "loading config")
+	}
+	cfg.Credentials = pail.CreateAWSStaticCredentials(buckets.Credentials.Key, buckets.Credentials.Secret, "")
+	if bucket.RoleARN != "" {
+		cfg.Credentials = stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), bucket.RoleARN, func(opts *stscreds.AssumeRoleOptions) {
+			if bucket.ExternalID != "" {
+				opts.ExternalID = aws.String(bucket.ExternalID)
+			}
+		})
+	}
+
+	return s3.NewPresignClient(s3.NewFromConfig(cfg)), nil
+}
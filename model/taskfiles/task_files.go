@@ -0,0 +1,57 @@
+package taskfiles
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const Collection = "task_files"
+
+// TaskFileSet is a named archive of files published by a single task
+// execution.
+type TaskFileSet struct {
+	ID        string `bson:"_id" json:"id"`
+	Version   string `bson:"version" json:"version"`
+	TaskID    string `bson:"task_id" json:"task_id"`
+	Execution int    `bson:"execution" json:"execution"`
+	Name      string `bson:"name" json:"name"`
+	// Key is the key of the archive in the task files bucket.
+	Key        string    `bson:"key" json:"key"`
+	SizeBytes  int64     `bson:"size_bytes" json:"size_bytes"`
+	CreateTime time.Time `bson:"create_time" json:"create_time"`
+}
+
+// TaskFileSetID returns the ID of the file set with the given name for the
+// given task execution.
+func TaskFileSetID(taskID string, execution int, name string) string {
+	return fmt.Sprintf("%s_%d_%s", taskID, execution, name)
+}
+
+// Key returns the key in the task files bucket for the archive of the file
+// set. Archives are grouped under their version's prefix so that they can be
+// removed together once the version expires.
+func Key(version, taskID string, execution int, name string) string {
+	return path.Join(version, taskID, strconv.Itoa(execution), name+".tar.gz")
+}
+
+// VersionPrefix returns the prefix in the task files bucket under which all of
+// the version's archives are stored.
+func VersionPrefix(version string) string {
+	return version + "/"
+}
+
+// ValidateName checks that the name can be used to identify a file set.
+func ValidateName(name string) error {
+	if name == "" {
+		return errors.New("file set name cannot be empty")
+	}
+	if strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return errors.Errorf("file set name '%s' cannot contain path separators or be a relative path", name)
+	}
+	return nil
+}
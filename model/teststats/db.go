@@ -0,0 +1,287 @@
+package teststats
+
+// This file provides database layer logic for pre-computed test execution
+// statistics.
+// The database schema is the following:
+// *daily_test_stats*
+// {
+//   "_id": {
+//     "test_name": <Test display name (string)>,
+//     "task_name": <Task display name (string)>,
+//     "variant": <Build variant (string)>,
+//     "project": <Project Id (string)>,
+//     "requester": <Requester (string)>,
+//     "date": <UTC day period this document covers (date)>,
+//   },
+//   "num_pass": <Number of times the test passed (int)>,
+//   "num_fail": <Number of times the test failed, including timeouts (int)>,
+//   "p50_duration_pass": <Median duration in seconds of the passing runs (double)>,
+//   "p95_duration_pass": <95th percentile duration in seconds of the passing runs (double)>,
+//   "last_update": <Date of the job run that last updated this document (date)>
+// }
+//
+// The documents are generated alongside the daily task stats, so the stats
+// status of the project (see taskstats.StatsStatus) also tracks how far the
+// daily test stats have been processed.
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/taskstats"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	DailyTestStatsCollection = "daily_test_stats"
+	bulkSize                 = 1000
+)
+
+//////////////////////
+// Daily Test Stats //
+//////////////////////
+
+// DBTestStatsID represents the _id field for daily_test_stats documents.
+type DBTestStatsID struct {
+	TestName     string    `bson:"test_name"`
+	TaskName     string    `bson:"task_name"`
+	BuildVariant string    `bson:"variant"`
+	Project      string    `bson:"project"`
+	Requester    string    `bson:"requester"`
+	Date         time.Time `bson:"date"`
+}
+
+// DBTestStats represents the daily_test_stats documents.
+type DBTestStats struct {
+	Id              DBTestStatsID `bson:"_id"`
+	NumPass         int           `bson:"num_pass"`
+	NumFail         int           `bson:"num_fail"`
+	P50DurationPass float64       `bson:"p50_duration_pass"`
+	P95DurationPass float64       `bson:"p95_duration_pass"`
+	LastUpdate      time.Time     `bson:"last_update"`
+}
+
+var (
+	// BSON fields for the test stats ID struct.
+	DBTestStatsIDTestNameKey     = bsonutil.MustHaveTag(DBTestStatsID{}, "TestName")
+	DBTestStatsIDTaskNameKey     = bsonutil.MustHaveTag(DBTestStatsID{}, "TaskName")
+	DBTestStatsIDBuildVariantKey = bsonutil.MustHaveTag(DBTestStatsID{}, "BuildVariant")
+	DBTestStatsIDProjectKey      = bsonutil.MustHaveTag(DBTestStatsID{}, "Project")
+	DBTestStatsIDRequesterKey    = bsonutil.MustHaveTag(DBTestStatsID{}, "Requester")
+	DBTestStatsIDDateKey         = bsonutil.MustHaveTag(DBTestStatsID{}, "Date")
+
+	// BSON fields for the test stats struct.
+	DBTestStatsIDKey              = bsonutil.MustHaveTag(DBTestStats{}, "Id")
+	DBTestStatsNumPassKey         = bsonutil.MustHaveTag(DBTestStats{}, "NumPass")
+	DBTestStatsNumFailKey         = bsonutil.MustHaveTag(DBTestStats{}, "NumFail")
+	DBTestStatsP50DurationPassKey = bsonutil.MustHaveTag(DBTestStats{}, "P50DurationPass")
+	DBTestStatsP95DurationPassKey = bsonutil.MustHaveTag(DBTestStats{}, "P95DurationPass")
+	DBTestStatsLastUpdateKey      = bsonutil.MustHaveTag(DBTestStats{}, "LastUpdate")
+
+	// BSON dotted field names for test stats ID elements.
+	DBTestStatsIDTestNameKeyFull     = bsonutil.GetDottedKeyName(DBTestStatsIDKey, DBTestStatsIDTestNameKey)
+	DBTestStatsIDTaskNameKeyFull     = bsonutil.GetDottedKeyName(DBTestStatsIDKey, DBTestStatsIDTaskNameKey)
+	DBTestStatsIDBuildVariantKeyFull = bsonutil.GetDottedKeyName(DBTestStatsIDKey, DBTestStatsIDBuildVariantKey)
+	DBTestStatsIDProjectKeyFull      = bsonutil.GetDottedKeyName(DBTestStatsIDKey, DBTestStatsIDProjectKey)
+	DBTestStatsIDRequesterKeyFull    = bsonutil.GetDottedKeyName(DBTestStatsIDKey, DBTestStatsIDRequesterKey)
+	DBTestStatsIDDateKeyFull         = bsonutil.GetDottedKeyName(DBTestStatsIDKey, DBTestStatsIDDateKey)
+)
+
+// upsertDailyTestStats replaces the given daily test stats documents,
+// inserting the ones that do not exist yet.
+func upsertDailyTestStats(ctx context.Context, docs []DBTestStats) error {
+	env := evergreen.GetEnvironment()
+	buf := make([]mongo.WriteModel, 0, bulkSize)
+	for _, doc := range docs {
+		buf = append(buf, mongo.NewReplaceOneModel().
+			SetUpsert(true).
+			SetFilter(bson.M{DBTestStatsIDKey: doc.Id}).
+			SetReplacement(doc))
+
+		if len(buf) >= bulkSize {
+			if _, err := env.DB().Collection(DailyTestStatsCollection).BulkWrite(ctx, buf); err != nil {
+				return errors.Wrapf(err, "bulk writing to collection '%s'", DailyTestStatsCollection)
+			}
+			buf = make([]mongo.WriteModel, 0, bulkSize)
+		}
+	}
+	if len(buf) == 0 {
+		return nil
+	}
+	if _, err := env.DB().Collection(DailyTestStatsCollection).BulkWrite(ctx, buf); err != nil {
+		return errors.Wrapf(err, "bulk writing to collection '%s'", DailyTestStatsCollection)
+	}
+
+	return nil
+}
+
+///////////////////////////////////////////
+// Queries on the precomputed statistics //
+///////////////////////////////////////////
+
+var (
+	// BSON fields for the test stats struct.
+	TestStatsTestNameKey        = bsonutil.MustHaveTag(TestStats{}, "TestName")
+	TestStatsTaskNameKey        = bsonutil.MustHaveTag(TestStats{}, "TaskName")
+	TestStatsBuildVariantKey    = bsonutil.MustHaveTag(TestStats{}, "BuildVariant")
+	TestStatsDateKey            = bsonutil.MustHaveTag(TestStats{}, "Date")
+	TestStatsNumPassKey         = bsonutil.MustHaveTag(TestStats{}, "NumPass")
+	TestStatsNumFailKey         = bsonutil.MustHaveTag(TestStats{}, "NumFail")
+	TestStatsNumTotalKey        = bsonutil.MustHaveTag(TestStats{}, "NumTotal")
+	TestStatsP50DurationPassKey = bsonutil.MustHaveTag(TestStats{}, "P50DurationPass")
+	TestStatsP95DurationPassKey = bsonutil.MustHaveTag(TestStats{}, "P95DurationPass")
+	TestStatsLastUpdateKey      = bsonutil.MustHaveTag(TestStats{}, "LastUpdate")
+)
+
+// periodFailRateKey is the key of each test's failure rate when combining
+// the daily test statistics over a period.
+const periodFailRateKey = "fail_rate"
+
+// buildMatchStage builds the match stage of the test stats query pipelines
+// based on the filter options.
+func (filter StatsFilter) buildMatchStage() bson.M {
+	match := bson.M{
+		DBTestStatsIDDateKeyFull: bson.M{
+			"$gte": filter.AfterDate,
+			"$lt":  filter.BeforeDate,
+		},
+		DBTestStatsIDProjectKeyFull:   filter.Project,
+		DBTestStatsIDRequesterKeyFull: bson.M{"$in": filter.Requesters},
+	}
+	if len(filter.Tests) > 0 {
+		match[DBTestStatsIDTestNameKeyFull] = taskstats.BuildMatchArrayExpression(filter.Tests)
+	}
+	if len(filter.Tasks) > 0 {
+		match[DBTestStatsIDTaskNameKeyFull] = taskstats.BuildMatchArrayExpression(filter.Tasks)
+	}
+	if len(filter.BuildVariants) > 0 {
+		match[DBTestStatsIDBuildVariantKeyFull] = taskstats.BuildMatchArrayExpression(filter.BuildVariants)
+	}
+	if filter.StartAt != nil {
+		match["$or"] = filter.buildPaginationOrBranches()
+	}
+
+	return bson.M{"$match": match}
+}
+
+// buildPaginationOrBranches builds an expression for the conditions imposed by
+// the filter StartAt field.
+func (filter StatsFilter) buildPaginationOrBranches() []bson.M {
+	return taskstats.BuildPaginationOrBranches([]taskstats.PaginationField{
+		{Field: DBTestStatsIDDateKeyFull, Descending: filter.Sort == taskstats.SortLatestFirst, Strict: true, Value: filter.StartAt.Date},
+		{Field: DBTestStatsIDBuildVariantKeyFull, Strict: true, Value: filter.StartAt.BuildVariant},
+		{Field: DBTestStatsIDTaskNameKeyFull, Strict: true, Value: filter.StartAt.Task},
+		{Field: DBTestStatsIDTestNameKeyFull, Strict: false, Value: filter.StartAt.Test},
+	})
+}
+
+// dailyTestStatsQueryPipeline creates an aggregation pipeline to query the
+// daily test statistics.
+func (filter StatsFilter) dailyTestStatsQueryPipeline() []bson.M {
+	return []bson.M{
+		filter.buildMatchStage(),
+		{"$sort": bson.D{
+			{Key: DBTestStatsIDDateKeyFull, Value: taskstats.SortDateOrder(filter.Sort)},
+			{Key: DBTestStatsIDBuildVariantKeyFull, Value: 1},
+			{Key: DBTestStatsIDTaskNameKeyFull, Value: 1},
+			{Key: DBTestStatsIDTestNameKeyFull, Value: 1},
+		}},
+		{"$limit": filter.Limit},
+		{"$project": bson.M{
+			"_id":                       0,
+			TestStatsTestNameKey:        "$" + DBTestStatsIDTestNameKeyFull,
+			TestStatsTaskNameKey:        "$" + DBTestStatsIDTaskNameKeyFull,
+			TestStatsBuildVariantKey:    "$" + DBTestStatsIDBuildVariantKeyFull,
+			TestStatsDateKey:            "$" + DBTestStatsIDDateKeyFull,
+			TestStatsNumPassKey:         "$" + DBTestStatsNumPassKey,
+			TestStatsNumFailKey:         "$" + DBTestStatsNumFailKey,
+			TestStatsNumTotalKey:        bson.M{"$add": taskstats.Array{"$" + DBTestStatsNumPassKey, "$" + DBTestStatsNumFailKey}},
+			TestStatsP50DurationPassKey: "$" + DBTestStatsP50DurationPassKey,
+			TestStatsP95DurationPassKey: "$" + DBTestStatsP95DurationPassKey,
+			TestStatsLastUpdateKey:      "$" + DBTestStatsLastUpdateKey,
+		}},
+	}
+}
+
+// periodTestStatsQueryPipeline creates an aggregation pipeline that combines
+// the daily test statistics in the filter's date range into a single document
+// per test, and keeps up to limit of the tests with the highest failure rates.
+// Percentiles cannot be combined exactly, so the durations of the period are
+// the averages of the daily values weighted by the number of passing runs on
+// each day.
+func (filter StatsFilter) periodTestStatsQueryPipeline(limit int) []bson.M {
+	weightedDuration := func(key string) bson.M {
+		return bson.M{"$sum": bson.M{"$multiply": taskstats.Array{"$" + DBTestStatsNumPassKey, "$" + key}}}
+	}
+	averageDuration := func(key string) bson.M {
+		return bson.M{"$cond": bson.M{
+			"if":   bson.M{"$ne": taskstats.Array{"$" + TestStatsNumPassKey, 0}},
+			"then": bson.M{"$divide": taskstats.Array{"$" + key, "$" + TestStatsNumPassKey}},
+			"else": 0,
+		}}
+	}
+
+	return []bson.M{
+		filter.buildMatchStage(),
+		{"$group": bson.M{
+			"_id": bson.M{
+				TestStatsTestNameKey:     "$" + DBTestStatsIDTestNameKeyFull,
+				TestStatsTaskNameKey:     "$" + DBTestStatsIDTaskNameKeyFull,
+				TestStatsBuildVariantKey: "$" + DBTestStatsIDBuildVariantKeyFull,
+			},
+			TestStatsNumPassKey:         bson.M{"$sum": "$" + DBTestStatsNumPassKey},
+			TestStatsNumFailKey:         bson.M{"$sum": "$" + DBTestStatsNumFailKey},
+			TestStatsP50DurationPassKey: weightedDuration(DBTestStatsP50DurationPassKey),
+			TestStatsP95DurationPassKey: weightedDuration(DBTestStatsP95DurationPassKey),
+			TestStatsLastUpdateKey:      bson.M{"$max": "$" + DBTestStatsLastUpdateKey},
+		}},
+		{"$addFields": bson.M{
+			periodFailRateKey: bson.M{"$cond": bson.M{
+				"if":   bson.M{"$gt": taskstats.Array{"$" + TestStatsNumFailKey, 0}},
+				"then": bson.M{"$divide": taskstats.Array{"$" + TestStatsNumFailKey, bson.M{"$add": taskstats.Array{"$" + TestStatsNumPassKey, "$" + TestStatsNumFailKey}}}},
+				"else": 0,
+			}},
+		}},
+		{"$sort": bson.D{
+			{Key: periodFailRateKey, Value: -1},
+			{Key: TestStatsNumFailKey, Value: -1},
+			{Key: "_id." + TestStatsBuildVariantKey, Value: 1},
+			{Key: "_id." + TestStatsTaskNameKey, Value: 1},
+			{Key: "_id." + TestStatsTestNameKey, Value: 1},
+		}},
+		{"$limit": limit},
+		{"$project": bson.M{
+			"_id":                       0,
+			TestStatsTestNameKey:        "$_id." + TestStatsTestNameKey,
+			TestStatsTaskNameKey:        "$_id." + TestStatsTaskNameKey,
+			TestStatsBuildVariantKey:    "$_id." + TestStatsBuildVariantKey,
+			TestStatsDateKey:            filter.AfterDate,
+			TestStatsNumPassKey:         1,
+			TestStatsNumFailKey:         1,
+			TestStatsNumTotalKey:        bson.M{"$add": taskstats.Array{"$" + TestStatsNumPassKey, "$" + TestStatsNumFailKey}},
+			TestStatsP50DurationPassKey: averageDuration(TestStatsP50DurationPassKey),
+			TestStatsP95DurationPassKey: averageDuration(TestStatsP95DurationPassKey),
+			TestStatsLastUpdateKey:      1,
+		}},
+	}
+}
+
+///////////////////////////////////////////////////////////////////
+// Functions to access pre-computed stats documents for testing. //
+///////////////////////////////////////////////////////////////////
+
+func GetDailyTestDoc(ctx context.Context, id DBTestStatsID) (*DBTestStats, error) {
+	doc := DBTestStats{}
+	q := db.Query(bson.M{DBTestStatsIDKey: id})
+	err := db.FindOneQ(ctx, DailyTestStatsCollection, q, &doc)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return &doc, err
+}
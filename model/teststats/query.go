@@ -0,0 +1,130 @@
+package teststats
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/taskstats"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const MaxQueryLimit = taskstats.MaxQueryLimit
+
+/////////////
+// Filters //
+/////////////
+
+// StartAt represents parameters that allow a search query to resume at a
+// specific point. Used for pagination.
+type StartAt struct {
+	Date         time.Time
+	BuildVariant string
+	Task         string
+	Test         string
+}
+
+// validate validates that the StartAt struct is valid for use with test
+// stats.
+func (s *StartAt) validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(!s.Date.Equal(utility.GetUTCDay(s.Date)), "invalid 'start at' date")
+	catcher.NewWhen(s.BuildVariant == "", "missing build variant pagination value")
+	catcher.NewWhen(s.Task == "", "missing task pagination value")
+	catcher.NewWhen(s.Test == "", "missing test pagination value")
+	return catcher.Resolve()
+}
+
+// StatsFilter represents search parameters when querying the test
+// statistics.
+type StatsFilter struct {
+	Project    string
+	Requesters []string
+	AfterDate  time.Time
+	BeforeDate time.Time
+
+	Tests         []string
+	Tasks         []string
+	BuildVariants []string
+
+	StartAt *StartAt
+	Limit   int
+	Sort    taskstats.Sort
+}
+
+// Validate validates that the StatsFilter struct is valid for use with test
+// stats.
+func (f *StatsFilter) Validate() error {
+	if f == nil {
+		return errors.New("stats filter cannot be nil")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(f.Project == "", "missing project")
+	catcher.NewWhen(len(f.Requesters) == 0, "missing requesters")
+	catcher.NewWhen(!f.AfterDate.Equal(utility.GetUTCDay(f.AfterDate)), "'after' date is not in UTC")
+	catcher.NewWhen(!f.BeforeDate.Equal(utility.GetUTCDay(f.BeforeDate)), "'before' date is not in UTC")
+	catcher.NewWhen(!f.BeforeDate.After(f.AfterDate), "'after' date restriction must be earlier than 'before' date restriction")
+	catcher.NewWhen(f.Limit > MaxQueryLimit || f.Limit <= 0, "invalid limit")
+	catcher.ErrorfWhen(f.Sort != taskstats.SortEarliestFirst && f.Sort != taskstats.SortLatestFirst, "invalid sort '%s'", f.Sort)
+	if f.StartAt != nil {
+		catcher.Add(f.StartAt.validate())
+	}
+
+	return catcher.Resolve()
+}
+
+//////////////////////////////
+// Test Statistics Querying //
+//////////////////////////////
+
+// TestStats represents test execution statistics.
+type TestStats struct {
+	TestName     string    `bson:"test_name"`
+	TaskName     string    `bson:"task_name"`
+	BuildVariant string    `bson:"variant"`
+	Date         time.Time `bson:"date"`
+
+	NumTotal        int       `bson:"num_total"`
+	NumPass         int       `bson:"num_pass"`
+	NumFail         int       `bson:"num_fail"`
+	P50DurationPass float64   `bson:"p50_duration_pass"`
+	P95DurationPass float64   `bson:"p95_duration_pass"`
+	LastUpdate      time.Time `bson:"last_update"`
+}
+
+// GetDailyTestStats queries the precomputed daily test statistics using a
+// filter. The results are sorted by date, build variant, task and test.
+func GetDailyTestStats(ctx context.Context, filter StatsFilter) ([]TestStats, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid stats filter")
+	}
+	var stats []TestStats
+	if err := db.Aggregate(ctx, DailyTestStatsCollection, filter.dailyTestStatsQueryPipeline(), &stats); err != nil {
+		return nil, errors.Wrap(err, "aggregating daily test statistics")
+	}
+	return stats, nil
+}
+
+// GetTestStatsForPeriod queries the precomputed daily test statistics using a
+// filter and combines them into one result per test over the whole date
+// range. The date of each result is the filter's 'after' date. Only up to
+// limit of the tests with the highest failure rates are returned, sorted by
+// failure rate and then by number of failures. The filter's own limit is
+// ignored since the caller is expected to rank the results.
+func GetTestStatsForPeriod(ctx context.Context, filter StatsFilter, limit int) ([]TestStats, error) {
+	filter.StartAt = nil
+	if err := filter.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid stats filter")
+	}
+	if limit <= 0 {
+		return nil, errors.New("limit must be positive")
+	}
+	var stats []TestStats
+	if err := db.Aggregate(ctx, DailyTestStatsCollection, filter.periodTestStatsQueryPipeline(limit), &stats); err != nil {
+		return nil, errors.Wrap(err, "aggregating test statistics")
+	}
+	return stats, nil
+}
@@ -0,0 +1,156 @@
+// Package teststats provides functions to generate and query pre-computed test
+// statistics. The statistics are aggregated per day and a combination of
+// (project, variant, task, test, requester).
+package teststats
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// GenerateStatsOptions represent the options for generating the daily test
+// stats of a project.
+type GenerateStatsOptions struct {
+	ProjectID string
+	Requester string
+	Tasks     []string
+	Date      time.Time
+}
+
+// GenerateStats aggregates the test results of the finished tasks in the
+// database into daily test stats documents for the given project, requester,
+// day, and tasks specified. The day covered is the UTC day corresponding to
+// the given day parameter. Like the daily task stats, only the latest
+// execution of each task is taken into account.
+func GenerateStats(ctx context.Context, opts GenerateStatsOptions) error {
+	grip.Info(ctx, message.Fields{
+		"message":   "generating daily test stats",
+		"project":   opts.ProjectID,
+		"requester": opts.Requester,
+		"day":       opts.Date,
+		"tasks":     opts.Tasks,
+	})
+	start := utility.GetUTCDay(opts.Date)
+	end := start.Add(24 * time.Hour)
+
+	tasks, err := task.FindAll(ctx, db.Query(bson.M{
+		task.ProjectKey:     opts.ProjectID,
+		task.RequesterKey:   opts.Requester,
+		task.CreateTimeKey:  bson.M{"$gte": start, "$lt": end},
+		task.DisplayNameKey: bson.M{"$in": opts.Tasks},
+		task.StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		"$or": []bson.M{
+			{task.DisplayTaskIdKey: bson.M{"$exists": false}},
+			{task.DisplayTaskIdKey: ""},
+		},
+	}).Project(bson.M{task.GeneratedJSONAsStringKey: 0}))
+	if err != nil {
+		return errors.Wrap(err, "finding tasks")
+	}
+
+	env := evergreen.GetEnvironment()
+	runs := make([]taskTestResults, 0, len(tasks))
+	for _, t := range tasks {
+		results, err := t.GetTestResults(ctx, env, nil)
+		if err != nil {
+			return errors.Wrapf(err, "getting test results for task '%s'", t.Id)
+		}
+		if len(results.Results) == 0 {
+			continue
+		}
+		runs = append(runs, taskTestResults{
+			taskName:     t.DisplayName,
+			buildVariant: t.BuildVariant,
+			results:      results.Results,
+		})
+	}
+
+	docs := aggregateTestResults(DBTestStatsID{
+		Project:   opts.ProjectID,
+		Requester: opts.Requester,
+		Date:      start,
+	}, runs)
+
+	return errors.Wrap(upsertDailyTestStats(ctx, docs), "saving daily test stats")
+}
+
+// taskTestResults are the test results of a single task run.
+type taskTestResults struct {
+	taskName     string
+	buildVariant string
+	results      []testresult.TestResult
+}
+
+// aggregateTestResults aggregates the test results of the task runs into
+// daily test stats documents, one per test. The project, requester and date
+// of the documents are taken from the given base ID. Skipped tests are not
+// counted.
+func aggregateTestResults(baseID DBTestStatsID, runs []taskTestResults) []DBTestStats {
+	now := time.Now()
+	stats := map[DBTestStatsID]*DBTestStats{}
+	durations := map[DBTestStatsID][]float64{}
+	var ids []DBTestStatsID
+	for _, run := range runs {
+		for _, result := range run.results {
+			id := baseID
+			id.TestName = result.GetDisplayTestName()
+			id.TaskName = run.taskName
+			id.BuildVariant = run.buildVariant
+
+			var passed bool
+			switch result.Status {
+			case evergreen.TestSucceededStatus:
+				passed = true
+			case evergreen.TestFailedStatus, evergreen.TestSilentlyFailedStatus, evergreen.TestTimedOutStatus:
+			default:
+				continue
+			}
+
+			doc, ok := stats[id]
+			if !ok {
+				doc = &DBTestStats{Id: id, LastUpdate: now}
+				stats[id] = doc
+				ids = append(ids, id)
+			}
+			if !passed {
+				doc.NumFail++
+				continue
+			}
+			doc.NumPass++
+			if !result.TestStartTime.IsZero() && !result.TestEndTime.Before(result.TestStartTime) {
+				durations[id] = append(durations[id], result.Duration().Seconds())
+			}
+		}
+	}
+
+	docs := make([]DBTestStats, 0, len(ids))
+	for _, id := range ids {
+		doc := stats[id]
+		slices.Sort(durations[id])
+		doc.P50DurationPass = percentile(durations[id], 50)
+		doc.P95DurationPass = percentile(durations[id], 95)
+		docs = append(docs, *doc)
+	}
+	return docs
+}
+
+// percentile returns the nearest-rank percentile of the sorted values.
+func percentile(sorted []float64, pct float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(pct / 100 * float64(len(sorted))))
+	return sorted[max(rank-1, 0)]
+}
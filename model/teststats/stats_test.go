@@ -0,0 +1,265 @@
+package teststats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/taskstats"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	resultTestutil "github.com/evergreen-ci/evergreen/model/testresult/testutil"
+	_ "github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeTestResult(name, status string, duration time.Duration) testresult.TestResult {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return testresult.TestResult{
+		TestName:      name,
+		Status:        status,
+		TestStartTime: start,
+		TestEndTime:   start.Add(duration),
+	}
+}
+
+func TestAggregateTestResults(t *testing.T) {
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	baseID := DBTestStatsID{Project: "project", Requester: evergreen.RepotrackerVersionRequester, Date: day}
+
+	t.Run("CountsPassesAndFailuresPerTest", func(t *testing.T) {
+		docs := aggregateTestResults(baseID, []taskTestResults{
+			{
+				taskName:     "unit",
+				buildVariant: "ubuntu",
+				results: []testresult.TestResult{
+					makeTestResult("test_a", evergreen.TestSucceededStatus, time.Second),
+					makeTestResult("test_b", evergreen.TestFailedStatus, time.Second),
+					makeTestResult("test_c", evergreen.TestSkippedStatus, time.Second),
+				},
+			},
+			{
+				taskName:     "unit",
+				buildVariant: "ubuntu",
+				results: []testresult.TestResult{
+					makeTestResult("test_a", evergreen.TestTimedOutStatus, time.Minute),
+					makeTestResult("test_b", evergreen.TestSilentlyFailedStatus, time.Second),
+				},
+			},
+			{
+				taskName:     "unit",
+				buildVariant: "windows",
+				results: []testresult.TestResult{
+					makeTestResult("test_a", evergreen.TestSucceededStatus, 3*time.Second),
+				},
+			},
+		})
+		require.Len(t, docs, 3, "skipped tests should not be counted")
+
+		expectedID := baseID
+		expectedID.TestName = "test_a"
+		expectedID.TaskName = "unit"
+		expectedID.BuildVariant = "ubuntu"
+		assert.Equal(t, expectedID, docs[0].Id)
+		assert.Equal(t, 1, docs[0].NumPass)
+		assert.Equal(t, 1, docs[0].NumFail)
+		assert.InDelta(t, 1, docs[0].P50DurationPass, 0.001, "durations should only include passing runs")
+
+		assert.Equal(t, "test_b", docs[1].Id.TestName)
+		assert.Zero(t, docs[1].NumPass)
+		assert.Equal(t, 2, docs[1].NumFail)
+		assert.Zero(t, docs[1].P50DurationPass)
+
+		assert.Equal(t, "test_a", docs[2].Id.TestName)
+		assert.Equal(t, "windows", docs[2].Id.BuildVariant)
+		assert.Equal(t, 1, docs[2].NumPass)
+		assert.InDelta(t, 3, docs[2].P95DurationPass, 0.001)
+	})
+	t.Run("UsesDisplayTestName", func(t *testing.T) {
+		result := makeTestResult("internal_name", evergreen.TestSucceededStatus, time.Second)
+		result.DisplayTestName = "display_name"
+		docs := aggregateTestResults(baseID, []taskTestResults{
+			{taskName: "unit", buildVariant: "ubuntu", results: []testresult.TestResult{result}},
+		})
+		require.Len(t, docs, 1)
+		assert.Equal(t, "display_name", docs[0].Id.TestName)
+	})
+	t.Run("ComputesDurationPercentiles", func(t *testing.T) {
+		var results []testresult.TestResult
+		for i := 20; i >= 1; i-- {
+			results = append(results, makeTestResult("test", evergreen.TestSucceededStatus, time.Duration(i)*time.Second))
+		}
+		docs := aggregateTestResults(baseID, []taskTestResults{
+			{taskName: "unit", buildVariant: "ubuntu", results: results},
+		})
+		require.Len(t, docs, 1)
+		assert.Equal(t, 20, docs[0].NumPass)
+		assert.InDelta(t, 10, docs[0].P50DurationPass, 0.001)
+		assert.InDelta(t, 19, docs[0].P95DurationPass, 0.001)
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Zero(t, percentile(nil, 50))
+	assert.InDelta(t, 4, percentile([]float64{4}, 95), 0.001)
+	assert.InDelta(t, 2, percentile([]float64{1, 2, 3, 4}, 50), 0.001)
+	assert.InDelta(t, 4, percentile([]float64{1, 2, 3, 4}, 95), 0.001)
+	assert.InDelta(t, 1, percentile([]float64{1, 2, 3, 4}, 0), 0.001)
+}
+
+func TestGenerateAndQueryStats(t *testing.T) {
+	require.NoError(t, db.ClearCollections(task.Collection, DailyTestStatsCollection, testresult.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(task.Collection, DailyTestStatsCollection, testresult.Collection))
+	}()
+	ctx := t.Context()
+	env := evergreen.GetEnvironment()
+
+	day1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	makeTask := func(id, variant string, createTime time.Time, results []testresult.TestResult) {
+		tsk := task.Task{
+			Id:             id,
+			DisplayName:    "unit",
+			BuildVariant:   variant,
+			Project:        "project",
+			Requester:      evergreen.RepotrackerVersionRequester,
+			CreateTime:     createTime.Add(time.Hour),
+			Status:         evergreen.TaskSucceeded,
+			HasTestResults: true,
+			TaskOutputInfo: &task.TaskOutput{
+				TestResults: task.TestResultOutput{Version: task.TestResultServiceLocal},
+			},
+		}
+		require.NoError(t, tsk.Insert(ctx))
+		for i := range results {
+			results[i].TaskID = id
+		}
+		require.NoError(t, task.NewLocalService(env).AppendTestResultMetadata(resultTestutil.MakeAppendTestResultMetadataReq(ctx, results, id)))
+	}
+	makeTask("t1", "ubuntu", day1, []testresult.TestResult{
+		makeTestResult("test_a", evergreen.TestSucceededStatus, 2*time.Second),
+		makeTestResult("test_b", evergreen.TestFailedStatus, time.Second),
+	})
+	makeTask("t2", "ubuntu", day1, []testresult.TestResult{
+		makeTestResult("test_a", evergreen.TestSucceededStatus, 4*time.Second),
+		makeTestResult("test_b", evergreen.TestSucceededStatus, time.Second),
+	})
+	makeTask("t3", "ubuntu", day2, []testresult.TestResult{
+		makeTestResult("test_a", evergreen.TestFailedStatus, time.Second),
+		makeTestResult("test_b", evergreen.TestSucceededStatus, 3*time.Second),
+	})
+
+	for _, day := range []time.Time{day1, day2} {
+		require.NoError(t, GenerateStats(ctx, GenerateStatsOptions{
+			ProjectID: "project",
+			Requester: evergreen.RepotrackerVersionRequester,
+			Tasks:     []string{"unit"},
+			Date:      day,
+		}))
+	}
+
+	doc, err := GetDailyTestDoc(ctx, DBTestStatsID{
+		TestName:     "test_a",
+		TaskName:     "unit",
+		BuildVariant: "ubuntu",
+		Project:      "project",
+		Requester:    evergreen.RepotrackerVersionRequester,
+		Date:         day1,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, doc)
+	assert.Equal(t, 2, doc.NumPass)
+	assert.Zero(t, doc.NumFail)
+	assert.InDelta(t, 2, doc.P50DurationPass, 0.001)
+	assert.InDelta(t, 4, doc.P95DurationPass, 0.001)
+
+	filter := StatsFilter{
+		Project:    "project",
+		Requesters: []string{evergreen.RepotrackerVersionRequester},
+		AfterDate:  day1,
+		BeforeDate: day2.Add(24 * time.Hour),
+		Limit:      MaxQueryLimit,
+		Sort:       taskstats.SortLatestFirst,
+	}
+
+	t.Run("DailyStats", func(t *testing.T) {
+		stats, err := GetDailyTestStats(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, stats, 4)
+		assert.True(t, day2.Equal(stats[0].Date))
+		assert.Equal(t, "test_a", stats[0].TestName)
+		assert.Equal(t, 1, stats[0].NumTotal)
+		assert.Equal(t, 1, stats[0].NumFail)
+		assert.True(t, day1.Equal(stats[3].Date))
+		assert.Equal(t, "test_b", stats[3].TestName)
+		assert.Equal(t, 2, stats[3].NumTotal)
+	})
+	t.Run("DailyStatsWithStartAt", func(t *testing.T) {
+		paginated := filter
+		paginated.StartAt = &StartAt{Date: day1, BuildVariant: "ubuntu", Task: "unit", Test: "test_b"}
+		stats, err := GetDailyTestStats(ctx, paginated)
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		assert.Equal(t, "test_b", stats[0].TestName)
+		assert.True(t, day1.Equal(stats[0].Date))
+	})
+	t.Run("DailyStatsFilteredByTest", func(t *testing.T) {
+		filtered := filter
+		filtered.Tests = []string{"test_b"}
+		stats, err := GetDailyTestStats(ctx, filtered)
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+		for _, stat := range stats {
+			assert.Equal(t, "test_b", stat.TestName)
+		}
+	})
+	t.Run("StatsForPeriod", func(t *testing.T) {
+		stats, err := GetTestStatsForPeriod(ctx, filter, MaxQueryLimit)
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+		for _, stat := range stats {
+			assert.True(t, day1.Equal(stat.Date))
+			assert.Equal(t, 3, stat.NumTotal)
+			switch stat.TestName {
+			case "test_a":
+				assert.Equal(t, 2, stat.NumPass)
+				assert.InDelta(t, 2, stat.P50DurationPass, 0.001)
+			case "test_b":
+				assert.Equal(t, 2, stat.NumPass)
+				assert.InDelta(t, 2, stat.P50DurationPass, 0.001, "durations should be weighted by passing runs")
+			default:
+				assert.Fail(t, "unexpected test", stat.TestName)
+			}
+		}
+	})
+	t.Run("StatsForPeriodAreLimited", func(t *testing.T) {
+		stats, err := GetTestStatsForPeriod(ctx, filter, 1)
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		assert.Equal(t, "test_a", stats[0].TestName, "tests with the same failure rate should be sorted by name")
+		assert.Equal(t, 1, stats[0].NumFail)
+
+		_, err = GetTestStatsForPeriod(ctx, filter, 0)
+		assert.Error(t, err)
+	})
+	t.Run("FailsWithInvalidFilter", func(t *testing.T) {
+		invalid := filter
+		invalid.BeforeDate = invalid.AfterDate
+		_, err := GetDailyTestStats(ctx, invalid)
+		assert.Error(t, err)
+
+		invalid = filter
+		invalid.Limit = 0
+		_, err = GetDailyTestStats(ctx, invalid)
+		assert.Error(t, err)
+
+		invalid = filter
+		invalid.AfterDate = utility.GetUTCDay(day1).Add(time.Hour)
+		_, err = GetTestStatsForPeriod(ctx, invalid, MaxQueryLimit)
+		assert.Error(t, err)
+	})
+}
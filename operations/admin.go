@@ -31,6 +31,7 @@ func Admin() cli.Command {
 			revert(),
 			fetchAllProjectConfigs(),
 			adminFeatureTracker(),
+			adminScheduler(),
 			amboyCmd(),
 			fromMdbForLocal(),
 			toMdbForLocal(),
@@ -38,6 +39,7 @@ func Admin() cli.Command {
 			updateServiceUser(),
 			getServiceUsers(),
 			deleteServiceUser(),
+			adminDeadLetter(),
 		},
 	}
 }
@@ -0,0 +1,148 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/evergreen-ci/evergreen/rest/client"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+const (
+	deadLetterIDFlagName             = "id"
+	deadLetterSubscriberTypeFlagName = "subscriber-type"
+	deadLetterAllFlagName            = "all"
+)
+
+func adminDeadLetter() cli.Command {
+	return cli.Command{
+		Name:  "dead-letter",
+		Usage: "inspect, replay, or purge notifications that ran out of attempts to be sent",
+		Subcommands: []cli.Command{
+			adminDeadLetterList(),
+			adminDeadLetterReplay(),
+			adminDeadLetterPurge(),
+		},
+	}
+}
+
+func adminDeadLetterList() cli.Command {
+	return cli.Command{
+		Name:   "list",
+		Usage:  "print the most recently dead lettered notifications",
+		Before: setPlainLogger,
+		Flags: addLimitFlag(cli.StringFlag{
+			Name:  deadLetterSubscriberTypeFlagName,
+			Usage: "only print notifications for this type of subscriber",
+		}),
+		Action: func(c *cli.Context) error {
+			confPath := getRootContext(c).String(ConfFlagName)
+			subscriberType := c.String(deadLetterSubscriberTypeFlagName)
+			limit := c.Int(limitFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			comm, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer comm.Close()
+
+			notifications, err := comm.GetDeadLetteredNotifications(ctx, subscriberType, limit)
+			if err != nil {
+				return errors.Wrap(err, "getting dead lettered notifications")
+			}
+
+			out, err := json.MarshalIndent(notifications, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "marshalling dead lettered notifications")
+			}
+			_, err = fmt.Fprintln(os.Stdout, string(out))
+			return errors.Wrap(err, "writing dead lettered notifications")
+		},
+	}
+}
+
+func adminDeadLetterReplay() cli.Command {
+	return adminDeadLetterAction("replay", "send dead lettered notifications again with a full set of attempts",
+		func(ctx context.Context, comm client.Communicator, filter model.APIDeadLetterFilter) (int, error) {
+			return comm.ReplayDeadLetteredNotifications(ctx, filter)
+		})
+}
+
+func adminDeadLetterPurge() cli.Command {
+	return adminDeadLetterAction("purge", "delete dead lettered notifications",
+		func(ctx context.Context, comm client.Communicator, filter model.APIDeadLetterFilter) (int, error) {
+			return comm.PurgeDeadLetteredNotifications(ctx, filter)
+		})
+}
+
+// adminDeadLetterAction returns a command that applies the action to the
+// dead lettered notifications selected by its flags. At least one flag must be
+// given so that every notification isn't selected by accident.
+func adminDeadLetterAction(name, usage string, action func(context.Context, client.Communicator, model.APIDeadLetterFilter) (int, error)) cli.Command {
+	return cli.Command{
+		Name:  name,
+		Usage: usage,
+		Before: mergeBeforeFuncs(
+			setPlainLogger,
+			requireAtLeastOneFlag(deadLetterIDFlagName, deadLetterSubscriberTypeFlagName, deadLetterAllFlagName),
+		),
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  deadLetterIDFlagName,
+				Usage: "the ID of a notification (can be specified multiple times)",
+			},
+			cli.StringFlag{
+				Name:  deadLetterSubscriberTypeFlagName,
+				Usage: "select notifications for this type of subscriber",
+			},
+			cli.BoolFlag{
+				Name:  deadLetterAllFlagName,
+				Usage: "select all dead lettered notifications",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			confPath := getRootContext(c).String(ConfFlagName)
+			filter := model.APIDeadLetterFilter{
+				IDs: c.StringSlice(deadLetterIDFlagName),
+				All: c.Bool(deadLetterAllFlagName),
+			}
+			if subscriberType := c.String(deadLetterSubscriberTypeFlagName); subscriberType != "" {
+				filter.SubscriberType = utility.ToStringPtr(subscriberType)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			comm, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer comm.Close()
+
+			count, err := action(ctx, comm, filter)
+			if err != nil {
+				return errors.Wrapf(err, "trying to %s dead lettered notifications", name)
+			}
+			grip.Infof(ctx, "%d dead lettered notification(s) affected by %s", count, name)
+
+			return nil
+		},
+	}
+}
@@ -146,10 +146,16 @@ func ftDetectors() []ftDetector {
 		ftCommandDetector("s3_get", "s3.get", "s3.get"),
 		ftCommandDetector("subprocess_exec", "subprocess.exec", "subprocess.exec"),
 		ftCommandDetector("shell_exec", "shell.exec", "shell.exec"),
+		ftCommandDetector("service_start", "service.start (managed background services)", "service.start"),
+		ftCommandDetector("task_files_publish", "task_files.publish (files shared with dependent tasks)", "task_files.publish"),
+		ftCommandDetector("task_files_fetch", "task_files.fetch (files shared with dependent tasks)", "task_files.fetch"),
+		ftCommandDetector("task_outputs_set", "task_outputs.set (structured task outputs)", "task_outputs.set"),
 		ftCommandDetector("manifest_load", "manifest.load", "manifest.load"),
 		ftCommandDetector("attach_results", "attach.results", "attach.results"),
 		ftCommandDetector("attach_xunit_results", "attach.xunit_results", "attach.xunit_results"),
+		ftCommandDetector("attach_test_results", "attach.test_results (multi-format test report parsing)", "attach.test_results"),
 		ftCommandDetector("gotest_parse_files", "gotest.parse_files", "gotest.parse_files"),
+		ftCommandDetector("coverage_parse", "coverage.parse (code coverage ingestion)", "coverage.parse"),
 		ftCommandDetector("perf_send", "perf.send", "perf.send"),
 		ftCommandDetector("ec2_assume_role", "ec2.assume_role", "ec2.assume_role"),
 	}
@@ -0,0 +1,267 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/scheduler"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+// adminScheduler returns the `evergreen admin scheduler` subcommand, which
+// captures snapshots of a distro's task queue and hosts and replays them
+// offline to compare scheduler settings.
+func adminScheduler() cli.Command {
+	return cli.Command{
+		Name:  "scheduler",
+		Usage: "capture and replay snapshots of a distro's scheduler inputs",
+		Subcommands: []cli.Command{
+			adminSchedulerSnapshot(),
+			adminSchedulerReplay(),
+		},
+	}
+}
+
+func adminSchedulerSnapshot() cli.Command {
+	const (
+		distroFlagName = "distro"
+		outputFlagName = "output"
+	)
+
+	return cli.Command{
+		Name:   "snapshot",
+		Usage:  "record a distro's task queue and hosts for offline replay",
+		Before: mergeBeforeFuncs(setPlainLogger, requireStringFlag(distroFlagName)),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  joinFlagNames(distroFlagName, "d"),
+				Usage: "the distro to capture",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(outputFlagName, "o"),
+				Usage: "file to write the snapshot to (defaults to stdout)",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			confPath := getRootContext(c).String(ConfFlagName)
+			distroID := c.String(distroFlagName)
+			output := c.String(outputFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+			client, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			snapshot, err := client.GetSchedulerSnapshot(ctx, distroID)
+			if err != nil {
+				return errors.Wrapf(err, "getting scheduler snapshot for distro '%s'", distroID)
+			}
+			out, err := json.MarshalIndent(snapshot, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "marshalling snapshot")
+			}
+
+			if output == "" {
+				_, err = fmt.Fprintln(os.Stdout, string(out))
+				return errors.Wrap(err, "writing snapshot")
+			}
+			return errors.Wrapf(os.WriteFile(output, out, 0644), "writing snapshot to file '%s'", output)
+		},
+	}
+}
+
+func adminSchedulerReplay() cli.Command {
+	const (
+		snapshotFlagName              = "snapshot"
+		plannerSettingsFlagName       = "planner-settings"
+		hostAllocatorSettingsFlagName = "host-allocator-settings"
+		intervalFlagName              = "interval"
+		hostStartupTimeFlagName       = "host-startup-time"
+		maxDurationFlagName           = "max-duration"
+		jsonFlagName                  = "json"
+	)
+
+	return cli.Command{
+		Name:  "replay",
+		Usage: "project queue latency and host-hours for a snapshot under alternative scheduler settings",
+		Description: "Replays the snapshot with the settings it was captured with, and again with the planner and host allocator settings " +
+			"from the given files if there are any. The files are JSON in the same format as a distro's planner_settings and " +
+			"host_allocator_settings, and only need to contain the settings to change.",
+		Before: mergeBeforeFuncs(setPlainLogger, requireStringFlag(snapshotFlagName)),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  joinFlagNames(snapshotFlagName, "s"),
+				Usage: "file containing a snapshot from `evergreen admin scheduler snapshot`",
+			},
+			cli.StringFlag{
+				Name:  plannerSettingsFlagName,
+				Usage: "JSON file of planner settings to replay the snapshot with",
+			},
+			cli.StringFlag{
+				Name:  hostAllocatorSettingsFlagName,
+				Usage: "JSON file of host allocator settings to replay the snapshot with",
+			},
+			cli.DurationFlag{
+				Name:  intervalFlagName,
+				Usage: "how often the scheduler runs during the replay",
+				Value: time.Minute,
+			},
+			cli.DurationFlag{
+				Name:  hostStartupTimeFlagName,
+				Usage: "how long new hosts take to start running tasks",
+				Value: 5 * time.Minute,
+			},
+			cli.DurationFlag{
+				Name:  maxDurationFlagName,
+				Usage: "the longest time to simulate",
+				Value: 7 * 24 * time.Hour,
+			},
+			cli.BoolFlag{
+				Name:  jsonFlagName,
+				Usage: "print the reports as JSON",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			snapshot := &model.SchedulerSnapshot{}
+			if err := readJSONFile(c.String(snapshotFlagName), snapshot); err != nil {
+				return errors.Wrap(err, "reading snapshot")
+			}
+
+			opts := scheduler.ReplayOptions{
+				SchedulingInterval: c.Duration(intervalFlagName),
+				HostStartupTime:    c.Duration(hostStartupTimeFlagName),
+				MaxDuration:        c.Duration(maxDurationFlagName),
+			}
+			reports := []scheduler.ReplayReport{}
+			baseline, err := scheduler.Replay(ctx, snapshot, opts)
+			if err != nil {
+				return errors.Wrap(err, "replaying snapshot with its own settings")
+			}
+			reports = append(reports, *baseline)
+
+			if fileName := c.String(plannerSettingsFlagName); fileName != "" {
+				apiSettings := restmodel.APIPlannerSettings{}
+				apiSettings.BuildFromService(snapshot.PlannerSettings)
+				if err = readJSONFile(fileName, &apiSettings); err != nil {
+					return errors.Wrap(err, "reading planner settings")
+				}
+				settings := apiSettings.ToService()
+				opts.PlannerSettings = &settings
+			}
+			if fileName := c.String(hostAllocatorSettingsFlagName); fileName != "" {
+				apiSettings := restmodel.APIHostAllocatorSettings{}
+				apiSettings.BuildFromService(snapshot.HostAllocatorSettings)
+				if err = readJSONFile(fileName, &apiSettings); err != nil {
+					return errors.Wrap(err, "reading host allocator settings")
+				}
+				settings := apiSettings.ToService()
+				opts.HostAllocatorSettings = &settings
+			}
+			if opts.PlannerSettings != nil || opts.HostAllocatorSettings != nil {
+				candidate, err := scheduler.Replay(ctx, snapshot, opts)
+				if err != nil {
+					return errors.Wrap(err, "replaying snapshot with the new settings")
+				}
+				reports = append(reports, *candidate)
+			}
+
+			if c.Bool(jsonFlagName) {
+				out, err := json.MarshalIndent(reports, "", "  ")
+				if err != nil {
+					return errors.Wrap(err, "marshalling replay reports")
+				}
+				_, err = fmt.Fprintln(os.Stdout, string(out))
+				return errors.Wrap(err, "writing replay reports")
+			}
+			return errors.Wrap(printReplayReports(os.Stdout, snapshot, reports), "writing replay reports")
+		},
+	}
+}
+
+func readJSONFile(fileName string, out any) error {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "reading file '%s'", fileName)
+	}
+	return errors.Wrapf(json.Unmarshal(data, out), "unmarshalling file '%s'", fileName)
+}
+
+// printReplayReports prints the replay reports as a table with a column for
+// each report, so that the settings can be compared side by side.
+func printReplayReports(w io.Writer, snapshot *model.SchedulerSnapshot, reports []scheduler.ReplayReport) error {
+	if _, err := fmt.Fprintf(w, "Distro '%s' captured at %s with %d queued tasks and %d hosts\n\n",
+		snapshot.DistroID, snapshot.CapturedAt.Format(time.RFC3339), len(snapshot.Tasks), len(snapshot.Hosts)); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	row := func(name string, value func(scheduler.ReplayReport) string) {
+		fmt.Fprint(tw, name)
+		for _, r := range reports {
+			fmt.Fprintf(tw, "\t%s", value(r))
+		}
+		fmt.Fprintln(tw)
+	}
+	duration := func(d time.Duration) string { return d.Round(time.Second).String() }
+
+	fmt.Fprint(tw, "\tcurrent settings")
+	if len(reports) > 1 {
+		fmt.Fprint(tw, "\tnew settings")
+	}
+	fmt.Fprintln(tw)
+	row("tasks dispatched", func(r scheduler.ReplayReport) string {
+		return fmt.Sprintf("%d/%d", r.NumTasksDispatched, r.NumTasks)
+	})
+	row("complete", func(r scheduler.ReplayReport) string { return fmt.Sprint(r.Complete) })
+	row("average queue latency", func(r scheduler.ReplayReport) string { return duration(r.AverageQueueLatency) })
+	row("median queue latency", func(r scheduler.ReplayReport) string { return duration(r.MedianQueueLatency) })
+	row("p90 queue latency", func(r scheduler.ReplayReport) string { return duration(r.P90QueueLatency) })
+	row("max queue latency", func(r scheduler.ReplayReport) string { return duration(r.MaxQueueLatency) })
+	row("makespan", func(r scheduler.ReplayReport) string { return duration(r.Makespan) })
+	row("host-hours", func(r scheduler.ReplayReport) string { return fmt.Sprintf("%.1f", r.HostHours) })
+	row("hosts started", func(r scheduler.ReplayReport) string { return fmt.Sprint(r.HostsStarted) })
+	row("peak hosts", func(r scheduler.ReplayReport) string { return fmt.Sprint(r.PeakHosts) })
+
+	projects := []string{}
+	for _, r := range reports {
+		for _, p := range r.Projects {
+			if !utility.StringSliceContains(projects, p.Project) {
+				projects = append(projects, p.Project)
+			}
+		}
+	}
+	sort.Strings(projects)
+	for _, project := range projects {
+		row(fmt.Sprintf("average queue latency (%s)", project), func(r scheduler.ReplayReport) string {
+			for _, p := range r.Projects {
+				if p.Project == project {
+					return duration(p.AverageQueueLatency)
+				}
+			}
+			return "-"
+		})
+	}
+
+	return tw.Flush()
+}
@@ -18,6 +18,7 @@ func Evaluate() cli.Command {
 		variantsFlagName    = "variants"
 		diffableFlagName    = "diffable"
 		yamlAnchorsFlagName = "yaml-anchors"
+		diffAgainstFlagName = "diff-against"
 	)
 
 	return cli.Command{
@@ -44,6 +45,10 @@ func Evaluate() cli.Command {
 				Name:  yamlAnchorsFlagName,
 				Usage: "(BETA) enable cross-file YAML anchors in included files",
 			},
+			cli.StringFlag{
+				Name:  diffAgainstFlagName,
+				Usage: "instead of printing the project, report how each build variant's tasks would behave differently in the given project configuration file or version ID",
+			},
 		),
 		Before: mergeBeforeFuncs(requirePathFlag),
 		Action: func(c *cli.Context) error {
@@ -57,28 +62,37 @@ func Evaluate() cli.Command {
 				return err
 			}
 
-			configBytes, err := os.ReadFile(path)
-			if err != nil {
-				return errors.Wrap(err, "reading project config")
-			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
 
 			cwd, err := os.Getwd()
 			if err != nil {
 				return errors.Wrap(err, "getting current working directory")
 			}
 
-			p := &model.Project{}
-			ctx := context.Background()
 			opts := &model.GetProjectOpts{
 				LocalModules:      localModuleMap,
 				ReadFileFrom:      model.ReadFromLocal,
 				LocalIncludeDir:   cwd,
 				EnableYAMLAnchors: c.Bool(yamlAnchorsFlagName),
 			}
-			_, err = model.LoadProjectInto(ctx, configBytes, opts, "", p)
+			p, err := loadLocalProject(ctx, path, opts)
 			if err != nil {
-				return errors.Wrap(err, "loading project")
+				return err
 			}
+
+			if diffAgainst := c.String(diffAgainstFlagName); diffAgainst != "" {
+				other, err := loadProjectToDiff(ctx, c.Parent().String(ConfFlagName), diffAgainst, opts)
+				if err != nil {
+					return err
+				}
+				diff, err := diffProjects(other, p)
+				if err != nil {
+					return errors.Wrap(err, "diffing projects")
+				}
+				return writeProjectDiff(os.Stdout, diff)
+			}
+
 			if diffable {
 				sortTasksByName := model.ProjectTasksByName(p.Tasks)
 				sort.Sort(sortTasksByName)
@@ -118,3 +132,46 @@ func Evaluate() cli.Command {
 		},
 	}
 }
+
+// loadLocalProject reads and translates the project configuration file at the
+// given path.
+func loadLocalProject(ctx context.Context, path string, opts *model.GetProjectOpts) (*model.Project, error) {
+	configBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading project config '%s'", path)
+	}
+
+	// Loading the project can modify the options, so each project gets its own
+	// copy.
+	projectOpts := *opts
+	p := &model.Project{}
+	if _, err = model.LoadProjectInto(ctx, configBytes, &projectOpts, "", p); err != nil {
+		return nil, errors.Wrapf(err, "loading project '%s'", path)
+	}
+	return p, nil
+}
+
+// loadProjectToDiff loads the project to compare against, which is either a
+// local project configuration file or the project that an existing version
+// was created from.
+func loadProjectToDiff(ctx context.Context, confPath, fileOrVersion string, opts *model.GetProjectOpts) (*model.Project, error) {
+	if _, err := os.Stat(fileOrVersion); err == nil {
+		return loadLocalProject(ctx, fileOrVersion, opts)
+	}
+
+	conf, err := NewClientSettings(confPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading configuration")
+	}
+	client, err := conf.setupRestCommunicator(ctx, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "setting up REST communicator")
+	}
+	defer client.Close()
+
+	p, err := client.GetProjectForVersion(ctx, fileOrVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting project for version '%s'", fileOrVersion)
+	}
+	return p, nil
+}
@@ -0,0 +1,397 @@
+package operations
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// projectDiff describes the behavioral differences between two translated
+// project configurations, grouped by build variant.
+type projectDiff struct {
+	AddedVariants   []string
+	RemovedVariants []string
+	Variants        []variantDiff
+}
+
+// variantDiff describes how a build variant that exists in both projects
+// changed.
+type variantDiff struct {
+	Name         string
+	AddedTasks   []string
+	RemovedTasks []string
+	Expansions   *fieldDiff
+	ChangedTasks []taskDiff
+}
+
+// taskDiff describes how a task that runs on the same build variant in both
+// projects changed.
+type taskDiff struct {
+	Name    string
+	Changes []fieldDiff
+}
+
+// fieldDiff lists the values of a single setting that were removed and added.
+// Scalar settings have at most one removed and one added value.
+type fieldDiff struct {
+	Field   string
+	Removed []string
+	Added   []string
+}
+
+// effectiveTask is the fully resolved configuration of a task as it would run
+// on a particular build variant.
+type effectiveTask struct {
+	taskGroup       string
+	commands        []string
+	dependsOn       []string
+	runOn           []string
+	execTimeoutSecs int
+	idleTimeoutSecs int
+}
+
+func (d *projectDiff) isEmpty() bool {
+	return len(d.AddedVariants) == 0 && len(d.RemovedVariants) == 0 && len(d.Variants) == 0
+}
+
+// diffProjects compares the effective behavior of each build variant's tasks
+// in the base project against the ones in the other project.
+func diffProjects(base, other *model.Project) (*projectDiff, error) {
+	baseTasks, err := effectiveTasksByVariant(base)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving tasks for base project")
+	}
+	otherTasks, err := effectiveTasksByVariant(other)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving tasks for other project")
+	}
+
+	diff := &projectDiff{}
+	for _, bv := range other.BuildVariants {
+		if base.FindBuildVariant(bv.Name) == nil {
+			diff.AddedVariants = append(diff.AddedVariants, bv.Name)
+		}
+	}
+	for _, bv := range base.BuildVariants {
+		otherBV := other.FindBuildVariant(bv.Name)
+		if otherBV == nil {
+			diff.RemovedVariants = append(diff.RemovedVariants, bv.Name)
+			continue
+		}
+		if vd := diffVariant(bv, *otherBV, baseTasks[bv.Name], otherTasks[bv.Name]); vd != nil {
+			diff.Variants = append(diff.Variants, *vd)
+		}
+	}
+
+	sort.Strings(diff.AddedVariants)
+	sort.Strings(diff.RemovedVariants)
+	sort.Slice(diff.Variants, func(i, j int) bool { return diff.Variants[i].Name < diff.Variants[j].Name })
+
+	return diff, nil
+}
+
+func diffVariant(baseBV, otherBV model.BuildVariant, baseTasks, otherTasks map[string]effectiveTask) *variantDiff {
+	vd := variantDiff{Name: baseBV.Name}
+	for name := range otherTasks {
+		if _, ok := baseTasks[name]; !ok {
+			vd.AddedTasks = append(vd.AddedTasks, name)
+		}
+	}
+	for name, baseTask := range baseTasks {
+		otherTask, ok := otherTasks[name]
+		if !ok {
+			vd.RemovedTasks = append(vd.RemovedTasks, name)
+			continue
+		}
+		if changes := diffTask(baseTask, otherTask); len(changes) > 0 {
+			vd.ChangedTasks = append(vd.ChangedTasks, taskDiff{Name: name, Changes: changes})
+		}
+	}
+	if expansions := diffSets("expansions", formatExpansions(baseBV.Expansions), formatExpansions(otherBV.Expansions)); expansions != nil {
+		vd.Expansions = expansions
+	}
+
+	if len(vd.AddedTasks) == 0 && len(vd.RemovedTasks) == 0 && len(vd.ChangedTasks) == 0 && vd.Expansions == nil {
+		return nil
+	}
+
+	sort.Strings(vd.AddedTasks)
+	sort.Strings(vd.RemovedTasks)
+	sort.Slice(vd.ChangedTasks, func(i, j int) bool { return vd.ChangedTasks[i].Name < vd.ChangedTasks[j].Name })
+
+	return &vd
+}
+
+func diffTask(base, other effectiveTask) []fieldDiff {
+	var changes []fieldDiff
+	for _, change := range []*fieldDiff{
+		diffScalar("task_group", base.taskGroup, other.taskGroup),
+		diffSequence("commands", base.commands, other.commands),
+		diffSets("depends_on", base.dependsOn, other.dependsOn),
+		diffSequence("run_on", base.runOn, other.runOn),
+		diffScalar("exec_timeout_secs", strconv.Itoa(base.execTimeoutSecs), strconv.Itoa(other.execTimeoutSecs)),
+		diffScalar("timeout_secs", strconv.Itoa(base.idleTimeoutSecs), strconv.Itoa(other.idleTimeoutSecs)),
+	} {
+		if change != nil {
+			changes = append(changes, *change)
+		}
+	}
+	return changes
+}
+
+// effectiveTasksByVariant resolves every task in the project, including tasks
+// in task groups, to the settings it would actually run with on each build
+// variant.
+func effectiveTasksByVariant(p *model.Project) (map[string]map[string]effectiveTask, error) {
+	tasksByVariant := map[string]map[string]effectiveTask{}
+	for _, bvt := range p.FindAllBuildVariantTasks() {
+		bv := p.FindBuildVariant(bvt.Variant)
+		if bv == nil {
+			return nil, errors.Errorf("build variant '%s' for task '%s' not found", bvt.Variant, bvt.Name)
+		}
+		pt := p.FindProjectTask(bvt.Name)
+		if pt == nil {
+			return nil, errors.Errorf("task '%s' referenced by build variant '%s' not found", bvt.Name, bvt.Variant)
+		}
+
+		commands, err := effectiveCommands(p, pt.Commands, bv.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolving commands for task '%s' on build variant '%s'", pt.Name, bv.Name)
+		}
+
+		et := effectiveTask{
+			taskGroup:       bvt.GroupName,
+			commands:        commands,
+			runOn:           bvt.RunOn,
+			execTimeoutSecs: bvt.ExecTimeoutSecs,
+			idleTimeoutSecs: p.TimeoutSecs,
+		}
+		if len(et.runOn) == 0 {
+			et.runOn = bv.RunOn
+		}
+		if et.execTimeoutSecs == 0 {
+			et.execTimeoutSecs = p.ExecTimeoutSecs
+		}
+		for _, dep := range bvt.DependsOn {
+			et.dependsOn = append(et.dependsOn, formatDependency(dep))
+		}
+
+		if _, ok := tasksByVariant[bv.Name]; !ok {
+			tasksByVariant[bv.Name] = map[string]effectiveTask{}
+		}
+		tasksByVariant[bv.Name][pt.Name] = et
+	}
+	return tasksByVariant, nil
+}
+
+// effectiveCommands renders each command that would run on the build variant,
+// replacing function calls with the commands in the function body so that
+// changes to a function show up in every task that calls it.
+func effectiveCommands(p *model.Project, cmds []model.PluginCommandConf, variant string) ([]string, error) {
+	var rendered []string
+	for _, cmd := range cmds {
+		if len(cmd.Variants) > 0 && !slices.Contains(cmd.Variants, variant) {
+			continue
+		}
+		if cmd.Function == "" {
+			out, err := renderCommand(cmd)
+			if err != nil {
+				return nil, err
+			}
+			rendered = append(rendered, out)
+			continue
+		}
+
+		fn, ok := p.Functions[cmd.Function]
+		if !ok || fn == nil {
+			return nil, errors.Errorf("function '%s' not found", cmd.Function)
+		}
+		for _, fnCmd := range fn.List() {
+			if len(fnCmd.Variants) > 0 && !slices.Contains(fnCmd.Variants, variant) {
+				continue
+			}
+			fnCmd.Function = cmd.Function
+			fnCmd.Vars = cmd.Vars
+			if cmd.TimeoutSecs != 0 {
+				fnCmd.TimeoutSecs = cmd.TimeoutSecs
+			}
+			out, err := renderCommand(fnCmd)
+			if err != nil {
+				return nil, err
+			}
+			rendered = append(rendered, out)
+		}
+	}
+	return rendered, nil
+}
+
+func renderCommand(cmd model.PluginCommandConf) (string, error) {
+	cmd.Variants = nil
+	if len(cmd.Params) > 0 {
+		cmd.ParamsYAML = ""
+	}
+	out, err := yaml.Marshal(cmd)
+	if err != nil {
+		return "", errors.Wrapf(err, "marshalling command '%s'", cmd.Command)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func formatDependency(dep model.TaskUnitDependency) string {
+	out := dep.Name
+	if dep.Variant != "" {
+		out = fmt.Sprintf("%s (variant: %s)", out, dep.Variant)
+	}
+	if dep.Status != "" {
+		out = fmt.Sprintf("%s (status: %s)", out, dep.Status)
+	}
+	if dep.PatchOptional {
+		out += " (patch optional)"
+	}
+	if dep.OmitGeneratedTasks {
+		out += " (omit generated tasks)"
+	}
+	return out
+}
+
+func formatExpansions(expansions map[string]string) []string {
+	formatted := make([]string, 0, len(expansions))
+	for k, v := range expansions {
+		formatted = append(formatted, fmt.Sprintf("%s=%s", k, v))
+	}
+	return formatted
+}
+
+func diffScalar(field, base, other string) *fieldDiff {
+	if base == other {
+		return nil
+	}
+	return &fieldDiff{Field: field, Removed: []string{base}, Added: []string{other}}
+}
+
+// diffSets compares two collections where ordering does not matter.
+func diffSets(field string, base, other []string) *fieldDiff {
+	d := fieldDiff{Field: field}
+	for _, item := range base {
+		if !slices.Contains(other, item) {
+			d.Removed = append(d.Removed, item)
+		}
+	}
+	for _, item := range other {
+		if !slices.Contains(base, item) {
+			d.Added = append(d.Added, item)
+		}
+	}
+	if len(d.Removed) == 0 && len(d.Added) == 0 {
+		return nil
+	}
+	sort.Strings(d.Removed)
+	sort.Strings(d.Added)
+	return &d
+}
+
+// diffSequence compares two ordered collections, reporting the items that are
+// not part of their longest common subsequence.
+func diffSequence(field string, base, other []string) *fieldDiff {
+	if slices.Equal(base, other) {
+		return nil
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of base[i:]
+	// and other[j:].
+	lcs := make([][]int, len(base)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(other)+1)
+	}
+	for i := len(base) - 1; i >= 0; i-- {
+		for j := len(other) - 1; j >= 0; j-- {
+			if base[i] == other[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	d := fieldDiff{Field: field}
+	i, j := 0, 0
+	for i < len(base) && j < len(other) {
+		switch {
+		case base[i] == other[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			d.Removed = append(d.Removed, base[i])
+			i++
+		default:
+			d.Added = append(d.Added, other[j])
+			j++
+		}
+	}
+	d.Removed = append(d.Removed, base[i:]...)
+	d.Added = append(d.Added, other[j:]...)
+
+	if len(d.Removed) == 0 && len(d.Added) == 0 {
+		// The sequences contain the same items in a different order.
+		d.Removed = base
+		d.Added = other
+	}
+	return &d
+}
+
+// writeProjectDiff writes a human-readable report of the project diff.
+func writeProjectDiff(w io.Writer, d *projectDiff) error {
+	var sb strings.Builder
+	if d.isEmpty() {
+		sb.WriteString("no behavioral changes\n")
+	}
+	for _, name := range d.AddedVariants {
+		fmt.Fprintf(&sb, "+ variant %s\n", name)
+	}
+	for _, name := range d.RemovedVariants {
+		fmt.Fprintf(&sb, "- variant %s\n", name)
+	}
+	for _, vd := range d.Variants {
+		fmt.Fprintf(&sb, "~ variant %s\n", vd.Name)
+		for _, name := range vd.AddedTasks {
+			fmt.Fprintf(&sb, "    + task %s\n", name)
+		}
+		for _, name := range vd.RemovedTasks {
+			fmt.Fprintf(&sb, "    - task %s\n", name)
+		}
+		if vd.Expansions != nil {
+			writeFieldDiff(&sb, *vd.Expansions, "    ")
+		}
+		for _, td := range vd.ChangedTasks {
+			fmt.Fprintf(&sb, "    ~ task %s\n", td.Name)
+			for _, change := range td.Changes {
+				writeFieldDiff(&sb, change, "        ")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return errors.Wrap(err, "writing project diff")
+}
+
+func writeFieldDiff(sb *strings.Builder, d fieldDiff, indent string) {
+	fmt.Fprintf(sb, "%s%s:\n", indent, d.Field)
+	writeValues := func(prefix string, values []string) {
+		for _, value := range values {
+			lines := strings.Split(value, "\n")
+			fmt.Fprintf(sb, "%s    %s %s\n", indent, prefix, lines[0])
+			for _, line := range lines[1:] {
+				fmt.Fprintf(sb, "%s      %s\n", indent, line)
+			}
+		}
+	}
+	writeValues("-", d.Removed)
+	writeValues("+", d.Added)
+}
@@ -0,0 +1,86 @@
+package operations
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadEvaluateDiffProject(t *testing.T, name string) *model.Project {
+	dir := filepath.Join("testdata", "evaluate_diff")
+	p, err := loadLocalProject(t.Context(), filepath.Join(dir, name), &model.GetProjectOpts{
+		ReadFileFrom:    model.ReadFromLocal,
+		LocalIncludeDir: dir,
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func TestDiffProjects(t *testing.T) {
+	base := loadEvaluateDiffProject(t, "base.yml")
+
+	t.Run("EquivalentRefactorHasNoChanges", func(t *testing.T) {
+		diff, err := diffProjects(base, loadEvaluateDiffProject(t, "refactored.yml"))
+		require.NoError(t, err)
+		assert.True(t, diff.isEmpty(), "%+v", diff)
+
+		var out bytes.Buffer
+		require.NoError(t, writeProjectDiff(&out, diff))
+		assert.Equal(t, "no behavioral changes\n", out.String())
+	})
+	t.Run("ReportsChangesPerVariant", func(t *testing.T) {
+		diff, err := diffProjects(base, loadEvaluateDiffProject(t, "changed.yml"))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"macos"}, diff.AddedVariants)
+		assert.Equal(t, []string{"windows"}, diff.RemovedVariants)
+		require.Len(t, diff.Variants, 1)
+
+		vd := diff.Variants[0]
+		assert.Equal(t, "ubuntu", vd.Name)
+		assert.Equal(t, []string{"integration"}, vd.AddedTasks)
+		assert.Equal(t, []string{"lint"}, vd.RemovedTasks)
+		require.NotNil(t, vd.Expansions)
+		assert.Equal(t, []string{"goarch=amd64"}, vd.Expansions.Removed)
+		assert.Equal(t, []string{"goarch=arm64"}, vd.Expansions.Added)
+
+		require.Len(t, vd.ChangedTasks, 2)
+		compile := vd.ChangedTasks[0]
+		assert.Equal(t, "compile", compile.Name)
+		require.Len(t, compile.Changes, 2)
+		assert.Equal(t, "commands", compile.Changes[0].Field, "changes to a function should show up in the tasks that call it")
+		require.Len(t, compile.Changes[0].Removed, 1)
+		assert.Contains(t, compile.Changes[0].Removed[0], "make build")
+		require.Len(t, compile.Changes[0].Added, 1)
+		assert.Contains(t, compile.Changes[0].Added[0], "make build -j8")
+		assert.Equal(t, fieldDiff{Field: "run_on", Removed: []string{"ubuntu2204-small"}, Added: []string{"ubuntu2404-small"}}, compile.Changes[1])
+
+		unit := vd.ChangedTasks[1]
+		assert.Equal(t, "unit", unit.Name)
+		require.Len(t, unit.Changes, 3)
+		assert.Equal(t, fieldDiff{Field: "depends_on", Added: []string{"lint"}}, unit.Changes[0])
+		assert.Equal(t, "run_on", unit.Changes[1].Field)
+		assert.Equal(t, fieldDiff{Field: "exec_timeout_secs", Removed: []string{"3600"}, Added: []string{"600"}}, unit.Changes[2])
+
+		var out bytes.Buffer
+		require.NoError(t, writeProjectDiff(&out, diff))
+		assert.Contains(t, out.String(), "+ variant macos\n")
+		assert.Contains(t, out.String(), "- variant windows\n")
+		assert.Contains(t, out.String(), "~ variant ubuntu\n")
+		assert.Contains(t, out.String(), "    + task integration\n")
+		assert.Contains(t, out.String(), "    ~ task unit\n")
+		assert.Contains(t, out.String(), "            - 3600\n            + 600\n")
+	})
+}
+
+func TestDiffSequence(t *testing.T) {
+	assert.Nil(t, diffSequence("field", []string{"a", "b"}, []string{"a", "b"}))
+	assert.Equal(t, &fieldDiff{Field: "field", Removed: []string{"b"}, Added: []string{"d"}},
+		diffSequence("field", []string{"a", "b", "c"}, []string{"a", "c", "d"}))
+	assert.Equal(t, &fieldDiff{Field: "field", Removed: []string{"a"}, Added: []string{"a"}},
+		diffSequence("field", []string{"a", "b"}, []string{"b", "a"}), "reordering should be reported")
+}
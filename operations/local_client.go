@@ -30,10 +30,13 @@ const (
 
 	daemonEnvVar = "_EVERGREEN_DAEMON_CHILD"
 
-	stepFlagName        = "step"
-	setupFlagName       = "setup"
-	tailFlagName        = "tail"
-	debugTaskIDFlagName = "task-id"
+	stepFlagName            = "step"
+	setupFlagName           = "setup"
+	tailFlagName            = "tail"
+	debugTaskIDFlagName     = "task-id"
+	debugOutputDirFlagName  = "output-dir"
+	debugRecordFileFlagName = "file"
+	debugResumeFlagName     = "resume"
 )
 
 // getRootContext walks up the cli.Context chain to find the root context,
@@ -163,6 +166,56 @@ func Debug() cli.Command {
 				ArgsUsage: "<step_number>",
 				Action:    runUntilCmd,
 			},
+			{
+				Name:      "run-with-deps",
+				Usage:     "Run a task after the tasks it depends on in its build variant, backing S3 and test results with a local directory",
+				ArgsUsage: "[task_name]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "variant, v",
+						Usage: "Build variant whose dependencies to run (defaults to the selected task's variant)",
+					},
+					cli.StringFlag{
+						Name:  debugOutputDirFlagName,
+						Usage: "Directory for S3 files and the test results report (defaults to '.evergreen-local/output' in the working directory)",
+					},
+				},
+				Action: runWithDependenciesCmd,
+			},
+			{
+				Name:  "record",
+				Usage: "Record every step that runs to a session file that can be replayed later",
+				Subcommands: []cli.Command{
+					{
+						Name:  "start",
+						Usage: "Start recording the session",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  debugRecordFileFlagName,
+								Usage: "Session file to record to (defaults to a new file in '~/.evergreen-local/recordings')",
+							},
+						},
+						Action: startRecordingCmd,
+					},
+					{
+						Name:   "stop",
+						Usage:  "Stop recording the session",
+						Action: stopRecordingCmd,
+					},
+				},
+			},
+			{
+				Name:      "replay",
+				Usage:     "Replay a recorded session and report how each step differs from the recording",
+				ArgsUsage: "<session_file>",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  debugResumeFlagName,
+						Usage: "Replay the steps before the first failing step, then stop at the failing step",
+					},
+				},
+				Action: replaySessionCmd,
+			},
 			{
 				Name:   "list-steps",
 				Usage:  "List all steps in the current task",
@@ -436,11 +489,12 @@ func stopDebugDaemonCmd(c *cli.Context) error {
 
 // daemonStatusResponse is the typed response from the /status endpoint.
 type daemonStatusResponse struct {
-	Healthy      bool   `json:"healthy"`
-	TaskSelected bool   `json:"task_selected"`
-	CurrentStep  int    `json:"current_step"`
-	TotalSteps   int    `json:"total_steps"`
-	SelectedTask string `json:"selected_task"`
+	Healthy       bool   `json:"healthy"`
+	TaskSelected  bool   `json:"task_selected"`
+	CurrentStep   int    `json:"current_step"`
+	TotalSteps    int    `json:"total_steps"`
+	SelectedTask  string `json:"selected_task"`
+	RecordingPath string `json:"recording_path"`
 }
 
 // daemonStatusCmd checks the debug daemon status.
@@ -468,6 +522,9 @@ func daemonStatusCmd(c *cli.Context) error {
 	if status.TaskSelected {
 		grip.Infof(context.Background(), "Task: %s (step %d/%d)", status.SelectedTask, status.CurrentStep, status.TotalSteps)
 	}
+	if status.RecordingPath != "" {
+		grip.Infof(context.Background(), "Recording session to: %s", status.RecordingPath)
+	}
 
 	return nil
 }
@@ -600,6 +657,108 @@ func runAllCmd(c *cli.Context) error {
 	return postAndStreamStepResponse(url + "/step/run-all")
 }
 
+// runWithDependenciesCmd runs a task and the tasks it depends on with
+// streaming output. If no task name is given, it runs the selected task.
+func runWithDependenciesCmd(c *cli.Context) error {
+	outputDir := c.String(debugOutputDirFlagName)
+	if outputDir != "" {
+		// The daemon resolves paths relative to its own working directory.
+		absDir, err := filepath.Abs(outputDir)
+		if err != nil {
+			return errors.Wrapf(err, "resolving absolute path for '%s'", outputDir)
+		}
+		outputDir = absDir
+	}
+
+	if err := taskexec.ClearSessionLogs(); err != nil {
+		grip.Warning(context.Background(), errors.Wrap(err, "clearing previous session logs"))
+	}
+
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	reqBody := map[string]string{
+		"task_name":    c.Args().Get(0),
+		"variant_name": c.String("variant"),
+		"output_dir":   outputDir,
+	}
+
+	return postAndStreamResponse(url+"/task/run-with-deps", reqBody)
+}
+
+// startRecordingCmd starts recording the session to a session file.
+func startRecordingCmd(c *cli.Context) error {
+	path := c.String(debugRecordFileFlagName)
+	if path != "" {
+		// The daemon resolves paths relative to its own working directory.
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return errors.Wrapf(err, "resolving absolute path for '%s'", path)
+		}
+		path = absPath
+	}
+
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	resp, err := postJSON(url+"/record/start", map[string]string{"path": path})
+	if err != nil {
+		return err
+	}
+
+	grip.Infof(context.Background(), "Recording session to: %v", resp["path"])
+	return nil
+}
+
+// stopRecordingCmd stops recording the session.
+func stopRecordingCmd(c *cli.Context) error {
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	resp, err := postJSON(url+"/record/stop", nil)
+	if err != nil {
+		return err
+	}
+
+	grip.Infof(context.Background(), "Session recorded to: %v", resp["path"])
+	return nil
+}
+
+// replaySessionCmd replays a recorded session with streaming output. It
+// fails if any step behaves differently from the recording.
+func replaySessionCmd(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return errors.New("session file required")
+	}
+
+	path, err := filepath.Abs(c.Args().Get(0))
+	if err != nil {
+		return errors.Wrapf(err, "resolving absolute path for '%s'", c.Args().Get(0))
+	}
+
+	if err := taskexec.ClearSessionLogs(); err != nil {
+		grip.Warning(context.Background(), errors.Wrap(err, "clearing previous session logs"))
+	}
+
+	url, err := getDaemonURL()
+	if err != nil {
+		return err
+	}
+
+	reqBody := map[string]any{
+		"path":   path,
+		"resume": c.Bool(debugResumeFlagName),
+	}
+
+	return postAndStreamResponse(url+"/replay", reqBody)
+}
+
 const noMoreStepsMessage = "No more steps to execute. You've reached the end of the task commands."
 
 func postAndStreamStepResponse(url string) error {
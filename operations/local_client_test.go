@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/evergreen-ci/evergreen/agent/taskexec"
@@ -386,6 +387,164 @@ func TestSelectTaskCmd(t *testing.T) {
 	})
 }
 
+func TestRunWithDependenciesCmd(t *testing.T) {
+	t.Run("sends task, variant, and absolute output directory", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/health":
+				w.WriteHeader(http.StatusOK)
+			case "/task/run-with-deps":
+				var reqBody map[string]string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.Equal(t, "test_task", reqBody["task_name"])
+				assert.Equal(t, "ubuntu", reqBody["variant_name"])
+				assert.True(t, filepath.IsAbs(reqBody["output_dir"]))
+				assert.Equal(t, "output", filepath.Base(reqBody["output_dir"]))
+				fmt.Fprintln(w, `{"ch":"done","success":true}`)
+			}
+		}))
+		defer server.Close()
+
+		tempDir := t.TempDir()
+		setHomeDir(t, tempDir)
+
+		daemonDir := filepath.Join(tempDir, ".evergreen-local")
+		require.NoError(t, os.MkdirAll(daemonDir, 0755))
+
+		var port int
+		_, err := fmt.Sscanf(server.URL, "http://127.0.0.1:%d", &port)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(daemonDir, "daemon.port"), []byte(fmt.Sprintf("%d", port)), 0644))
+
+		app := cli.NewApp()
+		set := flag.NewFlagSet("test", 0)
+		set.String("variant", "", "")
+		set.String(debugOutputDirFlagName, "", "")
+		require.NoError(t, set.Parse([]string{"--variant", "ubuntu", "--" + debugOutputDirFlagName, "output", "test_task"}))
+		c := cli.NewContext(app, set, nil)
+
+		assert.NoError(t, runWithDependenciesCmd(c))
+	})
+}
+
+func TestHandleRunWithDependencies(t *testing.T) {
+	t.Run("no configuration loaded", func(t *testing.T) {
+		d := newLocalDaemonREST(9090, &ClientSettings{})
+		req := httptest.NewRequest(http.MethodPost, "/task/run-with-deps", strings.NewReader(`{"task_name": "test"}`))
+		recorder := httptest.NewRecorder()
+
+		d.handleRunWithDependencies(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "no configuration loaded")
+	})
+}
+
+func TestRecordAndReplayCmds(t *testing.T) {
+	// writeDaemonPort points the CLI at the test server.
+	writeDaemonPort := func(t *testing.T, server *httptest.Server) {
+		tempDir := t.TempDir()
+		setHomeDir(t, tempDir)
+
+		daemonDir := filepath.Join(tempDir, ".evergreen-local")
+		require.NoError(t, os.MkdirAll(daemonDir, 0755))
+
+		var port int
+		_, err := fmt.Sscanf(server.URL, "http://127.0.0.1:%d", &port)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(daemonDir, "daemon.port"), []byte(fmt.Sprintf("%d", port)), 0644))
+	}
+
+	t.Run("record start sends absolute session file path", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/health":
+				w.WriteHeader(http.StatusOK)
+			case "/record/start":
+				var reqBody map[string]string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.True(t, filepath.IsAbs(reqBody["path"]))
+				assert.Equal(t, "session.jsonl", filepath.Base(reqBody["path"]))
+				fmt.Fprintf(w, `{"success": true, "path": %q}`, reqBody["path"])
+			}
+		}))
+		defer server.Close()
+		writeDaemonPort(t, server)
+
+		set := flag.NewFlagSet("test", 0)
+		set.String(debugRecordFileFlagName, "", "")
+		require.NoError(t, set.Parse([]string{"--" + debugRecordFileFlagName, "session.jsonl"}))
+
+		assert.NoError(t, startRecordingCmd(cli.NewContext(cli.NewApp(), set, nil)))
+	})
+
+	t.Run("record stop fails when not recording", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/health":
+				w.WriteHeader(http.StatusOK)
+			case "/record/stop":
+				http.Error(w, "session is not being recorded", http.StatusBadRequest)
+			}
+		}))
+		defer server.Close()
+		writeDaemonPort(t, server)
+
+		err := stopRecordingCmd(cli.NewContext(cli.NewApp(), flag.NewFlagSet("test", 0), nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "session is not being recorded")
+	})
+
+	t.Run("replay sends session file and fails on differences", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/health":
+				w.WriteHeader(http.StatusOK)
+			case "/replay":
+				var reqBody struct {
+					Path   string `json:"path"`
+					Resume bool   `json:"resume"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.True(t, filepath.IsAbs(reqBody.Path))
+				assert.True(t, reqBody.Resume)
+				fmt.Fprintln(w, `{"ch":"done","success":false,"error":"1 step(s) differ from the recording"}`)
+			}
+		}))
+		defer server.Close()
+		writeDaemonPort(t, server)
+
+		set := flag.NewFlagSet("test", 0)
+		set.Bool(debugResumeFlagName, false, "")
+		require.NoError(t, set.Parse([]string{"--" + debugResumeFlagName, "session.jsonl"}))
+
+		err := replaySessionCmd(cli.NewContext(cli.NewApp(), set, nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "1 step(s) differ from the recording")
+	})
+
+	t.Run("replay requires session file", func(t *testing.T) {
+		assert.Error(t, replaySessionCmd(cli.NewContext(cli.NewApp(), flag.NewFlagSet("test", 0), nil)))
+	})
+}
+
+func TestHandleRecording(t *testing.T) {
+	d := newLocalDaemonREST(9090, &ClientSettings{})
+	for name, handler := range map[string]http.HandlerFunc{
+		"/record/start": d.handleStartRecording,
+		"/record/stop":  d.handleStopRecording,
+		"/replay":       d.handleReplay,
+	} {
+		t.Run(name+" without configuration loaded", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, name, strings.NewReader(`{"path": "/tmp/session.jsonl"}`))
+			recorder := httptest.NewRecorder()
+
+			handler(recorder, req)
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), "no configuration loaded")
+		})
+	}
+}
+
 func TestWaitForDaemon(t *testing.T) {
 	t.Run("HealthyDaemonShouldSucceed", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
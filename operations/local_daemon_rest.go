@@ -42,11 +42,15 @@ func (d *localDaemonREST) Start() error {
 	router.HandleFunc("/config/load", d.handleLoadConfig).Methods("POST")
 	router.HandleFunc("/task/select", d.handleSelectTask).Methods("POST")
 	router.HandleFunc("/task/list-steps", d.handleListSteps).Methods("GET")
+	router.HandleFunc("/task/run-with-deps", d.handleRunWithDependencies).Methods("POST")
 	router.HandleFunc("/step/next", d.handleStepNext).Methods("POST")
 	router.HandleFunc("/step/run-all", d.handleRunAll).Methods("POST")
 	router.HandleFunc("/step/run-until/{step}", d.handleRunUntil).Methods("POST")
 	router.HandleFunc("/step/jump/{step}", d.handleJumpTo).Methods("POST")
 	router.HandleFunc("/variable/set", d.handleSetVariable).Methods("POST")
+	router.HandleFunc("/record/start", d.handleStartRecording).Methods("POST")
+	router.HandleFunc("/record/stop", d.handleStopRecording).Methods("POST")
+	router.HandleFunc("/replay", d.handleReplay).Methods("POST")
 	router.HandleFunc("/status", d.handleStatus).Methods("GET")
 
 	if err := d.writeDaemonInfo(); err != nil {
@@ -222,6 +226,50 @@ func (d *localDaemonREST) handleSelectTask(w http.ResponseWriter, r *http.Reques
 	}))
 }
 
+// handleRunWithDependencies runs a task after the tasks it depends on within
+// its build variant with streaming output. S3 commands and test results are
+// backed by a local output directory.
+func (d *localDaemonREST) handleRunWithDependencies(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		TaskName    string `json:"task_name"`
+		VariantName string `json:"variant_name"`
+		OutputDir   string `json:"output_dir"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, errors.Wrap(err, "running task with dependencies").Error(), http.StatusBadRequest)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	state := d.executor.GetDebugState()
+	if req.TaskName == "" {
+		req.TaskName = state.SelectedTask
+		if req.VariantName == "" {
+			req.VariantName = state.SelectedVariant
+		}
+	}
+	if req.TaskName == "" {
+		http.Error(w, "task name is required when no task is selected", http.StatusBadRequest)
+		return
+	}
+
+	if req.OutputDir != "" || d.executor.GetLocalOutputDir() == "" {
+		d.executor.SetLocalOutputDir(req.OutputDir)
+	}
+
+	d.withStreaming(r.Context(), w, func(ctx context.Context) error {
+		return d.executor.RunTaskWithDependencies(ctx, req.TaskName, req.VariantName)
+	})
+}
+
 // writeDaemonInfo writes PID and port files
 func (d *localDaemonREST) writeDaemonInfo() error {
 	dir, err := getDaemonDir()
@@ -427,6 +475,89 @@ func (d *localDaemonREST) handleSetVariable(w http.ResponseWriter, r *http.Reque
 	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]bool{"success": true}))
 }
 
+// handleStartRecording starts recording the steps that run to a session file.
+func (d *localDaemonREST) handleStartRecording(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Path string `json:"path"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, errors.Wrap(err, "starting recording").Error(), http.StatusBadRequest)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	path, err := d.executor.StartRecording(req.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]any{
+		"success": true,
+		"path":    path,
+	}))
+}
+
+// handleStopRecording stops recording the session.
+func (d *localDaemonREST) handleStopRecording(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	path, err := d.executor.StopRecording()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	grip.Error(r.Context(), json.NewEncoder(w).Encode(map[string]any{
+		"success": true,
+		"path":    path,
+	}))
+}
+
+// handleReplay replays a recorded session with streaming output.
+func (d *localDaemonREST) handleReplay(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Path   string `json:"path"`
+		Resume bool   `json:"resume"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, errors.Wrap(err, "replaying session").Error(), http.StatusBadRequest)
+		return
+	}
+	if req.Path == "" {
+		http.Error(w, "session file path is required", http.StatusBadRequest)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.executor == nil {
+		http.Error(w, "no configuration loaded", http.StatusBadRequest)
+		return
+	}
+
+	d.withStreaming(r.Context(), w, func(ctx context.Context) error {
+		_, err := d.executor.ReplaySession(ctx, req.Path, taskexec.ReplayOptions{ResumeAtFailure: req.Resume})
+		return err
+	})
+}
+
 // handleStepNext executes the next step with streaming output.
 func (d *localDaemonREST) handleStepNext(w http.ResponseWriter, r *http.Request) {
 	d.mu.Lock()
@@ -461,6 +592,12 @@ func (d *localDaemonREST) handleStatus(w http.ResponseWriter, r *http.Request) {
 		response["selected_task"] = state.SelectedTask
 		response["current_step"] = state.CurrentStepIndex
 		response["total_steps"] = len(state.CommandList)
+		if outputDir := d.executor.GetLocalOutputDir(); outputDir != "" {
+			response["local_output_dir"] = outputDir
+		}
+		if recordingPath := d.executor.GetRecordingPath(); recordingPath != "" {
+			response["recording_path"] = recordingPath
+		}
 	}
 
 	grip.Error(r.Context(), json.NewEncoder(w).Encode(response))
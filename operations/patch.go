@@ -36,6 +36,7 @@ const (
 	testSelectionExcludeVariantsFlagName = "test-selection-exclude-variants"
 	testSelectionExcludeTasksFlagName    = "test-selection-exclude-tasks"
 	emptyFlagName                        = "empty"
+	dryRunFlagName                       = "dry-run"
 )
 
 func getPatchFlags(flags ...cli.Flag) []cli.Flag {
@@ -124,6 +125,7 @@ func Patch() cli.Command {
 			mutuallyExclusiveArgs(false, repeatDefinitionFlag, repeatPatchIdFlag),
 			mutuallyExclusiveArgs(false, repeatPatchIdFlag, includeModulesFlag),
 			mutuallyExclusiveArgs(false, repeatPatchIdFlag, includeModuleFlag),
+			mutuallyExclusiveArgs(false, dryRunFlagName, patchFinalizeFlagName),
 		),
 		Aliases: []string{"create-patch", "submit-patch"},
 		Usage:   "submit a new patch to Evergreen",
@@ -136,6 +138,10 @@ func Patch() cli.Command {
 				Name:  includeModuleFlag,
 				Usage: "specify a module as MODULE_NAME=PATH to override the module path for this invocation (repeatable); implicitly enables --include-modules",
 			},
+			cli.BoolFlag{
+				Name:  dryRunFlagName,
+				Usage: "create the patch without finalizing it and show the tasks it would run, including dependencies, along with their estimated runtime and cost",
+			},
 		),
 		Action: func(c *cli.Context) error {
 			ctx, cancel := context.WithCancel(context.Background())
@@ -183,6 +189,7 @@ func Patch() cli.Command {
 
 			var err error
 			shouldFinalize := c.Bool(patchFinalizeFlagName)
+			dryRun := c.Bool(dryRunFlagName)
 			paramsPairs := c.StringSlice(parameterFlagName)
 			params.Parameters, err = getParametersFromInput(paramsPairs)
 			if err != nil {
@@ -307,6 +314,15 @@ func Patch() cli.Command {
 				}
 			}
 
+			if dryRun {
+				preview, err := comm.GetPatchPreview(ctx, patchId, params.previewSelection())
+				if err != nil {
+					return errors.Wrapf(err, "previewing patch '%s'", patchId)
+				}
+				params.setDefaultProject(ctx, conf)
+				return displayPatchPreview(os.Stdout, patchId, preview, outputJSON)
+			}
+
 			if shouldFinalize {
 				shouldContinue, err := checkForLargeNumFinalizedTasks(ctx, comm, rc, params, patchId)
 				if err != nil {
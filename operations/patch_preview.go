@@ -0,0 +1,87 @@
+package operations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// previewSelection returns the variants and tasks that were requested for the
+// patch. Patches that repeat a previous patch's definition have no selection,
+// so the server previews the variant tasks that were copied into the patch.
+func (p *patchParams) previewSelection() restmodel.APIPatchPreviewSelection {
+	if p.RepeatDefinition || p.RepeatFailed {
+		return restmodel.APIPatchPreviewSelection{}
+	}
+	return restmodel.APIPatchPreviewSelection{
+		Variants:      p.Variants,
+		Tasks:         p.Tasks,
+		RegexVariants: p.RegexVariants,
+		RegexTasks:    p.RegexTasks,
+		Aliases:       p.submissionAliases(),
+	}
+}
+
+// displayPatchPreview writes the tasks that an unfinalized patch would run,
+// grouped by build variant, followed by the estimated totals.
+func displayPatchPreview(w io.Writer, patchID string, preview *restmodel.APIPatchPreview, outputJSON bool) error {
+	if outputJSON {
+		b, err := json.MarshalIndent(struct {
+			PatchID string                     `json:"patch_id"`
+			Preview *restmodel.APIPatchPreview `json:"preview"`
+		}{PatchID: patchID, Preview: preview}, "", "\t")
+		if err != nil {
+			return errors.Wrap(err, "marshalling patch preview to JSON")
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	}
+
+	var variants []string
+	tasksByVariant := map[string][]restmodel.APIPatchPreviewTask{}
+	for _, t := range preview.Tasks {
+		bv := utility.FromStringPtr(t.BuildVariant)
+		if _, ok := tasksByVariant[bv]; !ok {
+			variants = append(variants, bv)
+		}
+		tasksByVariant[bv] = append(tasksByVariant[bv], t)
+	}
+
+	fmt.Fprintf(w, "Patch '%s' would run %d tasks (%d added as dependencies):\n", patchID, preview.NumTasks, preview.NumDependencyTasks)
+	for _, bv := range variants {
+		fmt.Fprintf(w, "  %s\n", bv)
+		for _, t := range tasksByVariant[bv] {
+			line := fmt.Sprintf("    %s", utility.FromStringPtr(t.DisplayName))
+			if t.IsDependency {
+				line += " (dependency)"
+			}
+			if d := t.ExpectedDuration.ToDuration(); d > 0 {
+				line += fmt.Sprintf(" ~%s", d.Round(time.Second))
+			}
+			if !preview.CostHidden && t.PredictedCost > 0 {
+				line += fmt.Sprintf(" $%.2f", t.PredictedCost)
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+
+	fmt.Fprintf(w, "Estimated total runtime: %s", preview.TotalExpectedDuration.ToDuration().Round(time.Second))
+	if preview.NumTasksWithoutDuration > 0 {
+		fmt.Fprintf(w, " (%d tasks have no recent runtime history)", preview.NumTasksWithoutDuration)
+	}
+	fmt.Fprintln(w)
+	if !preview.CostHidden {
+		fmt.Fprintf(w, "Estimated total cost: $%.2f", preview.TotalPredictedCost)
+		if preview.NumTasksWithoutCost > 0 {
+			fmt.Fprintf(w, " (%d tasks could not be priced)", preview.NumTasksWithoutCost)
+		}
+		fmt.Fprintln(w)
+	}
+	_, err := fmt.Fprintf(w, "The patch has not been finalized. To schedule it, run: evergreen finalize-patch -i %s\n", patchID)
+	return err
+}
@@ -0,0 +1,81 @@
+package operations
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchParamsPreviewSelection(t *testing.T) {
+	params := &patchParams{
+		Variants: []string{"bv"},
+		Tasks:    []string{"t1"},
+		Alias:    "alias",
+	}
+	assert.Equal(t, restmodel.APIPatchPreviewSelection{
+		Variants: []string{"bv"},
+		Tasks:    []string{"t1"},
+		Aliases:  []string{"alias"},
+	}, params.previewSelection())
+
+	params.RepeatDefinition = true
+	assert.True(t, params.previewSelection().IsEmpty(), "repeated patches should preview their copied variant tasks")
+}
+
+func TestDisplayPatchPreview(t *testing.T) {
+	preview := &restmodel.APIPatchPreview{
+		Tasks: []restmodel.APIPatchPreviewTask{
+			{
+				BuildVariant:     utility.ToStringPtr("ubuntu"),
+				DisplayName:      utility.ToStringPtr("unit"),
+				ExpectedDuration: restmodel.NewAPIDuration(10 * time.Minute),
+				PredictedCost:    1.5,
+			},
+			{
+				BuildVariant: utility.ToStringPtr("ubuntu"),
+				DisplayName:  utility.ToStringPtr("compile"),
+				IsDependency: true,
+			},
+		},
+		NumTasks:                2,
+		NumDependencyTasks:      1,
+		TotalExpectedDuration:   restmodel.NewAPIDuration(10 * time.Minute),
+		NumTasksWithoutDuration: 1,
+		TotalPredictedCost:      1.5,
+		NumTasksWithoutCost:     1,
+	}
+
+	t.Run("Text", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, displayPatchPreview(&out, "patch_id", preview, false))
+		assert.Contains(t, out.String(), "would run 2 tasks (1 added as dependencies)")
+		assert.Contains(t, out.String(), "  ubuntu\n    unit ~10m0s $1.50\n    compile (dependency)\n")
+		assert.Contains(t, out.String(), "Estimated total runtime: 10m0s (1 tasks have no recent runtime history)\n")
+		assert.Contains(t, out.String(), "Estimated total cost: $1.50 (1 tasks could not be priced)\n")
+		assert.Contains(t, out.String(), "evergreen finalize-patch -i patch_id")
+	})
+	t.Run("HidesCost", func(t *testing.T) {
+		hidden := *preview
+		hidden.CostHidden = true
+		var out bytes.Buffer
+		require.NoError(t, displayPatchPreview(&out, "patch_id", &hidden, false))
+		assert.NotContains(t, out.String(), "$")
+	})
+	t.Run("JSON", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, displayPatchPreview(&out, "patch_id", preview, true))
+		var res struct {
+			PatchID string                    `json:"patch_id"`
+			Preview restmodel.APIPatchPreview `json:"preview"`
+		}
+		require.NoError(t, json.Unmarshal(out.Bytes(), &res))
+		assert.Equal(t, "patch_id", res.PatchID)
+		assert.Equal(t, 2, res.Preview.NumTasks)
+	})
+}
@@ -51,6 +51,9 @@ type Communicator interface {
 	GetServiceUsers(ctx context.Context) ([]restmodel.APIDBUser, error)
 	UpdateServiceUser(context.Context, string, string, []string) error
 	DeleteServiceUser(context.Context, string) error
+	GetDeadLetteredNotifications(context.Context, string, int) ([]restmodel.APIDeadLetteredNotification, error)
+	ReplayDeadLetteredNotifications(context.Context, restmodel.APIDeadLetterFilter) (int, error)
+	PurgeDeadLetteredNotifications(context.Context, restmodel.APIDeadLetterFilter) (int, error)
 
 	// Spawnhost methods
 	//
@@ -89,6 +92,9 @@ type Communicator interface {
 	ListAliases(context.Context, string, bool) ([]model.ProjectAlias, error)
 	ListPatchTriggerAliases(context.Context, string) ([]string, error)
 	GetDistroByName(context.Context, string) (*restmodel.APIDistro, error)
+	// GetSchedulerSnapshot captures a snapshot of the distro's task queue and
+	// hosts that can be replayed offline.
+	GetSchedulerSnapshot(context.Context, string) (*model.SchedulerSnapshot, error)
 
 	// Get project settings by project ID
 	GetProject(context.Context, string) (*restmodel.APIProjectRef, error)
@@ -111,6 +117,9 @@ type Communicator interface {
 	GetManifestByTask(ctx context.Context, taskId string) (*manifest.Manifest, error)
 	// GetManifestForVersion returns the manifest for a given version ID.
 	GetManifestForVersion(ctx context.Context, versionID string) (*restmodel.APIManifest, error)
+	// GetProjectForVersion returns the translated project configuration that
+	// was used to create the given version.
+	GetProjectForVersion(ctx context.Context, versionID string) (*model.Project, error)
 
 	// GetRecentVersionsForProject returns the most recent versions for a
 	// project.
@@ -142,6 +151,11 @@ type Communicator interface {
 	// GetEstimatedGeneratedTasks returns the estimated number of generated tasks to be created by an unfinalized patch.
 	GetEstimatedGeneratedTasks(context.Context, string, []model.TVPair) (int, error)
 
+	// GetPatchPreview returns the tasks that a patch would create if it were
+	// finalized with the given selection, along with estimates of their
+	// runtime and cost.
+	GetPatchPreview(ctx context.Context, patchID string, selection restmodel.APIPatchPreviewSelection) (*restmodel.APIPatchPreview, error)
+
 	// RevokeGitHubDynamicAccessToken revokes the given GitHub dynamic access tokens.
 	RevokeGitHubDynamicAccessTokens(ctx context.Context, taskID string, tokens []string) error
 
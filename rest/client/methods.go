@@ -639,6 +639,28 @@ func (c *communicatorImpl) GetEstimatedGeneratedTasks(ctx context.Context, patch
 	return utility.FromIntPtr(numTasksToFinalize.NumTasksToFinalize), nil
 }
 
+func (c *communicatorImpl) GetPatchPreview(ctx context.Context, patchID string, selection restmodel.APIPatchPreviewSelection) (*restmodel.APIPatchPreview, error) {
+	info := requestInfo{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("patches/%s/preview", patchID),
+	}
+	resp, err := c.request(ctx, info, selection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to preview patch '%s'", patchID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespError(resp, "previewing patch")
+	}
+
+	preview := restmodel.APIPatchPreview{}
+	if err = utility.ReadJSON(resp.Body, &preview); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+	return &preview, nil
+}
+
 func (c *communicatorImpl) RevokeGitHubDynamicAccessTokens(ctx context.Context, taskId string, tokens []string) error {
 	info := requestInfo{
 		method: http.MethodDelete,
@@ -905,6 +927,73 @@ func (c *communicatorImpl) DeleteServiceUser(ctx context.Context, username strin
 	return nil
 }
 
+func (c *communicatorImpl) GetDeadLetteredNotifications(ctx context.Context, subscriberType string, limit int) ([]model.APIDeadLetteredNotification, error) {
+	params := url.Values{}
+	if subscriberType != "" {
+		params.Set("subscriber_type", subscriberType)
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   "/admin/notifications/dead_letter?" + params.Encode(),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending request to get dead lettered notifications")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespError(resp, "getting dead lettered notifications")
+	}
+	var result []model.APIDeadLetteredNotification
+	if err = utility.ReadJSON(resp.Body, &result); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response")
+	}
+
+	return result, nil
+}
+
+func (c *communicatorImpl) ReplayDeadLetteredNotifications(ctx context.Context, filter model.APIDeadLetterFilter) (int, error) {
+	return c.handleDeadLetteredNotifications(ctx, "replay", filter)
+}
+
+func (c *communicatorImpl) PurgeDeadLetteredNotifications(ctx context.Context, filter model.APIDeadLetterFilter) (int, error) {
+	return c.handleDeadLetteredNotifications(ctx, "purge", filter)
+}
+
+func (c *communicatorImpl) handleDeadLetteredNotifications(ctx context.Context, action string, filter model.APIDeadLetterFilter) (int, error) {
+	info := requestInfo{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/admin/notifications/dead_letter/%s", action),
+	}
+
+	resp, err := c.request(ctx, info, filter)
+	if err != nil {
+		return 0, errors.Wrapf(err, "sending request to %s dead lettered notifications", action)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		return 0, util.RespError(resp, VPNError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, util.RespErrorf(resp, "trying to %s dead lettered notifications", action)
+	}
+	result := model.APIDeadLetterResult{}
+	if err = utility.ReadJSON(resp.Body, &result); err != nil {
+		return 0, errors.Wrap(err, "reading JSON response")
+	}
+
+	return result.Count, nil
+}
+
 func (c *communicatorImpl) GetDistrosList(ctx context.Context) ([]model.APIDistro, error) {
 	info := requestInfo{
 		method: http.MethodGet,
@@ -1448,6 +1537,26 @@ func (c *communicatorImpl) GetDistroByName(ctx context.Context, id string) (*res
 
 }
 
+func (c *communicatorImpl) GetSchedulerSnapshot(ctx context.Context, distroID string) (*serviceModel.SchedulerSnapshot, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("distros/%s/scheduler_snapshot", distroID),
+	}
+
+	resp, err := c.request(ctx, info, nil)
+	if err != nil {
+		return nil, util.RespError(resp, errors.Wrapf(err, "getting scheduler snapshot for distro '%s'", distroID).Error())
+	}
+	defer resp.Body.Close()
+
+	snapshot := &serviceModel.SchedulerSnapshot{}
+	if err = utility.ReadJSON(resp.Body, snapshot); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return snapshot, nil
+}
+
 func (c *communicatorImpl) GetClientURLs(ctx context.Context, distroID string) ([]string, error) {
 	info := requestInfo{
 		method: http.MethodGet,
@@ -1627,6 +1736,25 @@ func (c *communicatorImpl) GetManifestForVersion(ctx context.Context, versionID
 	return &manifestResp, nil
 }
 
+func (c *communicatorImpl) GetProjectForVersion(ctx context.Context, versionID string) (*serviceModel.Project, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("versions/%s/parser_project", versionID),
+	}
+	resp, err := c.retryRequest(ctx, info, nil)
+	if err != nil {
+		return nil, util.RespError(resp, errors.Wrapf(err, "getting project for version '%s'", versionID).Error())
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading parser project from response")
+	}
+
+	return serviceModel.GetProjectFromBSON(respBytes)
+}
+
 func (c *communicatorImpl) GetTaskLogs(ctx context.Context, opts GetTaskLogsOptions) (io.ReadCloser, error) {
 	var params []string
 	if opts.Execution != nil {
@@ -280,6 +280,10 @@ func (c *Mock) GetManifestForVersion(context.Context, string) (*model.APIManifes
 	return nil, nil
 }
 
+func (c *Mock) GetProjectForVersion(context.Context, string) (*serviceModel.Project, error) {
+	return nil, nil
+}
+
 func (c *Mock) StartHostProcesses(context.Context, []string, string, int) ([]model.APIHostProcess, error) {
 	return nil, nil
 }
@@ -327,6 +331,10 @@ func (c *Mock) GetDistroByName(context.Context, string) (*model.APIDistro, error
 	return nil, nil
 }
 
+func (c *Mock) GetSchedulerSnapshot(context.Context, string) (*serviceModel.SchedulerSnapshot, error) {
+	return nil, nil
+}
+
 func (c *Mock) UpdateServiceUser(context.Context, string, string, []string) error {
 	return nil
 }
@@ -336,6 +344,15 @@ func (c *Mock) DeleteServiceUser(context.Context, string) error {
 func (c *Mock) GetServiceUsers(context.Context) ([]model.APIDBUser, error) {
 	return nil, nil
 }
+func (c *Mock) GetDeadLetteredNotifications(context.Context, string, int) ([]model.APIDeadLetteredNotification, error) {
+	return nil, nil
+}
+func (c *Mock) ReplayDeadLetteredNotifications(context.Context, model.APIDeadLetterFilter) (int, error) {
+	return 0, nil
+}
+func (c *Mock) PurgeDeadLetteredNotifications(context.Context, model.APIDeadLetterFilter) (int, error) {
+	return 0, nil
+}
 
 func (c *Mock) GetClientURLs(context.Context, string) ([]string, error) {
 	return []string{"https://example.com"}, nil
@@ -361,6 +378,10 @@ func (c *Mock) GetEstimatedGeneratedTasks(ctx context.Context, patchId string, t
 	return 0, nil
 }
 
+func (c *Mock) GetPatchPreview(context.Context, string, restmodel.APIPatchPreviewSelection) (*restmodel.APIPatchPreview, error) {
+	return nil, nil
+}
+
 func (c *Mock) GetTaskLogs(ctx context.Context, opts GetTaskLogsOptions) (io.ReadCloser, error) {
 	return nil, nil
 }
@@ -140,6 +140,7 @@ func (s *AdminDataSuite) TestSetAndGetSettings() {
 	s.EqualValues(testSettings.Buckets.RetryFailedLogMoveLookbackDays, settingsFromConnector.Buckets.RetryFailedLogMoveLookbackDays)
 	s.EqualValues(testSettings.Buckets.RetryFailedLogMoveMaxJobsPerRun, settingsFromConnector.Buckets.RetryFailedLogMoveMaxJobsPerRun)
 	s.EqualValues(testSettings.Buckets.TestResultsBucket, settingsFromConnector.Buckets.TestResultsBucket)
+	s.EqualValues(testSettings.Buckets.TaskFilesBucket, settingsFromConnector.Buckets.TaskFilesBucket)
 	s.Equal(testSettings.Buckets.Credentials.Key, settingsFromConnector.Buckets.Credentials.Key)
 	s.Equal(testSettings.Buckets.Credentials.Secret, settingsFromConnector.Buckets.Credentials.Secret)
 	s.Equal(testSettings.Buckets.Credentials.Bucket, settingsFromConnector.Buckets.Credentials.Bucket)
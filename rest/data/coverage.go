@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+// GetVersionCoverageDelta returns the coverage reported by the tasks in the
+// given version along with the change in coverage from its base version. For
+// patches, the base version is the mainline commit the patch is based on; for
+// mainline commits, it is the previous mainline commit.
+func GetVersionCoverageDelta(ctx context.Context, versionID string) (*coverage.VersionCoverageDelta, error) {
+	v, err := model.VersionFindOneId(ctx, versionID)
+	if err != nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "finding version '%s'", versionID).Error(),
+		}
+	}
+	if v == nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version '%s' not found", versionID),
+		}
+	}
+
+	current, err := coverage.Find(ctx, coverage.ByVersion(versionID))
+	if err != nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "finding coverage for version '%s'", versionID).Error(),
+		}
+	}
+
+	baseVersion, err := model.FindBaseVersionForVersion(ctx, versionID)
+	if err != nil {
+		return nil, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "finding base version for version '%s'", versionID).Error(),
+		}
+	}
+	var (
+		baseVersionID string
+		base          []coverage.TaskCoverage
+	)
+	if baseVersion != nil {
+		baseVersionID = baseVersion.Id
+		base, err = coverage.Find(ctx, coverage.ByVersion(baseVersionID))
+		if err != nil {
+			return nil, gimlet.ErrorResponse{
+				StatusCode: http.StatusInternalServerError,
+				Message:    errors.Wrapf(err, "finding coverage for base version '%s'", baseVersionID).Error(),
+			}
+		}
+	}
+
+	delta := coverage.Compare(versionID, baseVersionID, current, base)
+	return &delta, nil
+}
@@ -32,3 +32,36 @@ func GetTaskReliabilityScores(ctx context.Context, filter reliability.TaskReliab
 	}
 	return apiStatsResult, nil
 }
+
+// GetDailyTestReliabilityScores queries the service backend to retrieve the
+// daily test reliability scores that match the given filter.
+func GetDailyTestReliabilityScores(ctx context.Context, filter reliability.TestReliabilityFilter) ([]restModel.APITestReliability, error) {
+	return getTestReliabilityScores(ctx, filter, reliability.GetDailyTestReliabilityScores)
+}
+
+// GetWorstTestReliabilityScores queries the service backend to retrieve the
+// least reliable tests over the date range of the given filter.
+func GetWorstTestReliabilityScores(ctx context.Context, filter reliability.TestReliabilityFilter) ([]restModel.APITestReliability, error) {
+	return getTestReliabilityScores(ctx, filter, reliability.GetWorstTestReliabilityScores)
+}
+
+func getTestReliabilityScores(ctx context.Context, filter reliability.TestReliabilityFilter, getScores func(context.Context, reliability.TestReliabilityFilter) ([]reliability.TestReliability, error)) ([]restModel.APITestReliability, error) {
+	if filter.Project != "" {
+		projectID, err := model.GetIdForProject(ctx, filter.Project)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting project ref ID for identifier '%s'", filter.Project)
+		}
+		filter.Project = projectID
+	}
+
+	scores, err := getScores(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting test reliability scores")
+	}
+
+	apiScores := make([]restModel.APITestReliability, len(scores))
+	for i, score := range scores {
+		apiScores[i].BuildFromService(score)
+	}
+	return apiScores, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/thirdparty"
 	testselection "github.com/evergreen-ci/test-selection-client"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
@@ -99,28 +100,13 @@ func wrapTSSError(err error) error {
 	return errors.Wrap(err, "forwarding request to test selection service")
 }
 
-// newTestSelectionClient constructs a new test selection client using the
-// provided HTTP client.
-func newTestSelectionClient(c *http.Client) *testselection.APIClient {
-	tssBaseURL := evergreen.GetEnvironment().Settings().TestSelection.URL
-	conf := testselection.NewConfiguration()
-	conf.HTTPClient = c
-	conf.Servers = testselection.ServerConfigurations{
-		testselection.ServerConfiguration{
-			URL:         tssBaseURL,
-			Description: "Test selection service",
-		},
-	}
-	return testselection.NewAPIClient(conf)
-}
-
 // SelectTests uses the test selection service to return a filtered set of tests
 // to run based on the provided SelectTestsRequest. It returns the list of
 // selected tests.
 func SelectTests(ctx context.Context, req model.SelectTestsRequest) ([]string, error) {
 	ctx, cancel := context.WithTimeout(ctx, testSelectionSelectTimeout)
 	defer cancel()
-	c := newTestSelectionClient(testSelectionHTTPClient)
+	c := thirdparty.NewTestSelectionClient(testSelectionHTTPClient)
 	var strategies []testselection.StrategyEnum
 	for _, s := range req.Strategies {
 		strategies = append(strategies, testselection.StrategyEnum(s))
@@ -285,7 +271,7 @@ func findQuarantinedSkippedTests(ctx context.Context, req model.SelectTestsReque
 func SetTestQuarantined(ctx context.Context, projectID, bvName, taskName, testName string, isManuallyQuarantined bool) error {
 	ctx, cancel := context.WithTimeout(ctx, testSelectionWriteTimeout)
 	defer cancel()
-	c := newTestSelectionClient(testSelectionHTTPClient)
+	c := thirdparty.NewTestSelectionClient(testSelectionHTTPClient)
 
 	startAt := time.Now()
 	reqBody := testselection.NewBodyMarkTestsAsManuallyQuarantinedDataInBodyApiTestSelectionTransitionTestsPost(
@@ -327,7 +313,7 @@ func GetTestsQuarantineStatus(ctx context.Context, projectID, bvName, taskName s
 	}
 	ctx, cancel := context.WithTimeout(ctx, testSelectionStatusTimeout)
 	defer cancel()
-	c := newTestSelectionClient(testSelectionHTTPClient)
+	c := thirdparty.NewTestSelectionClient(testSelectionHTTPClient)
 
 	startAt := time.Now()
 	reqBody := testselection.NewBodyGetTestsStateDataInBodyApiTestSelectionGetTestsStatePost(projectID, bvName, taskName, testNames)
@@ -379,7 +365,7 @@ func GetTestsQuarantineStatus(ctx context.Context, projectID, bvName, taskName s
 func SetTaskQuarantined(ctx context.Context, projectID, bvName, taskName string, isManuallyQuarantined bool) error {
 	ctx, cancel := context.WithTimeout(ctx, testSelectionWriteTimeout)
 	defer cancel()
-	c := newTestSelectionClient(testSelectionHTTPClient)
+	c := thirdparty.NewTestSelectionClient(testSelectionHTTPClient)
 
 	startAt := time.Now()
 	reqBody := testselection.NewBodyMarkTaskAsManuallyQuarantinedDataInBodyApiTestSelectionTransitionTaskPost(
@@ -411,7 +397,7 @@ func SetTaskQuarantined(ctx context.Context, projectID, bvName, taskName string,
 func SetVariantQuarantined(ctx context.Context, projectID, bvName string, isManuallyQuarantined bool) error {
 	ctx, cancel := context.WithTimeout(ctx, testSelectionWriteTimeout)
 	defer cancel()
-	c := newTestSelectionClient(testSelectionHTTPClient)
+	c := thirdparty.NewTestSelectionClient(testSelectionHTTPClient)
 
 	startAt := time.Now()
 	reqBody := testselection.NewBodyMarkVariantAsManuallyQuarantinedDataInBodyApiTestSelectionTransitionVariantPost(
@@ -443,7 +429,7 @@ func SetVariantQuarantined(ctx context.Context, projectID, bvName string, isManu
 func GetVariantQuarantineStatus(ctx context.Context, projectID, bvName string) (map[string]map[string]bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, testSelectionStatusTimeout)
 	defer cancel()
-	c := newTestSelectionClient(testSelectionHTTPClient)
+	c := thirdparty.NewTestSelectionClient(testSelectionHTTPClient)
 
 	startAt := time.Now()
 	reqBody := testselection.NewBodyGetVariantStateDataInBodyApiTestSelectionGetVariantStatePost(projectID, bvName)
@@ -83,6 +83,13 @@ func SaveSubscriptions(ctx context.Context, owner string, subscriptions []restMo
 			}
 		}
 
+		if ws, ok := dbSubscription.Subscriber.Target.(*event.WebhookSubscriber); ok && ws.IsCloudEvents() && !trigger.SupportsCloudEvents(dbSubscription.ResourceType, dbSubscription.Trigger) {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("subscription type/trigger does not support CloudEvents: %s/%s", dbSubscription.ResourceType, dbSubscription.Trigger),
+			}
+		}
+
 		if dbSubscription.OwnerType == event.OwnerTypePerson && dbSubscription.Owner == "" {
 			dbSubscription.Owner = owner // default the current user
 		}
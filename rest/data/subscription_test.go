@@ -184,6 +184,31 @@ func TestSaveProjectSubscriptions(t *testing.T) {
 			}
 			assert.Error(t, SaveSubscriptions(t.Context(), utility.FromStringPtr(subscription.Owner), []restModel.APISubscription{subscription}, true))
 		},
+		"CloudEventsWebhookWithUnsupportedTrigger": func(t *testing.T) {
+			subscription := restModel.APISubscription{
+				ResourceType: utility.ToStringPtr(event.ResourceTypeHost),
+				Trigger:      utility.ToStringPtr(event.TriggerExpiration),
+				Owner:        utility.ToStringPtr("project"),
+				OwnerType:    utility.ToStringPtr(string(event.OwnerTypeProject)),
+				Selectors: []restModel.APISelector{
+					{
+						Type: utility.ToStringPtr(event.SelectorObject),
+						Data: utility.ToStringPtr(event.ObjectHost),
+					},
+				},
+				Subscriber: restModel.APISubscriber{
+					Type: utility.ToStringPtr(event.EvergreenWebhookSubscriberType),
+					Target: map[string]any{
+						"url":    "https://example.com",
+						"secret": "shh",
+						"format": event.WebhookFormatCloudEvents,
+					},
+				},
+			}
+			err := SaveSubscriptions(t.Context(), utility.FromStringPtr(subscription.Owner), []restModel.APISubscription{subscription}, true)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "does not support CloudEvents")
+		},
 		"VersionRequesterSubscription": func(t *testing.T) {
 			subscription := restModel.APISubscription{
 				ResourceType: utility.ToStringPtr(event.ResourceTypeVersion),
@@ -789,6 +789,7 @@ type APIBucketsConfig struct {
 	RetryFailedLogMoveLookbackMonths *int             `json:"retry_failed_log_move_lookback_months,omitempty"`
 	RetryFailedLogMoveMaxJobsPerRun  *int             `json:"retry_failed_log_move_max_jobs_per_run,omitempty"`
 	TestResultsBucket                APIBucketConfig  `json:"test_results_bucket"`
+	TaskFilesBucket                  APIBucketConfig  `json:"task_files_bucket"`
 	InternalBuckets                  []string         `json:"internal_buckets"`
 	Credentials                      APIS3Credentials `json:"credentials"`
 }
@@ -865,6 +866,7 @@ func (a *APIBucketsConfig) BuildFromService(h any) error {
 		a.LogBucketLongRetention.buildFromService(v.LogBucketLongRetention)
 		a.LogBucketFailedTasks.buildFromService(v.LogBucketFailedTasks)
 		a.TestResultsBucket.buildFromService(v.TestResultsBucket)
+		a.TaskFilesBucket.buildFromService(v.TaskFilesBucket)
 
 		a.LongRetentionProjects = v.LongRetentionProjects
 		a.RetryFailedLogMoveLookbackDays = utility.ToIntPtr(v.RetryFailedLogMoveLookbackDays)
@@ -906,6 +908,7 @@ func (a *APIBucketsConfig) ToService() (any, error) {
 		RetryFailedLogMoveLookbackDays:  utility.FromIntPtr(lookbackDays),
 		RetryFailedLogMoveMaxJobsPerRun: utility.FromIntPtr(a.RetryFailedLogMoveMaxJobsPerRun),
 		TestResultsBucket:               a.TestResultsBucket.ToService(),
+		TaskFilesBucket:                 a.TaskFilesBucket.ToService(),
 		Credentials:                     creds,
 	}, nil
 }
@@ -134,6 +134,8 @@ func TestModelConversion(t *testing.T) {
 	assert.Equal(testSettings.Buckets.TestResultsBucket.DBName, utility.FromStringPtr(apiSettings.Buckets.TestResultsBucket.DBName))
 	assert.Equal(testSettings.Buckets.TestResultsBucket.TestResultsPrefix, utility.FromStringPtr(apiSettings.Buckets.TestResultsBucket.TestResultsPrefix))
 	assert.Equal(testSettings.Buckets.TestResultsBucket.RoleARN, utility.FromStringPtr(apiSettings.Buckets.TestResultsBucket.RoleARN))
+	assert.Equal(testSettings.Buckets.TaskFilesBucket.Name, utility.FromStringPtr(apiSettings.Buckets.TaskFilesBucket.Name))
+	assert.EqualValues(testSettings.Buckets.TaskFilesBucket.Type, utility.FromStringPtr(apiSettings.Buckets.TaskFilesBucket.Type))
 	assert.EqualValues(testSettings.Buckets.Credentials.Key, utility.FromStringPtr(apiSettings.Buckets.Credentials.Key))
 	assert.EqualValues(testSettings.Buckets.Credentials.Secret, utility.FromStringPtr(apiSettings.Buckets.Credentials.Secret))
 	assert.EqualValues(testSettings.Buckets.Credentials.Bucket, utility.FromStringPtr(apiSettings.Buckets.Credentials.Bucket))
@@ -260,6 +262,7 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.Buckets.RetryFailedLogMoveLookbackDays, dbSettings.Buckets.RetryFailedLogMoveLookbackDays)
 	assert.EqualValues(testSettings.Buckets.RetryFailedLogMoveMaxJobsPerRun, dbSettings.Buckets.RetryFailedLogMoveMaxJobsPerRun)
 	assert.EqualValues(testSettings.Buckets.TestResultsBucket, dbSettings.Buckets.TestResultsBucket)
+	assert.EqualValues(testSettings.Buckets.TaskFilesBucket, dbSettings.Buckets.TaskFilesBucket)
 	assert.EqualValues(testSettings.Buckets.Credentials.Key, dbSettings.Buckets.Credentials.Key)
 	assert.EqualValues(testSettings.Buckets.Credentials.Secret, dbSettings.Buckets.Credentials.Secret)
 	assert.EqualValues(testSettings.Buckets.Credentials.Bucket, dbSettings.Buckets.Credentials.Bucket)
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/utility"
+)
+
+// APIVersionCoverage is the code coverage reported by a version's tasks and
+// the change in coverage from its base version.
+type APIVersionCoverage struct {
+	VersionID        *string                `json:"version_id"`
+	BaseVersionID    *string                `json:"base_version_id"`
+	CoveredLines     int                    `json:"covered_lines"`
+	TotalLines       int                    `json:"total_lines"`
+	Percent          float64                `json:"percent"`
+	BaseCoveredLines int                    `json:"base_covered_lines"`
+	BaseTotalLines   int                    `json:"base_total_lines"`
+	BasePercent      float64                `json:"base_percent"`
+	Delta            float64                `json:"delta"`
+	Tasks            []APITaskCoverageDelta `json:"tasks"`
+	BaseOnlyTasks    []APITaskCoverageDelta `json:"base_only_tasks"`
+}
+
+func (c *APIVersionCoverage) BuildFromService(d coverage.VersionCoverageDelta) {
+	c.VersionID = utility.ToStringPtr(d.Version)
+	c.BaseVersionID = utility.ToStringPtr(d.BaseVersion)
+	c.CoveredLines = d.CoveredLines
+	c.TotalLines = d.TotalLines
+	c.Percent = d.Percent()
+	c.BaseCoveredLines = d.BaseCoveredLines
+	c.BaseTotalLines = d.BaseTotalLines
+	c.BasePercent = d.BasePercent()
+	c.Delta = d.Delta()
+	c.Tasks = buildAPITaskCoverageDeltas(d.Tasks)
+	c.BaseOnlyTasks = buildAPITaskCoverageDeltas(d.BaseOnlyTasks)
+}
+
+func buildAPITaskCoverageDeltas(tasks []coverage.TaskCoverageDelta) []APITaskCoverageDelta {
+	apiTasks := make([]APITaskCoverageDelta, 0, len(tasks))
+	for _, t := range tasks {
+		apiTask := APITaskCoverageDelta{}
+		apiTask.BuildFromService(t)
+		apiTasks = append(apiTasks, apiTask)
+	}
+	return apiTasks
+}
+
+// APITaskCoverageDelta is the change in coverage of a task from the same task
+// in the base version.
+type APITaskCoverageDelta struct {
+	TaskName         *string                `json:"task_name"`
+	BuildVariant     *string                `json:"build_variant"`
+	TaskID           *string                `json:"task_id"`
+	BaseTaskID       *string                `json:"base_task_id"`
+	CoveredLines     int                    `json:"covered_lines"`
+	TotalLines       int                    `json:"total_lines"`
+	Percent          float64                `json:"percent"`
+	BaseCoveredLines int                    `json:"base_covered_lines"`
+	BaseTotalLines   int                    `json:"base_total_lines"`
+	BasePercent      float64                `json:"base_percent"`
+	Delta            float64                `json:"delta"`
+	Files            []APIFileCoverageDelta `json:"files"`
+}
+
+func (c *APITaskCoverageDelta) BuildFromService(d coverage.TaskCoverageDelta) {
+	c.TaskName = utility.ToStringPtr(d.TaskName)
+	c.BuildVariant = utility.ToStringPtr(d.BuildVariant)
+	c.TaskID = utility.ToStringPtr(d.TaskID)
+	c.BaseTaskID = utility.ToStringPtr(d.BaseTaskID)
+	c.CoveredLines = d.CoveredLines
+	c.TotalLines = d.TotalLines
+	c.Percent = d.Percent()
+	c.BaseCoveredLines = d.BaseCoveredLines
+	c.BaseTotalLines = d.BaseTotalLines
+	c.BasePercent = d.BasePercent()
+	c.Delta = d.Delta()
+	c.Files = make([]APIFileCoverageDelta, 0, len(d.Files))
+	for _, f := range d.Files {
+		apiFile := APIFileCoverageDelta{}
+		apiFile.BuildFromService(f)
+		c.Files = append(c.Files, apiFile)
+	}
+}
+
+// APIFileCoverageDelta is the change in coverage of a single source file.
+type APIFileCoverageDelta struct {
+	Name             *string `json:"name"`
+	CoveredLines     int     `json:"covered_lines"`
+	TotalLines       int     `json:"total_lines"`
+	Percent          float64 `json:"percent"`
+	BaseCoveredLines int     `json:"base_covered_lines"`
+	BaseTotalLines   int     `json:"base_total_lines"`
+	BasePercent      float64 `json:"base_percent"`
+	Delta            float64 `json:"delta"`
+}
+
+func (c *APIFileCoverageDelta) BuildFromService(d coverage.FileCoverageDelta) {
+	c.Name = utility.ToStringPtr(d.Name)
+	c.CoveredLines = d.CoveredLines
+	c.TotalLines = d.TotalLines
+	c.Percent = d.Percent()
+	c.BaseCoveredLines = d.BaseCoveredLines
+	c.BaseTotalLines = d.BaseTotalLines
+	c.BasePercent = d.BasePercent()
+	c.Delta = d.Delta()
+}
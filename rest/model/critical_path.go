@@ -0,0 +1,51 @@
+package model
+
+import (
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+)
+
+// APIVersionCriticalPath is the longest chain of dependent tasks in a version
+// and how long the tasks on it spent waiting.
+type APIVersionCriticalPath struct {
+	VersionID           *string               `json:"version_id"`
+	Tasks               []APICriticalPathTask `json:"tasks"`
+	MinimumMakespan     APIDuration           `json:"minimum_makespan_ms"`
+	Makespan            APIDuration           `json:"makespan_ms"`
+	TotalQueueWait      APIDuration           `json:"total_queue_wait_ms"`
+	TotalDependencyWait APIDuration           `json:"total_dependency_wait_ms"`
+}
+
+// APICriticalPathTask is a single task on a version's critical path.
+type APICriticalPathTask struct {
+	TaskID            *string     `json:"task_id"`
+	DisplayName       *string     `json:"display_name"`
+	BuildVariant      *string     `json:"build_variant"`
+	Status            *string     `json:"status"`
+	Duration          APIDuration `json:"duration_ms"`
+	DurationEstimated bool        `json:"duration_estimated"`
+	QueueWait         APIDuration `json:"queue_wait_ms"`
+	DependencyWait    APIDuration `json:"dependency_wait_ms"`
+}
+
+// BuildFromService converts a service level struct to an API level struct.
+func (p *APIVersionCriticalPath) BuildFromService(versionID string, in task.CriticalPath) {
+	p.VersionID = utility.ToStringPtr(versionID)
+	p.Tasks = make([]APICriticalPathTask, 0, len(in.Tasks))
+	for _, t := range in.Tasks {
+		p.Tasks = append(p.Tasks, APICriticalPathTask{
+			TaskID:            utility.ToStringPtr(t.TaskID),
+			DisplayName:       utility.ToStringPtr(t.DisplayName),
+			BuildVariant:      utility.ToStringPtr(t.BuildVariant),
+			Status:            utility.ToStringPtr(t.Status),
+			Duration:          NewAPIDuration(t.Duration),
+			DurationEstimated: t.DurationEstimated,
+			QueueWait:         NewAPIDuration(t.QueueWait),
+			DependencyWait:    NewAPIDuration(t.DependencyWait),
+		})
+	}
+	p.MinimumMakespan = NewAPIDuration(in.MinimumMakespan)
+	p.Makespan = NewAPIDuration(in.Makespan)
+	p.TotalQueueWait = NewAPIDuration(in.TotalQueueWait)
+	p.TotalDependencyWait = NewAPIDuration(in.TotalDependencyWait)
+}
@@ -23,6 +23,16 @@ type APIPlannerSettings struct {
 	GenerateTaskFactor        int64       `json:"generate_task_factor"`
 	NumDependentsFactor       float64     `json:"num_dependents_factor"`
 	CommitQueueFactor         int64       `json:"commit_queue_factor"`
+	// FairShareWeights divide the distro's hosts between projects.
+	FairShareWeights []APIFairShareWeight `json:"fair_share_weights"`
+}
+
+// APIFairShareWeight gives a project, or a group of projects, a share of the
+// distro's hosts.
+type APIFairShareWeight struct {
+	Name     *string  `json:"name"`
+	Projects []string `json:"projects"`
+	Weight   int      `json:"weight"`
 }
 
 // BuildFromService converts from service level distro.PlannerSetting to an APIPlannerSettings
@@ -41,6 +51,14 @@ func (s *APIPlannerSettings) BuildFromService(settings distro.PlannerSettings) {
 	s.GenerateTaskFactor = settings.GenerateTaskFactor
 	s.NumDependentsFactor = settings.NumDependentsFactor
 	s.CommitQueueFactor = settings.CommitQueueFactor
+	s.FairShareWeights = nil
+	for _, w := range settings.FairShareWeights {
+		s.FairShareWeights = append(s.FairShareWeights, APIFairShareWeight{
+			Name:     utility.ToStringPtr(w.Name),
+			Projects: w.Projects,
+			Weight:   w.Weight,
+		})
+	}
 }
 
 // ToService returns a service layer distro.PlannerSettings using the data from APIPlannerSettings
@@ -60,6 +78,13 @@ func (s *APIPlannerSettings) ToService() distro.PlannerSettings {
 	settings.GenerateTaskFactor = s.GenerateTaskFactor
 	settings.NumDependentsFactor = s.NumDependentsFactor
 	settings.CommitQueueFactor = s.CommitQueueFactor
+	for _, w := range s.FairShareWeights {
+		settings.FairShareWeights = append(settings.FairShareWeights, distro.FairShareWeight{
+			Name:     utility.FromStringPtr(w.Name),
+			Projects: w.Projects,
+			Weight:   w.Weight,
+		})
+	}
 
 	return settings
 }
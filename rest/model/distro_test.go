@@ -37,6 +37,9 @@ func TestDistroBuildFromService(t *testing.T) {
 		HostAllocatorSettings: distro.HostAllocatorSettings{
 			AutoTuneMaximumHosts: true,
 		},
+		PlannerSettings: distro.PlannerSettings{
+			FairShareWeights: []distro.FairShareWeight{{Name: "release", Projects: []string{"server", "tools"}, Weight: 3}},
+		},
 	}
 	apiDistro := &APIDistro{}
 	apiDistro.BuildFromService(d)
@@ -56,6 +59,10 @@ func TestDistroBuildFromService(t *testing.T) {
 	assert.Equal(t, d.SingleTaskDistro, apiDistro.SingleTaskDistro)
 	assert.Equal(t, d.ExecUser, utility.FromStringPtr(apiDistro.ExecUser))
 	assert.True(t, apiDistro.HostAllocatorSettings.AutoTuneMaximumHosts)
+	require.Len(t, apiDistro.PlannerSettings.FairShareWeights, 1)
+	assert.Equal(t, "release", utility.FromStringPtr(apiDistro.PlannerSettings.FairShareWeights[0].Name))
+	assert.Equal(t, []string{"server", "tools"}, apiDistro.PlannerSettings.FairShareWeights[0].Projects)
+	assert.Equal(t, 3, apiDistro.PlannerSettings.FairShareWeights[0].Weight)
 }
 
 func TestDistroBuildFromServiceDefaults(t *testing.T) {
@@ -109,6 +116,9 @@ func TestDistroToService(t *testing.T) {
 		HostAllocatorSettings: APIHostAllocatorSettings{
 			AutoTuneMaximumHosts: true,
 		},
+		PlannerSettings: APIPlannerSettings{
+			FairShareWeights: []APIFairShareWeight{{Name: utility.ToStringPtr("release"), Projects: []string{"server"}, Weight: 2}},
+		},
 	}
 
 	d := apiDistro.ToService()
@@ -138,6 +148,7 @@ func TestDistroToService(t *testing.T) {
 	assert.Equal(t, apiDistro.Mountpoints, d.Mountpoints)
 	assert.Equal(t, utility.FromStringPtr(apiDistro.ExecUser), d.ExecUser)
 	assert.True(t, d.HostAllocatorSettings.AutoTuneMaximumHosts)
+	assert.Equal(t, []distro.FairShareWeight{{Name: "release", Projects: []string{"server"}, Weight: 2}}, d.PlannerSettings.FairShareWeights)
 }
 
 func TestDistroToServiceDefaults(t *testing.T) {
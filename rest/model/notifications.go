@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/utility"
 )
 
 type APIEventStats struct {
@@ -23,6 +24,8 @@ type apiNotificationStats struct {
 	EvergreenWebhook  int `json:"evergreen_webhook"`
 	Email             int `json:"email"`
 	Slack             int `json:"slack"`
+	Teams             int `json:"teams"`
+	ChatWebhook       int `json:"chat_webhook"`
 }
 
 func (n *apiNotificationStats) BuildFromService(data notification.NotificationStats) {
@@ -32,4 +35,53 @@ func (n *apiNotificationStats) BuildFromService(data notification.NotificationSt
 	n.EvergreenWebhook = data.EvergreenWebhook
 	n.Email = data.Email
 	n.Slack = data.Slack
+	n.Teams = data.Teams
+	n.ChatWebhook = data.ChatWebhook
+}
+
+// APIDeadLetteredNotification is a notification that ran out of attempts to
+// be sent.
+type APIDeadLetteredNotification struct {
+	ID             *string    `json:"id"`
+	SubscriberType *string    `json:"subscriber_type"`
+	Subscriber     *string    `json:"subscriber"`
+	SubscriptionID *string    `json:"subscription_id"`
+	Attempts       int        `json:"attempts"`
+	Error          *string    `json:"error"`
+	DeadLetteredAt *time.Time `json:"dead_lettered_at"`
+}
+
+func (n *APIDeadLetteredNotification) BuildFromService(in notification.Notification) {
+	n.ID = utility.ToStringPtr(in.ID)
+	n.SubscriberType = utility.ToStringPtr(in.Subscriber.Type)
+	n.Subscriber = utility.ToStringPtr(in.Subscriber.String())
+	n.SubscriptionID = utility.ToStringPtr(in.SubscriptionID)
+	n.Error = utility.ToStringPtr(in.Error)
+	if in.Retry != nil {
+		n.Attempts = in.Retry.Attempts
+		n.DeadLetteredAt = ToTimePtr(in.Retry.DeadLetteredAt)
+	}
+}
+
+// APIDeadLetterFilter selects the dead lettered notifications to replay or
+// purge.
+type APIDeadLetterFilter struct {
+	IDs            []string `json:"ids"`
+	SubscriberType *string  `json:"subscriber_type"`
+	// All must be set to select every dead lettered notification, so that
+	// an empty filter doesn't do so by accident.
+	All bool `json:"all"`
+}
+
+func (f *APIDeadLetterFilter) ToService() notification.DeadLetterFilter {
+	return notification.DeadLetterFilter{
+		IDs:            f.IDs,
+		SubscriberType: utility.FromStringPtr(f.SubscriberType),
+	}
+}
+
+// APIDeadLetterResult is the number of dead lettered notifications that were
+// replayed or purged.
+type APIDeadLetterResult struct {
+	Count int `json:"count"`
 }
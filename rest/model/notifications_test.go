@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/utility"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEventStats(t *testing.T) {
@@ -44,3 +47,32 @@ func TestNotificationStats(t *testing.T) {
 		assert.Equal(1, int(f.Int()))
 	}
 }
+
+func TestDeadLetteredNotification(t *testing.T) {
+	deadLetteredAt := time.Now().Round(time.Millisecond)
+	target := "#evergreen"
+	n := notification.Notification{
+		ID: "notification",
+		Subscriber: event.Subscriber{
+			Type:   event.SlackSubscriberType,
+			Target: &target,
+		},
+		Error:          "rate limited",
+		SubscriptionID: "subscription",
+		Retry: &notification.RetryInfo{
+			Attempts:       6,
+			DeadLetteredAt: deadLetteredAt,
+		},
+	}
+
+	apiNotification := APIDeadLetteredNotification{}
+	apiNotification.BuildFromService(n)
+	assert.Equal(t, "notification", utility.FromStringPtr(apiNotification.ID))
+	assert.Equal(t, event.SlackSubscriberType, utility.FromStringPtr(apiNotification.SubscriberType))
+	assert.Equal(t, "slack-#evergreen", utility.FromStringPtr(apiNotification.Subscriber))
+	assert.Equal(t, "subscription", utility.FromStringPtr(apiNotification.SubscriptionID))
+	assert.Equal(t, "rate limited", utility.FromStringPtr(apiNotification.Error))
+	assert.Equal(t, 6, apiNotification.Attempts)
+	require.NotNil(t, apiNotification.DeadLetteredAt)
+	assert.True(t, deadLetteredAt.Equal(*apiNotification.DeadLetteredAt))
+}
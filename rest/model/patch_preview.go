@@ -0,0 +1,68 @@
+package model
+
+import (
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/utility"
+)
+
+// APIPatchPreviewSelection is the variant and task selection to preview for a
+// patch. If it is empty, the patch's existing variant tasks are previewed.
+type APIPatchPreviewSelection struct {
+	Variants      []string `json:"variants,omitempty"`
+	Tasks         []string `json:"tasks,omitempty"`
+	RegexVariants []string `json:"regex_variants,omitempty"`
+	RegexTasks    []string `json:"regex_tasks,omitempty"`
+	Aliases       []string `json:"aliases,omitempty"`
+}
+
+// IsEmpty returns whether the selection does not select any variants or tasks.
+func (s APIPatchPreviewSelection) IsEmpty() bool {
+	return len(s.Variants) == 0 && len(s.Tasks) == 0 && len(s.RegexVariants) == 0 && len(s.RegexTasks) == 0 && len(s.Aliases) == 0
+}
+
+// APIPatchPreview is the model to be returned by the API when previewing the
+// tasks that a patch would create if finalized.
+type APIPatchPreview struct {
+	Tasks                   []APIPatchPreviewTask `json:"tasks"`
+	NumTasks                int                   `json:"num_tasks"`
+	NumDependencyTasks      int                   `json:"num_dependency_tasks"`
+	TotalExpectedDuration   APIDuration           `json:"total_expected_duration_ms"`
+	NumTasksWithoutDuration int                   `json:"num_tasks_without_duration"`
+	TotalPredictedCost      float64               `json:"total_predicted_cost"`
+	NumTasksWithoutCost     int                   `json:"num_tasks_without_cost"`
+	CostHidden              bool                  `json:"cost_hidden"`
+}
+
+// APIPatchPreviewTask is a single task in a patch preview.
+type APIPatchPreviewTask struct {
+	BuildVariant     *string     `json:"build_variant"`
+	DisplayName      *string     `json:"display_name"`
+	IsDependency     bool        `json:"is_dependency"`
+	Distro           *string     `json:"distro"`
+	ExpectedDuration APIDuration `json:"expected_duration_ms"`
+	PredictedCost    float64     `json:"predicted_cost"`
+}
+
+// BuildFromService converts a service level struct to an API level struct.
+func (p *APIPatchPreview) BuildFromService(in model.PatchPreview) {
+	p.Tasks = make([]APIPatchPreviewTask, 0, len(in.Tasks))
+	for _, t := range in.Tasks {
+		p.Tasks = append(p.Tasks, APIPatchPreviewTask{
+			BuildVariant:     utility.ToStringPtr(t.BuildVariant),
+			DisplayName:      utility.ToStringPtr(t.DisplayName),
+			IsDependency:     t.IsDependency,
+			Distro:           utility.ToStringPtr(t.Distro),
+			ExpectedDuration: NewAPIDuration(t.ExpectedDuration),
+			PredictedCost:    t.PredictedCost,
+		})
+		if t.IsDependency {
+			p.NumDependencyTasks++
+		}
+	}
+	p.NumTasks = len(in.Tasks)
+	p.TotalExpectedDuration = NewAPIDuration(in.TotalExpectedDuration)
+	p.NumTasksWithoutDuration = in.NumTasksWithoutDuration
+	p.TotalPredictedCost = in.TotalPredictedCost
+	p.NumTasksWithoutCost = in.NumTasksWithoutCost
+	p.CostHidden = in.CostHidden
+}
@@ -488,6 +488,81 @@ func (ts *APITestSelectionSettings) BuildFromService(settings model.TestSelectio
 	ts.MainlineDefaultEnabled = utility.BoolPtrCopy(settings.MainlineDefaultEnabled)
 }
 
+type APIFlakyTestPolicy struct {
+	// Whether or not tests detected as flaky are automatically quarantined.
+	AutoQuarantineEnabled *bool `json:"auto_quarantine_enabled,omitzero"`
+	// Minimum flakiness score, between 0 and 1, for a test to be quarantined.
+	FlakinessThreshold float64 `json:"flakiness_threshold,omitzero"`
+	// Minimum number of times a test must flip between passing and failing
+	// for it to be quarantined.
+	MinFlips int `json:"min_flips,omitzero"`
+	// Number of days of task executions to scan for flaky tests.
+	LookbackDays int `json:"lookback_days,omitzero"`
+	// Number of consecutive passing runs after which an automatically
+	// quarantined test is unquarantined.
+	StableStreak int `json:"stable_streak,omitzero"`
+}
+
+func (ftp *APIFlakyTestPolicy) ToService() model.FlakyTestPolicy {
+	return model.FlakyTestPolicy{
+		AutoQuarantineEnabled: utility.BoolPtrCopy(ftp.AutoQuarantineEnabled),
+		FlakinessThreshold:    ftp.FlakinessThreshold,
+		MinFlips:              ftp.MinFlips,
+		LookbackDays:          ftp.LookbackDays,
+		StableStreak:          ftp.StableStreak,
+	}
+}
+
+func (ftp *APIFlakyTestPolicy) BuildFromService(policy model.FlakyTestPolicy) {
+	ftp.AutoQuarantineEnabled = utility.BoolPtrCopy(policy.AutoQuarantineEnabled)
+	ftp.FlakinessThreshold = policy.FlakinessThreshold
+	ftp.MinFlips = policy.MinFlips
+	ftp.LookbackDays = policy.LookbackDays
+	ftp.StableStreak = policy.StableStreak
+}
+
+type APISecretDetectionSettings struct {
+	// Whether or not task logs are scanned for secrets to redact.
+	Enabled *bool `json:"enabled,omitzero"`
+	// Whether or not detection of high-entropy tokens is turned off.
+	HighEntropyDisabled bool `json:"high_entropy_disabled,omitzero"`
+	// Additional project-specific secret patterns to redact.
+	Patterns []APISecretDetectionPattern `json:"patterns,omitempty"`
+}
+
+type APISecretDetectionPattern struct {
+	// Name of the type of secret, shown in the redacted logs.
+	Name *string `json:"name"`
+	// Regular expression matching the secret.
+	Regex *string `json:"regex"`
+}
+
+func (sd *APISecretDetectionSettings) ToService() model.SecretDetectionSettings {
+	settings := model.SecretDetectionSettings{
+		Enabled:             utility.BoolPtrCopy(sd.Enabled),
+		HighEntropyDisabled: sd.HighEntropyDisabled,
+	}
+	for _, p := range sd.Patterns {
+		settings.Patterns = append(settings.Patterns, model.SecretDetectionPattern{
+			Name:  utility.FromStringPtr(p.Name),
+			Regex: utility.FromStringPtr(p.Regex),
+		})
+	}
+	return settings
+}
+
+func (sd *APISecretDetectionSettings) BuildFromService(settings model.SecretDetectionSettings) {
+	sd.Enabled = utility.BoolPtrCopy(settings.Enabled)
+	sd.HighEntropyDisabled = settings.HighEntropyDisabled
+	sd.Patterns = nil
+	for _, p := range settings.Patterns {
+		sd.Patterns = append(sd.Patterns, APISecretDetectionPattern{
+			Name:  utility.ToStringPtr(p.Name),
+			Regex: utility.ToStringPtr(p.Regex),
+		})
+	}
+}
+
 type APIProjectRef struct {
 	Id *string `json:"id"`
 	// GitHub org name.
@@ -612,6 +687,10 @@ type APIProjectRef struct {
 	GitHubPermissionGroupByRequester map[string]string `json:"github_permission_group_by_requester,omitempty"`
 	// Test selection settings.
 	TestSelection APITestSelectionSettings `json:"test_selection,omitzero"`
+	// Flaky test detection and auto-quarantine policy.
+	FlakyTestPolicy APIFlakyTestPolicy `json:"flaky_test_policy,omitzero"`
+	// Settings for detecting and redacting secrets in task logs.
+	SecretDetection APISecretDetectionSettings `json:"secret_detection,omitzero"`
 	// Whether or not to run every mainline commit version.
 	RunEveryMainlineCommit *bool `json:"run_every_mainline_commit,omitzero"`
 }
@@ -663,6 +742,8 @@ func (p *APIProjectRef) ToService() (*model.ProjectRef, error) {
 		ProjectHealthView:                p.ProjectHealthView,
 		GitHubPermissionGroupByRequester: p.GitHubPermissionGroupByRequester,
 		TestSelection:                    p.TestSelection.ToService(),
+		FlakyTestPolicy:                  p.FlakyTestPolicy.ToService(),
+		SecretDetection:                  p.SecretDetection.ToService(),
 		RunEveryMainlineCommit:           p.RunEveryMainlineCommit,
 	}
 
@@ -770,6 +851,8 @@ func (p *APIProjectRef) BuildPublicFields(ctx context.Context, projectRef model.
 	p.GithubMQTriggerAliases = utility.ToStringPtrSlice(projectRef.GithubMQTriggerAliases)
 	p.GitHubPermissionGroupByRequester = projectRef.GitHubPermissionGroupByRequester
 	p.TestSelection.BuildFromService(projectRef.TestSelection)
+	p.FlakyTestPolicy.BuildFromService(projectRef.FlakyTestPolicy)
+	p.SecretDetection.BuildFromService(projectRef.SecretDetection)
 	p.RunEveryMainlineCommit = projectRef.RunEveryMainlineCommit
 
 	if projectRef.ProjectHealthView == "" {
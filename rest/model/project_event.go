@@ -15,10 +15,33 @@ import (
 )
 
 type APIProjectEvent struct {
-	Timestamp *time.Time              `json:"ts"`
-	User      *string                 `json:"user"`
-	Before    APIProjectEventSettings `json:"before"`
-	After     APIProjectEventSettings `json:"after"`
+	Timestamp      *time.Time               `json:"ts"`
+	User           *string                  `json:"user"`
+	Before         APIProjectEventSettings  `json:"before"`
+	After          APIProjectEventSettings  `json:"after"`
+	TestQuarantine *APITestQuarantineChange `json:"test_quarantine,omitempty"`
+}
+
+// APITestQuarantineChange describes a test whose quarantine state was changed
+// automatically.
+type APITestQuarantineChange struct {
+	BuildVariant *string `json:"build_variant"`
+	TaskName     *string `json:"task_name"`
+	TestName     *string `json:"test_name"`
+	Quarantined  bool    `json:"quarantined"`
+	Flakiness    float64 `json:"flakiness,omitempty"`
+	Flips        int     `json:"flips,omitempty"`
+	StableStreak int     `json:"stable_streak,omitempty"`
+}
+
+func (c *APITestQuarantineChange) BuildFromService(change model.TestQuarantineChange) {
+	c.BuildVariant = utility.ToStringPtr(change.BuildVariant)
+	c.TaskName = utility.ToStringPtr(change.TaskName)
+	c.TestName = utility.ToStringPtr(change.TestName)
+	c.Quarantined = change.Quarantined
+	c.Flakiness = change.Flakiness
+	c.Flips = change.Flips
+	c.StableStreak = change.StableStreak
 }
 
 // take this from the original place instead of redefinning it here
@@ -109,6 +132,10 @@ func (e *APIProjectEvent) BuildFromService(ctx context.Context, entry model.Proj
 	e.User = user
 	e.Before = APIProjectEventSettings(before)
 	e.After = APIProjectEventSettings(after)
+	if data.TestQuarantine != nil {
+		e.TestQuarantine = &APITestQuarantineChange{}
+		e.TestQuarantine.BuildFromService(*data.TestQuarantine)
+	}
 	return nil
 }
 
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strings"
+
 	"github.com/evergreen-ci/evergreen/model/reliability"
 	"github.com/evergreen-ci/utility"
 )
@@ -50,3 +52,47 @@ func (tr *APITaskReliability) StartAtKey() string {
 		distro:       utility.FromStringPtr(tr.Distro),
 	}.String()
 }
+
+// APITestReliability is the model to be returned by the API when querying test
+// execution statistics.
+type APITestReliability struct {
+	TestName     *string `json:"test_name"`
+	TaskName     *string `json:"task_name"`
+	BuildVariant *string `json:"variant"`
+	Date         *string `json:"date"`
+
+	NumPass         int     `json:"num_pass"`
+	NumFail         int     `json:"num_fail"`
+	NumTotal        int     `json:"num_total"`
+	P50DurationPass float64 `json:"p50_duration_pass"`
+	P95DurationPass float64 `json:"p95_duration_pass"`
+	SuccessRate     float64 `json:"success_rate"`
+	MaxSuccessRate  float64 `json:"max_success_rate"`
+}
+
+// BuildFromService converts a service level struct to an API level struct
+func (tr *APITestReliability) BuildFromService(in reliability.TestReliability) {
+	tr.TestName = utility.ToStringPtr(in.TestName)
+	tr.TaskName = utility.ToStringPtr(in.TaskName)
+	tr.BuildVariant = utility.ToStringPtr(in.BuildVariant)
+	tr.Date = utility.ToStringPtr(in.Date.UTC().Format("2006-01-02"))
+
+	tr.NumPass = in.NumPass
+	tr.NumFail = in.NumFail
+	tr.NumTotal = in.NumTotal
+	tr.P50DurationPass = in.P50DurationPass
+	tr.P95DurationPass = in.P95DurationPass
+	tr.SuccessRate = in.SuccessRate
+	tr.MaxSuccessRate = in.MaxSuccessRate
+}
+
+// StartAtKey returns the start_at key parameter that can be used to paginate
+// and start at this element.
+func (tr *APITestReliability) StartAtKey() string {
+	return strings.Join([]string{
+		utility.FromStringPtr(tr.Date),
+		utility.FromStringPtr(tr.BuildVariant),
+		utility.FromStringPtr(tr.TaskName),
+		utility.FromStringPtr(tr.TestName),
+	}, "|")
+}
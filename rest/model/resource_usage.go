@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/utility"
+)
+
+// APIResourceUsageSample is a snapshot of the host's resource usage while a
+// task was running.
+type APIResourceUsageSample struct {
+	// Time the sample was taken.
+	Time *time.Time `json:"time"`
+	// Full display name of the command that was running when the sample was
+	// taken, if any.
+	Command *string `json:"command"`
+	// Percentage of the host's total CPU in use.
+	CPUPercent float64 `json:"cpu_percent"`
+	// Percentage of the host's memory in use.
+	MemoryPercent float64 `json:"memory_percent"`
+	// Amount of the host's memory in use, in bytes.
+	MemoryUsedBytes int64 `json:"memory_used_bytes"`
+	// Number of bytes read from all disks since the previous sample.
+	DiskReadBytes int64 `json:"disk_read_bytes"`
+	// Number of bytes written to all disks since the previous sample.
+	DiskWriteBytes int64 `json:"disk_write_bytes"`
+	// Total size of the files in the task's working directory, in bytes.
+	WorkDirDiskUsedBytes int64 `json:"work_dir_disk_used_bytes"`
+	// Number of processes running on the host.
+	NumProcesses int `json:"num_processes"`
+}
+
+func (s *APIResourceUsageSample) BuildFromService(sample resourceusage.Sample) {
+	s.Time = ToTimePtr(sample.Time)
+	s.Command = utility.ToStringPtr(sample.Command)
+	s.CPUPercent = sample.CPUPercent
+	s.MemoryPercent = sample.MemoryPercent
+	s.MemoryUsedBytes = int64(sample.MemoryUsedBytes)
+	s.DiskReadBytes = int64(sample.DiskReadBytes)
+	s.DiskWriteBytes = int64(sample.DiskWriteBytes)
+	s.WorkDirDiskUsedBytes = int64(sample.WorkDirDiskUsedBytes)
+	s.NumProcesses = sample.NumProcesses
+}
@@ -18,9 +18,11 @@ type APISubscriber struct {
 	// Target can be either a slice or a string. However, since swaggo does not
 	// support the OpenAPI `oneOf` keyword, we set `swaggerignore` and document
 	// the field manually in the "Fetch all projects" endpoint.
-	Target              any                     `json:"target" swaggerignore:"true"`
-	WebhookSubscriber   *APIWebhookSubscriber   `json:"-"`
-	JiraIssueSubscriber *APIJIRAIssueSubscriber `json:"-"`
+	Target                any                       `json:"target" swaggerignore:"true"`
+	WebhookSubscriber     *APIWebhookSubscriber     `json:"-"`
+	JiraIssueSubscriber   *APIJIRAIssueSubscriber   `json:"-"`
+	TeamsSubscriber       *APITeamsSubscriber       `json:"-"`
+	ChatWebhookSubscriber *APIChatWebhookSubscriber `json:"-"`
 }
 
 type APIGithubPRSubscriber struct {
@@ -49,6 +51,9 @@ type APIWebhookSubscriber struct {
 	MinDelayMS int                `json:"min_delay_ms" mapstructure:"min_delay_ms"`
 	TimeoutMS  int                `json:"timeout_ms" mapstructure:"timeout_ms"`
 	Headers    []APIWebhookHeader `json:"headers" mapstructure:"headers"`
+	// Format is the format of the webhook's body, either "evergreen" or
+	// "cloudevents". It defaults to "evergreen".
+	Format *string `json:"format" mapstructure:"format"`
 }
 
 type APIWebhookHeader struct {
@@ -104,6 +109,24 @@ func (s *APISubscriber) BuildFromService(in event.Subscriber) error {
 		target = sub
 		s.JiraIssueSubscriber = &sub
 
+	case event.TeamsSubscriberType:
+		sub := APITeamsSubscriber{}
+		err := sub.BuildFromService(in.Target)
+		if err != nil {
+			return err
+		}
+		target = sub
+		s.TeamsSubscriber = &sub
+
+	case event.ChatWebhookSubscriberType:
+		sub := APIChatWebhookSubscriber{}
+		err := sub.BuildFromService(in.Target)
+		if err != nil {
+			return err
+		}
+		target = sub
+		s.ChatWebhookSubscriber = &sub
+
 	case event.JIRACommentSubscriberType, event.EmailSubscriberType,
 		event.SlackSubscriberType, event.RunChildPatchSubscriberType:
 		target = in.Target
@@ -183,6 +206,36 @@ func (s *APISubscriber) ToService() (event.Subscriber, error) {
 		}
 		target = apiModel.ToService()
 
+	case event.TeamsSubscriberType:
+		apiModel := APITeamsSubscriber{}
+		if s.TeamsSubscriber != nil {
+			apiModel = *s.TeamsSubscriber
+		} else {
+			if err = mapstructure.Decode(s.Target, &apiModel); err != nil {
+				return event.Subscriber{}, gimlet.ErrorResponse{
+					StatusCode: http.StatusBadRequest,
+					Message:    errors.Wrap(err, "Teams subscriber target is malformed").Error(),
+				}
+			}
+		}
+		ts := apiModel.ToService()
+		target = &ts
+
+	case event.ChatWebhookSubscriberType:
+		apiModel := APIChatWebhookSubscriber{}
+		if s.ChatWebhookSubscriber != nil {
+			apiModel = *s.ChatWebhookSubscriber
+		} else {
+			if err = mapstructure.Decode(s.Target, &apiModel); err != nil {
+				return event.Subscriber{}, gimlet.ErrorResponse{
+					StatusCode: http.StatusBadRequest,
+					Message:    errors.Wrap(err, "chat webhook subscriber target is malformed").Error(),
+				}
+			}
+		}
+		cs := apiModel.ToService()
+		target = &cs
+
 	case event.JIRACommentSubscriberType, event.EmailSubscriberType,
 		event.SlackSubscriberType, event.RunChildPatchSubscriberType:
 		target = s.Target
@@ -275,6 +328,10 @@ func (s *APIWebhookSubscriber) BuildFromService(h any) error {
 		s.Retries = v.Retries
 		s.MinDelayMS = v.MinDelayMS
 		s.TimeoutMS = v.TimeoutMS
+		s.Format = utility.ToStringPtr(v.Format)
+		if v.Format == "" {
+			s.Format = utility.ToStringPtr(event.WebhookFormatEvergreen)
+		}
 		for _, header := range v.Headers {
 			apiHeader := APIWebhookHeader{}
 			apiHeader.BuildFromService(header)
@@ -296,6 +353,11 @@ func (s *APIWebhookSubscriber) ToService() event.WebhookSubscriber {
 		Retries:    s.Retries,
 		MinDelayMS: s.MinDelayMS,
 		TimeoutMS:  s.TimeoutMS,
+		Format:     utility.FromStringPtr(s.Format),
+	}
+	// The Evergreen format is the default, so it's stored as empty.
+	if sub.Format == event.WebhookFormatEvergreen {
+		sub.Format = ""
 	}
 	for _, apiHeader := range s.Headers {
 		sub.Headers = append(sub.Headers, apiHeader.ToService())
@@ -343,3 +405,53 @@ func (s *APIJIRAIssueSubscriber) ToService() event.JIRAIssueSubscriber {
 		IssueType: utility.FromStringPtr(s.IssueType),
 	}
 }
+
+type APITeamsSubscriber struct {
+	URL *string `json:"url" mapstructure:"url"`
+}
+
+func (s *APITeamsSubscriber) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case *event.TeamsSubscriber:
+		s.URL = utility.ToStringPtr(v.URL)
+
+	default:
+		return errors.Errorf("programmatic error: expected Teams subscriber but got type %T", h)
+	}
+
+	return nil
+}
+
+func (s *APITeamsSubscriber) ToService() event.TeamsSubscriber {
+	return event.TeamsSubscriber{
+		URL: utility.FromStringPtr(s.URL),
+	}
+}
+
+type APIChatWebhookSubscriber struct {
+	URL         *string `json:"url" mapstructure:"url"`
+	Template    *string `json:"template" mapstructure:"template"`
+	ContentType *string `json:"content_type" mapstructure:"content_type"`
+}
+
+func (s *APIChatWebhookSubscriber) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case *event.ChatWebhookSubscriber:
+		s.URL = utility.ToStringPtr(v.URL)
+		s.Template = utility.ToStringPtr(v.Template)
+		s.ContentType = utility.ToStringPtr(v.ContentType)
+
+	default:
+		return errors.Errorf("programmatic error: expected chat webhook subscriber but got type %T", h)
+	}
+
+	return nil
+}
+
+func (s *APIChatWebhookSubscriber) ToService() event.ChatWebhookSubscriber {
+	return event.ChatWebhookSubscriber{
+		URL:         utility.FromStringPtr(s.URL),
+		Template:    utility.FromStringPtr(s.Template),
+		ContentType: utility.FromStringPtr(s.ContentType),
+	}
+}
@@ -95,6 +95,44 @@ func TestSubscriberModelsWebhook(t *testing.T) {
 	assert.EqualValues(origWebhookSubscriber, serviceModel)
 }
 
+func TestSubscriberModelsWebhookFormat(t *testing.T) {
+	webhookSubscriber := event.Subscriber{
+		Type: event.EvergreenWebhookSubscriberType,
+		Target: &event.WebhookSubscriber{
+			URL:    "https://example.com",
+			Secret: []byte("shh"),
+			Format: event.WebhookFormatCloudEvents,
+		},
+	}
+	apiSubscriber := APISubscriber{}
+	require.NoError(t, apiSubscriber.BuildFromService(webhookSubscriber))
+	require.NotNil(t, apiSubscriber.WebhookSubscriber)
+	assert.Equal(t, event.WebhookFormatCloudEvents, utility.FromStringPtr(apiSubscriber.WebhookSubscriber.Format))
+
+	serviceModel, err := apiSubscriber.ToService()
+	require.NoError(t, err)
+	ws, ok := serviceModel.Target.(*event.WebhookSubscriber)
+	require.True(t, ok)
+	assert.Equal(t, event.WebhookFormatCloudEvents, ws.Format)
+
+	incoming := APISubscriber{
+		Type: utility.ToStringPtr(event.EvergreenWebhookSubscriberType),
+		Target: map[string]any{
+			"url":    "https://example.com",
+			"secret": "shh",
+			"format": event.WebhookFormatEvergreen,
+		},
+	}
+	serviceModel, err = incoming.ToService()
+	require.NoError(t, err)
+	ws, ok = serviceModel.Target.(*event.WebhookSubscriber)
+	require.True(t, ok)
+	assert.Empty(t, ws.Format)
+
+	require.NoError(t, apiSubscriber.BuildFromService(serviceModel))
+	assert.Equal(t, event.WebhookFormatEvergreen, utility.FromStringPtr(apiSubscriber.WebhookSubscriber.Format))
+}
+
 func TestSubscriberModelsJIRAIssue(t *testing.T) {
 	assert := assert.New(t)
 
@@ -146,3 +184,65 @@ func TestSubscriberModelsSlack(t *testing.T) {
 	assert.NoError(err)
 	assert.EqualValues(slackSubscriber, origSlackSubscriber)
 }
+
+func TestSubscriberModelsTeams(t *testing.T) {
+	target := event.TeamsSubscriber{URL: "https://example.webhook.office.com/webhook"}
+	teamsSubscriber := event.Subscriber{
+		Type:   event.TeamsSubscriberType,
+		Target: &target,
+	}
+	apiTeamsSubscriber := APISubscriber{}
+	require.NoError(t, apiTeamsSubscriber.BuildFromService(teamsSubscriber))
+	require.NotNil(t, apiTeamsSubscriber.TeamsSubscriber)
+	assert.Equal(t, target.URL, utility.FromStringPtr(apiTeamsSubscriber.TeamsSubscriber.URL))
+
+	origTeamsSubscriber, err := apiTeamsSubscriber.ToService()
+	require.NoError(t, err)
+	assert.EqualValues(t, teamsSubscriber, origTeamsSubscriber)
+
+	// incoming subscribers have target serialized as a map
+	incoming := APISubscriber{
+		Type: utility.ToStringPtr(event.TeamsSubscriberType),
+		Target: map[string]any{
+			"url": "https://example.webhook.office.com/webhook",
+		},
+	}
+
+	serviceModel, err := incoming.ToService()
+	require.NoError(t, err)
+	assert.EqualValues(t, teamsSubscriber, serviceModel)
+}
+
+func TestSubscriberModelsChatWebhook(t *testing.T) {
+	target := event.ChatWebhookSubscriber{
+		URL:         "https://chat.example.com/hooks/abc",
+		Template:    `{"text": {{ json .DisplayName }}}`,
+		ContentType: "application/json",
+	}
+	chatWebhookSubscriber := event.Subscriber{
+		Type:   event.ChatWebhookSubscriberType,
+		Target: &target,
+	}
+	apiChatWebhookSubscriber := APISubscriber{}
+	require.NoError(t, apiChatWebhookSubscriber.BuildFromService(chatWebhookSubscriber))
+	require.NotNil(t, apiChatWebhookSubscriber.ChatWebhookSubscriber)
+	assert.Equal(t, target.Template, utility.FromStringPtr(apiChatWebhookSubscriber.ChatWebhookSubscriber.Template))
+
+	origChatWebhookSubscriber, err := apiChatWebhookSubscriber.ToService()
+	require.NoError(t, err)
+	assert.EqualValues(t, chatWebhookSubscriber, origChatWebhookSubscriber)
+
+	// incoming subscribers have target serialized as a map
+	incoming := APISubscriber{
+		Type: utility.ToStringPtr(event.ChatWebhookSubscriberType),
+		Target: map[string]any{
+			"url":          "https://chat.example.com/hooks/abc",
+			"template":     `{"text": {{ json .DisplayName }}}`,
+			"content_type": "application/json",
+		},
+	}
+
+	serviceModel, err := incoming.ToService()
+	require.NoError(t, err)
+	assert.EqualValues(t, chatWebhookSubscriber, serviceModel)
+}
@@ -30,6 +30,41 @@ type APISubscription struct {
 	Owner *string `json:"owner"`
 	// Data for the particular condition that triggers the subscription.
 	TriggerData map[string]string `json:"trigger_data,omitempty"`
+	// If set, notifications are held and sent together in a periodic digest
+	// instead of one message per event.
+	Digest *APIDigestSettings `json:"digest,omitempty"`
+}
+
+type APIDigestSettings struct {
+	// How often to send the digest (hourly or daily).
+	Interval *string `json:"interval"`
+	// IANA time zone for the daily hour and quiet hours. Defaults to UTC.
+	Timezone *string `json:"timezone"`
+	// Hour of the day (0-23) to send daily digests.
+	DailyHour int `json:"daily_hour"`
+	// Hour of the day (0-23) that quiet hours start.
+	QuietHoursStart int `json:"quiet_hours_start"`
+	// Hour of the day (0-23) that quiet hours end. Digests due during quiet
+	// hours are sent when they end.
+	QuietHoursEnd int `json:"quiet_hours_end"`
+}
+
+func (d *APIDigestSettings) BuildFromService(settings event.DigestSettings) {
+	d.Interval = utility.ToStringPtr(settings.Interval)
+	d.Timezone = utility.ToStringPtr(settings.Timezone)
+	d.DailyHour = settings.DailyHour
+	d.QuietHoursStart = settings.QuietHoursStart
+	d.QuietHoursEnd = settings.QuietHoursEnd
+}
+
+func (d *APIDigestSettings) ToService() event.DigestSettings {
+	return event.DigestSettings{
+		Interval:        utility.FromStringPtr(d.Interval),
+		Timezone:        utility.FromStringPtr(d.Timezone),
+		DailyHour:       d.DailyHour,
+		QuietHoursStart: d.QuietHoursStart,
+		QuietHoursEnd:   d.QuietHoursEnd,
+	}
 }
 
 func (s *APISelector) BuildFromService(selector event.Selector) {
@@ -51,6 +86,10 @@ func (s *APISubscription) BuildFromService(sub event.Subscription) error {
 	s.Owner = utility.ToStringPtr(sub.Owner)
 	s.OwnerType = utility.ToStringPtr(string(sub.OwnerType))
 	s.TriggerData = sub.TriggerData
+	if sub.Digest != nil {
+		s.Digest = &APIDigestSettings{}
+		s.Digest.BuildFromService(*sub.Digest)
+	}
 	err := s.Subscriber.BuildFromService(sub.Subscriber)
 	if err != nil {
 		return err
@@ -87,6 +126,10 @@ func (s *APISubscription) ToService() (event.Subscription, error) {
 	}
 
 	out.Subscriber = subscriber
+	if s.Digest != nil {
+		digest := s.Digest.ToService()
+		out.Digest = &digest
+	}
 	for _, selector := range s.Selectors {
 		out.Selectors = append(out.Selectors, selector.ToService())
 	}
@@ -100,3 +143,10 @@ func (s *APISubscription) ToService() (event.Subscription, error) {
 
 	return out, nil
 }
+
+// APICloudEventType is a CloudEvents type that webhook subscribers can
+// receive, along with the URL of its JSON Schema.
+type APICloudEventType struct {
+	Type      *string `json:"type"`
+	SchemaURL *string `json:"schema_url"`
+}
@@ -54,3 +54,42 @@ func TestSubscriptionModels(t *testing.T) {
 	assert.NoError(err)
 	assert.EqualValues(subscription, origSubscription)
 }
+
+func TestSubscriptionModelsWithDigest(t *testing.T) {
+	subscription := event.Subscription{
+		ID:           mgobson.NewObjectId().Hex(),
+		ResourceType: event.ResourceTypeTask,
+		Trigger:      event.TriggerFailure,
+		Owner:        "me",
+		OwnerType:    event.OwnerTypePerson,
+		Selectors: []event.Selector{
+			{
+				Type: event.SelectorProject,
+				Data: "mci",
+			},
+		},
+		RegexSelectors: []event.Selector{},
+		Filter: event.Filter{
+			Project: "mci",
+		},
+		Subscriber: event.Subscriber{
+			Type:   event.SlackSubscriberType,
+			Target: "#evergreen",
+		},
+		Digest: &event.DigestSettings{
+			Interval:        event.DigestIntervalDaily,
+			Timezone:        "America/New_York",
+			DailyHour:       9,
+			QuietHoursStart: 22,
+			QuietHoursEnd:   7,
+		},
+	}
+
+	apiSubscription := APISubscription{}
+	assert.NoError(t, apiSubscription.BuildFromService(subscription))
+	assert.Equal(t, event.DigestIntervalDaily, *apiSubscription.Digest.Interval)
+
+	origSubscription, err := apiSubscription.ToService()
+	assert.NoError(t, err)
+	assert.EqualValues(t, subscription, origSubscription)
+}
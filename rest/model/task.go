@@ -191,6 +191,9 @@ type ApiTaskEndDetail struct {
 	TraceID             *string                   `json:"trace_id"`
 	DiskDevices         []string                  `json:"disk_devices"`
 	ResourceConstraints APIResourceConstraintInfo `json:"resource_constraints"`
+	// RedactionSummary describes the secrets detected and redacted from the
+	// task's logs.
+	RedactionSummary *APIRedactionSummary `json:"redaction_summary,omitempty"`
 }
 
 func (at *ApiTaskEndDetail) BuildFromService(t apimodels.TaskEndDetail) error {
@@ -223,6 +226,11 @@ func (at *ApiTaskEndDetail) BuildFromService(t apimodels.TaskEndDetail) error {
 	apiResourceConstraint.BuildFromService(t.ResourceConstraints)
 	at.ResourceConstraints = apiResourceConstraint
 
+	if t.RedactionSummary != nil {
+		at.RedactionSummary = &APIRedactionSummary{}
+		at.RedactionSummary.BuildFromService(*t.RedactionSummary)
+	}
+
 	return nil
 }
 
@@ -231,6 +239,10 @@ func (ad *ApiTaskEndDetail) ToService() apimodels.TaskEndDetail {
 	for _, failingCmd := range ad.OtherFailingCommands {
 		failingCmds = append(failingCmds, failingCmd.ToService())
 	}
+	var redactionSummary *apimodels.RedactionSummary
+	if ad.RedactionSummary != nil {
+		redactionSummary = ad.RedactionSummary.ToService()
+	}
 	return apimodels.TaskEndDetail{
 		Status:               utility.FromStringPtr(ad.Status),
 		Type:                 utility.FromStringPtr(ad.Type),
@@ -245,6 +257,7 @@ func (ad *ApiTaskEndDetail) ToService() apimodels.TaskEndDetail {
 		TraceID:              utility.FromStringPtr(ad.TraceID),
 		DiskDevices:          ad.DiskDevices,
 		ResourceConstraints:  ad.ResourceConstraints.ToService(),
+		RedactionSummary:     redactionSummary,
 	}
 }
 
@@ -313,6 +326,22 @@ func (ar *APIResourceConstraintInfo) ToService() *apimodels.ResourceConstraintIn
 	}
 }
 
+type APIRedactionSummary struct {
+	// DetectedSecrets maps each type of detected secret to the number of
+	// times it was redacted.
+	DetectedSecrets map[string]int `json:"detected_secrets,omitempty"`
+}
+
+func (ar *APIRedactionSummary) BuildFromService(s apimodels.RedactionSummary) {
+	ar.DetectedSecrets = s.DetectedSecrets
+}
+
+func (ar *APIRedactionSummary) ToService() *apimodels.RedactionSummary {
+	return &apimodels.RedactionSummary{
+		DetectedSecrets: ar.DetectedSecrets,
+	}
+}
+
 // BuildPreviousExecutions adds the given previous executions to the given API task.
 func (at *APITask) BuildPreviousExecutions(ctx context.Context, tasks []task.Task, logURL, parsleyURL string) error {
 	at.PreviousExecutions = make([]APITask, len(tasks))
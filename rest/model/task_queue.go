@@ -1,7 +1,10 @@
 package model
 
 import (
+	"time"
+
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/utility"
 )
 
@@ -35,3 +38,146 @@ func (s *APITaskQueueItem) BuildFromService(tqi model.TaskQueueItem) {
 	s.Priority = tqi.Priority
 	s.ActivatedBy = utility.ToStringPtr(tqi.ActivatedBy)
 }
+
+// APITaskQueueExplanation explains why an undispatched task is not running.
+type APITaskQueueExplanation struct {
+	TaskID    *string `json:"task_id"`
+	Status    *string `json:"status"`
+	Activated bool    `json:"activated"`
+	Priority  int64   `json:"priority"`
+	Distro    *string `json:"distro"`
+	// Reasons are human-readable explanations for why the task is not running.
+	Reasons []string `json:"reasons"`
+
+	InQueue                        bool                      `json:"in_queue"`
+	QueueDistro                    *string                   `json:"queue_distro"`
+	InSecondaryQueue               bool                      `json:"in_secondary_queue"`
+	QueuePosition                  int                       `json:"queue_position"`
+	QueueLength                    int                       `json:"queue_length"`
+	QueueLengthWithDependenciesMet int                       `json:"queue_length_with_dependencies_met"`
+	QueueGeneratedAt               *time.Time                `json:"queue_generated_at"`
+	SortingValueBreakdown          *APISortingValueBreakdown `json:"sorting_value_breakdown"`
+
+	UnmetDependencies []APIUnmetTaskDependency `json:"unmet_dependencies"`
+
+	DistroDisabled   bool `json:"distro_disabled"`
+	DistroHosts      int  `json:"distro_hosts"`
+	DistroMaxHosts   int  `json:"distro_max_hosts"`
+	DistroAtMaxHosts bool `json:"distro_at_max_hosts"`
+
+	TaskGroup           *string `json:"task_group"`
+	TaskGroupHosts      int     `json:"task_group_hosts"`
+	TaskGroupMaxHosts   int     `json:"task_group_max_hosts"`
+	TaskGroupAtMaxHosts bool    `json:"task_group_at_max_hosts"`
+
+	ProjectDisabled            bool `json:"project_disabled"`
+	ProjectDispatchingDisabled bool `json:"project_dispatching_disabled"`
+	TaskDispatchDisabled       bool `json:"task_dispatch_disabled"`
+	SchedulerDisabled          bool `json:"scheduler_disabled"`
+	HostAllocatorDisabled      bool `json:"host_allocator_disabled"`
+}
+
+// APIUnmetTaskDependency is a dependency that a task is waiting on.
+type APIUnmetTaskDependency struct {
+	TaskID         *string `json:"task_id"`
+	DisplayName    *string `json:"display_name"`
+	BuildVariant   *string `json:"build_variant"`
+	Status         *string `json:"status"`
+	RequiredStatus *string `json:"required_status"`
+	Unattainable   bool    `json:"unattainable"`
+}
+
+// APISortingValueBreakdown is how the scheduler weighed a task when ordering
+// its distro's queue. Tasks with a higher total value are dispatched first.
+type APISortingValueBreakdown struct {
+	TotalValue      int64 `json:"total_value"`
+	TaskGroupLength int64 `json:"task_group_length"`
+
+	InitialPriorityImpact     int64 `json:"initial_priority_impact"`
+	TaskGroupPriorityImpact   int64 `json:"task_group_priority_impact"`
+	GeneratorTaskImpact       int64 `json:"generator_task_impact"`
+	CommitQueuePriorityImpact int64 `json:"commit_queue_priority_impact"`
+
+	CommitQueueRankImpact  int64 `json:"commit_queue_rank_impact"`
+	NumDependentsImpact    int64 `json:"num_dependents_impact"`
+	EstimatedRuntimeImpact int64 `json:"estimated_runtime_impact"`
+	MainlineWaitTimeImpact int64 `json:"mainline_wait_time_impact"`
+	StepbackImpact         int64 `json:"stepback_impact"`
+	PatchImpact            int64 `json:"patch_impact"`
+	PatchWaitTimeImpact    int64 `json:"patch_wait_time_impact"`
+
+	FairShareImpact int64 `json:"fair_share_impact"`
+	OverFairShare   bool  `json:"over_fair_share"`
+}
+
+// BuildFromService converts a service level struct to an API level struct.
+func (b *APISortingValueBreakdown) BuildFromService(in task.SortingValueBreakdown) {
+	b.TotalValue = in.TotalValue
+	b.TaskGroupLength = in.TaskGroupLength
+	b.InitialPriorityImpact = in.PriorityBreakdown.InitialPriorityImpact
+	b.TaskGroupPriorityImpact = in.PriorityBreakdown.TaskGroupImpact
+	b.GeneratorTaskImpact = in.PriorityBreakdown.GeneratorTaskImpact
+	b.CommitQueuePriorityImpact = in.PriorityBreakdown.CommitQueueImpact
+	b.CommitQueueRankImpact = in.RankValueBreakdown.CommitQueueImpact
+	b.NumDependentsImpact = in.RankValueBreakdown.NumDependentsImpact
+	b.EstimatedRuntimeImpact = in.RankValueBreakdown.EstimatedRuntimeImpact
+	b.MainlineWaitTimeImpact = in.RankValueBreakdown.MainlineWaitTimeImpact
+	b.StepbackImpact = in.RankValueBreakdown.StepbackImpact
+	b.PatchImpact = in.RankValueBreakdown.PatchImpact
+	b.PatchWaitTimeImpact = in.RankValueBreakdown.PatchWaitTimeImpact
+	b.FairShareImpact = in.FairShareImpact
+	b.OverFairShare = in.OverFairShare
+}
+
+// BuildFromService converts a service level struct to an API level struct.
+func (e *APITaskQueueExplanation) BuildFromService(in model.TaskQueueExplanation) {
+	e.TaskID = utility.ToStringPtr(in.TaskID)
+	e.Status = utility.ToStringPtr(in.Status)
+	e.Activated = in.Activated
+	e.Priority = in.Priority
+	e.Distro = utility.ToStringPtr(in.DistroID)
+	e.Reasons = in.Reasons
+	if e.Reasons == nil {
+		e.Reasons = []string{}
+	}
+
+	e.InQueue = in.InQueue
+	e.QueueDistro = utility.ToStringPtr(in.QueueDistroID)
+	e.InSecondaryQueue = in.InSecondaryQueue
+	e.QueuePosition = in.QueuePosition
+	e.QueueLength = in.QueueLength
+	e.QueueLengthWithDependenciesMet = in.QueueLengthWithDependenciesMet
+	e.QueueGeneratedAt = ToTimePtr(in.QueueGeneratedAt)
+	if in.SortingValueBreakdown != nil {
+		e.SortingValueBreakdown = &APISortingValueBreakdown{}
+		e.SortingValueBreakdown.BuildFromService(*in.SortingValueBreakdown)
+	}
+
+	e.UnmetDependencies = make([]APIUnmetTaskDependency, 0, len(in.UnmetDependencies))
+	for _, dep := range in.UnmetDependencies {
+		e.UnmetDependencies = append(e.UnmetDependencies, APIUnmetTaskDependency{
+			TaskID:         utility.ToStringPtr(dep.TaskID),
+			DisplayName:    utility.ToStringPtr(dep.DisplayName),
+			BuildVariant:   utility.ToStringPtr(dep.BuildVariant),
+			Status:         utility.ToStringPtr(dep.Status),
+			RequiredStatus: utility.ToStringPtr(dep.RequiredStatus),
+			Unattainable:   dep.Unattainable,
+		})
+	}
+
+	e.DistroDisabled = in.DistroDisabled
+	e.DistroHosts = in.DistroHosts
+	e.DistroMaxHosts = in.DistroMaxHosts
+	e.DistroAtMaxHosts = in.DistroAtMaxHosts
+
+	e.TaskGroup = utility.ToStringPtr(in.TaskGroup)
+	e.TaskGroupHosts = in.TaskGroupHosts
+	e.TaskGroupMaxHosts = in.TaskGroupMaxHosts
+	e.TaskGroupAtMaxHosts = in.TaskGroupAtMaxHosts
+
+	e.ProjectDisabled = in.ProjectDisabled
+	e.ProjectDispatchingDisabled = in.ProjectDispatchingDisabled
+	e.TaskDispatchDisabled = in.TaskDispatchDisabled
+	e.SchedulerDisabled = in.SchedulerDisabled
+	e.HostAllocatorDisabled = in.HostAllocatorDisabled
+}
@@ -0,0 +1,140 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+func validateDeadLetterSubscriberType(subscriberType string) error {
+	if subscriberType == "" || utility.StringSliceContains(event.SubscriberTypes, subscriberType) {
+		return nil
+	}
+	return gimlet.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Message:    fmt.Sprintf("invalid subscriber type '%s'", subscriberType),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /admin/notifications/dead_letter
+
+type deadLetteredNotificationsGetHandler struct {
+	subscriberType string
+	limit          int
+}
+
+func makeFetchDeadLetteredNotifications() gimlet.RouteHandler {
+	return &deadLetteredNotificationsGetHandler{}
+}
+
+func (h *deadLetteredNotificationsGetHandler) Factory() gimlet.RouteHandler {
+	return &deadLetteredNotificationsGetHandler{}
+}
+
+func (h *deadLetteredNotificationsGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	vals := r.URL.Query()
+	h.subscriberType = vals.Get("subscriber_type")
+	if err := validateDeadLetterSubscriberType(h.subscriberType); err != nil {
+		return err
+	}
+
+	var err error
+	h.limit, err = getLimit(vals)
+	return errors.WithStack(err)
+}
+
+func (h *deadLetteredNotificationsGetHandler) Run(ctx context.Context) gimlet.Responder {
+	notifications, err := notification.FindDeadLettered(ctx, notification.DeadLetterFilter{SubscriberType: h.subscriberType}, h.limit)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	resp := gimlet.NewResponseBuilder()
+	catcher := grip.NewBasicCatcher()
+	for _, n := range notifications {
+		apiNotification := model.APIDeadLetteredNotification{}
+		apiNotification.BuildFromService(n)
+		catcher.Wrapf(resp.AddData(apiNotification), "adding data for notification '%s'", n.ID)
+	}
+	if catcher.HasErrors() {
+		return gimlet.MakeJSONInternalErrorResponder(catcher.Resolve())
+	}
+
+	return resp
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /admin/notifications/dead_letter/replay
+// POST /admin/notifications/dead_letter/purge
+
+type deadLetteredNotificationsActionHandler struct {
+	purge  bool
+	filter notification.DeadLetterFilter
+}
+
+func makeReplayDeadLetteredNotifications() gimlet.RouteHandler {
+	return &deadLetteredNotificationsActionHandler{}
+}
+
+func makePurgeDeadLetteredNotifications() gimlet.RouteHandler {
+	return &deadLetteredNotificationsActionHandler{purge: true}
+}
+
+func (h *deadLetteredNotificationsActionHandler) Factory() gimlet.RouteHandler {
+	return &deadLetteredNotificationsActionHandler{purge: h.purge}
+}
+
+func (h *deadLetteredNotificationsActionHandler) Parse(ctx context.Context, r *http.Request) error {
+	apiFilter := model.APIDeadLetterFilter{}
+	if err := utility.ReadJSON(r.Body, &apiFilter); err != nil {
+		return errors.Wrap(err, "reading dead letter filter from JSON request body")
+	}
+
+	h.filter = apiFilter.ToService()
+	if len(h.filter.IDs) == 0 && h.filter.SubscriberType == "" && !apiFilter.All {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "must specify notification IDs, a subscriber type, or all notifications",
+		}
+	}
+
+	return validateDeadLetterSubscriberType(h.filter.SubscriberType)
+}
+
+func (h *deadLetteredNotificationsActionHandler) Run(ctx context.Context) gimlet.Responder {
+	var (
+		count int
+		err   error
+	)
+	if h.purge {
+		count, err = notification.PurgeDeadLettered(ctx, h.filter)
+	} else {
+		count, err = notification.ReplayDeadLettered(ctx, h.filter)
+	}
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":         "handled dead lettered notifications",
+		"purge":           h.purge,
+		"ids":             h.filter.IDs,
+		"subscriber_type": h.filter.SubscriberType,
+		"count":           count,
+		"user":            MustHaveUser(ctx).Username(),
+	})
+
+	return gimlet.NewJSONResponse(model.APIDeadLetterResult{Count: count})
+}
@@ -0,0 +1,74 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetteredNotificationsParse(t *testing.T) {
+	t.Run("GetRejectsInvalidSubscriberType", func(t *testing.T) {
+		r, err := http.NewRequest(http.MethodGet, "/admin/notifications/dead_letter?subscriber_type=carrier-pigeon", nil)
+		require.NoError(t, err)
+		assert.Error(t, makeFetchDeadLetteredNotifications().Parse(context.Background(), r))
+	})
+	t.Run("GetParsesSubscriberTypeAndLimit", func(t *testing.T) {
+		r, err := http.NewRequest(http.MethodGet, "/admin/notifications/dead_letter?subscriber_type=slack&limit=5", nil)
+		require.NoError(t, err)
+		h := makeFetchDeadLetteredNotifications().(*deadLetteredNotificationsGetHandler)
+		require.NoError(t, h.Parse(context.Background(), r))
+		assert.Equal(t, event.SlackSubscriberType, h.subscriberType)
+		assert.Equal(t, 5, h.limit)
+	})
+	for name, testCase := range map[string]struct {
+		body          string
+		errorExpected bool
+	}{
+		"EmptyFilterIsInvalid": {
+			body:          `{}`,
+			errorExpected: true,
+		},
+		"InvalidSubscriberType": {
+			body:          `{"subscriber_type": "carrier-pigeon"}`,
+			errorExpected: true,
+		},
+		"IDs": {
+			body: `{"ids": ["n1", "n2"]}`,
+		},
+		"SubscriberType": {
+			body: `{"subscriber_type": "evergreen-webhook"}`,
+		},
+		"All": {
+			body: `{"all": true}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, makeHandler := range []func() *deadLetteredNotificationsActionHandler{
+				func() *deadLetteredNotificationsActionHandler {
+					return makeReplayDeadLetteredNotifications().Factory().(*deadLetteredNotificationsActionHandler)
+				},
+				func() *deadLetteredNotificationsActionHandler {
+					return makePurgeDeadLetteredNotifications().Factory().(*deadLetteredNotificationsActionHandler)
+				},
+			} {
+				r, err := http.NewRequest(http.MethodPost, "/admin/notifications/dead_letter/replay", bytes.NewBufferString(testCase.body))
+				require.NoError(t, err)
+				err = makeHandler().Parse(context.Background(), r)
+				if testCase.errorExpected {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+	t.Run("FactoryPreservesPurge", func(t *testing.T) {
+		assert.True(t, makePurgeDeadLetteredNotifications().Factory().(*deadLetteredNotificationsActionHandler).purge)
+		assert.False(t, makeReplayDeadLetteredNotifications().Factory().(*deadLetteredNotificationsActionHandler).purge)
+	})
+}
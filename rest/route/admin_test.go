@@ -178,6 +178,7 @@ func (s *AdminRouteSuite) TestAdminRoute() {
 	s.EqualValues(testSettings.Buckets.RetryFailedLogMoveLookbackDays, settings.Buckets.RetryFailedLogMoveLookbackDays)
 	s.EqualValues(testSettings.Buckets.RetryFailedLogMoveMaxJobsPerRun, settings.Buckets.RetryFailedLogMoveMaxJobsPerRun)
 	s.EqualValues(testSettings.Buckets.TestResultsBucket, settings.Buckets.TestResultsBucket)
+	s.EqualValues(testSettings.Buckets.TaskFilesBucket, settings.Buckets.TaskFilesBucket)
 	s.EqualValues(testSettings.Buckets.Credentials.Key, settings.Buckets.Credentials.Key)
 	s.EqualValues(testSettings.Buckets.Credentials.Secret, settings.Buckets.Credentials.Secret)
 	s.EqualValues(testSettings.Buckets.Credentials.Bucket, settings.Buckets.Credentials.Bucket)
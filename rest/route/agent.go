@@ -16,15 +16,18 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/coverage"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/githubapp"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/s3lifecycle"
 	"github.com/evergreen-ci/evergreen/model/s3usage"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/taskfiles"
 	"github.com/evergreen-ci/evergreen/model/testlog"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/thirdparty"
@@ -763,6 +766,282 @@ func (h *attachFilesHandler) Run(ctx context.Context) gimlet.Responder {
 	return gimlet.NewJSONResponse(fmt.Sprintf("Artifact files for task %s successfully attached", t.Id))
 }
 
+// POST /task/{task_id}/coverage
+type attachCoverageHandler struct {
+	taskID string
+	files  []coverage.FileCoverage
+}
+
+func makeAttachCoverage() gimlet.RouteHandler {
+	return &attachCoverageHandler{}
+}
+
+func (h *attachCoverageHandler) Factory() gimlet.RouteHandler {
+	return &attachCoverageHandler{}
+}
+
+func (h *attachCoverageHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+	if err := utility.ReadJSON(r.Body, &h.files); err != nil {
+		return errors.Wrapf(err, "reading coverage for task '%s'", h.taskID)
+	}
+	return nil
+}
+
+// Run merges the coverage into the coverage already reported by the task.
+func (h *attachCoverageHandler) Run(ctx context.Context) gimlet.Responder {
+	t := MustHaveTask(ctx)
+
+	taskCoverage := &coverage.TaskCoverage{
+		TaskID:       t.Id,
+		Execution:    t.Execution,
+		TaskName:     t.DisplayName,
+		BuildVariant: t.BuildVariant,
+		Version:      t.Version,
+		Project:      t.Project,
+		Requester:    t.Requester,
+	}
+	if err := taskCoverage.AddFiles(ctx, h.files); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "attaching coverage for task '%s'", t.Id))
+	}
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// POST /task/{task_id}/resource_usage
+type attachResourceUsageHandler struct {
+	taskID  string
+	samples []resourceusage.Sample
+}
+
+func makeAttachResourceUsage() gimlet.RouteHandler {
+	return &attachResourceUsageHandler{}
+}
+
+func (h *attachResourceUsageHandler) Factory() gimlet.RouteHandler {
+	return &attachResourceUsageHandler{}
+}
+
+func (h *attachResourceUsageHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+	if err := utility.ReadJSON(r.Body, &h.samples); err != nil {
+		return errors.Wrapf(err, "reading resource usage for task '%s'", h.taskID)
+	}
+	return nil
+}
+
+// Run stores the resource usage time series for the task's current execution.
+func (h *attachResourceUsageHandler) Run(ctx context.Context) gimlet.Responder {
+	t := MustHaveTask(ctx)
+
+	usage := &resourceusage.TaskResourceUsage{
+		TaskID:    t.Id,
+		Execution: t.Execution,
+		Samples:   h.samples,
+	}
+	if err := usage.Upsert(ctx); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "attaching resource usage for task '%s'", t.Id))
+	}
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// POST /task/{task_id}/structured_outputs
+type setStructuredOutputsHandler struct {
+	outputs map[string]task.StructuredOutput
+}
+
+func makeSetStructuredOutputs() gimlet.RouteHandler {
+	return &setStructuredOutputsHandler{}
+}
+
+func (h *setStructuredOutputsHandler) Factory() gimlet.RouteHandler {
+	return &setStructuredOutputsHandler{}
+}
+
+func (h *setStructuredOutputsHandler) Parse(ctx context.Context, r *http.Request) error {
+	if err := utility.ReadJSON(r.Body, &h.outputs); err != nil {
+		return errors.Wrap(err, "reading structured outputs")
+	}
+	return errors.Wrap(task.ValidateStructuredOutputs(h.outputs), "validating structured outputs")
+}
+
+// Run adds the structured outputs to the task's current execution.
+func (h *setStructuredOutputsHandler) Run(ctx context.Context) gimlet.Responder {
+	t := MustHaveTask(ctx)
+
+	numOutputs := len(t.StructuredOutputs)
+	for key := range h.outputs {
+		if _, ok := t.StructuredOutputs[key]; !ok {
+			numOutputs++
+		}
+	}
+	if numOutputs > task.MaxStructuredOutputs {
+		return gimlet.MakeJSONErrorResponder(errors.Errorf("task cannot set more than %d structured outputs", task.MaxStructuredOutputs))
+	}
+
+	if err := task.SetStructuredOutputs(ctx, t.Id, t.Execution, h.outputs); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "setting structured outputs for task '%s'", t.Id))
+	}
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// POST /task/{task_id}/task_files/upload_url
+type getTaskFilesUploadURLHandler struct {
+	name string
+
+	env evergreen.Environment
+}
+
+func makeGetTaskFilesUploadURL(env evergreen.Environment) gimlet.RouteHandler {
+	return &getTaskFilesUploadURLHandler{env: env}
+}
+
+func (h *getTaskFilesUploadURLHandler) Factory() gimlet.RouteHandler {
+	return &getTaskFilesUploadURLHandler{env: h.env}
+}
+
+func (h *getTaskFilesUploadURLHandler) Parse(ctx context.Context, r *http.Request) error {
+	var req apimodels.TaskFilesPublishRequest
+	if err := utility.ReadJSON(r.Body, &req); err != nil {
+		return errors.Wrap(err, "reading task files publish request")
+	}
+	h.name = req.Name
+	return taskfiles.ValidateName(h.name)
+}
+
+// Run returns a presigned URL to upload the archive for the file set from the
+// task's current execution.
+func (h *getTaskFilesUploadURLHandler) Run(ctx context.Context) gimlet.Responder {
+	t := MustHaveTask(ctx)
+
+	key := taskfiles.Key(t.Version, t.Id, t.Execution, h.name)
+	url, err := taskfiles.PresignUpload(ctx, h.env.Settings().Buckets, key)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting upload URL for task files '%s'", h.name))
+	}
+	return gimlet.NewJSONResponse(apimodels.TaskFilesURLResponse{URL: url})
+}
+
+// POST /task/{task_id}/task_files
+type publishTaskFilesHandler struct {
+	req apimodels.TaskFilesPublishRequest
+}
+
+func makePublishTaskFiles() gimlet.RouteHandler {
+	return &publishTaskFilesHandler{}
+}
+
+func (h *publishTaskFilesHandler) Factory() gimlet.RouteHandler {
+	return &publishTaskFilesHandler{}
+}
+
+func (h *publishTaskFilesHandler) Parse(ctx context.Context, r *http.Request) error {
+	if err := utility.ReadJSON(r.Body, &h.req); err != nil {
+		return errors.Wrap(err, "reading task files publish request")
+	}
+	return taskfiles.ValidateName(h.req.Name)
+}
+
+// Run records the file set uploaded by the task's current execution so that
+// its dependent tasks can fetch it.
+func (h *publishTaskFilesHandler) Run(ctx context.Context) gimlet.Responder {
+	t := MustHaveTask(ctx)
+
+	fs := &taskfiles.TaskFileSet{
+		Version:   t.Version,
+		TaskID:    t.Id,
+		Execution: t.Execution,
+		Name:      h.req.Name,
+		Key:       taskfiles.Key(t.Version, t.Id, t.Execution, h.req.Name),
+		SizeBytes: h.req.SizeBytes,
+	}
+	if err := fs.Upsert(ctx); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "publishing task files '%s' for task '%s'", h.req.Name, t.Id))
+	}
+	return gimlet.NewJSONResponse(struct{}{})
+}
+
+// POST /task/{task_id}/task_files/download_url
+type getTaskFilesDownloadURLHandler struct {
+	req apimodels.TaskFilesFetchRequest
+
+	env evergreen.Environment
+}
+
+func makeGetTaskFilesDownloadURL(env evergreen.Environment) gimlet.RouteHandler {
+	return &getTaskFilesDownloadURLHandler{env: env}
+}
+
+func (h *getTaskFilesDownloadURLHandler) Factory() gimlet.RouteHandler {
+	return &getTaskFilesDownloadURLHandler{env: h.env}
+}
+
+func (h *getTaskFilesDownloadURLHandler) Parse(ctx context.Context, r *http.Request) error {
+	if err := utility.ReadJSON(r.Body, &h.req); err != nil {
+		return errors.Wrap(err, "reading task files fetch request")
+	}
+	if h.req.Task == "" {
+		return errors.New("must specify the task that published the files")
+	}
+	return taskfiles.ValidateName(h.req.Name)
+}
+
+// Run returns a presigned URL to download the file set published by the
+// requested dependency. The dependency must be one of the task's direct
+// dependencies, and its file set is taken from its latest execution.
+func (h *getTaskFilesDownloadURLHandler) Run(ctx context.Context) gimlet.Responder {
+	t := MustHaveTask(ctx)
+
+	variant := h.req.Variant
+	if variant == "" {
+		variant = t.BuildVariant
+	}
+
+	depIDs := make([]string, 0, len(t.DependsOn))
+	for _, dep := range t.DependsOn {
+		depIDs = append(depIDs, dep.TaskId)
+	}
+	var dep *task.Task
+	if len(depIDs) > 0 {
+		deps, err := task.FindAll(ctx, db.Query(task.ByIds(depIDs)))
+		if err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding dependencies for task '%s'", t.Id))
+		}
+		for i := range deps {
+			if deps[i].DisplayName == h.req.Task && deps[i].BuildVariant == variant {
+				dep = &deps[i]
+				break
+			}
+		}
+	}
+	if dep == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("task '%s' on build variant '%s' is not a dependency of task '%s'", h.req.Task, variant, t.Id),
+		})
+	}
+
+	fs, err := taskfiles.FindOne(ctx, taskfiles.ByTaskExecutionAndName(dep.Id, dep.Execution, h.req.Name))
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task files '%s' for task '%s'", h.req.Name, dep.Id))
+	}
+	if fs == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' execution %d did not publish task files '%s'", dep.Id, dep.Execution, h.req.Name),
+		})
+	}
+
+	url, err := taskfiles.PresignDownload(ctx, h.env.Settings().Buckets, fs.Key)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "getting download URL for task files '%s'", h.req.Name))
+	}
+	return gimlet.NewJSONResponse(apimodels.TaskFilesURLResponse{URL: url})
+}
+
 // discoverAndCacheBucketLifecycleRules will look at all the buckets that the files are being uploaded
 // to and check if we have lifecycle rules cached for them. If not, it will attempt to discover
 // and cache them. This is best-effort and will not fail the file upload if discovery fails.
@@ -26,6 +26,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/s3lifecycle"
 	"github.com/evergreen-ci/evergreen/model/s3usage"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/taskfiles"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/testutil"
@@ -1751,6 +1752,148 @@ func TestReportS3Usage(t *testing.T) {
 	}
 }
 
+func TestSetStructuredOutputsHandler(t *testing.T) {
+	ctx := t.Context()
+
+	for tName, tCase := range map[string]func(t *testing.T, tsk *task.Task){
+		"SetsOutputs": func(t *testing.T, tsk *task.Task) {
+			handler := makeSetStructuredOutputs().(*setStructuredOutputsHandler)
+			handler.outputs = map[string]task.StructuredOutput{
+				"build_id": {Type: task.StructuredOutputTypeString, Value: "abc"},
+			}
+			resp := handler.Run(context.WithValue(ctx, model.ApiTaskKey, tsk))
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			dbTask, err := task.FindOneId(ctx, tsk.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbTask)
+			assert.Equal(t, handler.outputs, dbTask.StructuredOutputs)
+		},
+		"FailsWithTooManyOutputs": func(t *testing.T, tsk *task.Task) {
+			tsk.StructuredOutputs = map[string]task.StructuredOutput{}
+			for i := 0; i < task.MaxStructuredOutputs; i++ {
+				tsk.StructuredOutputs[fmt.Sprintf("output_%d", i)] = task.StructuredOutput{Type: task.StructuredOutputTypeInt, Value: "1"}
+			}
+			handler := makeSetStructuredOutputs().(*setStructuredOutputsHandler)
+			handler.outputs = map[string]task.StructuredOutput{
+				"output_0": {Type: task.StructuredOutputTypeInt, Value: "2"},
+				"build_id": {Type: task.StructuredOutputTypeString, Value: "abc"},
+			}
+			resp := handler.Run(context.WithValue(ctx, model.ApiTaskKey, tsk))
+			assert.Equal(t, http.StatusBadRequest, resp.Status())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection))
+			tsk := &task.Task{Id: "task_id"}
+			require.NoError(t, tsk.Insert(ctx))
+			tCase(t, tsk)
+		})
+	}
+}
+
+func TestTaskFilesHandlers(t *testing.T) {
+	ctx := t.Context()
+
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(ctx))
+	env.EvergreenSettings.Buckets.TaskFilesBucket = evergreen.BucketConfig{
+		Name: "task-files",
+		Type: evergreen.BucketTypeS3,
+	}
+	env.EvergreenSettings.Buckets.Credentials = evergreen.S3Credentials{
+		Key:    "key",
+		Secret: "secret",
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, producer, consumer *task.Task){
+		"PublishRecordsFileSet": func(t *testing.T, producer, consumer *task.Task) {
+			handler := makePublishTaskFiles().(*publishTaskFilesHandler)
+			handler.req = apimodels.TaskFilesPublishRequest{Name: "binaries", SizeBytes: 10}
+			resp := handler.Run(context.WithValue(ctx, model.ApiTaskKey, producer))
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			fs, err := taskfiles.FindOne(ctx, taskfiles.ByTaskExecutionAndName(producer.Id, producer.Execution, "binaries"))
+			require.NoError(t, err)
+			require.NotNil(t, fs)
+			assert.Equal(t, producer.Version, fs.Version)
+			assert.Equal(t, taskfiles.Key(producer.Version, producer.Id, producer.Execution, "binaries"), fs.Key)
+			assert.EqualValues(t, 10, fs.SizeBytes)
+		},
+		"UploadURLIsPresignedForTaskKey": func(t *testing.T, producer, consumer *task.Task) {
+			handler := makeGetTaskFilesUploadURL(env).(*getTaskFilesUploadURLHandler)
+			handler.name = "binaries"
+			resp := handler.Run(context.WithValue(ctx, model.ApiTaskKey, producer))
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			urlResp, ok := resp.Data().(apimodels.TaskFilesURLResponse)
+			require.True(t, ok)
+			assert.Contains(t, urlResp.URL, "task-files")
+			assert.Contains(t, urlResp.URL, taskfiles.Key(producer.Version, producer.Id, producer.Execution, "binaries"))
+		},
+		"DownloadURLIsPresignedForDependencyFileSet": func(t *testing.T, producer, consumer *task.Task) {
+			fs := &taskfiles.TaskFileSet{
+				Version:   producer.Version,
+				TaskID:    producer.Id,
+				Execution: producer.Execution,
+				Name:      "binaries",
+				Key:       taskfiles.Key(producer.Version, producer.Id, producer.Execution, "binaries"),
+			}
+			require.NoError(t, fs.Upsert(ctx))
+
+			handler := makeGetTaskFilesDownloadURL(env).(*getTaskFilesDownloadURLHandler)
+			handler.req = apimodels.TaskFilesFetchRequest{Name: "binaries", Task: producer.DisplayName}
+			resp := handler.Run(context.WithValue(ctx, model.ApiTaskKey, consumer))
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			urlResp, ok := resp.Data().(apimodels.TaskFilesURLResponse)
+			require.True(t, ok)
+			assert.Contains(t, urlResp.URL, fs.Key)
+		},
+		"DownloadFailsForTaskThatIsNotADependency": func(t *testing.T, producer, consumer *task.Task) {
+			handler := makeGetTaskFilesDownloadURL(env).(*getTaskFilesDownloadURLHandler)
+			handler.req = apimodels.TaskFilesFetchRequest{Name: "binaries", Task: consumer.DisplayName}
+			resp := handler.Run(context.WithValue(ctx, model.ApiTaskKey, producer))
+			assert.Equal(t, http.StatusBadRequest, resp.Status())
+		},
+		"DownloadFailsForDependencyOnOtherVariant": func(t *testing.T, producer, consumer *task.Task) {
+			handler := makeGetTaskFilesDownloadURL(env).(*getTaskFilesDownloadURLHandler)
+			handler.req = apimodels.TaskFilesFetchRequest{Name: "binaries", Task: producer.DisplayName, Variant: "other_bv"}
+			resp := handler.Run(context.WithValue(ctx, model.ApiTaskKey, consumer))
+			assert.Equal(t, http.StatusBadRequest, resp.Status())
+		},
+		"DownloadFailsWhenDependencyDidNotPublish": func(t *testing.T, producer, consumer *task.Task) {
+			handler := makeGetTaskFilesDownloadURL(env).(*getTaskFilesDownloadURLHandler)
+			handler.req = apimodels.TaskFilesFetchRequest{Name: "binaries", Task: producer.DisplayName}
+			resp := handler.Run(context.WithValue(ctx, model.ApiTaskKey, consumer))
+			assert.Equal(t, http.StatusNotFound, resp.Status())
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(task.Collection, taskfiles.Collection))
+
+			producer := &task.Task{
+				Id:           "producer",
+				DisplayName:  "compile",
+				BuildVariant: "bv",
+				Version:      "v1",
+				Execution:    1,
+			}
+			require.NoError(t, producer.Insert(ctx))
+			consumer := &task.Task{
+				Id:           "consumer",
+				DisplayName:  "test",
+				BuildVariant: "bv",
+				Version:      "v1",
+				DependsOn:    []task.Dependency{{TaskId: producer.Id}},
+			}
+			require.NoError(t, consumer.Insert(ctx))
+
+			tCase(t, producer, consumer)
+		})
+	}
+}
+
 func TestLogLookupClosureUsesAdminBucketsConfig(t *testing.T) {
 	days90 := 90
 	cfg := &evergreen.BucketsConfig{
@@ -0,0 +1,66 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/scheduler"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/distros/{distro_id}/scheduler_snapshot
+
+type distroSchedulerSnapshotHandler struct {
+	distroID string
+	env      evergreen.Environment
+}
+
+func makeGetDistroSchedulerSnapshot(env evergreen.Environment) gimlet.RouteHandler {
+	return &distroSchedulerSnapshotHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a scheduler snapshot of a distro
+//	@Description	Records the tasks waiting to run in the distro and its up hosts, along with the distro's resolved planner and host allocator settings. The snapshot can be replayed offline with "evergreen admin scheduler replay" to project how different planner and host allocator settings would handle the same traffic.
+//	@Tags			distros
+//	@Router			/distros/{distro_id}/scheduler_snapshot [get]
+//	@Security		Api-User || Api-Key
+//	@Param			distro_id	path		string	true	"distro ID"
+//	@Success		200			{object}	model.SchedulerSnapshot
+func (h *distroSchedulerSnapshotHandler) Factory() gimlet.RouteHandler {
+	return &distroSchedulerSnapshotHandler{env: h.env}
+}
+
+func (h *distroSchedulerSnapshotHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.distroID = gimlet.GetVars(r)["distro_id"]; h.distroID == "" {
+		return errors.New("missing distro ID")
+	}
+	return nil
+}
+
+func (h *distroSchedulerSnapshotHandler) Run(ctx context.Context) gimlet.Responder {
+	d, err := distro.FindOneId(ctx, h.distroID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding distro '%s'", h.distroID))
+	}
+	if d == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("distro '%s' not found", h.distroID),
+		})
+	}
+
+	snapshot, err := scheduler.CaptureSnapshot(ctx, h.env.Settings(), d.Id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "capturing scheduler snapshot for distro '%s'", h.distroID))
+	}
+
+	return gimlet.NewJSONResponse(snapshot)
+}
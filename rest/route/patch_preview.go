@@ -0,0 +1,108 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/patches/{patch_id}/preview
+
+type patchPreviewHandler struct {
+	patchId   string
+	selection model.APIPatchPreviewSelection
+	env       evergreen.Environment
+}
+
+func makePatchPreviewHandler(env evergreen.Environment) gimlet.RouteHandler {
+	return &patchPreviewHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Preview patch tasks
+//	@Description	Resolves the variant and task selection for a patch and returns the tasks that would be created if it were finalized, including tasks that are only added as dependencies, along with estimated runtime and cost. Nothing is scheduled. If no selection is given, the patch's current variants and tasks are previewed.
+//	@Tags			patches
+//	@Router			/patches/{patch_id}/preview [post]
+//	@Security		Api-User || Api-Key
+//	@Param			patch_id	path		string					true	"patch ID"
+//	@Param			{object}	body		model.APIPatchPreviewSelection	false	"variants, tasks and aliases to preview"
+//	@Success		200			{object}	model.APIPatchPreview
+func (h *patchPreviewHandler) Factory() gimlet.RouteHandler {
+	return &patchPreviewHandler{env: h.env}
+}
+
+func (h *patchPreviewHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.patchId = gimlet.GetVars(r)["patch_id"]
+	if h.patchId == "" {
+		return errors.New("must specify a patch ID")
+	}
+	if !patch.IsValidId(h.patchId) {
+		return errors.Errorf("'%s' is not a valid patch ID", h.patchId)
+	}
+
+	if r.Body == nil || r.ContentLength == 0 {
+		return nil
+	}
+	body := utility.NewRequestReader(r)
+	defer body.Close()
+	if err := utility.ReadJSON(body, &h.selection); err != nil {
+		return errors.Wrap(err, "reading selection from JSON request body")
+	}
+	return nil
+}
+
+func (h *patchPreviewHandler) Run(ctx context.Context) gimlet.Responder {
+	p, err := patch.FindOneId(ctx, h.patchId)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding patch '%s'", h.patchId))
+	}
+	if p == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("patch '%s' not found", h.patchId),
+		})
+	}
+
+	project, _, err := dbModel.FindAndTranslateProjectForPatch(ctx, h.env.Settings(), p)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding project for patch '%s'", h.patchId))
+	}
+
+	if !h.selection.IsEmpty() {
+		p.BuildVariants = h.selection.Variants
+		p.Tasks = h.selection.Tasks
+		p.RegexBuildVariants = h.selection.RegexVariants
+		p.RegexTasks = h.selection.RegexTasks
+		p.Alias = ""
+		p.Aliases = h.selection.Aliases
+	} else {
+		// The patch's variants and tasks were already resolved when it was
+		// created, so preview them as-is.
+		p.BuildVariants = nil
+		p.Tasks = nil
+		p.RegexBuildVariants = nil
+		p.RegexTasks = nil
+		p.Alias = ""
+		p.Aliases = nil
+	}
+
+	preview, err := dbModel.PreviewPatch(ctx, h.env.Settings(), project, p)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "previewing patch '%s'", h.patchId))
+	}
+
+	apiPreview := model.APIPatchPreview{}
+	apiPreview.BuildFromService(*preview)
+	return gimlet.NewJSONResponse(apiPreview)
+}
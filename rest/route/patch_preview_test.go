@@ -0,0 +1,140 @@
+package route
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/mock"
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const patchPreviewProjectYAML = `
+tasks:
+  - name: compile
+    run_on: [small]
+  - name: unit
+    depends_on:
+      - name: compile
+  - name: lint
+
+buildvariants:
+  - name: ubuntu
+    run_on: [ubuntu]
+    tasks:
+      - name: compile
+      - name: unit
+      - name: lint
+`
+
+func TestPatchPreviewHandlerParse(t *testing.T) {
+	patchID := mgobson.NewObjectId().Hex()
+
+	t.Run("ParsesSelection", func(t *testing.T) {
+		body := []byte(`{"variants": ["ubuntu"], "tasks": ["unit"]}`)
+		req, err := http.NewRequest(http.MethodPost, "/patches/"+patchID+"/preview", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		req = gimlet.SetURLVars(req, map[string]string{"patch_id": patchID})
+
+		handler := makePatchPreviewHandler(&mock.Environment{}).(*patchPreviewHandler)
+		require.NoError(t, handler.Parse(t.Context(), req))
+		assert.Equal(t, patchID, handler.patchId)
+		assert.Equal(t, []string{"ubuntu"}, handler.selection.Variants)
+		assert.Equal(t, []string{"unit"}, handler.selection.Tasks)
+	})
+	t.Run("AllowsEmptyBody", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "/patches/"+patchID+"/preview", nil)
+		require.NoError(t, err)
+		req = gimlet.SetURLVars(req, map[string]string{"patch_id": patchID})
+
+		handler := makePatchPreviewHandler(&mock.Environment{}).(*patchPreviewHandler)
+		require.NoError(t, handler.Parse(t.Context(), req))
+		assert.True(t, handler.selection.IsEmpty())
+	})
+	t.Run("ErrorsForInvalidPatchID", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "/patches/invalid/preview", nil)
+		require.NoError(t, err)
+		req = gimlet.SetURLVars(req, map[string]string{"patch_id": "invalid"})
+
+		handler := makePatchPreviewHandler(&mock.Environment{}).(*patchPreviewHandler)
+		assert.Error(t, handler.Parse(t.Context(), req))
+	})
+}
+
+func TestPatchPreviewHandlerRun(t *testing.T) {
+	require.NoError(t, db.ClearCollections(patch.Collection, serviceModel.ParserProjectCollection, task.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(patch.Collection, serviceModel.ParserProjectCollection, task.Collection))
+	}()
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(t.Context()))
+
+	p := patch.Patch{
+		Id:                   mgobson.NewObjectId(),
+		Project:              "project",
+		ProjectStorageMethod: evergreen.ProjectStorageMethodDB,
+		VariantsTasks: []patch.VariantTasks{
+			{Variant: "ubuntu", Tasks: []string{"lint"}},
+		},
+	}
+	require.NoError(t, p.Insert(t.Context()))
+
+	var project serviceModel.Project
+	pp, err := serviceModel.LoadProjectInto(t.Context(), []byte(patchPreviewProjectYAML), nil, "project", &project)
+	require.NoError(t, err)
+	pp.Id = p.Id.Hex()
+	require.NoError(t, pp.Insert(t.Context()))
+
+	t.Run("IncludesDependenciesOfSelection", func(t *testing.T) {
+		handler := makePatchPreviewHandler(env).(*patchPreviewHandler)
+		handler.patchId = p.Id.Hex()
+		handler.selection = model.APIPatchPreviewSelection{Variants: []string{"ubuntu"}, Tasks: []string{"unit"}}
+
+		res := handler.Run(t.Context())
+		require.Equal(t, http.StatusOK, res.Status(), res.Data())
+		preview, ok := res.Data().(model.APIPatchPreview)
+		require.True(t, ok)
+
+		assert.Equal(t, 2, preview.NumTasks)
+		assert.Equal(t, 1, preview.NumDependencyTasks)
+		tasksByName := map[string]model.APIPatchPreviewTask{}
+		for _, pt := range preview.Tasks {
+			tasksByName[utility.FromStringPtr(pt.DisplayName)] = pt
+		}
+		require.Contains(t, tasksByName, "unit")
+		assert.False(t, tasksByName["unit"].IsDependency)
+		assert.Equal(t, "ubuntu", utility.FromStringPtr(tasksByName["unit"].Distro))
+		require.Contains(t, tasksByName, "compile")
+		assert.True(t, tasksByName["compile"].IsDependency)
+		assert.Equal(t, "small", utility.FromStringPtr(tasksByName["compile"].Distro))
+		assert.Equal(t, 2, preview.NumTasksWithoutDuration, "tasks without history should not have a duration")
+	})
+	t.Run("UsesPatchVariantTasksWithoutSelection", func(t *testing.T) {
+		handler := makePatchPreviewHandler(env).(*patchPreviewHandler)
+		handler.patchId = p.Id.Hex()
+
+		res := handler.Run(t.Context())
+		require.Equal(t, http.StatusOK, res.Status(), res.Data())
+		preview, ok := res.Data().(model.APIPatchPreview)
+		require.True(t, ok)
+
+		require.Len(t, preview.Tasks, 1)
+		assert.Equal(t, "lint", utility.FromStringPtr(preview.Tasks[0].DisplayName))
+		assert.False(t, preview.Tasks[0].IsDependency)
+	})
+	t.Run("ErrorsForNonexistentPatch", func(t *testing.T) {
+		handler := makePatchPreviewHandler(env).(*patchPreviewHandler)
+		handler.patchId = mgobson.NewObjectId().Hex()
+		assert.Equal(t, http.StatusNotFound, handler.Run(t.Context()).Status())
+	})
+}
@@ -395,6 +395,10 @@ func (h *projectIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid Parsley filters"))
 	}
 
+	if err = h.newProjectRef.FlakyTestPolicy.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "invalid flaky test policy"))
+	}
+
 	err = dbModel.ValidateBbProject(ctx, h.newProjectRef.Id, h.newProjectRef.BuildBaronSettings, &h.newProjectRef.TaskAnnotationSettings.FileTicketWebhook)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "validating build baron config"))
@@ -404,6 +408,10 @@ func (h *projectIDPatchHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{StatusCode: http.StatusBadRequest, Message: errors.Wrap(err, "invalid artifact credentials").Error()})
 	}
 
+	if err = h.newProjectRef.SecretDetection.Validate(); err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{StatusCode: http.StatusBadRequest, Message: err.Error()})
+	}
+
 	newRevision := utility.FromStringPtr(h.apiNewProjectRef.Revision)
 	if newRevision != "" {
 		if err = dbModel.UpdateLastRevision(ctx, h.project, newRevision); err != nil {
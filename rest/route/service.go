@@ -87,6 +87,12 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/task/{task_id}/downstreamParams").Version(2).Post().Wrap(requireTask, rateLimit).RouteHandler(makeSetDownstreamParams())
 	app.AddRoute("/task/{task_id}/expansions_and_vars").Version(2).Get().Wrap(requireUserOrTask, rateLimit).RouteHandler(makeGetExpansionsAndVars(settings))
 	app.AddRoute("/task/{task_id}/files").Version(2).Post().Wrap(requireTask, requireHost, rateLimit).RouteHandler(makeAttachFiles())
+	app.AddRoute("/task/{task_id}/coverage").Version(2).Post().Wrap(requireTask, requireHost, rateLimit).RouteHandler(makeAttachCoverage())
+	app.AddRoute("/task/{task_id}/resource_usage").Version(2).Post().Wrap(requireTask, requireHost, rateLimit).RouteHandler(makeAttachResourceUsage())
+	app.AddRoute("/task/{task_id}/structured_outputs").Version(2).Post().Wrap(requireTask, requireHost, rateLimit).RouteHandler(makeSetStructuredOutputs())
+	app.AddRoute("/task/{task_id}/task_files").Version(2).Post().Wrap(requireTask, requireHost, rateLimit).RouteHandler(makePublishTaskFiles())
+	app.AddRoute("/task/{task_id}/task_files/upload_url").Version(2).Post().Wrap(requireTask, requireHost, rateLimit).RouteHandler(makeGetTaskFilesUploadURL(env))
+	app.AddRoute("/task/{task_id}/task_files/download_url").Version(2).Post().Wrap(requireTask, requireHost, rateLimit).RouteHandler(makeGetTaskFilesDownloadURL(env))
 	app.AddRoute("/task/{task_id}/generate").Version(2).Post().Wrap(requireTask, rateLimit).RouteHandler(makeGenerateTasksHandler(env))
 	app.AddRoute("/task/{task_id}/generate").Version(2).Get().Wrap(requireTask, rateLimit).RouteHandler(makeGenerateTasksPollHandler())
 	app.AddRoute("/task/{task_id}/new_push").Version(2).Post().Wrap(requireTask, rateLimit).RouteHandler(makeNewPush())
@@ -121,6 +127,9 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/admin/events").Version(2).Get().Wrap(requireUser, adminSettings).RouteHandler(makeFetchAdminEvents())
 	app.AddRoute("/admin/spawn_hosts").Version(2).Get().Wrap(requireUser, adminSettings).RouteHandler(makeFetchSpawnHostUsage())
 	app.AddRoute("/admin/restart/tasks").Version(2).Post().Wrap(adminSettings).RouteHandler(makeRestartRoute(opts.APIQueue))
+	app.AddRoute("/admin/notifications/dead_letter").Version(2).Get().Wrap(requireUser, adminSettings).RouteHandler(makeFetchDeadLetteredNotifications())
+	app.AddRoute("/admin/notifications/dead_letter/purge").Version(2).Post().Wrap(requireUser, adminSettings).RouteHandler(makePurgeDeadLetteredNotifications())
+	app.AddRoute("/admin/notifications/dead_letter/replay").Version(2).Post().Wrap(requireUser, adminSettings).RouteHandler(makeReplayDeadLetteredNotifications())
 	app.AddRoute("/admin/revert").Version(2).Post().Wrap(requireUser, adminSettings).RouteHandler(makeRevertRouteManager())
 	app.AddRoute("/admin/service_flags").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchServiceFlags())
 	app.AddRoute("/admin/service_flags").Version(2).Post().Wrap(requireUser, adminSettings).RouteHandler(makeSetServiceFlagsRouteManager())
@@ -152,6 +161,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/distros/{distro_id}/setup").Version(2).Get().Wrap(requireUser, editDistroSettings, rateLimit).RouteHandler(makeGetDistroSetup())
 	app.AddRoute("/distros/{distro_id}/setup").Version(2).Patch().Wrap(requireUser, editDistroSettings, rateLimit).RouteHandler(makeChangeDistroSetup())
 	app.AddRoute("/distros/{distro_id}/copy/{new_distro_id}").Version(2).Put().Wrap(requireUser, editDistroSettings, rateLimit).RouteHandler(makeCopyDistro())
+	app.AddRoute("/distros/{distro_id}/scheduler_snapshot").Version(2).Get().Wrap(requireUser, viewDistroSettings, rateLimit).RouteHandler(makeGetDistroSchedulerSnapshot(env))
 
 	app.AddRoute("/hooks/github").Version(2).Post().Wrap(requireValidGithubPayload, rateLimit).RouteHandler(makeGithubHooksRoute(sc, opts.APIQueue, opts.GithubSecret, settings))
 	app.AddRoute("/hooks/aws").Version(2).Post().Wrap(requireValidSNSPayload, rateLimit).RouteHandler(makeEC2SNS(env, opts.APIQueue))
@@ -191,6 +201,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/patches/{patch_id}/raw_modules").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeModuleRawHandler())
 	app.AddRoute("/patches/{patch_id}/restart").Version(2).Post().Wrap(requireUser, submitPatches, rateLimit).RouteHandler(makeRestartPatch())
 	app.AddRoute("/patches/{patch_id}/estimated_generated_tasks").Version(2).Get().Wrap(requireUser, rateLimit).RouteHandler(makeCountEstimatedGeneratedTasks())
+	app.AddRoute("/patches/{patch_id}/preview").Version(2).Post().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makePatchPreviewHandler(env))
 	app.AddRoute("/projects").Version(2).Get().Wrap(requireUser, rateLimit).RouteHandler(makeFetchProjectsRoute())
 	app.AddRoute("/projects/test_alias").Version(2).Get().Wrap(requireUser, rateLimit).RouteHandler(makeGetProjectAliasResultsHandler())
 	app.AddRoute("/projects/{project_id}").Version(2).Delete().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings, rateLimit).RouteHandler(makeDeleteProject())
@@ -206,6 +217,8 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/projects/{project_id}/recent_versions").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeFetchProjectVersionsLegacy())
 	app.AddRoute("/projects/{project_id}/revisions/{commit_hash}/tasks").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeTasksByProjectAndCommitHandler(parsleyURL))
 	app.AddRoute("/projects/{project_id}/task_reliability").Version(2).Get().Wrap(requireUser, rateLimit).RouteHandler(makeGetProjectTaskReliability())
+	app.AddRoute("/projects/{project_id}/test_reliability").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetProjectTestReliability())
+	app.AddRoute("/projects/{project_id}/test_reliability/worst").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetProjectWorstTestReliability())
 	app.AddRoute("/projects/{project_id}/task_stats").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetProjectTaskStats())
 	app.AddRoute("/projects/{project_id}/versions").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetProjectVersionsHandler())
 	app.AddRoute("/projects/{project_id}/versions").Version(2).Patch().Wrap(requireUser, requireProjectAdmin, rateLimit).RouteHandler(makeModifyProjectVersionsHandler())
@@ -229,6 +242,9 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/subscriptions").Version(2).Delete().Wrap(requireUser, rateLimit).RouteHandler(makeDeleteSubscription())
 	app.AddRoute("/subscriptions").Version(2).Get().Wrap(requireUser, rateLimit).RouteHandler(makeFetchSubscription())
 	app.AddRoute("/subscriptions").Version(2).Post().Wrap(requireUser, rateLimit).RouteHandler(makeSetSubscription())
+	// No auth or rate-limit middleware: CloudEvents consumers fetch these from the dataschema URL without Evergreen credentials, and they only describe static types.
+	app.AddRoute("/subscriptions/cloudevents/schemas").Version(2).Get().RouteHandler(makeFetchCloudEventTypes(env))
+	app.AddRoute("/subscriptions/cloudevents/schemas/{type}").Version(2).Get().RouteHandler(makeFetchCloudEventSchema(env))
 	app.AddRoute("/tasks/{task_id}").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetTaskRoute(parsleyURL))
 	app.AddRoute("/tasks/{task_id}").Version(2).Patch().Wrap(requireUser, addProject, editTasks, rateLimit).RouteHandler(makeModifyTaskRoute())
 	// No auth or rate-limit middleware: this endpoint is hit by plain curl from tasks, so it uses in-band HMAC token authentication.
@@ -242,9 +258,11 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/tasks/{task_id}/manifest").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetManifestHandler())
 	app.AddRoute("/tasks/{task_id}/quarantine").Version(2).Post().Wrap(requireUser, addProject, editTasks, rateLimit).RouteHandler(makeTaskQuarantineHandler())
 	app.AddRoute("/tasks/{task_id}/unquarantine").Version(2).Post().Wrap(requireUser, addProject, editTasks, rateLimit).RouteHandler(makeTaskUnquarantineHandler())
+	app.AddRoute("/tasks/{task_id}/resource_usage").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetTaskResourceUsage())
 	app.AddRoute("/tasks/{task_id}/restart").Version(2).Post().Wrap(requireUser, addProject, editTasks, rateLimit).RouteHandler(makeTaskRestartHandler())
 	app.AddRoute("/tasks/{task_id}/tests").Version(2).Get().Wrap(requireUser, addProject, viewTasks, rateLimit).RouteHandler(makeFetchTestsForTask(env, sc))
 	app.AddRoute("/tasks/{task_id}/tests/count").Version(2).Get().Wrap(requireUser, addProject, viewTasks, rateLimit).RouteHandler(makeFetchTestCountForTask())
+	app.AddRoute("/tasks/{task_id}/explain").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeTaskQueueExplainHandler(env))
 	app.AddRoute("/tasks/{task_id}/generated_tasks").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetGeneratedTasks())
 	app.AddRoute("/tasks/{task_id}/build/TaskLogs").Version(2).Get().Wrap(requireUser, viewTasks, compress, rateLimit).RouteHandler(makeGetTaskLogs())
 	app.AddRoute("/tasks/{task_id}/build/TestLogs/{path}").Version(2).Get().Wrap(requireUser, viewTasks, compress, rateLimit).RouteHandler(makeGetTestLogs())
@@ -269,10 +287,13 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/versions/{version_id}").Version(2).Patch().Wrap(requireUser, editTasks, rateLimit).RouteHandler(makePatchVersion())
 	app.AddRoute("/versions/{version_id}/abort").Version(2).Post().Wrap(requireUser, editTasks, rateLimit).RouteHandler(makeAbortVersion())
 	app.AddRoute("/versions/{version_id}/activate_tasks").Version(2).Post().Wrap(requireUser, editTasks, rateLimit).RouteHandler(makeActivateVersionTasks())
+	app.AddRoute("/versions/{version_id}/coverage").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetVersionCoverage())
+	app.AddRoute("/versions/{version_id}/critical_path").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetVersionCriticalPath())
 	app.AddRoute("/versions/{version_id}/builds").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetVersionBuilds(env))
 	app.AddRoute("/versions/{version_id}/restart").Version(2).Post().Wrap(requireUser, editTasks, rateLimit).RouteHandler(makeRestartVersion())
 	app.AddRoute("/versions/{version_id}/annotations").Version(2).Get().Wrap(requireUser, viewAnnotations, rateLimit).RouteHandler(makeFetchAnnotationsByVersion())
 	app.AddRoute("/versions/{version_id}/manifest").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetVersionManifest())
+	app.AddRoute("/versions/{version_id}/parser_project").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetVersionParserProject(env))
 
 	// Diagnostic manifest proof routes compare project and module revision movement across system versions.
 	app.AddRoute("/versions/{version_id}/manifest/proof").Version(2).Get().Wrap(requireUser, viewTasks, rateLimit).RouteHandler(makeGetVersionManifestProof())
@@ -2,6 +2,7 @@ package route
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/evergreen-ci/evergreen"
@@ -9,6 +10,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/trigger"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
@@ -147,3 +149,70 @@ func (s *subscriptionDeleteHandler) Run(ctx context.Context) gimlet.Responder {
 
 	return gimlet.NewJSONResponse(struct{}{})
 }
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/subscriptions/cloudevents/schemas
+
+type cloudEventTypesGetHandler struct {
+	env evergreen.Environment
+}
+
+func makeFetchCloudEventTypes(env evergreen.Environment) gimlet.RouteHandler {
+	return &cloudEventTypesGetHandler{env: env}
+}
+
+func (h *cloudEventTypesGetHandler) Factory() gimlet.RouteHandler {
+	return &cloudEventTypesGetHandler{env: h.env}
+}
+
+func (h *cloudEventTypesGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	return nil
+}
+
+func (h *cloudEventTypesGetHandler) Run(ctx context.Context) gimlet.Responder {
+	apiURL := h.env.Settings().Api.URL
+	types := []model.APICloudEventType{}
+	for _, eventType := range trigger.CloudEventTypes() {
+		types = append(types, model.APICloudEventType{
+			Type:      utility.ToStringPtr(eventType),
+			SchemaURL: utility.ToStringPtr(trigger.CloudEventSchemaURL(apiURL, eventType)),
+		})
+	}
+
+	return gimlet.NewJSONResponse(types)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/subscriptions/cloudevents/schemas/{type}
+
+type cloudEventSchemaGetHandler struct {
+	env       evergreen.Environment
+	eventType string
+}
+
+func makeFetchCloudEventSchema(env evergreen.Environment) gimlet.RouteHandler {
+	return &cloudEventSchemaGetHandler{env: env}
+}
+
+func (h *cloudEventSchemaGetHandler) Factory() gimlet.RouteHandler {
+	return &cloudEventSchemaGetHandler{env: h.env}
+}
+
+func (h *cloudEventSchemaGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.eventType = gimlet.GetVars(r)["type"]
+	return nil
+}
+
+func (h *cloudEventSchemaGetHandler) Run(ctx context.Context) gimlet.Responder {
+	schema, ok := trigger.CloudEventSchema(h.env.Settings().Api.URL, h.eventType)
+	if !ok {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("CloudEvents type '%s' not found", h.eventType),
+		})
+	}
+
+	return gimlet.NewJSONResponse(schema)
+}
@@ -10,12 +10,16 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
 	dbModel "github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/trigger"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -626,3 +630,49 @@ func (s *SubscriptionRouteSuite) TestAcceptSubscriptionWithOnlyRegexSelectors()
 	s.NoError(err)
 	s.NoError(s.postHandler.Parse(ctx, request))
 }
+
+func TestCloudEventSchemaRoutes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	env := &mock.Environment{EvergreenSettings: &evergreen.Settings{Api: evergreen.APIConfig{URL: "https://evergreen.example.com"}}}
+
+	t.Run("ListsTypes", func(t *testing.T) {
+		h := makeFetchCloudEventTypes(env).Factory()
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		types, ok := resp.Data().([]model.APICloudEventType)
+		require.True(t, ok)
+		require.NotEmpty(t, types)
+		for _, eventType := range types {
+			assert.Equal(t, trigger.CloudEventSchemaURL(env.Settings().Api.URL, utility.FromStringPtr(eventType.Type)), utility.FromStringPtr(eventType.SchemaURL))
+		}
+	})
+	t.Run("GetsSchema", func(t *testing.T) {
+		eventType, ok := trigger.CloudEventType(event.ObjectTask, event.TriggerFailure)
+		require.True(t, ok)
+
+		h := makeFetchCloudEventSchema(env).Factory()
+		req, err := http.NewRequest(http.MethodGet, "/subscriptions/cloudevents/schemas/"+eventType, nil)
+		require.NoError(t, err)
+		req = gimlet.SetURLVars(req, map[string]string{"type": eventType})
+		require.NoError(t, h.Parse(ctx, req))
+
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+		schema, ok := resp.Data().(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, trigger.CloudEventSchemaURL(env.Settings().Api.URL, eventType), schema["$id"])
+	})
+	t.Run("UnknownTypeIsNotFound", func(t *testing.T) {
+		h := makeFetchCloudEventSchema(env).Factory()
+		req, err := http.NewRequest(http.MethodGet, "/subscriptions/cloudevents/schemas/ci.evergreen.host.failed", nil)
+		require.NoError(t, err)
+		req = gimlet.SetURLVars(req, map[string]string{"type": "ci.evergreen.host.failed"})
+		require.NoError(t, h.Parse(ctx, req))
+
+		resp := h.Run(ctx)
+		assert.Equal(t, http.StatusNotFound, resp.Status())
+	})
+}
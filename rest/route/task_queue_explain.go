@@ -0,0 +1,69 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/tasks/{task_id}/explain
+
+type taskQueueExplainHandler struct {
+	taskID string
+	env    evergreen.Environment
+}
+
+func makeTaskQueueExplainHandler(env evergreen.Environment) gimlet.RouteHandler {
+	return &taskQueueExplainHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Explain why a task isn't running
+//	@Description	Explains why an undispatched task has not started. Reports the task's position in its distro's queue and the breakdown of the sorting value the scheduler used to order it, any dependencies that aren't met yet, whether the distro is at its maximum number of hosts, whether the task's task group is at its max_hosts, and whether the task is unscheduled or disabled or dispatching is disabled for its distro, project, or all of Evergreen. The reasons field summarizes everything that is holding the task back.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/explain [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id	path		string	true	"task ID"
+//	@Success		200		{object}	model.APITaskQueueExplanation
+func (h *taskQueueExplainHandler) Factory() gimlet.RouteHandler {
+	return &taskQueueExplainHandler{env: h.env}
+}
+
+func (h *taskQueueExplainHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+	return nil
+}
+
+func (h *taskQueueExplainHandler) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	explanation, err := serviceModel.ExplainTaskQueueStatus(ctx, h.env.Settings(), t)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "explaining queue status for task '%s'", h.taskID))
+	}
+
+	apiExplanation := model.APITaskQueueExplanation{}
+	apiExplanation.BuildFromService(*explanation)
+	return gimlet.NewJSONResponse(apiExplanation)
+}
@@ -0,0 +1,103 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskQueueExplainHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(ctx))
+
+	collections := []string{task.Collection, serviceModel.TaskQueuesCollection, serviceModel.TaskSecondaryQueuesCollection, distro.Collection, host.Collection, serviceModel.ProjectRefCollection}
+	require.NoError(t, db.ClearCollections(collections...))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(collections...))
+	}()
+
+	pRef := serviceModel.ProjectRef{Id: "project", Identifier: "project", Enabled: true}
+	require.NoError(t, pRef.Insert(ctx))
+	d := distro.Distro{Id: "d1", HostAllocatorSettings: distro.HostAllocatorSettings{MaximumHosts: 5}}
+	require.NoError(t, d.Insert(ctx))
+	for _, tsk := range []task.Task{
+		{
+			Id:        "queued",
+			Project:   "project",
+			Version:   "v1",
+			DistroId:  "d1",
+			Status:    evergreen.TaskUndispatched,
+			Activated: true,
+		},
+		{
+			Id:        "unknown_distro",
+			Project:   "project",
+			Version:   "v1",
+			DistroId:  "nonexistent",
+			Status:    evergreen.TaskUndispatched,
+			Activated: true,
+		},
+	} {
+		require.NoError(t, tsk.Insert(ctx))
+	}
+	queue := []serviceModel.TaskQueueItem{{Id: "other"}, {Id: "queued"}}
+	require.NoError(t, serviceModel.NewTaskQueue("d1", queue, serviceModel.DistroQueueInfo{}).Save(ctx))
+
+	run := func(t *testing.T, taskID string) gimlet.Responder {
+		h := makeTaskQueueExplainHandler(env).Factory()
+		r, err := http.NewRequest(http.MethodGet, "/tasks/"+taskID+"/explain", nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"task_id": taskID})
+		require.NoError(t, h.Parse(ctx, r))
+		return h.Run(ctx)
+	}
+
+	t.Run("MissingTaskIDFailsParse", func(t *testing.T) {
+		h := makeTaskQueueExplainHandler(env).Factory()
+		r, err := http.NewRequest(http.MethodGet, "/tasks//explain", nil)
+		require.NoError(t, err)
+		assert.Error(t, h.Parse(ctx, r))
+	})
+	t.Run("ReportsQueuePosition", func(t *testing.T) {
+		resp := run(t, "queued")
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		explanation, ok := resp.Data().(model.APITaskQueueExplanation)
+		require.True(t, ok)
+		assert.Equal(t, "queued", utility.FromStringPtr(explanation.TaskID))
+		assert.True(t, explanation.InQueue)
+		assert.Equal(t, "d1", utility.FromStringPtr(explanation.QueueDistro))
+		assert.Equal(t, 2, explanation.QueuePosition)
+		assert.Equal(t, 2, explanation.QueueLength)
+		assert.Contains(t, explanation.Reasons, "task is at position 2 of 2 in the queue for distro 'd1'")
+	})
+	t.Run("UnknownTaskIsNotFound", func(t *testing.T) {
+		resp := run(t, "nonexistent")
+		assert.Equal(t, http.StatusNotFound, resp.Status())
+	})
+	t.Run("UnknownDistroIsReported", func(t *testing.T) {
+		resp := run(t, "unknown_distro")
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		explanation, ok := resp.Data().(model.APITaskQueueExplanation)
+		require.True(t, ok)
+		assert.False(t, explanation.InQueue)
+		assert.Contains(t, explanation.Reasons, "distro 'nonexistent' does not exist")
+	})
+}
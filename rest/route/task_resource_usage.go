@@ -0,0 +1,91 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/tasks/{task_id}/resource_usage
+
+type taskResourceUsageGetHandler struct {
+	taskID    string
+	execution int
+}
+
+func makeGetTaskResourceUsage() gimlet.RouteHandler {
+	return &taskResourceUsageGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Fetch resource usage for a task
+//	@Description	Fetches the time series of host resource usage that the agent recorded while running the task. Samples are taken periodically and at the start and end of each command, and are tagged with the command that was running. Disk reads and writes are the bytes transferred since the previous sample. Long-running tasks are sampled at a coarser resolution later in the task.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/resource_usage [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id		path	string	true	"task ID"
+//	@Param			execution	query	int		false	"The 0-based number corresponding to the execution of the task ID. Defaults to the latest execution"
+//	@Success		200			{array}	model.APIResourceUsageSample
+func (h *taskResourceUsageGetHandler) Factory() gimlet.RouteHandler {
+	return &taskResourceUsageGetHandler{}
+}
+
+func (h *taskResourceUsageGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+
+	h.execution = -1
+	if execution := r.URL.Query().Get("execution"); execution != "" {
+		var err error
+		h.execution, err = strconv.Atoi(execution)
+		if err != nil {
+			return errors.Wrap(err, "invalid execution")
+		}
+		if h.execution < 0 {
+			return errors.New("execution cannot be negative")
+		}
+	}
+	return nil
+}
+
+func (h *taskResourceUsageGetHandler) Run(ctx context.Context) gimlet.Responder {
+	if h.execution < 0 {
+		t, err := task.FindOneId(ctx, h.taskID)
+		if err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+		}
+		if t == nil {
+			return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+				StatusCode: http.StatusNotFound,
+				Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+			})
+		}
+		h.execution = t.Execution
+	}
+
+	usage, err := resourceusage.FindOne(ctx, resourceusage.ByTaskIDAndExecution(h.taskID, h.execution))
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding resource usage for task '%s' execution %d", h.taskID, h.execution))
+	}
+
+	apiSamples := []restModel.APIResourceUsageSample{}
+	if usage != nil {
+		for _, s := range usage.Samples {
+			apiSample := restModel.APIResourceUsageSample{}
+			apiSample.BuildFromService(s)
+			apiSamples = append(apiSamples, apiSample)
+		}
+	}
+	return gimlet.NewJSONResponse(apiSamples)
+}
@@ -0,0 +1,317 @@
+package route
+
+// This file defines the handlers for the endpoints to query test reliability.
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/reliability"
+	"github.com/evergreen-ci/evergreen/model/teststats"
+	"github.com/evergreen-ci/evergreen/rest/data"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+const (
+	// testReliabilityAPIMaxLimit is the max number of tests returned in a
+	// single page of results.
+	testReliabilityAPIMaxLimit = 1000
+	// testReliabilityAPIDefaultWorstLimit is the default number of tests
+	// returned when ranking the least reliable tests.
+	testReliabilityAPIDefaultWorstLimit = 100
+	// testReliabilityAPIDefaultNumDays is the default number of days covered
+	// when no 'after' date is given.
+	testReliabilityAPIDefaultNumDays = 7
+)
+
+// testReliabilityFilterParser parses the query parameters common to the test
+// reliability handlers.
+type testReliabilityFilterParser struct {
+	StatsHandler
+}
+
+// parseTestReliabilityFilter parses the query parameter values into a test
+// reliability filter for the given project.
+func (p *testReliabilityFilterParser) parseTestReliabilityFilter(projectID string, vals url.Values, defaultLimit int) (reliability.TestReliabilityFilter, error) {
+	filter := reliability.TestReliabilityFilter{
+		StatsFilter: teststats.StatsFilter{Project: projectID},
+	}
+
+	var err error
+	filter.Requesters, err = p.readRequesters(p.readStringList(vals["requesters"]))
+	if err != nil {
+		return filter, gimlet.ErrorResponse{
+			Message:    errors.Wrap(err, "invalid requesters").Error(),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	filter.BuildVariants = p.readStringList(vals["variants"])
+	filter.Tasks = p.readStringList(vals["tasks"])
+	filter.Tests = p.readStringList(vals["tests"])
+
+	filter.Limit, err = p.readInt(vals.Get("limit"), 1, testReliabilityAPIMaxLimit, defaultLimit)
+	if err != nil {
+		return filter, gimlet.ErrorResponse{
+			Message:    errors.Wrap(err, "invalid limit").Error(),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+
+	// before_date is exclusive and defaults to tomorrow so that today's
+	// stats are included.
+	filter.BeforeDate = time.Now().UTC().Truncate(dayInHours).Add(dayInHours)
+	if beforeDate := vals.Get("before_date"); beforeDate != "" {
+		filter.BeforeDate, err = time.ParseInLocation(statsAPIDateFormat, beforeDate, time.UTC)
+		if err != nil {
+			return filter, gimlet.ErrorResponse{
+				Message:    errors.Wrapf(err, "parsing 'before' date in expected format (%s)", statsAPIDateFormat).Error(),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
+	filter.AfterDate = filter.BeforeDate.Add(-testReliabilityAPIDefaultNumDays * dayInHours)
+	if afterDate := vals.Get("after_date"); afterDate != "" {
+		filter.AfterDate, err = time.ParseInLocation(statsAPIDateFormat, afterDate, time.UTC)
+		if err != nil {
+			return filter, gimlet.ErrorResponse{
+				Message:    errors.Wrapf(err, "parsing 'after' date in expected format (%s)", statsAPIDateFormat).Error(),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
+
+	filter.Sort, err = p.readSort(vals.Get("sort"))
+	if err != nil {
+		return filter, err
+	}
+
+	filter.Significance = reliability.DefaultSignificance
+	if significance := vals.Get("significance"); significance != "" {
+		filter.Significance, err = strconv.ParseFloat(significance, 64)
+		if err != nil {
+			return filter, gimlet.ErrorResponse{
+				Message:    "invalid significance value",
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
+
+	return filter, nil
+}
+
+// readTestStartAt parses a start_at key value and returns the corresponding
+// StartAt struct. The test name is last since it may contain the separator.
+func (p *testReliabilityFilterParser) readTestStartAt(startAtValue string) (*teststats.StartAt, error) {
+	if startAtValue == "" {
+		return nil, nil
+	}
+	elements := strings.SplitN(startAtValue, "|", 4)
+	if len(elements) != 4 {
+		return nil, gimlet.ErrorResponse{
+			Message:    "invalid 'start at' value",
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	date, err := time.ParseInLocation(statsAPIDateFormat, elements[0], time.UTC)
+	if err != nil {
+		return nil, gimlet.ErrorResponse{
+			Message:    errors.Wrapf(err, "parsing date in expected format (%s)", statsAPIDateFormat).Error(),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return &teststats.StartAt{
+		Date:         date,
+		BuildVariant: elements[1],
+		Task:         elements[2],
+		Test:         elements[3],
+	}, nil
+}
+
+// checkTestReliabilityEnabled returns an error responder if the test
+// reliability endpoints are disabled.
+func checkTestReliabilityEnabled(ctx context.Context) gimlet.Responder {
+	flags, err := evergreen.GetServiceFlags(ctx)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "retrieving service flags"))
+	}
+	if flags.TaskReliabilityDisabled {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			Message:    "endpoint is disabled",
+			StatusCode: http.StatusServiceUnavailable,
+		})
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////
+// /projects/<project_id>/test_reliability handler //
+/////////////////////////////////////////////////////
+
+type testReliabilityHandler struct {
+	testReliabilityFilterParser
+	filter reliability.TestReliabilityFilter
+	url    string
+}
+
+func makeGetProjectTestReliability() gimlet.RouteHandler {
+	return &testReliabilityHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get daily test reliability
+//	@Description	Returns the daily statistics of each test in the project: the number of passing and failing runs, the median and 95th percentile durations of the passing runs, and the Wilson score interval of the pass rate. Results are sorted by date, build variant, task and test name and are paginated.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/test_reliability [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id		path		string		true	"the project ID"
+//	@Param			requesters		query		[]string	false	"requesters to include: mainline (default), patch, trigger, git_tag, adhoc"
+//	@Param			variants		query		[]string	false	"build variants to include"
+//	@Param			tasks			query		[]string	false	"task names to include"
+//	@Param			tests			query		[]string	false	"test names to include"
+//	@Param			after_date		query		string		false	"first day (YYYY-MM-DD) to include, defaults to 7 days before the before date"
+//	@Param			before_date		query		string		false	"day (YYYY-MM-DD) before which stats are included, defaults to tomorrow"
+//	@Param			sort			query		string		false	"earliest (default) or latest date first"
+//	@Param			significance	query		number		false	"significance level of the success rate interval, defaults to 0.05"
+//	@Param			limit			query		int			false	"maximum number of results per page, defaults to 1000"
+//	@Param			start_at		query		string		false	"pagination key"
+//	@Success		200				{array}		model.APITestReliability
+func (h *testReliabilityHandler) Factory() gimlet.RouteHandler {
+	return &testReliabilityHandler{}
+}
+
+func (h *testReliabilityHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.url = util.HttpsUrl(r.Host)
+
+	vals := r.URL.Query()
+	var err error
+	h.filter, err = h.parseTestReliabilityFilter(gimlet.GetVars(r)["project_id"], vals, testReliabilityAPIMaxLimit)
+	if err != nil {
+		return errors.Wrap(err, "parsing test reliability parameters")
+	}
+	h.filter.StartAt, err = h.readTestStartAt(vals.Get("start_at"))
+	if err != nil {
+		return errors.Wrap(err, "parsing test reliability parameters")
+	}
+	// Add 1 for pagination.
+	h.filter.Limit++
+
+	if err = h.filter.ValidateForTestReliability(); err != nil {
+		return gimlet.ErrorResponse{
+			Message:    errors.Wrap(err, "invalid test reliability parameters").Error(),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return nil
+}
+
+func (h *testReliabilityHandler) Run(ctx context.Context) gimlet.Responder {
+	if resp := checkTestReliabilityEnabled(ctx); resp != nil {
+		return resp
+	}
+
+	scores, err := data.GetDailyTestReliabilityScores(ctx, h.filter)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting test reliability stats"))
+	}
+
+	resp := gimlet.NewResponseBuilder()
+	requestLimit := h.filter.Limit - 1
+	if len(scores) > requestLimit {
+		err = resp.SetPages(&gimlet.ResponsePages{
+			Next: &gimlet.Page{
+				Relation:        "next",
+				LimitQueryParam: "limit",
+				KeyQueryParam:   "start_at",
+				BaseURL:         h.url,
+				Key:             scores[requestLimit].StartAtKey(),
+				Limit:           requestLimit,
+			},
+		})
+		if err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "paginating response"))
+		}
+		scores = scores[:requestLimit]
+	}
+
+	return addTestReliabilityData(resp, scores)
+}
+
+///////////////////////////////////////////////////////////
+// /projects/<project_id>/test_reliability/worst handler //
+///////////////////////////////////////////////////////////
+
+type worstTestReliabilityHandler struct {
+	testReliabilityFilterParser
+	filter reliability.TestReliabilityFilter
+}
+
+func makeGetProjectWorstTestReliability() gimlet.RouteHandler {
+	return &worstTestReliabilityHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get the least reliable tests
+//	@Description	Combines the daily test statistics over the date range and returns the least reliable tests in the project first. Tests are ranked by the upper bound of the Wilson score interval of their pass rate, so tests that ran too few times to be confidently unreliable are ranked after tests that failed consistently. To keep the query fast, only the tests with the highest failure rates, up to 10 times the limit, are ranked. The durations over the date range are the daily percentiles averaged by the number of passing runs on each day.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/test_reliability/worst [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id		path		string		true	"the project ID"
+//	@Param			requesters		query		[]string	false	"requesters to include: mainline (default), patch, trigger, git_tag, adhoc"
+//	@Param			variants		query		[]string	false	"build variants to include"
+//	@Param			tasks			query		[]string	false	"task names to include"
+//	@Param			tests			query		[]string	false	"test names to include"
+//	@Param			after_date		query		string		false	"first day (YYYY-MM-DD) to include, defaults to 7 days before the before date"
+//	@Param			before_date		query		string		false	"day (YYYY-MM-DD) before which stats are included, defaults to tomorrow"
+//	@Param			significance	query		number		false	"significance level of the success rate interval, defaults to 0.05"
+//	@Param			limit			query		int			false	"maximum number of tests to return, defaults to 100"
+//	@Success		200				{array}		model.APITestReliability
+func (h *worstTestReliabilityHandler) Factory() gimlet.RouteHandler {
+	return &worstTestReliabilityHandler{}
+}
+
+func (h *worstTestReliabilityHandler) Parse(ctx context.Context, r *http.Request) error {
+	var err error
+	h.filter, err = h.parseTestReliabilityFilter(gimlet.GetVars(r)["project_id"], r.URL.Query(), testReliabilityAPIDefaultWorstLimit)
+	if err != nil {
+		return errors.Wrap(err, "parsing test reliability parameters")
+	}
+	if err = h.filter.ValidateForTestReliability(); err != nil {
+		return gimlet.ErrorResponse{
+			Message:    errors.Wrap(err, "invalid test reliability parameters").Error(),
+			StatusCode: http.StatusBadRequest,
+		}
+	}
+	return nil
+}
+
+func (h *worstTestReliabilityHandler) Run(ctx context.Context) gimlet.Responder {
+	if resp := checkTestReliabilityEnabled(ctx); resp != nil {
+		return resp
+	}
+
+	scores, err := data.GetWorstTestReliabilityScores(ctx, h.filter)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "getting worst test reliability stats"))
+	}
+
+	return addTestReliabilityData(gimlet.NewResponseBuilder(), scores)
+}
+
+func addTestReliabilityData(resp gimlet.Responder, scores []model.APITestReliability) gimlet.Responder {
+	for i, score := range scores {
+		if err := resp.AddData(score); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "adding test reliability stats at index %d", i))
+		}
+	}
+	return resp
+}
@@ -0,0 +1,146 @@
+package route
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/reliability"
+	"github.com/evergreen-ci/evergreen/model/taskstats"
+	"github.com/evergreen-ci/evergreen/model/teststats"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeTestReliabilityRequest(t *testing.T, path string) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, "https://example.net/rest/v2/projects/project/"+path, nil)
+	require.NoError(t, err)
+	return gimlet.SetURLVars(req, map[string]string{"project_id": "project"})
+}
+
+func TestTestReliabilityParse(t *testing.T) {
+	ctx := t.Context()
+	tomorrow := time.Now().UTC().Truncate(dayInHours).Add(dayInHours)
+
+	t.Run("Defaults", func(t *testing.T) {
+		h := makeGetProjectTestReliability().(*testReliabilityHandler)
+		require.NoError(t, h.Parse(ctx, makeTestReliabilityRequest(t, "test_reliability")))
+
+		assert.Equal(t, "project", h.filter.Project)
+		assert.Equal(t, []string{evergreen.RepotrackerVersionRequester}, h.filter.Requesters)
+		assert.Equal(t, tomorrow, h.filter.BeforeDate)
+		assert.Equal(t, tomorrow.Add(-7*dayInHours), h.filter.AfterDate)
+		assert.Equal(t, taskstats.SortEarliestFirst, h.filter.Sort)
+		assert.Equal(t, testReliabilityAPIMaxLimit+1, h.filter.Limit)
+		//nolint:testifylint // We expect the float to be exactly equal.
+		assert.Equal(t, reliability.DefaultSignificance, h.filter.Significance)
+		assert.Nil(t, h.filter.StartAt)
+	})
+	t.Run("AllValues", func(t *testing.T) {
+		h := makeGetProjectTestReliability().(*testReliabilityHandler)
+		req := makeTestReliabilityRequest(t, "test_reliability?requesters=mainline&variants=ubuntu,windows&tasks=unit&tests=a,b"+
+			"&after_date=2025-01-01&before_date=2025-01-15&sort=latest&significance=0.1&limit=10&start_at=2025-01-10|ubuntu|unit|TestA|subtest")
+		require.NoError(t, h.Parse(ctx, req))
+
+		assert.Equal(t, []string{"ubuntu", "windows"}, h.filter.BuildVariants)
+		assert.Equal(t, []string{"unit"}, h.filter.Tasks)
+		assert.Equal(t, []string{"a", "b"}, h.filter.Tests)
+		assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), h.filter.AfterDate)
+		assert.Equal(t, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), h.filter.BeforeDate)
+		assert.Equal(t, taskstats.SortLatestFirst, h.filter.Sort)
+		assert.Equal(t, 11, h.filter.Limit)
+		//nolint:testifylint // We expect the float to be exactly 0.1.
+		assert.Equal(t, 0.1, h.filter.Significance)
+		require.NotNil(t, h.filter.StartAt)
+		assert.Equal(t, teststats.StartAt{
+			Date:         time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+			BuildVariant: "ubuntu",
+			Task:         "unit",
+			Test:         "TestA|subtest",
+		}, *h.filter.StartAt)
+	})
+	t.Run("FailsWithInvalidValues", func(t *testing.T) {
+		for _, query := range []string{
+			"requesters=invalid",
+			"limit=0",
+			"before_date=2025-01-01&after_date=2025-01-01",
+			"before_date=01-01-2025",
+			"significance=2",
+			"start_at=2025-01-01|ubuntu",
+		} {
+			h := makeGetProjectTestReliability().(*testReliabilityHandler)
+			assert.Error(t, h.Parse(ctx, makeTestReliabilityRequest(t, "test_reliability?"+query)), query)
+		}
+	})
+	t.Run("WorstDefaults", func(t *testing.T) {
+		h := makeGetProjectWorstTestReliability().(*worstTestReliabilityHandler)
+		require.NoError(t, h.Parse(ctx, makeTestReliabilityRequest(t, "test_reliability/worst")))
+		assert.Equal(t, testReliabilityAPIDefaultWorstLimit, h.filter.Limit)
+		assert.Equal(t, tomorrow, h.filter.BeforeDate)
+	})
+}
+
+func TestTestReliabilityRun(t *testing.T) {
+	require.NoError(t, db.ClearCollections(teststats.DailyTestStatsCollection, model.ProjectRefCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(teststats.DailyTestStatsCollection, model.ProjectRefCollection))
+	}()
+	ctx := t.Context()
+	require.NoError(t, setupTest(t))
+
+	proj := model.ProjectRef{Id: "project", Identifier: "project"}
+	require.NoError(t, proj.Insert(ctx))
+
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, doc := range []teststats.DBTestStats{
+		{Id: teststats.DBTestStatsID{TestName: "stable", TaskName: "unit", BuildVariant: "ubuntu", Project: "project", Requester: evergreen.RepotrackerVersionRequester, Date: day}, NumPass: 20},
+		{Id: teststats.DBTestStatsID{TestName: "flaky", TaskName: "unit", BuildVariant: "ubuntu", Project: "project", Requester: evergreen.RepotrackerVersionRequester, Date: day}, NumPass: 10, NumFail: 10},
+		{Id: teststats.DBTestStatsID{TestName: "broken", TaskName: "unit", BuildVariant: "ubuntu", Project: "project", Requester: evergreen.RepotrackerVersionRequester, Date: day}, NumFail: 20},
+	} {
+		require.NoError(t, db.Insert(ctx, teststats.DailyTestStatsCollection, doc))
+	}
+
+	t.Run("Daily", func(t *testing.T) {
+		h := makeGetProjectTestReliability().(*testReliabilityHandler)
+		require.NoError(t, h.Parse(ctx, makeTestReliabilityRequest(t, "test_reliability?after_date=2025-01-01&before_date=2025-01-02&limit=2")))
+
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+		data, ok := resp.Data().([]any)
+		require.True(t, ok)
+		require.Len(t, data, 2)
+		assert.Equal(t, "broken", utility.FromStringPtr(data[0].(restModel.APITestReliability).TestName))
+		assert.Equal(t, "flaky", utility.FromStringPtr(data[1].(restModel.APITestReliability).TestName))
+		require.NotNil(t, resp.Pages())
+		assert.Equal(t, "2025-01-01|ubuntu|unit|stable", resp.Pages().Next.Key)
+	})
+	t.Run("Worst", func(t *testing.T) {
+		h := makeGetProjectWorstTestReliability().(*worstTestReliabilityHandler)
+		require.NoError(t, h.Parse(ctx, makeTestReliabilityRequest(t, "test_reliability/worst?after_date=2025-01-01&before_date=2025-01-02")))
+
+		resp := h.Run(ctx)
+		require.Equal(t, http.StatusOK, resp.Status())
+		data, ok := resp.Data().([]any)
+		require.True(t, ok)
+		require.Len(t, data, 3)
+		for i, testName := range []string{"broken", "flaky", "stable"} {
+			assert.Equal(t, testName, utility.FromStringPtr(data[i].(restModel.APITestReliability).TestName))
+		}
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		require.NoError(t, disableTaskReliability())
+		defer func() {
+			assert.NoError(t, enableTaskReliability())
+		}()
+
+		h := makeGetProjectWorstTestReliability().(*worstTestReliabilityHandler)
+		require.NoError(t, h.Parse(ctx, makeTestReliabilityRequest(t, "test_reliability/worst")))
+		assert.Equal(t, http.StatusServiceUnavailable, h.Run(ctx).Status())
+	})
+}
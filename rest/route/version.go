@@ -519,6 +519,70 @@ func (h *versionManifestGetHandler) Run(ctx context.Context) gimlet.Responder {
 	return gimlet.NewJSONResponse(apiMfst)
 }
 
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/versions/{version_id}/parser_project
+
+type versionParserProjectGetHandler struct {
+	versionId string
+	env       evergreen.Environment
+}
+
+func makeGetVersionParserProject(env evergreen.Environment) gimlet.RouteHandler {
+	return &versionParserProjectGetHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Fetch project configuration by version ID
+//	@Description	Fetches the unexpanded project configuration used to create the version, encoded as BSON.
+//	@Tags			versions
+//	@Router			/versions/{version_id}/parser_project [get]
+//	@Security		Api-User || Api-Key
+//	@Param			version_id	path	string	true	"version ID"
+//	@Success		200
+func (h *versionParserProjectGetHandler) Factory() gimlet.RouteHandler {
+	return &versionParserProjectGetHandler{env: h.env}
+}
+
+// Parse fetches the versionId from the http request.
+func (h *versionParserProjectGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionId = gimlet.GetVars(r)["version_id"]
+	if h.versionId == "" {
+		return errors.New("missing version ID")
+	}
+	return nil
+}
+
+func (h *versionParserProjectGetHandler) Run(ctx context.Context) gimlet.Responder {
+	v, err := dbModel.VersionFindOne(ctx, dbModel.VersionById(h.versionId).WithFields(dbModel.VersionIdKey, dbModel.VersionProjectStorageMethodKey))
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding version '%s'", h.versionId))
+	}
+	if v == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version '%s' not found", h.versionId),
+		})
+	}
+
+	pp, err := dbModel.ParserProjectFindOneByID(ctx, h.env.Settings(), v.ProjectStorageMethod, v.Id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding parser project '%s'", v.Id))
+	}
+	if pp == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("parser project '%s' not found", v.Id),
+		})
+	}
+	projBytes, err := pp.MarshalBSON()
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "marshalling project bytes to bson"))
+	}
+	return gimlet.NewBinaryResponse(projBytes)
+}
+
 // GET /rest/v2/versions/{version_id}/manifest/proof
 
 type versionManifestProofGetHandler struct {
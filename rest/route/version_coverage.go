@@ -0,0 +1,55 @@
+package route
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen/rest/data"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/versions/{version_id}/coverage
+
+type versionCoverageGetHandler struct {
+	versionID string
+}
+
+func makeGetVersionCoverage() gimlet.RouteHandler {
+	return &versionCoverageGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Fetch code coverage for a version
+//	@Description	Fetches the code coverage reported by the version's tasks with the coverage.parse command, along with the change in coverage from the base version. For patches, the base version is the commit the patch is based on; for mainline commits, it is the previous commit. Tasks are matched to base tasks by build variant and display name, and only files whose coverage changed are listed for each task.
+//	@Tags			versions
+//	@Router			/versions/{version_id}/coverage [get]
+//	@Security		Api-User || Api-Key
+//	@Param			version_id	path		string	true	"version ID"
+//	@Success		200			{object}	model.APIVersionCoverage
+func (h *versionCoverageGetHandler) Factory() gimlet.RouteHandler {
+	return &versionCoverageGetHandler{}
+}
+
+func (h *versionCoverageGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionID = gimlet.GetVars(r)["version_id"]
+	if h.versionID == "" {
+		return errors.New("missing version ID")
+	}
+	return nil
+}
+
+func (h *versionCoverageGetHandler) Run(ctx context.Context) gimlet.Responder {
+	delta, err := data.GetVersionCoverageDelta(ctx, h.versionID)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "getting coverage for version '%s'", h.versionID))
+	}
+
+	apiCoverage := &restModel.APIVersionCoverage{}
+	apiCoverage.BuildFromService(*delta)
+	return gimlet.NewJSONResponse(apiCoverage)
+}
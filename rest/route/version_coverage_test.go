@@ -0,0 +1,99 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/coverage"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionCoverageGetHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, db.ClearCollections(serviceModel.VersionCollection, coverage.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(serviceModel.VersionCollection, coverage.Collection))
+	}()
+
+	base := serviceModel.Version{
+		Id:                  "base",
+		Identifier:          "project",
+		Requester:           evergreen.RepotrackerVersionRequester,
+		RevisionOrderNumber: 1,
+	}
+	require.NoError(t, base.Insert(ctx))
+	v := serviceModel.Version{
+		Id:                  "version",
+		Identifier:          "project",
+		Requester:           evergreen.RepotrackerVersionRequester,
+		RevisionOrderNumber: 2,
+	}
+	require.NoError(t, v.Insert(ctx))
+	for _, c := range []coverage.TaskCoverage{
+		{
+			ID:           coverage.TaskCoverageID("base_task", 0),
+			TaskID:       "base_task",
+			TaskName:     "unit",
+			BuildVariant: "bv",
+			Version:      base.Id,
+			Files:        []coverage.FileCoverage{{Name: "main.go", CoveredLines: 5, TotalLines: 10}},
+			CoveredLines: 5,
+			TotalLines:   10,
+		},
+		{
+			ID:           coverage.TaskCoverageID("task", 0),
+			TaskID:       "task",
+			TaskName:     "unit",
+			BuildVariant: "bv",
+			Version:      v.Id,
+			Files:        []coverage.FileCoverage{{Name: "main.go", CoveredLines: 8, TotalLines: 10}},
+			CoveredLines: 8,
+			TotalLines:   10,
+		},
+	} {
+		require.NoError(t, db.Insert(ctx, coverage.Collection, c))
+	}
+
+	run := func(t *testing.T, versionID string) gimlet.Responder {
+		h := makeGetVersionCoverage().Factory()
+		r, err := http.NewRequest(http.MethodGet, "/versions/"+versionID+"/coverage", nil)
+		require.NoError(t, err)
+		r = gimlet.SetURLVars(r, map[string]string{"version_id": versionID})
+		require.NoError(t, h.Parse(ctx, r))
+		return h.Run(ctx)
+	}
+
+	t.Run("ReturnsCoverageDelta", func(t *testing.T) {
+		resp := run(t, v.Id)
+		require.Equal(t, http.StatusOK, resp.Status())
+
+		apiCoverage, ok := resp.Data().(*model.APIVersionCoverage)
+		require.True(t, ok)
+		assert.Equal(t, v.Id, utility.FromStringPtr(apiCoverage.VersionID))
+		assert.Equal(t, base.Id, utility.FromStringPtr(apiCoverage.BaseVersionID))
+		assert.Equal(t, 8, apiCoverage.CoveredLines)
+		assert.Equal(t, 5, apiCoverage.BaseCoveredLines)
+		assert.InDelta(t, 30.0, apiCoverage.Delta, 0.001)
+		assert.Len(t, apiCoverage.Tasks, 1)
+	})
+	t.Run("UnknownVersionIsNotFound", func(t *testing.T) {
+		resp := run(t, "nonexistent")
+		assert.Equal(t, http.StatusNotFound, resp.Status())
+	})
+	t.Run("MissingVersionIDFailsParse", func(t *testing.T) {
+		h := makeGetVersionCoverage().Factory()
+		r, err := http.NewRequest(http.MethodGet, "/versions//coverage", nil)
+		require.NoError(t, err)
+		assert.Error(t, h.Parse(ctx, r))
+	})
+}
@@ -0,0 +1,68 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/versions/{version_id}/critical_path
+
+type versionCriticalPathGetHandler struct {
+	versionID string
+}
+
+func makeGetVersionCriticalPath() gimlet.RouteHandler {
+	return &versionCriticalPathGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Fetch the critical path of a version
+//	@Description	Fetches the longest chain of dependent tasks in the version, which bounds how quickly the version can finish regardless of available capacity. Finished tasks are weighted by how long they ran and unfinished tasks by their expected duration. For each task on the path, reports how long it waited for its dependencies after being scheduled and how long it waited in the queue after its dependencies were met. The minimum makespan is the total duration of the tasks on the path. For patches, use the patch ID as the version ID.
+//	@Tags			versions
+//	@Router			/versions/{version_id}/critical_path [get]
+//	@Security		Api-User || Api-Key
+//	@Param			version_id	path		string	true	"version ID"
+//	@Success		200			{object}	model.APIVersionCriticalPath
+func (h *versionCriticalPathGetHandler) Factory() gimlet.RouteHandler {
+	return &versionCriticalPathGetHandler{}
+}
+
+func (h *versionCriticalPathGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionID = gimlet.GetVars(r)["version_id"]
+	if h.versionID == "" {
+		return errors.New("missing version ID")
+	}
+	return nil
+}
+
+func (h *versionCriticalPathGetHandler) Run(ctx context.Context) gimlet.Responder {
+	v, err := serviceModel.VersionFindOneId(ctx, h.versionID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding version '%s'", h.versionID))
+	}
+	if v == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version '%s' not found", h.versionID),
+		})
+	}
+
+	path, err := task.GetVersionCriticalPath(ctx, h.versionID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "computing critical path for version '%s'", h.versionID))
+	}
+
+	apiPath := &restModel.APIVersionCriticalPath{}
+	apiPath.BuildFromService(h.versionID, *path)
+	return gimlet.NewJSONResponse(apiPath)
+}
@@ -0,0 +1,80 @@
+package route
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	serviceModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVersionCriticalPath(t *testing.T) {
+	require.NoError(t, db.ClearCollections(serviceModel.VersionCollection, task.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(serviceModel.VersionCollection, task.Collection))
+	}()
+
+	v := serviceModel.Version{Id: "v1"}
+	require.NoError(t, v.Insert(t.Context()))
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	tasks := []task.Task{
+		{
+			Id:            "compile",
+			Version:       "v1",
+			DisplayName:   "compile",
+			BuildVariant:  "bv",
+			Status:        evergreen.TaskSucceeded,
+			Activated:     true,
+			ScheduledTime: start,
+			StartTime:     start.Add(time.Minute),
+			FinishTime:    start.Add(11 * time.Minute),
+		},
+		{
+			Id:                  "unit",
+			Version:             "v1",
+			DisplayName:         "unit",
+			BuildVariant:        "bv",
+			Status:              evergreen.TaskSucceeded,
+			Activated:           true,
+			DependsOn:           []task.Dependency{{TaskId: "compile"}},
+			ScheduledTime:       start,
+			DependenciesMetTime: start.Add(11 * time.Minute),
+			StartTime:           start.Add(14 * time.Minute),
+			FinishTime:          start.Add(34 * time.Minute),
+		},
+	}
+	for _, tsk := range tasks {
+		require.NoError(t, tsk.Insert(t.Context()))
+	}
+
+	t.Run("ReturnsCriticalPath", func(t *testing.T) {
+		handler := makeGetVersionCriticalPath().(*versionCriticalPathGetHandler)
+		handler.versionID = "v1"
+
+		res := handler.Run(t.Context())
+		require.Equal(t, http.StatusOK, res.Status(), res.Data())
+		path, ok := res.Data().(*model.APIVersionCriticalPath)
+		require.True(t, ok)
+
+		require.Len(t, path.Tasks, 2)
+		assert.Equal(t, "compile", utility.FromStringPtr(path.Tasks[0].TaskID))
+		assert.Equal(t, "unit", utility.FromStringPtr(path.Tasks[1].TaskID))
+		assert.Equal(t, 30*time.Minute, path.MinimumMakespan.ToDuration())
+		assert.Equal(t, 34*time.Minute, path.Makespan.ToDuration())
+		assert.Equal(t, 4*time.Minute, path.TotalQueueWait.ToDuration())
+		assert.Equal(t, 11*time.Minute, path.TotalDependencyWait.ToDuration())
+	})
+	t.Run("ErrorsForNonexistentVersion", func(t *testing.T) {
+		handler := makeGetVersionCriticalPath().(*versionCriticalPathGetHandler)
+		handler.versionID = "nonexistent"
+		assert.Equal(t, http.StatusNotFound, handler.Run(t.Context()).Status())
+	})
+}
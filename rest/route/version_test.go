@@ -873,3 +873,42 @@ func proofHistoryItemByID(items []versionManifestProofHistoryItem, versionID str
 	}
 	return nil
 }
+
+func TestGetVersionParserProject(t *testing.T) {
+	require.NoError(t, db.ClearCollections(serviceModel.VersionCollection, serviceModel.ParserProjectCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(serviceModel.VersionCollection, serviceModel.ParserProjectCollection))
+	}()
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(t.Context()))
+
+	v := serviceModel.Version{
+		Id:                   "v1",
+		ProjectStorageMethod: evergreen.ProjectStorageMethodDB,
+	}
+	require.NoError(t, v.Insert(t.Context()))
+	pp := serviceModel.ParserProject{
+		Id:              "v1",
+		ExecTimeoutSecs: utility.ToIntPtr(600),
+	}
+	require.NoError(t, pp.Insert(t.Context()))
+
+	t.Run("ReturnsProjectAsBSON", func(t *testing.T) {
+		handler := makeGetVersionParserProject(env).(*versionParserProjectGetHandler)
+		handler.versionId = "v1"
+
+		res := handler.Run(t.Context())
+		require.Equal(t, http.StatusOK, res.Status(), res.Data())
+		projBytes, ok := res.Data().([]byte)
+		require.True(t, ok)
+
+		p, err := serviceModel.GetProjectFromBSON(projBytes)
+		require.NoError(t, err)
+		assert.Equal(t, 600, p.ExecTimeoutSecs)
+	})
+	t.Run("ErrorsForNonexistentVersion", func(t *testing.T) {
+		handler := makeGetVersionParserProject(env).(*versionParserProjectGetHandler)
+		handler.versionId = "nonexistent"
+		assert.Equal(t, http.StatusNotFound, handler.Run(t.Context()).Status())
+	})
+}
@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/pkg/errors"
+)
+
+// fairShare tracks how a distro's hosts are divided between the fair-share
+// weights in its planner settings. Only shares that have tasks running or
+// waiting in the distro are entitled to part of it, so a share without any
+// work doesn't hold on to hosts that other shares could use.
+type fairShare struct {
+	// shareByProject maps each project ID to the name of its share.
+	shareByProject map[string]string
+	// entitled is the number of hosts each share is entitled to.
+	entitled map[string]float64
+	// used is the number of hosts currently running each share's tasks.
+	used map[string]int
+}
+
+// getFairShare returns the current fair-share usage for the distro, or nil if
+// the distro doesn't have any fair-share weights.
+func getFairShare(ctx context.Context, d *distro.Distro, tasks []task.Task) (*fairShare, error) {
+	if len(d.PlannerSettings.FairShareWeights) == 0 {
+		return nil, nil
+	}
+
+	runningByProject, err := host.CountRunningTasksByProject(ctx, d.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "counting running tasks by project for distro '%s'", d.Id)
+	}
+
+	return newFairShare(d, tasks, runningByProject), nil
+}
+
+func newFairShare(d *distro.Distro, tasks []task.Task, runningByProject map[string]int) *fairShare {
+	fs := &fairShare{
+		shareByProject: map[string]string{},
+		entitled:       map[string]float64{},
+		used:           map[string]int{},
+	}
+
+	weights := map[string]int{}
+	for _, w := range d.PlannerSettings.FairShareWeights {
+		if w.Weight <= 0 {
+			continue
+		}
+		weights[w.Name] = w.Weight
+		for _, project := range w.Projects {
+			fs.shareByProject[project] = w.Name
+		}
+	}
+
+	active := map[string]bool{}
+	totalRunning := 0
+	for project, n := range runningByProject {
+		totalRunning += n
+		if name, ok := fs.shareByProject[project]; ok {
+			fs.used[name] += n
+			active[name] = true
+		}
+	}
+	for _, t := range tasks {
+		if name, ok := fs.shareByProject[t.Project]; ok {
+			active[name] = true
+		}
+	}
+
+	totalWeight := 0
+	for name := range active {
+		totalWeight += weights[name]
+	}
+	if totalWeight == 0 {
+		return fs
+	}
+
+	capacity := max(d.HostAllocatorSettings.MaximumHosts, totalRunning)
+	for name := range active {
+		fs.entitled[name] = float64(capacity) * float64(weights[name]) / float64(totalWeight)
+	}
+
+	return fs
+}
+
+// factor returns how much to scale the sorting value of a task from the
+// project by, and whether the project's share is already using all the hosts
+// it's entitled to.
+func (fs *fairShare) factor(project string) (float64, bool) {
+	if fs == nil {
+		return 1, false
+	}
+	name, ok := fs.shareByProject[project]
+	if !ok {
+		return 1, false
+	}
+
+	used := fs.used[name]
+	if !fs.isOver(name, used) {
+		return 1, false
+	}
+
+	return fs.entitled[name] / float64(used+1), true
+}
+
+// countTask counts a task from the project in queued, which tracks how many of
+// each share's tasks are ahead of it in the queue, and returns whether the
+// task is over the share's entitlement once the tasks running and ahead of it
+// are counted.
+func (fs *fairShare) countTask(project string, queued map[string]int) bool {
+	if fs == nil {
+		return false
+	}
+	name, ok := fs.shareByProject[project]
+	if !ok {
+		return false
+	}
+
+	over := fs.isOver(name, fs.used[name]+queued[name])
+	queued[name]++
+
+	return over
+}
+
+// isOver returns whether a share with the given number of tasks running or
+// ahead in the queue has used up its entitlement. A share without any is never
+// over, so that every share can make progress even if its entitlement is less
+// than a whole host.
+func (fs *fairShare) isOver(name string, used int) bool {
+	return used > 0 && float64(used) >= fs.entitled[name]
+}
@@ -0,0 +1,152 @@
+package scheduler
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFairShare(t *testing.T) {
+	d := &distro.Distro{
+		Id: "d",
+		HostAllocatorSettings: distro.HostAllocatorSettings{
+			MaximumHosts: 10,
+		},
+		PlannerSettings: distro.PlannerSettings{
+			FairShareWeights: []distro.FairShareWeight{
+				{Name: "release", Projects: []string{"server", "tools"}, Weight: 3},
+				{Name: "docs", Projects: []string{"docs"}, Weight: 1},
+				{Name: "idle", Projects: []string{"idle"}, Weight: 4},
+			},
+		},
+	}
+
+	t.Run("EntitlementIsSplitBetweenActiveShares", func(t *testing.T) {
+		fs := newFairShare(d, []task.Task{{Id: "t1", Project: "docs"}}, map[string]int{"server": 6, "tools": 2})
+		assert.Equal(t, 8, fs.used["release"])
+		assert.InDelta(t, 7.5, fs.entitled["release"], 0.001)
+		assert.InDelta(t, 2.5, fs.entitled["docs"], 0.001)
+		_, ok := fs.entitled["idle"]
+		assert.False(t, ok, "shares without any tasks should not be entitled to hosts")
+	})
+	t.Run("ShareOverEntitlementIsPenalized", func(t *testing.T) {
+		fs := newFairShare(d, []task.Task{{Id: "t1", Project: "docs"}}, map[string]int{"server": 6, "tools": 2})
+		factor, over := fs.factor("tools")
+		assert.True(t, over)
+		assert.InDelta(t, 7.5/9, factor, 0.001)
+
+		factor, over = fs.factor("docs")
+		assert.False(t, over, "a share that isn't running anything should never be over")
+		assert.EqualValues(t, 1, factor)
+	})
+	t.Run("ShareUnderEntitlementIsNotPenalized", func(t *testing.T) {
+		fs := newFairShare(d, []task.Task{{Id: "t1", Project: "docs"}}, map[string]int{"server": 3, "docs": 1})
+		factor, over := fs.factor("server")
+		assert.False(t, over)
+		assert.EqualValues(t, 1, factor)
+	})
+	t.Run("OnlyActiveShareIsEntitledToWholeDistro", func(t *testing.T) {
+		fs := newFairShare(d, []task.Task{{Id: "t1", Project: "server"}}, map[string]int{"server": 8})
+		assert.InDelta(t, 10, fs.entitled["release"], 0.001)
+		_, over := fs.factor("server")
+		assert.False(t, over)
+	})
+	t.Run("ProjectsWithoutShareAreNotLimited", func(t *testing.T) {
+		fs := newFairShare(d, nil, map[string]int{"server": 10, "other": 10})
+		factor, over := fs.factor("other")
+		assert.False(t, over)
+		assert.EqualValues(t, 1, factor)
+	})
+	t.Run("NilFairShareIsNotLimited", func(t *testing.T) {
+		var fs *fairShare
+		factor, over := fs.factor("server")
+		assert.False(t, over)
+		assert.EqualValues(t, 1, factor)
+	})
+	t.Run("TasksPastEntitlementAreOverShare", func(t *testing.T) {
+		fs := newFairShare(d, []task.Task{{Id: "t1", Project: "docs"}}, map[string]int{"server": 1, "docs": 1})
+		queued := map[string]int{}
+		assert.False(t, fs.countTask("docs", queued))
+		assert.False(t, fs.countTask("docs", queued))
+		assert.True(t, fs.countTask("docs", queued), "the share should be over once its running and queued tasks reach its entitlement")
+		assert.True(t, fs.countTask("docs", queued))
+		assert.False(t, fs.countTask("other", queued))
+		assert.Equal(t, 4, queued["docs"])
+	})
+	t.Run("ShareWithoutTasksCanAlwaysStartOne", func(t *testing.T) {
+		fs := newFairShare(d, []task.Task{{Id: "t1", Project: "docs"}}, map[string]int{"server": 10})
+		queued := map[string]int{}
+		assert.False(t, fs.countTask("docs", queued))
+		assert.False(t, fs.countTask("docs", queued), "the share is entitled to 2.5 hosts")
+		assert.False(t, fs.countTask("docs", queued))
+		assert.True(t, fs.countTask("docs", queued))
+	})
+	t.Run("PlanOnlyNeedsHostsForTasksWithinShare", func(t *testing.T) {
+		tasks := []task.Task{{Id: "docs_task", Project: "docs", DistroId: d.Id}}
+		for i := range 8 {
+			tasks = append(tasks, task.Task{
+				Id:       fmt.Sprintf("server_task%d", i),
+				Project:  "server",
+				DistroId: d.Id,
+			})
+		}
+		// The release share is entitled to 7.5 hosts and already has 4.
+		fs := newFairShare(d, tasks, map[string]int{"server": 4})
+
+		plan := PrepareTasksForPlanning(t.Context(), d, tasks).setFairShare(fs).Export(t.Context())
+		require.Len(t, plan, len(tasks))
+		var numOver int
+		for _, planned := range plan {
+			if planned.SortingValueBreakdown.OverFairShare {
+				numOver++
+			}
+		}
+		assert.Equal(t, 4, numOver, "only 4 of the 8 queued release tasks fit in its entitlement")
+
+		info := getDistroQueueInfo(t.Context(), d, plan, TaskPlannerOptions{}, func(*task.Task) bool { return true })
+		assert.Equal(t, 4, info.CountOverFairShare)
+		var numNeedingHosts int
+		for _, tg := range info.TaskGroupInfos {
+			numNeedingHosts += tg.Count
+		}
+		assert.Equal(t, 5, numNeedingHosts)
+	})
+	t.Run("PlanDeprioritizesUnitsOverShare", func(t *testing.T) {
+		tasks := []task.Task{
+			{Id: "release_task", Project: "server", Priority: 1},
+			{Id: "docs_task", Project: "docs"},
+		}
+		docsHeavy := &distro.Distro{
+			Id:                    "d",
+			HostAllocatorSettings: d.HostAllocatorSettings,
+			PlannerSettings: distro.PlannerSettings{
+				FairShareWeights: []distro.FairShareWeight{
+					{Name: "release", Projects: []string{"server"}, Weight: 1},
+					{Name: "docs", Projects: []string{"docs"}, Weight: 3},
+				},
+			},
+		}
+		fs := newFairShare(docsHeavy, tasks, map[string]int{"server": 9, "docs": 1})
+
+		withoutFairShare := PrepareTasksForPlanning(t.Context(), d, tasks).Export(t.Context())
+		require.Len(t, withoutFairShare, 2)
+		assert.Equal(t, "release_task", withoutFairShare[0].Id)
+		assert.False(t, withoutFairShare[0].SortingValueBreakdown.OverFairShare)
+
+		plan := PrepareTasksForPlanning(t.Context(), d, tasks).setFairShare(fs).Export(t.Context())
+		require.Len(t, plan, 2)
+		assert.Equal(t, "docs_task", plan[0].Id)
+		assert.False(t, plan[0].SortingValueBreakdown.OverFairShare)
+		assert.Zero(t, plan[0].SortingValueBreakdown.FairShareImpact)
+
+		overShare := plan[1].SortingValueBreakdown
+		assert.Equal(t, "release_task", plan[1].Id)
+		assert.True(t, overShare.OverFairShare)
+		assert.Negative(t, overShare.FairShareImpact)
+		assert.Equal(t, withoutFairShare[0].SortingValueBreakdown.TotalValue+overShare.FairShareImpact, overShare.TotalValue)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
 )
 
 // HostAllocator is responsible for determining how many new hosts should be
@@ -18,6 +19,10 @@ type HostAllocatorData struct {
 	Distro          distro.Distro
 	ExistingHosts   []host.Host
 	DistroQueueInfo model.DistroQueueInfo
+
+	// runningTasks are the tasks running on ExistingHosts, by ID. If it's nil,
+	// the running tasks are looked up when they're needed.
+	runningTasks map[string]task.Task
 }
 
 func GetHostAllocator(name string) HostAllocator {
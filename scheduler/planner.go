@@ -97,6 +97,7 @@ type Unit struct {
 	cachedValue task.SortingValueBreakdown
 	id          string
 	distro      *distro.Distro
+	fairShare   *fairShare
 }
 
 // MakeUnit constructs a new unit, caching a reference to the distro
@@ -198,6 +199,10 @@ type unitInfo struct {
 	ContainsGenerateTask bool `json:"contains_generate_task"`
 	// ContainsStepbackTask indicates if the unit contains task activated by stepback.
 	ContainsStepbackTask bool `json:"contains_stepback_task"`
+	// OverFairShare indicates if the unit contains tasks from a project that is over its fair share of the distro.
+	OverFairShare bool `json:"over_fair_share"`
+	// FairShareFactor is the factor to scale the unit's value by if it is over its fair share.
+	FairShareFactor float64 `json:"fair_share_factor"`
 }
 
 // value computes a full SortingValueBreakdown, containing the final value by which the unit
@@ -205,7 +210,8 @@ type unitInfo struct {
 // the unit's properties had on computing that final value. Currently, the formula for
 // computing this value is (custom_priority * custom_rankValue) + unit_length, where custom_priority
 // and custom_rankValue are both derived from specific properties of the unit and various
-// scheduler constants.
+// scheduler constants. If the unit's project is over its fair share of the distro, the value
+// is then scaled down by how far over its share it is.
 func (u *unitInfo) value() task.SortingValueBreakdown {
 	var breakdown task.SortingValueBreakdown
 	unitLength := int64(len(u.TaskIDs))
@@ -213,6 +219,12 @@ func (u *unitInfo) value() task.SortingValueBreakdown {
 	priority := u.computePriority(&breakdown)
 	rankValue := u.computeRankValue(&breakdown)
 	breakdown.TotalValue = priority*rankValue + breakdown.TaskGroupLength
+	if u.OverFairShare {
+		scaled := max(int64(float64(breakdown.TotalValue)*u.FairShareFactor), 1)
+		breakdown.FairShareImpact = scaled - breakdown.TotalValue
+		breakdown.OverFairShare = true
+		breakdown.TotalValue = scaled
+	}
 	return breakdown
 }
 
@@ -315,6 +327,12 @@ func (unit *Unit) info(ctx context.Context) unitInfo {
 		info.ContainsGenerateTask = info.ContainsGenerateTask || t.GenerateTask
 		info.ContainsStepbackTask = info.ContainsStepbackTask || t.ActivatedBy == evergreen.StepbackTaskActivator
 
+		// A unit is only as fair as its most over-share project.
+		if factor, over := unit.fairShare.factor(t.Project); over && (!info.OverFairShare || factor < info.FairShareFactor) {
+			info.OverFairShare = true
+			info.FairShareFactor = factor
+		}
+
 		if !t.ActivatedTime.IsZero() {
 			info.TimeInQueue += time.Since(t.ActivatedTime)
 		} else if !t.IngestTime.IsZero() {
@@ -458,12 +476,25 @@ func PrepareTasksForPlanning(ctx context.Context, distro *distro.Distro, tasks [
 	return cache.Export(ctx)
 }
 
+// setFairShare sets the distro's fair-share usage on every unit in the
+// plan, so that units from projects over their share are deprioritized.
+func (tpl TaskPlan) setFairShare(fs *fairShare) TaskPlan {
+	for _, unit := range tpl.units {
+		unit.fairShare = fs
+	}
+	return tpl
+}
+
 // Export sorts the TaskPlan returning a unique list of tasks.
 func (tpl TaskPlan) Export(ctx context.Context) []task.Task {
 	sort.Sort(tpl)
 
 	output := []task.Task{}
 	seen := StringSet{}
+	// Each share's tasks are counted in queue order, so that the tasks past
+	// a share's entitlement are over its fair share even if the share was
+	// under it before this plan.
+	queuedByShare := map[string]int{}
 	for _, unit := range tpl.units {
 		sortingValueBreakdown := unit.sortingValueBreakdown(ctx)
 		tasks := unit.Export(ctx)
@@ -472,7 +503,9 @@ func (tpl TaskPlan) Export(ctx context.Context) []task.Task {
 			if seen.Visit(tasks.tasks[i].Id) {
 				continue
 			}
-			tasks.tasks[i].SetSortingValueBreakdownAttributes(ctx, sortingValueBreakdown)
+			breakdown := sortingValueBreakdown
+			breakdown.OverFairShare = unit.fairShare.countTask(tasks.tasks[i].Project, queuedByShare)
+			tasks.tasks[i].SetSortingValueBreakdownAttributes(ctx, breakdown)
 			output = append(output, tasks.tasks[i])
 		}
 	}
@@ -0,0 +1,720 @@
+package scheduler
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultReplaySchedulingInterval = time.Minute
+	defaultReplayHostStartupTime    = 5 * time.Minute
+	defaultReplayMaxDuration        = 7 * 24 * time.Hour
+
+	// replayDurationPredictionTTL keeps the expected durations of replayed
+	// tasks from being refreshed from the database.
+	replayDurationPredictionTTL = 365 * 24 * time.Hour
+)
+
+// CaptureSnapshot records the tasks waiting to run in the distro and its up
+// hosts, along with its resolved planner and host allocator settings, so that
+// they can be replayed offline with Replay.
+func CaptureSnapshot(ctx context.Context, settings *evergreen.Settings, distroID string) (*model.SchedulerSnapshot, error) {
+	d, err := distro.FindOneId(ctx, distroID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding distro '%s'", distroID)
+	}
+	if d == nil {
+		return nil, errors.Errorf("distro '%s' not found", distroID)
+	}
+	plannerSettings, err := d.GetResolvedPlannerSettings(settings)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resolving planner settings for distro '%s'", d.Id)
+	}
+	hostAllocatorSettings, err := d.GetResolvedHostAllocatorSettings(settings)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resolving host allocator settings for distro '%s'", d.Id)
+	}
+
+	capturedAt := time.Now()
+	tasks, err := GetTaskFinder(settings.Scheduler.TaskFinder)(ctx, *d)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding tasks for distro '%s'", d.Id)
+	}
+	tasks, err = PopulateCaches(ctx, "scheduler-snapshot", tasks)
+	if err != nil {
+		return nil, errors.Wrap(err, "populating task caches")
+	}
+
+	hosts, err := host.AllActiveHosts(ctx, d.Id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding hosts for distro '%s'", d.Id)
+	}
+	hosts = hosts.Uphosts()
+	runningTaskIDs := []string{}
+	for _, h := range hosts {
+		if h.RunningTask != "" {
+			runningTaskIDs = append(runningTaskIDs, h.RunningTask)
+		}
+	}
+	runningTasks := map[string]task.Task{}
+	if len(runningTaskIDs) > 0 {
+		found, err := task.Find(ctx, task.ByIds(runningTaskIDs))
+		if err != nil {
+			return nil, errors.Wrap(err, "finding running tasks")
+		}
+		for _, t := range found {
+			runningTasks[t.Id] = t
+		}
+	}
+
+	inSnapshot := make(map[string]bool, len(tasks)+len(runningTasks))
+	for _, t := range tasks {
+		inSnapshot[t.Id] = true
+	}
+	for id := range runningTasks {
+		inSnapshot[id] = true
+	}
+
+	snapshot := &model.SchedulerSnapshot{
+		DistroID:              d.Id,
+		CapturedAt:            capturedAt,
+		Provider:              d.Provider,
+		DispatcherVersion:     d.DispatcherSettings.Version,
+		PlannerSettings:       plannerSettings,
+		HostAllocatorSettings: hostAllocatorSettings,
+		Tasks:                 make([]model.SchedulerSnapshotTask, 0, len(tasks)),
+		Hosts:                 make([]model.SchedulerSnapshotHost, 0, len(hosts)),
+	}
+	for i := range tasks {
+		snapshot.Tasks = append(snapshot.Tasks, newSnapshotTask(ctx, &tasks[i], inSnapshot))
+	}
+	for _, h := range hosts {
+		snapshotHost := model.SchedulerSnapshotHost{ID: h.Id, Status: h.Status}
+		if t, ok := runningTasks[h.RunningTask]; ok {
+			runningTask := newSnapshotTask(ctx, &t, inSnapshot)
+			snapshotHost.RunningTask = &runningTask
+		}
+		snapshot.Hosts = append(snapshot.Hosts, snapshotHost)
+	}
+
+	return snapshot, nil
+}
+
+func newSnapshotTask(ctx context.Context, t *task.Task, inSnapshot map[string]bool) model.SchedulerSnapshotTask {
+	duration := t.FetchExpectedDuration(ctx)
+	st := model.SchedulerSnapshotTask{
+		ID:                     t.Id,
+		DisplayName:            t.DisplayName,
+		BuildVariant:           t.BuildVariant,
+		Project:                t.Project,
+		Version:                t.Version,
+		Requester:              t.Requester,
+		TaskGroup:              t.TaskGroup,
+		TaskGroupMaxHosts:      t.TaskGroupMaxHosts,
+		TaskGroupOrder:         t.TaskGroupOrder,
+		Priority:               t.Priority,
+		ActivatedBy:            t.ActivatedBy,
+		GenerateTask:           t.GenerateTask,
+		NumDependents:          t.NumDependents,
+		ActivatedTime:          t.ActivatedTime,
+		IngestTime:             t.IngestTime,
+		ScheduledTime:          t.ScheduledTime,
+		DependenciesMetTime:    t.DependenciesMetTime,
+		StartTime:              t.StartTime,
+		ExpectedDuration:       duration.Average,
+		ExpectedDurationStdDev: duration.StdDev,
+	}
+	for _, dep := range t.DependsOn {
+		if inSnapshot[dep.TaskId] {
+			st.DependsOn = append(st.DependsOn, dep.TaskId)
+		}
+	}
+
+	return st
+}
+
+// ReplayOptions configure how a scheduler snapshot is replayed.
+type ReplayOptions struct {
+	// PlannerSettings, if set, replace the snapshot's planner settings.
+	PlannerSettings *distro.PlannerSettings
+	// HostAllocatorSettings, if set, replace the snapshot's host allocator
+	// settings.
+	HostAllocatorSettings *distro.HostAllocatorSettings
+	// SchedulingInterval is how often the planner and host allocator run.
+	// Defaults to one minute.
+	SchedulingInterval time.Duration
+	// HostStartupTime is how long a new host takes before it can run tasks.
+	// Defaults to five minutes.
+	HostStartupTime time.Duration
+	// MaxDuration limits how long the replay simulates before it gives up on
+	// the remaining tasks. Defaults to one week.
+	MaxDuration time.Duration
+}
+
+// ReplayReport summarizes how the tasks in a scheduler snapshot ran when it
+// was replayed.
+type ReplayReport struct {
+	DistroID              string                       `json:"distro_id"`
+	PlannerSettings       distro.PlannerSettings       `json:"planner_settings"`
+	HostAllocatorSettings distro.HostAllocatorSettings `json:"host_allocator_settings"`
+	// NumTasks is the number of tasks that were waiting to run in the
+	// snapshot.
+	NumTasks int `json:"num_tasks"`
+	// NumTasksDispatched is the number of those tasks that were dispatched
+	// before the replay finished.
+	NumTasksDispatched int `json:"num_tasks_dispatched"`
+	// Complete is whether every task finished within the replay's maximum
+	// duration.
+	Complete bool `json:"complete"`
+	// Queue latency is the time from when a task was ready to run until it
+	// was dispatched, including the time it waited before the snapshot was
+	// captured.
+	AverageQueueLatency time.Duration `json:"average_queue_latency_ns"`
+	MedianQueueLatency  time.Duration `json:"median_queue_latency_ns"`
+	P90QueueLatency     time.Duration `json:"p90_queue_latency_ns"`
+	MaxQueueLatency     time.Duration `json:"max_queue_latency_ns"`
+	// Makespan is the time from when the snapshot was captured until the
+	// last task finished.
+	Makespan time.Duration `json:"makespan_ns"`
+	// HostHours is the total time that hosts were up during the replay.
+	HostHours float64 `json:"host_hours"`
+	// HostsStarted is the number of hosts the host allocator started.
+	HostsStarted int `json:"hosts_started"`
+	// PeakHosts is the most hosts that were up at once.
+	PeakHosts int `json:"peak_hosts"`
+	// Projects break down queue latency for each project.
+	Projects []ReplayProjectReport `json:"projects"`
+}
+
+// ReplayProjectReport is the queue latency of a project's tasks in a replay.
+type ReplayProjectReport struct {
+	Project             string        `json:"project"`
+	NumTasksDispatched  int           `json:"num_tasks_dispatched"`
+	AverageQueueLatency time.Duration `json:"average_queue_latency_ns"`
+	MaxQueueLatency     time.Duration `json:"max_queue_latency_ns"`
+}
+
+// Replay simulates how the scheduler would have handled the tasks in the
+// snapshot, by repeatedly running the planner and the utilization-based host
+// allocator on them and dispatching tasks in plan order to free hosts. Tasks
+// are assumed to run for their expected duration, so the report is a
+// projection rather than a prediction of what exactly would have happened.
+func Replay(ctx context.Context, snapshot *model.SchedulerSnapshot, opts ReplayOptions) (*ReplayReport, error) {
+	if snapshot == nil {
+		return nil, errors.New("snapshot must not be nil")
+	}
+	if opts.SchedulingInterval < 0 || opts.HostStartupTime < 0 || opts.MaxDuration < 0 {
+		return nil, errors.New("replay durations must not be negative")
+	}
+	if opts.SchedulingInterval == 0 {
+		opts.SchedulingInterval = defaultReplaySchedulingInterval
+	}
+	if opts.HostStartupTime == 0 {
+		opts.HostStartupTime = defaultReplayHostStartupTime
+	}
+	if opts.MaxDuration == 0 {
+		opts.MaxDuration = defaultReplayMaxDuration
+	}
+
+	r := newReplay(snapshot, opts)
+	start := snapshot.CapturedAt
+	now := start
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Wrap(err, "replaying snapshot")
+		}
+
+		r.finishTasks(now)
+		if len(r.pending) == 0 && r.numRunning() == 0 {
+			r.report.Complete = true
+			break
+		}
+		if now.Sub(start) >= opts.MaxDuration {
+			break
+		}
+		r.terminateIdleHosts(now)
+
+		plan, err := r.schedule(ctx, now)
+		if err != nil {
+			return nil, errors.Wrapf(err, "scheduling at %s into the replay", now.Sub(start))
+		}
+		r.dispatch(now, plan)
+
+		now = now.Add(opts.SchedulingInterval)
+	}
+
+	r.summarize(start, now)
+	return &r.report, nil
+}
+
+// replayTask is a task's state during a replay.
+type replayTask struct {
+	model.SchedulerSnapshotTask
+	// unmetDeps are the IDs of the task's dependencies that haven't finished.
+	unmetDeps map[string]bool
+	// readyAt is when the task's dependencies were met.
+	readyAt      time.Time
+	dispatchedAt time.Time
+	finishesAt   time.Time
+}
+
+// replayHost is a host's state during a replay.
+type replayHost struct {
+	id           string
+	startedAt    time.Time
+	upAt         time.Time
+	terminatedAt time.Time
+	idleSince    time.Time
+	running      *replayTask
+}
+
+func (h *replayHost) isUp(now time.Time) bool {
+	return h.terminatedAt.IsZero() && !h.upAt.After(now)
+}
+
+type replayLatency struct {
+	project string
+	latency time.Duration
+}
+
+type replay struct {
+	distro      *distro.Distro
+	opts        ReplayOptions
+	plannerOpts TaskPlannerOptions
+
+	tasks      map[string]*replayTask
+	pending    []*replayTask
+	dependents map[string][]*replayTask
+	hosts      []*replayHost
+
+	latencies  []replayLatency
+	lastFinish time.Time
+	report     ReplayReport
+}
+
+func newReplay(snapshot *model.SchedulerSnapshot, opts ReplayOptions) *replay {
+	d := &distro.Distro{
+		Id:                    snapshot.DistroID,
+		Provider:              snapshot.Provider,
+		PlannerSettings:       snapshot.PlannerSettings,
+		HostAllocatorSettings: snapshot.HostAllocatorSettings,
+		DispatcherSettings:    distro.DispatcherSettings{Version: snapshot.DispatcherVersion},
+	}
+	if opts.PlannerSettings != nil {
+		d.PlannerSettings = *opts.PlannerSettings
+	}
+	if opts.HostAllocatorSettings != nil {
+		d.HostAllocatorSettings = *opts.HostAllocatorSettings
+	}
+
+	start := snapshot.CapturedAt
+	r := &replay{
+		distro: d,
+		opts:   opts,
+		plannerOpts: TaskPlannerOptions{
+			IncludesDependencies: d.DispatcherSettings.Version == evergreen.DispatcherVersionRevisedWithDependencies,
+		},
+		tasks:      map[string]*replayTask{},
+		dependents: map[string][]*replayTask{},
+		lastFinish: start,
+		report: ReplayReport{
+			DistroID:              d.Id,
+			PlannerSettings:       d.PlannerSettings,
+			HostAllocatorSettings: d.HostAllocatorSettings,
+			NumTasks:              len(snapshot.Tasks),
+		},
+	}
+
+	for _, st := range snapshot.Tasks {
+		rt := &replayTask{SchedulerSnapshotTask: st, unmetDeps: map[string]bool{}}
+		r.tasks[rt.ID] = rt
+		r.pending = append(r.pending, rt)
+	}
+	for _, sh := range snapshot.Hosts {
+		h := &replayHost{id: sh.ID, startedAt: start, upAt: start, idleSince: start}
+		if sh.Status != evergreen.HostRunning {
+			h.upAt = start.Add(opts.HostStartupTime)
+		}
+		if sh.RunningTask != nil {
+			rt := &replayTask{SchedulerSnapshotTask: *sh.RunningTask, dispatchedAt: sh.RunningTask.StartTime}
+			if rt.dispatchedAt.IsZero() {
+				rt.dispatchedAt = start
+			}
+			rt.finishesAt = rt.dispatchedAt.Add(rt.ExpectedDuration)
+			if rt.finishesAt.Before(start) {
+				rt.finishesAt = start
+			}
+			r.tasks[rt.ID] = rt
+			h.running = rt
+		}
+		r.hosts = append(r.hosts, h)
+	}
+
+	for _, rt := range r.pending {
+		for _, dep := range rt.DependsOn {
+			if _, ok := r.tasks[dep]; ok && dep != rt.ID {
+				rt.unmetDeps[dep] = true
+				r.dependents[dep] = append(r.dependents[dep], rt)
+			}
+		}
+		if len(rt.unmetDeps) == 0 {
+			rt.readyAt = snapshotReadyTime(rt.SchedulerSnapshotTask, start)
+		}
+	}
+
+	return r
+}
+
+// snapshotReadyTime returns when a task that was ready to run in a snapshot
+// started waiting for a host.
+func snapshotReadyTime(st model.SchedulerSnapshotTask, capturedAt time.Time) time.Time {
+	readyAt := st.ScheduledTime
+	if st.DependenciesMetTime.After(readyAt) {
+		readyAt = st.DependenciesMetTime
+	}
+	if readyAt.IsZero() {
+		readyAt = st.ActivatedTime
+	}
+	if readyAt.IsZero() || readyAt.After(capturedAt) {
+		readyAt = capturedAt
+	}
+	return readyAt
+}
+
+func (r *replay) numRunning() int {
+	n := 0
+	for _, h := range r.hosts {
+		if h.running != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// finishTasks finishes the tasks that are done running by now and unblocks
+// the tasks that depend on them.
+func (r *replay) finishTasks(now time.Time) {
+	for _, h := range r.hosts {
+		rt := h.running
+		if rt == nil || rt.finishesAt.After(now) {
+			continue
+		}
+		h.running = nil
+		h.idleSince = rt.finishesAt
+		if rt.finishesAt.After(r.lastFinish) {
+			r.lastFinish = rt.finishesAt
+		}
+		for _, dependent := range r.dependents[rt.ID] {
+			delete(dependent.unmetDeps, rt.ID)
+			if len(dependent.unmetDeps) == 0 {
+				dependent.readyAt = rt.finishesAt
+			}
+		}
+	}
+}
+
+// terminateIdleHosts terminates hosts that have been idle for longer than the
+// distro's acceptable idle time, as long as the distro stays above its
+// minimum number of hosts.
+func (r *replay) terminateIdleHosts(now time.Time) {
+	if !r.distro.IsEphemeral() {
+		return
+	}
+
+	numActive := 0
+	for _, h := range r.hosts {
+		if h.terminatedAt.IsZero() {
+			numActive++
+		}
+	}
+	for _, h := range r.hosts {
+		if numActive <= r.distro.HostAllocatorSettings.MinimumHosts {
+			return
+		}
+		if !h.isUp(now) || h.running != nil {
+			continue
+		}
+		idleTime := now.Sub(h.idleSince)
+		if idleTime > 0 && idleTime >= r.distro.HostAllocatorSettings.AcceptableHostIdleTime {
+			h.terminatedAt = now
+			numActive--
+		}
+	}
+}
+
+// schedule runs the planner and host allocator as they would run now, starts
+// the hosts that the host allocator asks for, and returns the plan.
+func (r *replay) schedule(ctx context.Context, now time.Time) ([]task.Task, error) {
+	// The planner and host allocator measure how long tasks have been waiting
+	// and running relative to the current time, so every time is shifted as
+	// if the replay were happening right now.
+	shift := time.Since(now)
+
+	tasks := make([]task.Task, 0, len(r.pending))
+	for _, rt := range r.pending {
+		tasks = append(tasks, rt.toTask(r.distro, shift))
+	}
+
+	var existingHosts []host.Host
+	runningTasks := map[string]task.Task{}
+	runningByProject := map[string]int{}
+	for _, h := range r.hosts {
+		if !h.terminatedAt.IsZero() {
+			continue
+		}
+		existingHost := host.Host{
+			Id:        h.id,
+			Distro:    *r.distro,
+			StartedBy: evergreen.User,
+			Status:    evergreen.HostStarting,
+		}
+		if h.isUp(now) {
+			existingHost.Status = evergreen.HostRunning
+		}
+		if rt := h.running; rt != nil {
+			existingHost.RunningTask = rt.ID
+			existingHost.RunningTaskGroup = rt.TaskGroup
+			existingHost.RunningTaskGroupOrder = rt.TaskGroupOrder
+			existingHost.RunningTaskBuildVariant = rt.BuildVariant
+			existingHost.RunningTaskProject = rt.Project
+			existingHost.RunningTaskVersion = rt.Version
+			runningTasks[rt.ID] = rt.toTask(r.distro, shift)
+			runningByProject[rt.Project]++
+		}
+		existingHosts = append(existingHosts, existingHost)
+	}
+
+	var fs *fairShare
+	if len(r.distro.PlannerSettings.FairShareWeights) > 0 {
+		fs = newFairShare(r.distro, tasks, runningByProject)
+	}
+	plan := PrepareTasksForPlanning(ctx, r.distro, tasks).setFairShare(fs).Export(ctx)
+	info := getDistroQueueInfo(ctx, r.distro, plan, r.plannerOpts, func(t *task.Task) bool {
+		rt, ok := r.tasks[t.Id]
+		return ok && len(rt.unmetDeps) == 0
+	})
+
+	numNewHosts, _, err := UtilizationBasedHostAllocator(ctx, &HostAllocatorData{
+		Distro:          *r.distro,
+		ExistingHosts:   existingHosts,
+		DistroQueueInfo: info,
+		runningTasks:    runningTasks,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "allocating hosts")
+	}
+	for i := 0; i < numNewHosts; i++ {
+		r.report.HostsStarted++
+		upAt := now.Add(r.opts.HostStartupTime)
+		r.hosts = append(r.hosts, &replayHost{startedAt: now, upAt: upAt, idleSince: upAt})
+	}
+
+	return plan, nil
+}
+
+// dispatch assigns the ready tasks in the plan to free hosts in order.
+func (r *replay) dispatch(now time.Time, plan []task.Task) {
+	var freeHosts []*replayHost
+	taskGroupHosts := map[string]int{}
+	for _, h := range r.hosts {
+		if !h.isUp(now) {
+			continue
+		}
+		if h.running == nil {
+			freeHosts = append(freeHosts, h)
+		} else if h.running.TaskGroup != "" {
+			taskGroupHosts[h.running.taskGroupString()]++
+		}
+	}
+
+	dispatched := map[string]bool{}
+	for _, t := range plan {
+		if len(freeHosts) == 0 {
+			break
+		}
+		rt, ok := r.tasks[t.Id]
+		if !ok || len(rt.unmetDeps) > 0 {
+			continue
+		}
+		var group string
+		if rt.TaskGroup != "" {
+			group = rt.taskGroupString()
+			if rt.TaskGroupMaxHosts > 0 && taskGroupHosts[group] >= rt.TaskGroupMaxHosts {
+				continue
+			}
+		}
+
+		h := freeHosts[0]
+		freeHosts = freeHosts[1:]
+		h.running = rt
+		rt.dispatchedAt = now
+		rt.finishesAt = now.Add(rt.ExpectedDuration)
+		if group != "" {
+			taskGroupHosts[group]++
+		}
+		dispatched[rt.ID] = true
+		r.latencies = append(r.latencies, replayLatency{project: rt.Project, latency: now.Sub(rt.readyAt)})
+	}
+
+	if len(dispatched) == 0 {
+		return
+	}
+	pending := r.pending[:0]
+	for _, rt := range r.pending {
+		if !dispatched[rt.ID] {
+			pending = append(pending, rt)
+		}
+	}
+	r.pending = pending
+}
+
+// summarize fills in the report once the replay has ended.
+func (r *replay) summarize(start, end time.Time) {
+	if r.report.Complete {
+		end = r.lastFinish
+		r.report.Makespan = r.lastFinish.Sub(start)
+	}
+
+	var hostTime time.Duration
+	for _, h := range r.hosts {
+		stoppedAt := end
+		if !h.terminatedAt.IsZero() && h.terminatedAt.Before(end) {
+			stoppedAt = h.terminatedAt
+		}
+		if stoppedAt.After(h.startedAt) {
+			hostTime += stoppedAt.Sub(h.startedAt)
+		}
+	}
+	r.report.HostHours = hostTime.Hours()
+	r.report.PeakHosts = r.peakHosts()
+
+	r.report.NumTasksDispatched = len(r.latencies)
+	if len(r.latencies) == 0 {
+		return
+	}
+
+	latencies := make([]time.Duration, 0, len(r.latencies))
+	byProject := map[string]*ReplayProjectReport{}
+	var total time.Duration
+	for _, l := range r.latencies {
+		latencies = append(latencies, l.latency)
+		total += l.latency
+
+		projectReport, ok := byProject[l.project]
+		if !ok {
+			projectReport = &ReplayProjectReport{Project: l.project}
+			byProject[l.project] = projectReport
+		}
+		projectReport.NumTasksDispatched++
+		projectReport.AverageQueueLatency += l.latency
+		projectReport.MaxQueueLatency = max(projectReport.MaxQueueLatency, l.latency)
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	r.report.AverageQueueLatency = total / time.Duration(len(latencies))
+	r.report.MedianQueueLatency = percentileDuration(latencies, 0.5)
+	r.report.P90QueueLatency = percentileDuration(latencies, 0.9)
+	r.report.MaxQueueLatency = latencies[len(latencies)-1]
+
+	for _, projectReport := range byProject {
+		projectReport.AverageQueueLatency /= time.Duration(projectReport.NumTasksDispatched)
+		r.report.Projects = append(r.report.Projects, *projectReport)
+	}
+	sort.Slice(r.report.Projects, func(i, j int) bool {
+		return r.report.Projects[i].Project < r.report.Projects[j].Project
+	})
+}
+
+// peakHosts returns the most hosts that were running or starting at once.
+func (r *replay) peakHosts() int {
+	type hostEvent struct {
+		at    time.Time
+		delta int
+	}
+	events := make([]hostEvent, 0, 2*len(r.hosts))
+	for _, h := range r.hosts {
+		events = append(events, hostEvent{at: h.startedAt, delta: 1})
+		if !h.terminatedAt.IsZero() {
+			events = append(events, hostEvent{at: h.terminatedAt, delta: -1})
+		}
+	}
+	// Hosts terminated at the same time others start aren't up at once.
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].at.Equal(events[j].at) {
+			return events[i].delta < events[j].delta
+		}
+		return events[i].at.Before(events[j].at)
+	})
+
+	peak, current := 0, 0
+	for _, e := range events {
+		current += e.delta
+		peak = max(peak, current)
+	}
+	return peak
+}
+
+// percentileDuration returns the pth percentile of the sorted durations.
+func percentileDuration(sorted []time.Duration, p float64) time.Duration {
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(idx, 0)]
+}
+
+func (rt *replayTask) taskGroupString() string {
+	t := task.Task{TaskGroup: rt.TaskGroup, BuildVariant: rt.BuildVariant, Project: rt.Project, Version: rt.Version}
+	return t.GetTaskGroupString()
+}
+
+// toTask converts the replayed task into the task that the planner and host
+// allocator would see, with its times shifted by the given amount.
+func (rt *replayTask) toTask(d *distro.Distro, shift time.Duration) task.Task {
+	t := task.Task{
+		Id:                  rt.ID,
+		DisplayName:         rt.DisplayName,
+		BuildVariant:        rt.BuildVariant,
+		Project:             rt.Project,
+		Version:             rt.Version,
+		Requester:           rt.Requester,
+		DistroId:            d.Id,
+		Activated:           true,
+		TaskGroup:           rt.TaskGroup,
+		TaskGroupMaxHosts:   rt.TaskGroupMaxHosts,
+		TaskGroupOrder:      rt.TaskGroupOrder,
+		Priority:            rt.Priority,
+		ActivatedBy:         rt.ActivatedBy,
+		GenerateTask:        rt.GenerateTask,
+		NumDependents:       rt.NumDependents,
+		ActivatedTime:       shiftTime(rt.ActivatedTime, shift),
+		IngestTime:          shiftTime(rt.IngestTime, shift),
+		ScheduledTime:       shiftTime(rt.ScheduledTime, shift),
+		DependenciesMetTime: shiftTime(rt.readyAt, shift),
+		StartTime:           shiftTime(rt.dispatchedAt, shift),
+		DurationPrediction: util.CachedDurationValue{
+			Value:       rt.ExpectedDuration,
+			StdDev:      rt.ExpectedDurationStdDev,
+			TTL:         replayDurationPredictionTTL,
+			CollectedAt: time.Now(),
+		},
+	}
+	for dep := range rt.unmetDeps {
+		t.DependsOn = append(t.DependsOn, task.Dependency{TaskId: dep})
+	}
+
+	return t
+}
+
+func shiftTime(t time.Time, shift time.Duration) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.Add(shift)
+}
@@ -0,0 +1,188 @@
+package scheduler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	capturedAt := time.Now().Truncate(time.Minute)
+	newTask := func(id, project, requester string, duration time.Duration) model.SchedulerSnapshotTask {
+		return model.SchedulerSnapshotTask{
+			ID:               id,
+			DisplayName:      id,
+			BuildVariant:     "bv",
+			Project:          project,
+			Version:          project + "_version",
+			Requester:        requester,
+			ActivatedTime:    capturedAt,
+			ScheduledTime:    capturedAt,
+			ExpectedDuration: duration,
+		}
+	}
+	staticSnapshot := func(numHosts int, tasks ...model.SchedulerSnapshotTask) *model.SchedulerSnapshot {
+		snapshot := &model.SchedulerSnapshot{
+			DistroID:              "static",
+			CapturedAt:            capturedAt,
+			Provider:              evergreen.ProviderNameStatic,
+			HostAllocatorSettings: distro.HostAllocatorSettings{MaximumHosts: numHosts},
+			Tasks:                 tasks,
+		}
+		for i := 0; i < numHosts; i++ {
+			snapshot.Hosts = append(snapshot.Hosts, model.SchedulerSnapshotHost{ID: fmt.Sprintf("h%d", i), Status: evergreen.HostRunning})
+		}
+		return snapshot
+	}
+
+	t.Run("PlannerSettingsChangeDispatchOrder", func(t *testing.T) {
+		snapshot := staticSnapshot(1,
+			newTask("mainline_task", "mainline", evergreen.RepotrackerVersionRequester, 10*time.Minute),
+			newTask("patch_task", "patch", evergreen.PatchVersionRequester, 10*time.Minute),
+		)
+
+		report, err := Replay(t.Context(), snapshot, ReplayOptions{})
+		require.NoError(t, err)
+		assert.True(t, report.Complete)
+		assert.Equal(t, 2, report.NumTasks)
+		assert.Equal(t, 2, report.NumTasksDispatched)
+		assert.Equal(t, 20*time.Minute, report.Makespan)
+		assert.InDelta(t, 20.0/60, report.HostHours, 0.001)
+		assert.Zero(t, report.HostsStarted)
+		assert.Equal(t, 1, report.PeakHosts)
+		require.Len(t, report.Projects, 2)
+		assert.Equal(t, "mainline", report.Projects[0].Project)
+		assert.Zero(t, report.Projects[0].AverageQueueLatency, "recent mainline tasks should run first by default")
+		assert.Equal(t, "patch", report.Projects[1].Project)
+		assert.Equal(t, 10*time.Minute, report.Projects[1].AverageQueueLatency)
+
+		report, err = Replay(t.Context(), snapshot, ReplayOptions{
+			PlannerSettings: &distro.PlannerSettings{PatchFactor: 1000},
+		})
+		require.NoError(t, err)
+		assert.True(t, report.Complete)
+		assert.EqualValues(t, 1000, report.PlannerSettings.PatchFactor)
+		require.Len(t, report.Projects, 2)
+		assert.Equal(t, 10*time.Minute, report.Projects[0].AverageQueueLatency)
+		assert.Zero(t, report.Projects[1].AverageQueueLatency, "a large patch factor should run the patch task first")
+	})
+	t.Run("DependentsWaitForDependencies", func(t *testing.T) {
+		test := newTask("test", "project", evergreen.RepotrackerVersionRequester, 5*time.Minute)
+		test.DependsOn = []string{"compile"}
+		snapshot := staticSnapshot(2, newTask("compile", "project", evergreen.RepotrackerVersionRequester, 10*time.Minute), test)
+
+		report, err := Replay(t.Context(), snapshot, ReplayOptions{})
+		require.NoError(t, err)
+		assert.True(t, report.Complete)
+		assert.Equal(t, 2, report.NumTasksDispatched)
+		assert.Equal(t, 15*time.Minute, report.Makespan)
+		assert.Zero(t, report.MaxQueueLatency, "queue latency should start when dependencies are met")
+	})
+	t.Run("TaskGroupMaxHostsIsRespected", func(t *testing.T) {
+		first := newTask("first", "project", evergreen.RepotrackerVersionRequester, 10*time.Minute)
+		second := newTask("second", "project", evergreen.RepotrackerVersionRequester, 10*time.Minute)
+		for _, tsk := range []*model.SchedulerSnapshotTask{&first, &second} {
+			tsk.TaskGroup = "tg"
+			tsk.TaskGroupMaxHosts = 1
+		}
+		snapshot := staticSnapshot(2, first, second)
+
+		report, err := Replay(t.Context(), snapshot, ReplayOptions{})
+		require.NoError(t, err)
+		assert.True(t, report.Complete)
+		assert.Equal(t, 10*time.Minute, report.MaxQueueLatency)
+		assert.Equal(t, 20*time.Minute, report.Makespan)
+	})
+	t.Run("RunningTasksFinishBeforeHostsAreFree", func(t *testing.T) {
+		snapshot := staticSnapshot(1, newTask("queued", "project", evergreen.RepotrackerVersionRequester, 10*time.Minute))
+		running := newTask("running", "project", evergreen.RepotrackerVersionRequester, 10*time.Minute)
+		running.StartTime = capturedAt.Add(-5 * time.Minute)
+		snapshot.Hosts[0].RunningTask = &running
+
+		report, err := Replay(t.Context(), snapshot, ReplayOptions{})
+		require.NoError(t, err)
+		assert.True(t, report.Complete)
+		assert.Equal(t, 1, report.NumTasksDispatched, "only queued tasks should be counted")
+		assert.Equal(t, 5*time.Minute, report.MaxQueueLatency)
+		assert.Equal(t, 15*time.Minute, report.Makespan)
+	})
+	t.Run("HostAllocatorStartsHosts", func(t *testing.T) {
+		snapshot := &model.SchedulerSnapshot{
+			DistroID:   "ephemeral",
+			CapturedAt: capturedAt,
+			Provider:   evergreen.ProviderNameEc2Fleet,
+			HostAllocatorSettings: distro.HostAllocatorSettings{
+				MaximumHosts:           2,
+				AcceptableHostIdleTime: 5 * time.Minute,
+			},
+		}
+		for _, id := range []string{"t1", "t2", "t3", "t4"} {
+			snapshot.Tasks = append(snapshot.Tasks, newTask(id, "project", evergreen.RepotrackerVersionRequester, 30*time.Minute))
+		}
+
+		report, err := Replay(t.Context(), snapshot, ReplayOptions{})
+		require.NoError(t, err)
+		assert.True(t, report.Complete)
+		assert.Equal(t, 4, report.NumTasksDispatched)
+		assert.Equal(t, 2, report.HostsStarted)
+		assert.Equal(t, 2, report.PeakHosts)
+		assert.Equal(t, 65*time.Minute, report.Makespan)
+		assert.InDelta(t, 2*65.0/60, report.HostHours, 0.001)
+		assert.Equal(t, 20*time.Minute, report.AverageQueueLatency)
+		assert.Equal(t, 5*time.Minute, report.MedianQueueLatency)
+		assert.Equal(t, 35*time.Minute, report.P90QueueLatency)
+		assert.Equal(t, 35*time.Minute, report.MaxQueueLatency)
+
+		report, err = Replay(t.Context(), snapshot, ReplayOptions{
+			HostAllocatorSettings: &distro.HostAllocatorSettings{MaximumHosts: 4, AcceptableHostIdleTime: 5 * time.Minute},
+		})
+		require.NoError(t, err)
+		assert.True(t, report.Complete)
+		assert.Equal(t, 4, report.HostsStarted)
+		assert.Equal(t, 35*time.Minute, report.Makespan)
+		assert.Equal(t, 5*time.Minute, report.MaxQueueLatency)
+	})
+	t.Run("IdleHostsAreTerminated", func(t *testing.T) {
+		snapshot := &model.SchedulerSnapshot{
+			DistroID:   "ephemeral",
+			CapturedAt: capturedAt,
+			Provider:   evergreen.ProviderNameEc2Fleet,
+			HostAllocatorSettings: distro.HostAllocatorSettings{
+				MaximumHosts:           2,
+				AcceptableHostIdleTime: 5 * time.Minute,
+			},
+			Tasks: []model.SchedulerSnapshotTask{newTask("t1", "project", evergreen.RepotrackerVersionRequester, 30*time.Minute)},
+			Hosts: []model.SchedulerSnapshotHost{
+				{ID: "h1", Status: evergreen.HostRunning},
+				{ID: "h2", Status: evergreen.HostRunning},
+			},
+		}
+
+		report, err := Replay(t.Context(), snapshot, ReplayOptions{})
+		require.NoError(t, err)
+		assert.True(t, report.Complete)
+		assert.Zero(t, report.HostsStarted)
+		assert.Equal(t, 30*time.Minute, report.Makespan)
+		assert.InDelta(t, 35.0/60, report.HostHours, 0.001, "the idle host should be terminated after its idle time")
+	})
+	t.Run("StopsAtMaxDuration", func(t *testing.T) {
+		snapshot := staticSnapshot(0, newTask("stuck", "project", evergreen.RepotrackerVersionRequester, time.Minute))
+
+		report, err := Replay(t.Context(), snapshot, ReplayOptions{MaxDuration: 10 * time.Minute})
+		require.NoError(t, err)
+		assert.False(t, report.Complete)
+		assert.Equal(t, 1, report.NumTasks)
+		assert.Zero(t, report.NumTasksDispatched)
+		assert.Zero(t, report.Makespan)
+	})
+	t.Run("NilSnapshot", func(t *testing.T) {
+		_, err := Replay(t.Context(), nil, ReplayOptions{})
+		assert.Error(t, err)
+	})
+}
@@ -40,7 +40,12 @@ func runTunablePlanner(ctx context.Context, d *distro.Distro, tasks []task.Task,
 		return nil, errors.WithStack(err)
 	}
 
-	plan := PrepareTasksForPlanning(ctx, d, tasks).Export(ctx)
+	fairShare, err := getFairShare(ctx, d, tasks)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	plan := PrepareTasksForPlanning(ctx, d, tasks).setFairShare(fairShare).Export(ctx)
 	info := GetDistroQueueInfo(ctx, d, plan, opts)
 	info.SecondaryQueue = opts.IsSecondaryQueue
 	info.PlanCreatedAt = opts.StartedAt
@@ -55,20 +60,29 @@ const RunnerName = "scheduler"
 
 // GetDistroQueueInfo returns the distroQueueInfo for the given set of tasks having set the task.ExpectedDuration for each task.
 func GetDistroQueueInfo(ctx context.Context, d *distro.Distro, tasks []task.Task, opts TaskPlannerOptions) model.DistroQueueInfo {
-	var distroExpectedDuration, distroDurationOverThreshold time.Duration
-	var distroCountDurationOverThreshold, distroCountWaitOverThreshold, numTasksDepsMet, numMergeQueueTasks, numLargeParserProjectTasks int
-	var isSecondaryQueue bool
-	taskGroupInfosMap := make(map[string]*model.TaskGroupInfo)
 	depCache := make(map[string]task.Task, len(tasks))
 	for _, t := range tasks {
 		depCache[t.Id] = t
 	}
 
+	return getDistroQueueInfo(ctx, d, tasks, opts, func(t *task.Task) bool {
+		return checkDependenciesMet(ctx, t, depCache)
+	})
+}
+
+// getDistroQueueInfo is the same as GetDistroQueueInfo, but uses
+// dependenciesMet to check whether each task's dependencies are met.
+func getDistroQueueInfo(ctx context.Context, d *distro.Distro, tasks []task.Task, opts TaskPlannerOptions, dependenciesMet func(*task.Task) bool) model.DistroQueueInfo {
+	var distroExpectedDuration, distroDurationOverThreshold time.Duration
+	var distroCountDurationOverThreshold, distroCountWaitOverThreshold, numTasksDepsMet, numMergeQueueTasks, numLargeParserProjectTasks, numOverFairShare int
+	var isSecondaryQueue bool
+	taskGroupInfosMap := make(map[string]*model.TaskGroupInfo)
+
 	// Resolve dependency state up front because the target time below depends on it.
 	depsMet := make(map[string]bool, len(tasks))
 	var hasMergeQueueTasks bool
 	for i := range tasks {
-		met := checkDependenciesMet(ctx, &tasks[i], depCache)
+		met := dependenciesMet(&tasks[i])
 		depsMet[tasks[i].Id] = met
 		if met && evergreen.IsGithubMergeQueueRequester(tasks[i].Requester) {
 			hasMergeQueueTasks = true
@@ -91,11 +105,18 @@ func GetDistroQueueInfo(ctx context.Context, d *distro.Distro, tasks []task.Task
 		}
 
 		dependenciesMet := depsMet[task.Id]
+		// Tasks from projects over their fair share shouldn't need new hosts,
+		// so they're left out of the durations used by the host allocator.
+		overFairShare := task.SortingValueBreakdown.OverFairShare
+		if overFairShare {
+			numOverFairShare++
+		}
+		needsHost := (!opts.IncludesDependencies || dependenciesMet) && !overFairShare
 
 		var exists bool
 		var info *model.TaskGroupInfo
 		if info, exists = taskGroupInfosMap[name]; exists {
-			if !opts.IncludesDependencies || dependenciesMet {
+			if needsHost {
 				info.Count++
 				info.ExpectedDuration += duration
 			}
@@ -105,7 +126,7 @@ func GetDistroQueueInfo(ctx context.Context, d *distro.Distro, tasks []task.Task
 				MaxHosts: task.TaskGroupMaxHosts,
 			}
 
-			if !opts.IncludesDependencies || dependenciesMet {
+			if needsHost {
 				info.Count++
 				info.ExpectedDuration += duration
 			}
@@ -123,9 +144,11 @@ func GetDistroQueueInfo(ctx context.Context, d *distro.Distro, tasks []task.Task
 		}
 		if !opts.IncludesDependencies || dependenciesMet {
 			task.ExpectedDuration = duration
-			distroExpectedDuration += duration
+			if needsHost {
+				distroExpectedDuration += duration
+			}
 			// duration is defined as expected runtime and does not include wait time
-			if duration > maxDurationThreshold {
+			if needsHost && duration > maxDurationThreshold {
 				if info != nil {
 					info.CountDurationOverThreshold++
 					info.DurationOverThreshold += duration
@@ -141,7 +164,7 @@ func GetDistroQueueInfo(ctx context.Context, d *distro.Distro, tasks []task.Task
 				task.WaitSinceDependenciesMet = time.Since(startTime)
 
 				// actual wait time allows us to independently check that the threshold is working
-				if task.WaitSinceDependenciesMet > maxDurationThreshold {
+				if needsHost && task.WaitSinceDependenciesMet > maxDurationThreshold {
 					if info != nil {
 						info.CountWaitOverThreshold++
 					}
@@ -170,6 +193,7 @@ func GetDistroQueueInfo(ctx context.Context, d *distro.Distro, tasks []task.Task
 		DurationOverThreshold:            distroDurationOverThreshold,
 		CountWaitOverThreshold:           distroCountWaitOverThreshold,
 		NumQueuedLargeParserProjectTasks: numLargeParserProjectTasks,
+		CountOverFairShare:               numOverFairShare,
 		TaskGroupInfos:                   taskGroupInfos,
 		SecondaryQueue:                   isSecondaryQueue,
 	}
@@ -272,3 +272,34 @@ func TestGetDistroQueueInfoMergeQueueTargetTime(t *testing.T) {
 		assert.Equal(t, 1, info.CountDepFilledMergeQueueTasks)
 	})
 }
+
+func TestGetDistroQueueInfoFairShare(t *testing.T) {
+	ctx := t.Context()
+	require.NoError(t, db.ClearCollections(task.Collection))
+
+	d := &distro.Distro{
+		Id:              "d",
+		PlannerSettings: distro.PlannerSettings{TargetTime: 30 * time.Minute},
+	}
+	underShare := task.Task{
+		Id:               "under_share",
+		DistroId:         d.Id,
+		Requester:        evergreen.RepotrackerVersionRequester,
+		ExpectedDuration: 10 * time.Minute,
+	}
+	overShare := task.Task{
+		Id:                    "over_share",
+		DistroId:              d.Id,
+		Requester:             evergreen.RepotrackerVersionRequester,
+		ExpectedDuration:      10 * time.Minute,
+		SortingValueBreakdown: task.SortingValueBreakdown{OverFairShare: true},
+	}
+
+	info := GetDistroQueueInfo(ctx, d, []task.Task{underShare, overShare}, TaskPlannerOptions{IncludesDependencies: true})
+	assert.Equal(t, 2, info.Length)
+	assert.Equal(t, 2, info.LengthWithDependenciesMet)
+	assert.Equal(t, 1, info.CountOverFairShare)
+	require.Len(t, info.TaskGroupInfos, 1)
+	assert.Equal(t, 1, info.TaskGroupInfos[0].Count, "tasks over their fair share should not count toward new hosts")
+	assert.Equal(t, info.ExpectedDuration, info.TaskGroupInfos[0].ExpectedDuration)
+}
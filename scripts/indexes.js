@@ -537,6 +537,18 @@ db.daily_task_stats.createIndex({
     "_id.date": 1
 })
 
+//======daily_test_stats======//
+db.daily_test_stats.createIndex({
+    "_id.date": 1
+}, {
+    expireAfterSeconds: 26 * 7 * 24 * 3600
+}) // 26 weeks TTL
+db.daily_test_stats.createIndex({
+    "_id.project": 1,
+    "_id.requester": 1,
+    "_id.date": 1
+})
+
 //======manifest======//
 db.manifest.createIndex({
     "project": 1,
@@ -224,6 +224,10 @@ func MockConfig() *evergreen.Settings {
 				TestResultsPrefix: "tr/prefix/",
 				RoleARN:           "arn:aws:iam::123456789012:role/test-results",
 			},
+			TaskFilesBucket: evergreen.BucketConfig{
+				Name: "task_files",
+				Type: evergreen.BucketTypeS3,
+			},
 			Credentials: evergreen.S3Credentials{
 				Key:    "aws_key",
 				Secret: "aws_secret",
@@ -0,0 +1,23 @@
+package thirdparty
+
+import (
+	"net/http"
+
+	"github.com/evergreen-ci/evergreen"
+	testselection "github.com/evergreen-ci/test-selection-client"
+)
+
+// NewTestSelectionClient constructs a new test selection service client using
+// the provided HTTP client.
+func NewTestSelectionClient(c *http.Client) *testselection.APIClient {
+	tssBaseURL := evergreen.GetEnvironment().Settings().TestSelection.URL
+	conf := testselection.NewConfiguration()
+	conf.HTTPClient = c
+	conf.Servers = testselection.ServerConfigurations{
+		testselection.ServerConfiguration{
+			URL:         tssBaseURL,
+			Description: "Test selection service",
+		},
+	}
+	return testselection.NewAPIClient(conf)
+}
@@ -216,6 +216,7 @@ func (t *buildTriggers) makeData(ctx context.Context, sub *event.Subscription, p
 	data := commonTemplateData{
 		ID:              t.build.Id,
 		EventID:         t.event.ID,
+		EventTime:       t.event.Timestamp,
 		SubscriptionID:  sub.ID,
 		DisplayName:     t.build.DisplayName,
 		Object:          event.ObjectBuild,
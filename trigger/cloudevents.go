@@ -0,0 +1,234 @@
+package trigger
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsContentType = "application/cloudevents+json; charset=utf-8"
+	cloudEventsTypePrefix  = "ci.evergreen."
+
+	// CloudEventsSchemaPath is the path of the REST route that serves the
+	// JSON Schema for a CloudEvents type, relative to the API URL.
+	CloudEventsSchemaPath = "/rest/v2/subscriptions/cloudevents/schemas"
+)
+
+// cloudEventObject is an object that can be sent as the data of a CloudEvent.
+type cloudEventObject struct {
+	resourceType string
+	// model is the REST model of the object, which is the CloudEvent's data.
+	model any
+}
+
+var cloudEventObjects = map[string]cloudEventObject{
+	event.ObjectTask:    {resourceType: event.ResourceTypeTask, model: restModel.APITask{}},
+	event.ObjectBuild:   {resourceType: event.ResourceTypeBuild, model: restModel.APIBuild{}},
+	event.ObjectVersion: {resourceType: event.ResourceTypeVersion, model: restModel.APIVersion{}},
+	event.ObjectPatch:   {resourceType: event.ResourceTypePatch, model: restModel.APIPatch{}},
+}
+
+// cloudEventTriggerNames maps triggers to the last part of their CloudEvents
+// type. Consumers route on the type, so these must not change once they're
+// published.
+var cloudEventTriggerNames = map[string]string{
+	event.TriggerOutcome:                     "finished",
+	event.TriggerFailure:                     "failed",
+	event.TriggerSuccess:                     "succeeded",
+	event.TriggerFamilyOutcome:               "family_finished",
+	event.TriggerFamilyFailure:               "family_failed",
+	event.TriggerFamilySuccess:               "family_succeeded",
+	event.TriggerGithubCheckOutcome:          "github_check_finished",
+	event.TriggerRegression:                  "regressed",
+	event.TriggerExceedsDuration:             "exceeded_duration",
+	event.TriggerSuccessfulExceedsDuration:   "succeeded_exceeding_duration",
+	event.TriggerRuntimeChangeByPercent:      "runtime_changed",
+	event.TriggerTaskFirstFailureInVersion:   "first_failure_in_version",
+	event.TriggerTaskStarted:                 "started",
+	event.TriggerTaskQueueLatency:            "queue_latency_exceeded",
+	event.TriggerPatchStarted:                "started",
+	triggerTaskFirstFailureInBuild:           "first_failure_in_build",
+	triggerTaskFirstFailureInVersionWithName: "first_failure_in_version_with_name",
+	triggerTaskRegressionByTest:              "regressed_by_test",
+	triggerBuildBreak:                        "build_broke",
+	triggerTaskFailedOrBlocked:               "failed_or_blocked",
+}
+
+// cloudEvent is a CloudEvents 1.0 event in structured mode.
+type cloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	DataSchema      string    `json:"dataschema,omitempty"`
+	// EvergreenProject is an extension attribute with the project of the
+	// object, so consumers can filter on it without reading the data.
+	EvergreenProject string `json:"evergreenproject,omitempty"`
+	Data             any    `json:"data"`
+}
+
+// CloudEventType returns the CloudEvents type of the notifications that the
+// trigger sends for the object. It returns false if the trigger can't send
+// CloudEvents for the object.
+func CloudEventType(object, triggerName string) (string, bool) {
+	if _, ok := cloudEventObjects[object]; !ok {
+		return "", false
+	}
+	name, ok := cloudEventTriggerNames[triggerName]
+	if !ok {
+		return "", false
+	}
+
+	return cloudEventsTypePrefix + object + "." + name, true
+}
+
+// SupportsCloudEvents returns whether subscriptions to the resource type and
+// trigger can send CloudEvents.
+func SupportsCloudEvents(resourceType, triggerName string) bool {
+	for object, info := range cloudEventObjects {
+		if info.resourceType == resourceType {
+			_, ok := CloudEventType(object, triggerName)
+			return ok && ValidateTrigger(resourceType, triggerName)
+		}
+	}
+
+	return false
+}
+
+// CloudEventTypes returns all the CloudEvents types that Evergreen sends, in
+// sorted order.
+func CloudEventTypes() []string {
+	seen := map[string]bool{}
+	for object, info := range cloudEventObjects {
+		for _, triggerName := range triggerNamesForResourceType(info.resourceType) {
+			if eventType, ok := CloudEventType(object, triggerName); ok {
+				seen[eventType] = true
+			}
+		}
+	}
+
+	types := make([]string, 0, len(seen))
+	for eventType := range seen {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+func triggerNamesForResourceType(resourceType string) []string {
+	var names []string
+	for _, factory := range registry.handlersByResourceType[resourceType] {
+		if h, ok := factory().(interface{ triggerNames() []string }); ok {
+			names = append(names, h.triggerNames()...)
+		}
+	}
+
+	return names
+}
+
+// CloudEventSchemaURL returns the URL of the JSON Schema for the CloudEvents
+// type.
+func CloudEventSchemaURL(apiURL, eventType string) string {
+	return strings.TrimSuffix(apiURL, "/") + CloudEventsSchemaPath + "/" + eventType
+}
+
+// CloudEventSchema returns the JSON Schema for CloudEvents of the given type,
+// with the schema of the event's data under "properties/data". It returns
+// false if Evergreen doesn't send the type.
+func CloudEventSchema(apiURL, eventType string) (map[string]any, bool) {
+	found := false
+	for _, t := range CloudEventTypes() {
+		if t == eventType {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	object, _, _ := strings.Cut(strings.TrimPrefix(eventType, cloudEventsTypePrefix), ".")
+	dataSchema, defs := util.JSONSchemaFor(cloudEventObjects[object].model)
+
+	stringSchema := map[string]any{"type": "string"}
+	return map[string]any{
+		"$schema": util.JSONSchemaDialect,
+		"$id":     CloudEventSchemaURL(apiURL, eventType),
+		"title":   eventType,
+		"type":    "object",
+		"required": []string{
+			"specversion", "id", "source", "type", "time", "datacontenttype", "data",
+		},
+		"properties": map[string]any{
+			"specversion":      map[string]any{"const": cloudEventsSpecVersion},
+			"id":               stringSchema,
+			"source":           map[string]any{"type": "string", "format": "uri-reference"},
+			"type":             map[string]any{"const": eventType},
+			"subject":          stringSchema,
+			"time":             map[string]any{"type": "string", "format": "date-time"},
+			"datacontenttype":  map[string]any{"const": "application/json"},
+			"dataschema":       map[string]any{"type": "string", "format": "uri"},
+			"evergreenproject": stringSchema,
+			"data":             dataSchema,
+		},
+		"$defs": defs,
+	}, true
+}
+
+func isCloudEventsWebhook(subscriber event.Subscriber) bool {
+	switch target := subscriber.Target.(type) {
+	case *event.WebhookSubscriber:
+		return target.IsCloudEvents()
+	case event.WebhookSubscriber:
+		return target.IsCloudEvents()
+	}
+
+	return false
+}
+
+// cloudEventPayload returns a webhook that posts the object's REST model as
+// the data of a CloudEvent. The webhook is signed the same way as webhooks in
+// the Evergreen format.
+func cloudEventPayload(sub *event.Subscription, data *commonTemplateData) (*util.EvergreenWebhook, error) {
+	eventType, ok := CloudEventType(data.Object, sub.Trigger)
+	if !ok {
+		return nil, errors.Errorf("trigger '%s' for object '%s' doesn't support CloudEvents", sub.Trigger, data.Object)
+	}
+
+	settings := evergreen.GetEnvironment().Settings()
+	eventTime := data.EventTime
+	if eventTime.IsZero() {
+		eventTime = time.Now()
+	}
+	ce := cloudEvent{
+		SpecVersion:      cloudEventsSpecVersion,
+		ID:               fmt.Sprintf("%s-%s", data.EventID, data.SubscriptionID),
+		Source:           settings.Ui.Url,
+		Type:             eventType,
+		Subject:          data.ID,
+		Time:             eventTime,
+		DataContentType:  "application/json",
+		DataSchema:       CloudEventSchemaURL(settings.Api.URL, eventType) + "#/properties/data",
+		EvergreenProject: data.Project,
+		Data:             data.apiModel,
+	}
+	body, err := json.Marshal(ce)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling CloudEvent")
+	}
+
+	return webhookWithContentType(body, cloudEventsContentType, data.Headers), nil
+}
@@ -0,0 +1,82 @@
+package trigger
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudEventTypes(t *testing.T) {
+	t.Run("EveryTriggerHasAType", func(t *testing.T) {
+		for object, info := range cloudEventObjects {
+			names := triggerNamesForResourceType(info.resourceType)
+			require.NotEmpty(t, names, object)
+			for _, triggerName := range names {
+				_, ok := CloudEventType(object, triggerName)
+				assert.True(t, ok, "trigger '%s' for object '%s' has no CloudEvents type", triggerName, object)
+			}
+		}
+	})
+	t.Run("TypesAreUniquePerObject", func(t *testing.T) {
+		for object, info := range cloudEventObjects {
+			seen := map[string]string{}
+			for _, triggerName := range triggerNamesForResourceType(info.resourceType) {
+				eventType, _ := CloudEventType(object, triggerName)
+				if other, ok := seen[eventType]; ok && other != triggerName {
+					assert.Fail(t, "duplicate CloudEvents type", "triggers '%s' and '%s' both have type '%s'", other, triggerName, eventType)
+				}
+				seen[eventType] = triggerName
+			}
+		}
+	})
+	t.Run("Types", func(t *testing.T) {
+		types := CloudEventTypes()
+		assert.Contains(t, types, "ci.evergreen.task.finished")
+		assert.Contains(t, types, "ci.evergreen.task.started")
+		assert.Contains(t, types, "ci.evergreen.patch.family_failed")
+		assert.NotContains(t, types, "ci.evergreen.task.family_finished")
+		assert.IsIncreasing(t, types)
+	})
+	t.Run("SupportsCloudEvents", func(t *testing.T) {
+		assert.True(t, SupportsCloudEvents(event.ResourceTypeTask, event.TriggerOutcome))
+		assert.True(t, SupportsCloudEvents(event.ResourceTypeVersion, event.TriggerFamilyFailure))
+		assert.False(t, SupportsCloudEvents(event.ResourceTypeTask, event.TriggerFamilyOutcome))
+		assert.False(t, SupportsCloudEvents(event.ResourceTypeHost, event.TriggerExpiration))
+	})
+}
+
+func TestCloudEventSchema(t *testing.T) {
+	const apiURL = "https://evergreen.example.com/"
+
+	_, ok := CloudEventSchema(apiURL, "ci.evergreen.task.exploded")
+	assert.False(t, ok)
+
+	for _, eventType := range CloudEventTypes() {
+		schema, ok := CloudEventSchema(apiURL, eventType)
+		require.True(t, ok, eventType)
+		assert.Equal(t, "https://evergreen.example.com"+CloudEventsSchemaPath+"/"+eventType, schema["$id"])
+
+		properties, ok := schema["properties"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, map[string]any{"const": eventType}, properties["type"])
+
+		object := strings.Split(eventType, ".")[2]
+		modelName := map[string]string{
+			event.ObjectTask:    "APITask",
+			event.ObjectBuild:   "APIBuild",
+			event.ObjectVersion: "APIVersion",
+			event.ObjectPatch:   "APIPatch",
+		}[object]
+		assert.Equal(t, map[string]any{"$ref": "#/$defs/" + modelName}, properties["data"])
+		defs, ok := schema["$defs"].(map[string]any)
+		require.True(t, ok)
+		assert.Contains(t, defs, modelName)
+
+		_, err := json.Marshal(schema)
+		assert.NoError(t, err)
+	}
+}
@@ -48,3 +48,11 @@ func (b *base) ValidateTrigger(t string) bool {
 	_, ok := b.triggers[t]
 	return ok
 }
+
+func (b *base) triggerNames() []string {
+	names := make([]string, 0, len(b.triggers))
+	for name := range b.triggers {
+		names = append(names, name)
+	}
+	return names
+}
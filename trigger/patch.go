@@ -253,6 +253,7 @@ func (t *patchTriggers) makeData(ctx context.Context, sub *event.Subscription) (
 	data := commonTemplateData{
 		ID:                t.patch.Id.Hex(),
 		EventID:           t.event.ID,
+		EventTime:         t.event.Timestamp,
 		SubscriptionID:    sub.ID,
 		DisplayName:       t.patch.Id.Hex(),
 		Description:       t.patch.Description,
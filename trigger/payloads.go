@@ -7,7 +7,9 @@ import (
 	"html/template"
 	"net/http"
 	"net/url"
+	"regexp"
 	ttemplate "text/template"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
@@ -34,11 +36,20 @@ const (
 	// or the link back to Github Pull Requests.
 	// This number MUST NOT exceed 100, and Slack recommends a limit of 10
 	slackAttachmentsLimit = 10
+
+	adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	adaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	adaptiveCardVersion     = "1.4"
 )
 
+// slackLinkRegex matches a Slack formatted link, <url|text>, so that it can
+// be converted to a Markdown link.
+var slackLinkRegex = regexp.MustCompile(`<([^|>]+)\|([^>]+)>`)
+
 type commonTemplateData struct {
 	ID              string
 	EventID         string
+	EventTime       time.Time
 	SubscriptionID  string
 	DisplayName     string
 	Object          string
@@ -231,6 +242,8 @@ const emailDefaultContentTemplateString = `{{ define "content"}}
 var emailDefaultContentTemplate = template.Must(template.New("content").Parse(emailDefaultContentTemplateString))
 var emailTaskContentTemplate = template.Must(template.New("content").Parse(emailTaskFailTemplate))
 
+const teamsTitleTemplate string = `The {{ .Object }} {{ .DisplayName }} in '{{ .Project }}' has {{ .PastTenseStatus }}!`
+
 const jiraCommentTemplate string = `Evergreen {{ .Object }} [{{ .DisplayName }}|{{ .URL }}] in '{{ .Project }}' has {{ .PastTenseStatus }}!`
 
 const jiraIssueTitle string = "Evergreen {{ .Object }} '{{ .DisplayName }}' in '{{ .Project }}' has {{ .PastTenseStatus }}"
@@ -368,6 +381,186 @@ func slack(t *commonTemplateData) (*notification.SlackPayload, error) {
 	}, nil
 }
 
+// teamsMessage is a message posted to a Microsoft Teams incoming webhook
+// that contains a single Adaptive Card.
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string       `json:"contentType"`
+	Content     adaptiveCard `json:"content"`
+}
+
+type adaptiveCard struct {
+	Schema  string                `json:"$schema"`
+	Type    string                `json:"type"`
+	Version string                `json:"version"`
+	Body    []adaptiveCardElement `json:"body"`
+	Actions []adaptiveCardAction  `json:"actions,omitempty"`
+}
+
+type adaptiveCardElement struct {
+	Type     string             `json:"type"`
+	Text     string             `json:"text,omitempty"`
+	Weight   string             `json:"weight,omitempty"`
+	Size     string             `json:"size,omitempty"`
+	Color    string             `json:"color,omitempty"`
+	IsSubtle bool               `json:"isSubtle,omitempty"`
+	Wrap     bool               `json:"wrap,omitempty"`
+	Facts    []adaptiveCardFact `json:"facts,omitempty"`
+}
+
+type adaptiveCardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type adaptiveCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// adaptiveCardColor returns the Adaptive Card color that matches the color
+// of the notification's Slack attachment.
+func adaptiveCardColor(slackColor string) string {
+	switch slackColor {
+	case evergreenSuccessColor:
+		return "Good"
+	case evergreenFailColor:
+		return "Attention"
+	case evergreenRunningColor:
+		return "Warning"
+	default:
+		return "Default"
+	}
+}
+
+func teams(t *commonTemplateData) (*util.EvergreenWebhook, error) {
+	titleTmpl, err := ttemplate.New("teams").Parse(teamsTitleTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Teams template")
+	}
+	buf := &bytes.Buffer{}
+	if err = titleTmpl.Execute(buf, t); err != nil {
+		return nil, errors.Wrap(err, "generating Teams message title from template")
+	}
+
+	title := adaptiveCardElement{
+		Type:   "TextBlock",
+		Text:   buf.String(),
+		Weight: "Bolder",
+		Size:   "Medium",
+		Wrap:   true,
+	}
+	var facts []adaptiveCardFact
+	for _, attachment := range t.slack {
+		if title.Color == "" && attachment.Color != "" {
+			title.Color = adaptiveCardColor(attachment.Color)
+		}
+		for _, field := range attachment.Fields {
+			facts = append(facts, adaptiveCardFact{
+				Title: field.Title,
+				Value: slackLinkRegex.ReplaceAllString(field.Value, "[$2]($1)"),
+			})
+		}
+	}
+
+	card := adaptiveCard{
+		Schema:  adaptiveCardSchema,
+		Type:    "AdaptiveCard",
+		Version: adaptiveCardVersion,
+		Body:    []adaptiveCardElement{title},
+	}
+	if t.Description != "" {
+		card.Body = append(card.Body, adaptiveCardElement{Type: "TextBlock", Text: t.Description, IsSubtle: true, Wrap: true})
+	}
+	if len(facts) > 0 {
+		card.Body = append(card.Body, adaptiveCardElement{Type: "FactSet", Facts: facts})
+	}
+	for i, test := range t.FailedTests {
+		if i == slackAttachmentsLimit {
+			card.Body = append(card.Body, adaptiveCardElement{
+				Type:     "TextBlock",
+				Text:     fmt.Sprintf("and %d more failed test(s)", len(t.FailedTests)-slackAttachmentsLimit),
+				IsSubtle: true,
+			})
+			break
+		}
+		text := test.GetDisplayTestName()
+		if test.LogURL != "" {
+			text = fmt.Sprintf("[%s](%s)", text, test.LogURL)
+		}
+		card.Body = append(card.Body, adaptiveCardElement{Type: "TextBlock", Text: "Failed test: " + text, Color: "Attention", Wrap: true})
+	}
+	card.Body = append(card.Body, adaptiveCardElement{
+		Type:     "TextBlock",
+		Text:     fmt.Sprintf("Subscription: %s; Event: %s", t.SubscriptionID, t.EventID),
+		Size:     "Small",
+		IsSubtle: true,
+		Wrap:     true,
+	})
+	if t.URL != "" {
+		card.Actions = []adaptiveCardAction{{Type: "Action.OpenUrl", Title: "View in Evergreen", URL: t.URL}}
+	}
+
+	body, err := json.Marshal(teamsMessage{
+		Type:        "message",
+		Attachments: []teamsAttachment{{ContentType: adaptiveCardContentType, Content: card}},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling Adaptive Card")
+	}
+
+	return webhookWithContentType(body, "application/json", t.Headers), nil
+}
+
+func chatWebhook(sub *event.Subscription, t *commonTemplateData) (*util.EvergreenWebhook, error) {
+	target, ok := sub.Subscriber.Target.(*event.ChatWebhookSubscriber)
+	if !ok {
+		return nil, errors.Errorf("unexpected target data type %T", sub.Subscriber.Target)
+	}
+
+	data := event.ChatWebhookTemplateData{
+		Object:         t.Object,
+		ID:             t.ID,
+		DisplayName:    t.DisplayName,
+		Project:        t.Project,
+		Status:         t.PastTenseStatus,
+		Description:    t.Description,
+		URL:            t.URL,
+		Trigger:        sub.Trigger,
+		EventID:        t.EventID,
+		SubscriptionID: t.SubscriptionID,
+	}
+	for _, test := range t.FailedTests {
+		data.FailedTests = append(data.FailedTests, test.GetDisplayTestName())
+	}
+	body, err := target.Render(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "rendering chat webhook template")
+	}
+
+	return webhookWithContentType(body, target.GetContentType(), t.Headers), nil
+}
+
+// webhookWithContentType returns a webhook that posts the body with the given
+// content type.
+func webhookWithContentType(body []byte, contentType string, headers http.Header) *util.EvergreenWebhook {
+	msgHeaders := headers.Clone()
+	if msgHeaders == nil {
+		msgHeaders = http.Header{}
+	}
+	msgHeaders.Set("Content-Type", contentType)
+
+	return &util.EvergreenWebhook{
+		Body:    body,
+		Headers: msgHeaders,
+	}
+}
+
 // truncateString splits a string into two parts, with the following behavior:
 // If the entire string is <= capacity, it's returned unchanged.
 // Otherwise, the string is split at the (capacity-3)'th byte. The first string
@@ -425,6 +618,9 @@ func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes
 		return jiraComment(data)
 
 	case event.EvergreenWebhookSubscriberType:
+		if isCloudEventsWebhook(sub.Subscriber) {
+			return cloudEventPayload(sub, data)
+		}
 		return webhookPayload(data.apiModel, data.Headers)
 
 	case event.EmailSubscriberType:
@@ -432,6 +628,12 @@ func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes
 
 	case event.SlackSubscriberType:
 		return slack(data)
+
+	case event.TeamsSubscriberType:
+		return teams(data)
+
+	case event.ChatWebhookSubscriberType:
+		return chatWebhook(sub, data)
 	case event.RunChildPatchSubscriberType:
 		return nil, nil
 	}
@@ -2,19 +2,24 @@ package trigger
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/message"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -173,6 +178,127 @@ func (s *payloadSuite) TestSlack() {
 	s.Empty(m.Attachments)
 }
 
+func (s *payloadSuite) TestTeams() {
+	s.t.Description = "a description"
+	s.t.FailedTests = []testresult.TestResult{
+		{
+			TestName: "test0",
+			LogURL:   "https://example.com/test0",
+		},
+	}
+	s.t.slack = []message.SlackAttachment{
+		{
+			Color: evergreenFailColor,
+			Fields: []*message.SlackAttachmentField{
+				{
+					Title: "Version",
+					Value: "<https://example.com/version/1234|1234>",
+				},
+			},
+		},
+	}
+
+	m, err := teams(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+	s.Equal("application/json", m.Headers.Get("Content-Type"))
+	s.Equal([]string{"something"}, m.Headers["X-Evergreen-test"])
+	s.Empty(m.URL)
+
+	msg := teamsMessage{}
+	s.Require().NoError(json.Unmarshal(m.Body, &msg))
+	s.Equal("message", msg.Type)
+	s.Require().Len(msg.Attachments, 1)
+	s.Equal(adaptiveCardContentType, msg.Attachments[0].ContentType)
+
+	card := msg.Attachments[0].Content
+	s.Equal("AdaptiveCard", card.Type)
+	s.Require().Len(card.Body, 5)
+	s.Equal("The patch display-1234 in 'test' has failed!", card.Body[0].Text)
+	s.Equal("Attention", card.Body[0].Color)
+	s.Equal("a description", card.Body[1].Text)
+	s.Equal([]adaptiveCardFact{{Title: "Version", Value: "[1234](https://example.com/version/1234)"}}, card.Body[2].Facts)
+	s.Equal("Failed test: [test0](https://example.com/test0)", card.Body[3].Text)
+	s.Equal("Subscription: subscriptionid; Event: eventid", card.Body[4].Text)
+	s.Equal([]adaptiveCardAction{{Type: "Action.OpenUrl", Title: "View in Evergreen", URL: s.url}}, card.Actions)
+}
+
+func (s *payloadSuite) TestChatWebhook() {
+	s.t.FailedTests = []testresult.TestResult{
+		{TestName: "test0"},
+		{TestName: "test1", DisplayTestName: "display_test1"},
+	}
+	sub := &event.Subscription{
+		Trigger: event.TriggerOutcome,
+		Subscriber: event.Subscriber{
+			Type: event.ChatWebhookSubscriberType,
+			Target: &event.ChatWebhookSubscriber{
+				URL:      "https://chat.example.com/hooks/abc",
+				Template: `{"text": {{ printf "%s %s has %s: %s" .Object .DisplayName .Status .URL | json }}, "tests": {{ json .FailedTests }}, "trigger": "{{ .Trigger }}"}`,
+			},
+		},
+	}
+
+	m, err := chatWebhook(sub, &s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+	s.Equal(event.DefaultChatWebhookContentType, m.Headers.Get("Content-Type"))
+	s.JSONEq(`{"text": "patch display-1234 has failed: https://example.com/patch/1234", "tests": ["test0", "display_test1"], "trigger": "outcome"}`, string(m.Body))
+
+	sub.Subscriber.Target = &event.ChatWebhookSubscriber{
+		URL:         "https://chat.example.com/hooks/abc",
+		Template:    "{{ .Project }}: {{ .Missing }}",
+		ContentType: "text/plain",
+	}
+	_, err = chatWebhook(sub, &s.t)
+	s.Error(err)
+}
+
+func (s *payloadSuite) TestCloudEventsWebhook() {
+	apiPatch := restModel.APIPatch{Id: utility.ToStringPtr("1234"), Author: utility.ToStringPtr("somebody")}
+	s.t.apiModel = &apiPatch
+	s.t.EventTime = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	sub := &event.Subscription{
+		ID:      "subscriptionid",
+		Trigger: event.TriggerFamilyOutcome,
+		Subscriber: event.Subscriber{
+			Type: event.EvergreenWebhookSubscriberType,
+			Target: &event.WebhookSubscriber{
+				URL:    "https://example.com",
+				Secret: []byte("shh"),
+				Format: event.WebhookFormatCloudEvents,
+			},
+		},
+	}
+
+	payload, err := makeCommonPayload(sub, event.Attributes{Object: []string{event.ObjectPatch}}, &s.t)
+	s.Require().NoError(err)
+	m, ok := payload.(*util.EvergreenWebhook)
+	s.Require().True(ok)
+	s.Equal(cloudEventsContentType, m.Headers.Get("Content-Type"))
+	s.Equal([]string{event.ObjectPatch}, m.Headers[evergreenHeaderPrefix+event.SelectorObject])
+
+	ce := map[string]any{}
+	s.Require().NoError(json.Unmarshal(m.Body, &ce))
+	settings := evergreen.GetEnvironment().Settings()
+	s.Equal("1.0", ce["specversion"])
+	s.Equal("eventid-subscriptionid", ce["id"])
+	s.Equal(settings.Ui.Url, ce["source"])
+	s.Equal("ci.evergreen.patch.family_finished", ce["type"])
+	s.Equal("1234", ce["subject"])
+	s.Equal("2026-05-01T12:00:00Z", ce["time"])
+	s.Equal("application/json", ce["datacontenttype"])
+	s.Equal(CloudEventSchemaURL(settings.Api.URL, "ci.evergreen.patch.family_finished")+"#/properties/data", ce["dataschema"])
+	s.Equal("test", ce["evergreenproject"])
+	data, ok := ce["data"].(map[string]any)
+	s.Require().True(ok)
+	s.Equal("somebody", data["author"])
+
+	sub.Trigger = "not-a-trigger"
+	_, err = makeCommonPayload(sub, event.Attributes{}, &s.t)
+	s.Error(err)
+}
+
 func (s *payloadSuite) TestGetFailedTestsFromTemplate() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -82,6 +82,18 @@ func NotificationsFromEvent(ctx context.Context, e *event.EventLogEntry) ([]noti
 		if n == nil {
 			continue
 		}
+		n.SubscriptionID = subscriptions[i].ID
+		if digest := subscriptions[i].Digest; digest != nil {
+			// If the notification can't be held, send it on its own rather
+			// than dropping it.
+			grip.Error(ctx, message.WrapError(n.HoldForDigest(subscriptions[i].ID, digest, h.Attributes(), time.Now()), message.Fields{
+				"source":          "events-processing",
+				"message":         "could not hold notification for digest, sending it immediately",
+				"event_id":        e.ID,
+				"subscription_id": subscriptions[i].ID,
+				"notification_id": n.ID,
+			}))
+		}
 		grip.Info(ctx, msg)
 
 		notifications = append(notifications, *n)
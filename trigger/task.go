@@ -32,6 +32,7 @@ func init() {
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskStarted, makeTaskTriggers)
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskFinished, makeTaskTriggers)
 	registry.registerEventHandler(event.ResourceTypeTask, event.TaskBlocked, makeTaskTriggers)
+	registry.registerEventHandler(event.ResourceTypeTask, event.TaskQueueLatencyExceeded, makeTaskTriggers)
 }
 
 const (
@@ -57,6 +58,7 @@ func makeTaskTriggers() eventHandler {
 		event.TriggerRegression:                  t.taskRegression,
 		event.TriggerTaskFirstFailureInVersion:   t.taskFirstFailureInVersion,
 		event.TriggerTaskStarted:                 t.taskStarted,
+		event.TriggerTaskQueueLatency:            t.taskQueueLatency,
 		triggerTaskFirstFailureInBuild:           t.taskFirstFailureInBuild,
 		triggerTaskFirstFailureInVersionWithName: t.taskFirstFailureInVersionWithName,
 		triggerTaskRegressionByTest:              t.taskRegressionByTest,
@@ -156,6 +158,11 @@ func (t *taskTriggers) Process(ctx context.Context, sub *event.Subscription) (*n
 	if t.task.Aborted {
 		return nil, nil
 	}
+	// Queue latency events are for tasks that haven't run yet, so only the
+	// queue latency trigger handles them, and it handles nothing else.
+	if (t.event.EventType == event.TaskQueueLatencyExceeded) != (sub.Trigger == event.TriggerTaskQueueLatency) {
+		return nil, nil
+	}
 	return t.base.Process(ctx, sub)
 }
 
@@ -241,6 +248,9 @@ func (t *taskTriggers) Attributes() event.Attributes {
 	if t.owner != "" {
 		attributes.Owner = append(attributes.Owner, t.owner)
 	}
+	if t.task.DistroId != "" {
+		attributes.Distro = []string{t.task.DistroId}
+	}
 
 	return attributes
 }
@@ -271,6 +281,7 @@ func (t *taskTriggers) makeData(ctx context.Context, sub *event.Subscription, pa
 	data := commonTemplateData{
 		ID:              t.task.Id,
 		EventID:         t.event.ID,
+		EventTime:       t.event.Timestamp,
 		SubscriptionID:  sub.ID,
 		DisplayName:     displayName,
 		Object:          "task",
@@ -704,6 +715,24 @@ func (t *taskTriggers) taskExceedsDuration(ctx context.Context, sub *event.Subsc
 	return t.generate(ctx, sub, fmt.Sprintf("exceeded %d seconds", threshold), "")
 }
 
+func (t *taskTriggers) taskQueueLatency(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
+	threshold, err := strconv.Atoi(sub.TriggerData[event.TaskQueueLatencyKey])
+	if err != nil {
+		return nil, errors.Errorf("subscription '%s' has an invalid queue latency threshold", sub.ID)
+	}
+	// Each threshold that the task exceeds gets its own event, so only notify
+	// the subscriptions with the event's threshold.
+	if threshold != t.data.QueueLatencyMins {
+		return nil, nil
+	}
+	// The task may have started since the event was logged.
+	if t.task.Status != evergreen.TaskUndispatched && t.task.Status != evergreen.TaskDispatched {
+		return nil, nil
+	}
+
+	return t.generate(ctx, sub, fmt.Sprintf("waited in the queue for more than %d minutes", threshold), "")
+}
+
 func (t *taskTriggers) taskRuntimeChange(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
 	if t.task.IsPartOfDisplay(ctx) {
 		return nil, nil
@@ -1283,6 +1283,57 @@ func (s *taskSuite) TestSuccessfulTaskExceedsTime() {
 	s.Nil(n)
 }
 
+func (s *taskSuite) TestQueueLatency() {
+	sub := event.Subscription{
+		ID:           mgobson.NewObjectId().Hex(),
+		ResourceType: event.ResourceTypeTask,
+		Trigger:      event.TriggerTaskQueueLatency,
+		Selectors:    []event.Selector{{Type: event.SelectorDistro, Data: "distro"}},
+		Subscriber: event.Subscriber{
+			Type:   event.EmailSubscriberType,
+			Target: "email",
+		},
+		Owner:       "test_project",
+		OwnerType:   event.OwnerTypeProject,
+		TriggerData: map[string]string{event.TaskQueueLatencyKey: "30"},
+	}
+	s.task.Status = evergreen.TaskUndispatched
+	_, err := db.Replace(s.ctx, task.Collection, bson.M{"_id": s.task.Id}, &s.task)
+	s.NoError(err)
+	s.t.event = &event.EventLogEntry{EventType: event.TaskQueueLatencyExceeded}
+	s.t.data.QueueLatencyMins = 30
+
+	// task that exceeded the subscription's threshold should generate
+	n, err := s.t.Process(s.ctx, &sub)
+	s.NoError(err)
+	s.NotNil(n)
+
+	// a different threshold should not generate
+	s.t.data.QueueLatencyMins = 60
+	n, err = s.t.Process(s.ctx, &sub)
+	s.NoError(err)
+	s.Nil(n)
+	s.t.data.QueueLatencyMins = 30
+
+	// other triggers should not generate for the queue latency event
+	n, err = s.t.Process(s.ctx, &s.subs[3])
+	s.NoError(err)
+	s.Nil(n)
+
+	// task that has started since the event should not generate
+	s.task.Status = evergreen.TaskStarted
+	n, err = s.t.Process(s.ctx, &sub)
+	s.NoError(err)
+	s.Nil(n)
+
+	// other events should not generate
+	s.task.Status = evergreen.TaskUndispatched
+	s.t.event = &event.EventLogEntry{EventType: event.TaskFinished}
+	n, err = s.t.Process(s.ctx, &sub)
+	s.NoError(err)
+	s.Nil(n)
+}
+
 func (s *taskSuite) TestTaskRuntimeChange() {
 	// no previous task should not generate
 	s.t.event = &event.EventLogEntry{
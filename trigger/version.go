@@ -152,6 +152,7 @@ func (t *versionTriggers) makeData(ctx context.Context, sub *event.Subscription,
 	data := commonTemplateData{
 		ID:             t.version.Id,
 		EventID:        t.event.ID,
+		EventTime:      t.event.Timestamp,
 		SubscriptionID: sub.ID,
 		DisplayName:    t.version.Id,
 		Object:         event.ObjectVersion,
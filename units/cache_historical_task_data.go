@@ -8,6 +8,7 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model/taskstats"
+	"github.com/evergreen-ci/evergreen/model/teststats"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/amboy"
 	"github.com/mongodb/amboy/job"
@@ -113,23 +114,34 @@ func (j *cacheHistoricalTaskDataJob) Run(ctx context.Context) {
 	timingMsg["update_daily_task_stats"] = reportTiming(func() {
 		for _, toUpdate := range statsToUpdate {
 			if len(toUpdate.Tasks) > 0 {
+				fields := message.Fields{
+					"job_id":         j.ID(),
+					"project":        j.ProjectID,
+					"job_type":       j.Type().Name,
+					"job_start_time": startAt,
+					"task_date":      utility.GetUTCDay(toUpdate.Day),
+				}
 				err := errors.Wrap(taskstats.GenerateStats(ctx, taskstats.GenerateStatsOptions{
 					ProjectID: j.ProjectID,
 					Requester: toUpdate.Requester,
 					Date:      toUpdate.Day,
 					Tasks:     toUpdate.Tasks,
 				}), "generating daily task stats")
-				grip.Warning(ctx, message.WrapError(err, message.Fields{
-					"job_id":         j.ID(),
-					"project":        j.ProjectID,
-					"job_type":       j.Type().Name,
-					"job_start_time": startAt,
-					"task_date":      utility.GetUTCDay(toUpdate.Day),
-				}))
+				grip.Warning(ctx, message.WrapError(err, fields))
 				if err != nil {
 					j.AddError(err)
 					return
 				}
+
+				// Test stats are best effort, so failing to generate them
+				// doesn't stop the task stats from being updated.
+				err = errors.Wrap(teststats.GenerateStats(ctx, teststats.GenerateStatsOptions{
+					ProjectID: j.ProjectID,
+					Requester: toUpdate.Requester,
+					Date:      toUpdate.Day,
+					Tasks:     toUpdate.Tasks,
+				}), "generating daily test stats")
+				grip.Warning(ctx, message.WrapError(err, fields))
 			}
 		}
 	}).Seconds()
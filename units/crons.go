@@ -116,6 +116,24 @@ func sendNotificationJobs(ctx context.Context, _ evergreen.Environment, ts time.
 	return notificationJobs(ctx, unprocessedNotifications, flags, ts)
 }
 
+func notificationDigestJobs(ctx context.Context, _ evergreen.Environment, ts time.Time) ([]amboy.Job, error) {
+	flags, err := evergreen.GetServiceFlags(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if flags.EventProcessingDisabled {
+		grip.InfoWhen(ctx, sometimes.Percent(evergreen.DegradedLoggingPercent), message.Fields{
+			"message": "notifications disabled",
+			"impact":  "not sending notification digests",
+			"mode":    "degraded",
+		})
+		return nil, nil
+	}
+
+	return []amboy.Job{NewNotificationDigestJob(ts.Format(TSFormat))}, nil
+}
+
 func eventNotifierJobs(ctx context.Context, env evergreen.Environment, ts time.Time) ([]amboy.Job, error) {
 	flags, err := evergreen.GetServiceFlags(ctx)
 	if err != nil {
@@ -786,6 +804,7 @@ func periodicNotificationJobs(ctx context.Context, _ evergreen.Environment, ts t
 		NewSpawnhostExpirationWarningsJob(ts.Format(TSFormat)),
 		NewVolumeExpirationWarningsJob(ts.Format(TSFormat)),
 		NewAlertableInstanceTypeNotifyJob(ts.Format(TSFormat)),
+		NewTaskQueueLatencyNotifyJob(ts.Format(TSFormat)),
 	}, nil
 }
 
@@ -1286,6 +1305,40 @@ func PopulateDistroAutoTuneJobs() amboy.QueueOperation {
 	}
 }
 
+// PopulateTaskFilesCleanupJob populates a job to remove the files published by
+// tasks in expired versions.
+func PopulateTaskFilesCleanupJob(env evergreen.Environment) amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		return errors.Wrap(amboy.EnqueueUniqueJob(ctx, queue, NewTaskFilesCleanupJob(env, utility.RoundPartOfHour(0).Format(TSFormat))), "enqueueing task files cleanup job")
+	}
+}
+
+// PopulateFlakyTestDetectionJobs populates jobs to detect and automatically
+// quarantine flaky tests in projects that have auto-quarantine enabled.
+func PopulateFlakyTestDetectionJobs(env evergreen.Environment) amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		if env.Settings().TestSelection.URL == "" {
+			return nil
+		}
+
+		projectRefs, err := model.FindAllMergedEnabledTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "finding enabled projects")
+		}
+		catcher := grip.NewBasicCatcher()
+		ts := utility.RoundPartOfHour(0).Format(TSFormat)
+		for _, p := range projectRefs {
+			if !p.IsFlakyTestAutoQuarantineEnabled() {
+				continue
+			}
+			if err := amboy.EnqueueUniqueJob(ctx, queue, NewFlakyTestDetectionJob(p.Id, ts)); err != nil {
+				catcher.Wrapf(err, "enqueueing flaky test detection job for project '%s'", p.Id)
+			}
+		}
+		return catcher.Resolve()
+	}
+}
+
 func populateQueueGroup(ctx context.Context, env evergreen.Environment, queueGroupName string, factory cronJobFactory, ts time.Time) error {
 	appCtx, _ := env.Context()
 	queueGroup, err := env.RemoteQueueGroup().Get(appCtx, queueGroupName)
@@ -58,6 +58,8 @@ func (j *cronsRemoteHourJob) Run(ctx context.Context) {
 		PopulateDuplicateTaskCheckJobs(),
 		PopulateUnexpirableSpawnHostStatsJob(),
 		PopulateDistroAutoTuneJobs(),
+		PopulateFlakyTestDetectionJobs(j.env),
+		PopulateTaskFilesCleanupJob(j.env),
 	}
 
 	queue := j.env.RemoteQueue()
@@ -53,6 +53,7 @@ func (j *cronsRemoteMinuteJob) Run(ctx context.Context) {
 		"host monitoring":            hostMonitoringJobs,
 		"last container finish time": lastContainerFinishTimeJobs,
 		"oldest image removal":       oldestImageRemovalJobs,
+		"notification digest":        notificationDigestJobs,
 		"parent decommission":        parentDecommissionJobs,
 		"periodic notification":      periodicNotificationJobs,
 		"user data done":             userDataDoneJobs,
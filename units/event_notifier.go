@@ -228,6 +228,10 @@ func notificationJobs(ctx context.Context, notifications []notification.Notifica
 	catcher := grip.NewBasicCatcher()
 	var jobs []amboy.Job
 	for i := range notifications {
+		if notifications[i].Digest != nil {
+			// Held notifications are sent by the notification digest job.
+			continue
+		}
 		if notificationIsEnabled(ctx, flags, &notifications[i]) {
 			jobs = append(jobs, NewEventSendJob(notifications[i].ID, ts.Format(TSFormat)))
 		} else {
@@ -245,7 +249,7 @@ func notificationIsEnabled(ctx context.Context, flags *evergreen.ServiceFlags, n
 	case event.JIRAIssueSubscriberType, event.JIRACommentSubscriberType:
 		return !flags.JIRANotificationsDisabled
 
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.ChatWebhookSubscriberType:
 		return !flags.WebhookNotificationsDisabled
 
 	case event.EmailSubscriberType:
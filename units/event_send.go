@@ -3,11 +3,16 @@ package units
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/githubapp"
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/amboy"
 	"github.com/mongodb/amboy/job"
@@ -18,10 +23,13 @@ import (
 	"github.com/mongodb/grip/send"
 	"github.com/mongodb/grip/sometimes"
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 const (
 	eventSendJobName = "event-send"
+
+	subscriptionDisabledTrigger = "subscription-disabled"
 )
 
 func init() {
@@ -101,31 +109,63 @@ func (j *eventSendJob) Run(ctx context.Context) {
 		j.AddError(errors.Errorf("notification '%s' has already been processed", n.ID))
 		return
 	}
+	if n.IsDeadLettered() {
+		j.AddError(errors.Errorf("notification '%s' is dead lettered", n.ID))
+		return
+	}
 
-	err = j.send(ctx, n)
+	c, err := j.compose(ctx, n)
+	if err != nil {
+		// The notification can't be sent as it is, so retrying it won't help.
+		j.logSendFailure(ctx, n, err)
+		j.AddError(err)
+		j.AddError(errors.Wrapf(n.MarkError(ctx, err), "setting error for notification '%s'", n.ID))
+		return
+	}
+
+	if err = j.send(ctx, n, c); err != nil {
+		j.logSendFailure(ctx, n, err)
+		j.AddError(err)
+		j.AddError(errors.Wrapf(j.handleSendFailure(ctx, n, err), "recording failed attempt for notification '%s'", n.ID))
+		return
+	}
+
+	j.AddError(errors.Wrapf(n.MarkSent(ctx), "marking notification '%s' as sent", n.ID))
+	if n.SubscriptionID != "" {
+		j.AddError(event.ResetSubscriptionDeliveryFailures(ctx, n.SubscriptionID))
+	}
+}
+
+func (j *eventSendJob) logSendFailure(ctx context.Context, n *notification.Notification, err error) {
 	grip.Error(ctx, message.WrapError(err, message.Fields{
 		"job_id":            j.ID(),
 		"notification_id":   n.ID,
 		"notification_type": n.Subscriber.Type,
 		"message":           "send failed",
 	}))
-	j.AddError(err)
-	j.AddError(errors.Wrapf(n.MarkSent(ctx), "marking notification '%s' as sent", n.ID))
-	j.AddError(errors.Wrapf(n.MarkError(ctx, err), "setting error for notification '%s'", n.ID))
 }
 
-func (j *eventSendJob) send(ctx context.Context, n *notification.Notification) error {
+func (j *eventSendJob) compose(ctx context.Context, n *notification.Notification) (message.Composer, error) {
 	c, err := n.Composer(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err = c.SetPriority(level.Notice); err != nil {
-		return errors.Wrap(err, "setting priority")
+		return nil, errors.Wrap(err, "setting priority")
 	}
 	if !c.Loggable() {
-		return errors.New("composer is not loggable")
+		return nil, errors.New("composer is not loggable")
 	}
 
+	return c, nil
+}
+
+// send sends the composed notification. Senders report errors to their error
+// handler rather than returning them, so the errors are collected from the
+// context that's passed to the sender. The notification is sent with the root
+// sender because the queue-backed sender sends it later with its own context,
+// which would drop the errors.
+func (j *eventSendJob) send(ctx context.Context, n *notification.Notification, c message.Composer) error {
 	key, err := n.SenderKey()
 	if err != nil {
 		return errors.Wrap(err, "getting sender key for notification")
@@ -142,13 +182,131 @@ func (j *eventSendJob) send(ctx context.Context, n *notification.Notification) e
 			return errors.Wrap(err, "getting github status sender")
 		}
 	} else {
-		sender, err = j.env.GetSender(key)
+		sender, err = j.env.GetRootSender(key)
 		if err != nil {
 			return errors.Wrap(err, "getting global notification sender")
 		}
 	}
-	sender.Send(ctx, c)
-	return nil
+
+	sendCtx, sendErrs := util.ContextWithSendErrors(ctx)
+	sender.Send(sendCtx, c)
+	return sendErrs.Resolve()
+}
+
+// handleSendFailure schedules the notification to be retried. If the
+// notification has run out of attempts, it's dead lettered, and the
+// subscription that created it is disabled if its notifications keep failing.
+func (j *eventSendJob) handleSendFailure(ctx context.Context, n *notification.Notification, sendErr error) error {
+	if err := n.MarkSendFailed(ctx, sendErr, time.Now()); err != nil {
+		return err
+	}
+	if !n.IsDeadLettered() {
+		return nil
+	}
+
+	grip.Warning(ctx, message.Fields{
+		"message":           "notification ran out of attempts and was dead lettered",
+		"job_id":            j.ID(),
+		"notification_id":   n.ID,
+		"notification_type": n.Subscriber.Type,
+		"attempts":          n.Retry.Attempts,
+		"subscription_id":   n.SubscriptionID,
+	})
+	if n.SubscriptionID == "" {
+		return nil
+	}
+
+	sub, err := event.RecordSubscriptionDeliveryFailure(ctx, n.SubscriptionID, time.Now())
+	if err != nil {
+		return errors.Wrapf(err, "recording delivery failure for subscription '%s'", n.SubscriptionID)
+	}
+	if sub == nil {
+		return nil
+	}
+
+	grip.Warning(ctx, message.Fields{
+		"message":         "disabled subscription whose notifications keep failing",
+		"job_id":          j.ID(),
+		"subscription_id": sub.ID,
+		"subscriber_type": sub.Subscriber.Type,
+		"owner":           sub.Owner,
+		"owner_type":      sub.OwnerType,
+	})
+
+	return errors.Wrapf(notifySubscriptionDisabled(ctx, sub, n.Error), "notifying owner of disabled subscription '%s'", sub.ID)
+}
+
+// notifySubscriptionDisabled emails the owner of a subscription that was
+// disabled. The owners of project subscriptions are the project's admins.
+func notifySubscriptionDisabled(ctx context.Context, sub *event.Subscription, lastErr string) error {
+	recipients, err := subscriptionOwnerEmails(ctx, sub)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	body := fmt.Sprintf(`Evergreen disabled a subscription because %d of its notifications in a row could not be sent.
+
+The last error was: %s
+
+To enable the subscription again, fix the problem with its subscriber and save the subscription.
+
+%s`, event.SubscriptionDisableThreshold, lastErr, sub.String())
+	eventID := fmt.Sprintf("%s.%d", sub.ID, sub.DisabledAt.Unix())
+
+	var notifications []notification.Notification
+	for _, recipient := range recipients {
+		subscriber := event.Subscriber{
+			Type:   event.EmailSubscriberType,
+			Target: &recipient,
+		}
+		n, err := notification.New(eventID, subscriptionDisabledTrigger, &subscriber, &message.Email{
+			Subject:           "Evergreen: subscription disabled",
+			Body:              body,
+			PlainTextContents: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "creating notification")
+		}
+		notifications = append(notifications, *n)
+	}
+
+	return errors.Wrap(notification.InsertMany(ctx, notifications...), "inserting notifications")
+}
+
+func subscriptionOwnerEmails(ctx context.Context, sub *event.Subscription) ([]string, error) {
+	var userIDs []string
+	switch sub.OwnerType {
+	case event.OwnerTypePerson:
+		userIDs = []string{sub.Owner}
+	case event.OwnerTypeProject:
+		projectRef, err := model.FindBranchProjectRef(ctx, sub.Owner)
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding project '%s'", sub.Owner)
+		}
+		if projectRef == nil {
+			return nil, errors.Errorf("project '%s' not found", sub.Owner)
+		}
+		userIDs = projectRef.Admins
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	users, err := user.Find(ctx, db.Query(bson.M{user.IdKey: bson.M{"$in": userIDs}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding subscription owners")
+	}
+	var emails []string
+	for _, u := range users {
+		if u.Email() != "" {
+			emails = append(emails, u.Email())
+		}
+	}
+
+	return emails, nil
 }
 
 func (j *eventSendJob) checkDegradedMode(ctx context.Context, n *notification.Notification) error {
@@ -165,7 +323,7 @@ func (j *eventSendJob) checkDegradedMode(ctx context.Context, n *notification.No
 	case event.JIRACommentSubscriberType:
 		return checkFlag(ctx, j.flags.JIRANotificationsDisabled)
 
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.ChatWebhookSubscriberType:
 		return checkFlag(ctx, j.flags.WebhookNotificationsDisabled)
 
 	case event.EmailSubscriberType:
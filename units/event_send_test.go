@@ -2,6 +2,7 @@ package units
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"testing"
 	"time"
@@ -11,10 +12,14 @@ import (
 	"github.com/evergreen-ci/evergreen/mock"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type eventNotificationSuite struct {
@@ -273,3 +278,107 @@ func (s *eventNotificationSuite) TestSendFailureResultsInNoMessages() {
 
 	s.NotZero(s.notificationHasError(s.ctx, s.webhook.ID, "^composer is not loggable$"))
 }
+
+// failingSenderEnvironment returns senders that fail to deliver every message.
+type failingSenderEnvironment struct {
+	*mock.Environment
+}
+
+func (e *failingSenderEnvironment) GetRootSender(evergreen.SenderKey) (send.Sender, error) {
+	return &failingSender{Sender: e.InternalSender}, nil
+}
+
+type failingSender struct {
+	send.Sender
+}
+
+func (s *failingSender) Send(ctx context.Context, _ message.Composer) {
+	util.RecordSendError(ctx, errors.New("connection refused"))
+}
+
+func (s *eventNotificationSuite) TestFailedSendIsRetriedAndDeadLettered() {
+	s.Require().NoError(db.ClearCollections(event.SubscriptionsCollection, user.Collection))
+	sub := event.Subscription{
+		ID:               "subscription",
+		ResourceType:     event.ResourceTypeTask,
+		Trigger:          "outcome",
+		Owner:            "me",
+		OwnerType:        event.OwnerTypePerson,
+		Subscriber:       s.webhook.Subscriber,
+		DeliveryFailures: event.SubscriptionDisableThreshold - 1,
+	}
+	s.Require().NoError(db.Insert(s.ctx, event.SubscriptionsCollection, sub))
+	owner := user.DBUser{Id: "me", EmailAddress: "me@example.com"}
+	s.Require().NoError(owner.Insert(s.ctx))
+	s.Require().NoError(db.UpdateId(s.ctx, notification.Collection, s.webhook.ID, bson.M{
+		"$set": bson.M{"subscription_id": sub.ID},
+	}))
+
+	env := &failingSenderEnvironment{Environment: s.env}
+	maxAttempts := notification.MaxSendAttempts(s.webhook.Subscriber.Type)
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		job := NewEventSendJob(s.webhook.ID, "").(*eventSendJob)
+		job.env = env
+		job.Run(s.ctx)
+		s.Error(job.Error())
+
+		n, err := notification.Find(s.ctx, s.webhook.ID)
+		s.Require().NoError(err)
+		s.Require().NotNil(n)
+		s.Require().NotNil(n.Retry)
+		s.Zero(n.SentAt)
+		s.Equal(attempt, n.Retry.Attempts)
+		s.Equal("connection refused", n.Error)
+		if attempt < maxAttempts {
+			s.False(n.IsDeadLettered())
+			s.NotZero(n.Retry.NextAttemptAt)
+		} else {
+			s.True(n.IsDeadLettered())
+		}
+	}
+
+	job := NewEventSendJob(s.webhook.ID, "").(*eventSendJob)
+	job.env = env
+	job.Run(s.ctx)
+	s.Require().Error(job.Error())
+	s.Contains(job.Error().Error(), "dead lettered")
+
+	dbSub, err := event.FindSubscriptionByID(s.ctx, sub.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(dbSub)
+	s.NotZero(dbSub.DisabledAt)
+
+	disabledNotifications, err := notification.FindByEventID(s.ctx, fmt.Sprintf("%s.%d", sub.ID, dbSub.DisabledAt.Unix()))
+	s.Require().NoError(err)
+	s.Require().Len(disabledNotifications, 1)
+	s.Contains(disabledNotifications[0].ID, subscriptionDisabledTrigger)
+	s.Equal("email-me@example.com", disabledNotifications[0].Subscriber.String())
+}
+
+func (s *eventNotificationSuite) TestSuccessfulSendResetsSubscriptionDeliveryFailures() {
+	s.Require().NoError(db.ClearCollections(event.SubscriptionsCollection))
+	sub := event.Subscription{
+		ID:               "subscription",
+		ResourceType:     event.ResourceTypeTask,
+		Trigger:          "outcome",
+		Owner:            "me",
+		OwnerType:        event.OwnerTypePerson,
+		Subscriber:       s.webhook.Subscriber,
+		DeliveryFailures: 1,
+	}
+	s.Require().NoError(db.Insert(s.ctx, event.SubscriptionsCollection, sub))
+	s.Require().NoError(db.UpdateId(s.ctx, notification.Collection, s.webhook.ID, bson.M{
+		"$set": bson.M{"subscription_id": sub.ID},
+	}))
+
+	job := NewEventSendJob(s.webhook.ID, "").(*eventSendJob)
+	job.env = s.env
+	job.Run(s.ctx)
+	s.NoError(job.Error())
+	s.NotZero(s.notificationHasError(s.ctx, s.webhook.ID, ""))
+
+	dbSub, err := event.FindSubscriptionByID(s.ctx, sub.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(dbSub)
+	s.Zero(dbSub.DeliveryFailures)
+}
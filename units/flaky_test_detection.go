@@ -0,0 +1,425 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/flakytest"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	testselection "github.com/evergreen-ci/test-selection-client"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	flakyTestDetectionJobName = "flaky-test-detection"
+	flakyTestDetectionUser    = "flaky_test_detection"
+
+	// maxStableStreakTasks is the maximum number of tasks checked for each
+	// auto-quarantined test's stable streak in a single run of the job. Any
+	// remaining tasks are checked in the next run.
+	maxStableStreakTasks = 100
+)
+
+func init() {
+	registry.AddJobType(flakyTestDetectionJobName, func() amboy.Job {
+		return makeFlakyTestDetectionJob()
+	})
+}
+
+type flakyTestDetectionJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+
+	env        evergreen.Environment
+	projectRef *model.ProjectRef
+	tssClient  *testselection.APIClient
+}
+
+func makeFlakyTestDetectionJob() *flakyTestDetectionJob {
+	j := &flakyTestDetectionJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    flakyTestDetectionJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewFlakyTestDetectionJob returns a job that scores the flakiness of the
+// project's tests, automatically quarantines flaky tests and unquarantines
+// automatically quarantined tests once they have passed consistently.
+func NewFlakyTestDetectionJob(projectID, ts string) amboy.Job {
+	j := makeFlakyTestDetectionJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", flakyTestDetectionJobName, projectID, ts))
+	j.SetScopes([]string{fmt.Sprintf("%s.%s", flakyTestDetectionJobName, projectID)})
+	j.SetEnqueueAllScopes(true)
+	return j
+}
+
+func (j *flakyTestDetectionJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	var err error
+	j.projectRef, err = model.FindMergedProjectRef(ctx, j.ProjectID, "", false)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding project '%s'", j.ProjectID))
+		return
+	}
+	if j.projectRef == nil {
+		j.AddError(errors.Errorf("project '%s' not found", j.ProjectID))
+		return
+	}
+	if !j.projectRef.IsFlakyTestAutoQuarantineEnabled() {
+		return
+	}
+
+	httpClient := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(httpClient)
+	j.tssClient = thirdparty.NewTestSelectionClient(httpClient)
+
+	// Unquarantine stable tests first so that tests quarantined in this run
+	// aren't immediately checked for a stable streak.
+	j.AddError(errors.Wrap(j.unquarantineStableTests(ctx), "unquarantining stable tests"))
+	j.AddError(errors.Wrap(j.quarantineFlakyTests(ctx), "quarantining flaky tests"))
+}
+
+// quarantineFlakyTests scores the flakiness of tests that ran in restarted
+// tasks within the lookback period and quarantines the tests that exceed the
+// project's flakiness thresholds.
+func (j *flakyTestDetectionJob) quarantineFlakyTests(ctx context.Context) error {
+	policy := j.projectRef.FlakyTestPolicy
+	runs, err := j.findRestartedTaskRuns(ctx, time.Now().Add(-policy.GetLookbackPeriod()))
+	if err != nil {
+		return errors.Wrap(err, "finding restarted task runs")
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+
+	autoQuarantined, err := flakytest.Find(ctx, flakytest.ByProject(j.ProjectID))
+	if err != nil {
+		return errors.Wrap(err, "finding auto-quarantined tests")
+	}
+	previouslyQuarantined := make(map[flakytest.TestKey]flakytest.AutoQuarantinedTest, len(autoQuarantined))
+	for _, t := range autoQuarantined {
+		previouslyQuarantined[t.TestKey] = t
+	}
+
+	// Group the flaky tests by task since the test selection service tracks
+	// quarantine state per task.
+	type taskKey struct {
+		buildVariant string
+		taskName     string
+	}
+	flakyTests := map[taskKey][]flakytest.Score{}
+	for _, score := range flakytest.ScoreTests(runs) {
+		if prev, ok := previouslyQuarantined[score.TestKey]; ok {
+			if prev.Quarantined {
+				continue
+			}
+			// Only count flips since the test was unquarantined, otherwise
+			// the flips that got it quarantined before would immediately
+			// get it quarantined again.
+			score = flakytest.ScoreTestSince(runs, score.TestKey, prev.UnquarantinedAt)
+		}
+		if score.Flips < policy.GetMinFlips() || score.Flakiness() < policy.GetFlakinessThreshold() {
+			continue
+		}
+		key := taskKey{buildVariant: score.BuildVariant, taskName: score.TaskName}
+		flakyTests[key] = append(flakyTests[key], score)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for key, scores := range flakyTests {
+		testNames := make([]string, 0, len(scores))
+		for _, score := range scores {
+			testNames = append(testNames, score.TestName)
+		}
+		// Tests that are already quarantined were quarantined by a user, so
+		// leave them alone to avoid unquarantining them later.
+		quarantined, err := j.getTestsQuarantineStatus(ctx, key.buildVariant, key.taskName, testNames)
+		if err != nil {
+			catcher.Wrapf(err, "getting quarantine status of tests in task '%s' in build variant '%s'", key.taskName, key.buildVariant)
+			continue
+		}
+		for _, score := range scores {
+			if quarantined[score.TestName] {
+				continue
+			}
+			catcher.Wrapf(j.quarantineTest(ctx, score), "quarantining test '%s' in task '%s' in build variant '%s'", score.TestName, score.TaskName, score.BuildVariant)
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+func (j *flakyTestDetectionJob) quarantineTest(ctx context.Context, score flakytest.Score) error {
+	if err := j.setTestQuarantined(ctx, score.TestKey, true); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	quarantinedTest := flakytest.AutoQuarantinedTest{
+		Project:       j.ProjectID,
+		TestKey:       score.TestKey,
+		Quarantined:   true,
+		Flakiness:     score.Flakiness(),
+		QuarantinedAt: now,
+		LastCheckedAt: now,
+	}
+	if err := quarantinedTest.Upsert(ctx); err != nil {
+		return errors.Wrap(err, "recording auto-quarantined test")
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":       "automatically quarantined flaky test",
+		"project":       j.ProjectID,
+		"build_variant": score.BuildVariant,
+		"task_name":     score.TaskName,
+		"test_name":     score.TestName,
+		"flakiness":     score.Flakiness(),
+		"flips":         score.Flips,
+		"flaky_runs":    score.FlakyRuns,
+		"runs":          score.Runs,
+		"job":           j.ID(),
+	})
+
+	return errors.Wrap(model.LogProjectTestQuarantineChanged(ctx, j.ProjectID, flakyTestDetectionUser, model.TestQuarantineChange{
+		BuildVariant: score.BuildVariant,
+		TaskName:     score.TaskName,
+		TestName:     score.TestName,
+		Quarantined:  true,
+		Flakiness:    score.Flakiness(),
+		Flips:        score.Flips,
+	}), "logging test quarantine event")
+}
+
+// findRestartedTaskRuns returns the test results of every execution of the
+// project's tasks that were restarted and finished since the given time.
+func (j *flakyTestDetectionJob) findRestartedTaskRuns(ctx context.Context, since time.Time) ([]flakytest.TaskRun, error) {
+	restartedTasks, err := task.Find(ctx, bson.M{
+		task.ProjectKey:     j.ProjectID,
+		task.ExecutionKey:   bson.M{"$gt": 0},
+		task.StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		task.FinishTimeKey:  bson.M{"$gte": since},
+		task.DisplayOnlyKey: bson.M{"$ne": true},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "finding restarted tasks")
+	}
+
+	runs := make([]flakytest.TaskRun, 0, len(restartedTasks))
+	for _, t := range restartedTasks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		executions, err := task.FindAllOld(ctx, db.Query(task.ByOldTaskID(t.Id)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding previous executions of task '%s'", t.Id)
+		}
+		executions = append(executions, t)
+		sort.Slice(executions, func(i, k int) bool { return executions[i].Execution < executions[k].Execution })
+
+		run := flakytest.TaskRun{
+			BuildVariant: t.BuildVariant,
+			TaskName:     t.DisplayName,
+			FinishTime:   t.FinishTime,
+		}
+		for _, execution := range executions {
+			results, err := execution.GetTestResults(ctx, j.env, nil)
+			if err != nil {
+				return nil, errors.Wrapf(err, "getting test results for execution %d of task '%s'", execution.Execution, t.Id)
+			}
+			run.Executions = append(run.Executions, results.Results)
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// unquarantineStableTests updates the stable streak of each of the project's
+// auto-quarantined tests using the tasks that finished since it was last
+// checked, and unquarantines the tests whose streak is long enough.
+func (j *flakyTestDetectionJob) unquarantineStableTests(ctx context.Context) error {
+	quarantinedTests, err := flakytest.Find(ctx, flakytest.QuarantinedByProject(j.ProjectID))
+	if err != nil {
+		return errors.Wrap(err, "finding auto-quarantined tests")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, quarantinedTest := range quarantinedTests {
+		if err := ctx.Err(); err != nil {
+			catcher.Add(err)
+			break
+		}
+		catcher.Wrapf(j.checkStableStreak(ctx, quarantinedTest), "checking stable streak of test '%s' in task '%s' in build variant '%s'", quarantinedTest.TestKey.TestName, quarantinedTest.TestKey.TaskName, quarantinedTest.TestKey.BuildVariant)
+	}
+
+	return catcher.Resolve()
+}
+
+func (j *flakyTestDetectionJob) checkStableStreak(ctx context.Context, quarantinedTest flakytest.AutoQuarantinedTest) error {
+	tasks, err := j.findTasksFinishedSince(ctx, quarantinedTest.TestKey, quarantinedTest.LastCheckedAt)
+	if err != nil {
+		return errors.Wrap(err, "finding tasks to check")
+	}
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	for _, t := range tasks {
+		results, err := t.GetTestResults(ctx, j.env, nil)
+		if err != nil {
+			return errors.Wrapf(err, "getting test results for task '%s'", t.Id)
+		}
+		for _, result := range results.Results {
+			if result.TestName != quarantinedTest.TestKey.TestName {
+				continue
+			}
+			// Quarantined tests may be skipped, which doesn't affect the
+			// streak.
+			if passed, ok := flakytest.TestPassed(result.Status); ok {
+				quarantinedTest.RecordResult(passed)
+			}
+		}
+	}
+
+	if err := quarantinedTest.UpdateStableStreak(ctx, quarantinedTest.StableStreak, tasks[len(tasks)-1].FinishTime); err != nil {
+		return errors.Wrap(err, "updating stable streak")
+	}
+	if quarantinedTest.StableStreak < j.projectRef.FlakyTestPolicy.GetStableStreak() {
+		return nil
+	}
+
+	if err := j.setTestQuarantined(ctx, quarantinedTest.TestKey, false); err != nil {
+		return err
+	}
+	if err := quarantinedTest.MarkUnquarantined(ctx); err != nil {
+		return errors.Wrap(err, "marking auto-quarantined test unquarantined")
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":       "automatically unquarantined stable test",
+		"project":       j.ProjectID,
+		"build_variant": quarantinedTest.TestKey.BuildVariant,
+		"task_name":     quarantinedTest.TestKey.TaskName,
+		"test_name":     quarantinedTest.TestKey.TestName,
+		"stable_streak": quarantinedTest.StableStreak,
+		"job":           j.ID(),
+	})
+
+	return errors.Wrap(model.LogProjectTestQuarantineChanged(ctx, j.ProjectID, flakyTestDetectionUser, model.TestQuarantineChange{
+		BuildVariant: quarantinedTest.TestKey.BuildVariant,
+		TaskName:     quarantinedTest.TestKey.TaskName,
+		TestName:     quarantinedTest.TestKey.TestName,
+		Quarantined:  false,
+		StableStreak: quarantinedTest.StableStreak,
+	}), "logging test quarantine event")
+}
+
+// findTasksFinishedSince returns the executions of the task containing the
+// given test that finished after the given time, sorted by finish time.
+func (j *flakyTestDetectionJob) findTasksFinishedSince(ctx context.Context, key flakytest.TestKey, since time.Time) ([]task.Task, error) {
+	query := db.Query(bson.M{
+		task.ProjectKey:      j.ProjectID,
+		task.BuildVariantKey: key.BuildVariant,
+		task.DisplayNameKey:  key.TaskName,
+		task.StatusKey:       bson.M{"$in": evergreen.TaskCompletedStatuses},
+		task.FinishTimeKey:   bson.M{"$gt": since},
+	}).Sort([]string{task.FinishTimeKey}).Limit(maxStableStreakTasks)
+
+	tasks, err := task.FindAll(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding tasks")
+	}
+	// Restarted executions are archived, so they must be checked too or a
+	// failure followed by a passing retry would be missed.
+	oldTasks, err := task.FindAllOld(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding archived task executions")
+	}
+	tasks = append(tasks, oldTasks...)
+
+	sort.SliceStable(tasks, func(i, k int) bool { return tasks[i].FinishTime.Before(tasks[k].FinishTime) })
+	if len(tasks) > maxStableStreakTasks {
+		tasks = tasks[:maxStableStreakTasks]
+	}
+
+	return tasks, nil
+}
+
+// getTestsQuarantineStatus returns whether each of the given tests is
+// currently quarantined in the test selection service.
+func (j *flakyTestDetectionJob) getTestsQuarantineStatus(ctx context.Context, buildVariant, taskName string, testNames []string) (map[string]bool, error) {
+	reqBody := testselection.NewBodyGetTestsStateDataInBodyApiTestSelectionGetTestsStatePost(j.ProjectID, buildVariant, taskName, testNames)
+	result, resp, err := j.tssClient.StateTransitionAPI.GetTestsStateDataInBodyApiTestSelectionGetTestsStatePost(ctx).
+		BodyGetTestsStateDataInBodyApiTestSelectionGetTestsStatePost(*reqBody).
+		Execute()
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	quarantined := make(map[string]bool, len(testNames))
+	if err != nil {
+		// The test selection service doesn't know about tasks it has never
+		// seen, so none of their tests can be quarantined.
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return quarantined, nil
+		}
+		return nil, errors.Wrap(err, "getting tests state from test selection service")
+	}
+	if result == nil {
+		return quarantined, nil
+	}
+
+	for testName, stateInfo := range *result {
+		if overrideState, ok := stateInfo.GetOverrideStateOk(); ok && overrideState != nil {
+			quarantined[testName] = *overrideState == testselection.STATEMACHINEENUM_MANUALLY_QUARANTINED
+			continue
+		}
+		quarantined[testName] = stateInfo.State == testselection.STATEMACHINEENUM_MANUALLY_QUARANTINED
+	}
+
+	return quarantined, nil
+}
+
+// setTestQuarantined quarantines or unquarantines the test in the test
+// selection service.
+func (j *flakyTestDetectionJob) setTestQuarantined(ctx context.Context, key flakytest.TestKey, quarantined bool) error {
+	reqBody := testselection.NewBodyMarkTestsAsManuallyQuarantinedDataInBodyApiTestSelectionTransitionTestsPost(
+		j.ProjectID,
+		key.BuildVariant,
+		key.TaskName,
+		[]string{key.TestName},
+		quarantined,
+	)
+	_, resp, err := j.tssClient.StateTransitionAPI.MarkTestsAsManuallyQuarantinedDataInBodyApiTestSelectionTransitionTestsPost(ctx).
+		BodyMarkTestsAsManuallyQuarantinedDataInBodyApiTestSelectionTransitionTestsPost(*reqBody).
+		Execute()
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	return errors.Wrapf(err, "setting quarantine state to '%t' in test selection service", quarantined)
+}
@@ -0,0 +1,277 @@
+package units
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/flakytest"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	resultTestutil "github.com/evergreen-ci/evergreen/model/testresult/testutil"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTSS records the quarantine transitions sent to it and reports every
+// test as not quarantined unless it is in manuallyQuarantined.
+type fakeTSS struct {
+	mu                  sync.Mutex
+	manuallyQuarantined map[string]bool
+	transitions         []tssTransition
+}
+
+type tssTransition struct {
+	TaskName              string   `json:"task_name"`
+	TestNames             []string `json:"test_names"`
+	IsManuallyQuarantined bool     `json:"is_manually_quarantined"`
+}
+
+func (f *fakeTSS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case strings.Contains(r.URL.Path, "transition_tests"):
+		var transition tssTransition
+		if err := json.NewDecoder(r.Body).Decode(&transition); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.transitions = append(f.transitions, transition)
+		_, _ = w.Write([]byte("null"))
+	case strings.Contains(r.URL.Path, "get_tests_state"):
+		var req struct {
+			TestNames []string `json:"test_names"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		states := map[string]map[string]any{}
+		for _, name := range req.TestNames {
+			state := "stable"
+			if f.manuallyQuarantined[name] {
+				state = "manually_quarantined"
+			}
+			states[name] = map[string]any{"state": state}
+		}
+		_ = json.NewEncoder(w).Encode(states)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestFlakyTestDetectionJob(t *testing.T) {
+	ctx := t.Context()
+	ctx = testutil.TestSpan(ctx, t)
+	env := evergreen.GetEnvironment()
+
+	const projectID = "project"
+	localOutput := &task.TaskOutput{
+		TestResults: task.TestResultOutput{Version: task.TestResultServiceLocal},
+	}
+
+	insertTaskWithResults := func(t *testing.T, tsk task.Task, archived bool, results map[string]string) {
+		tsk.Project = projectID
+		tsk.BuildVariant = "ubuntu"
+		tsk.DisplayName = "unit"
+		tsk.Status = evergreen.TaskSucceeded
+		tsk.HasTestResults = true
+		tsk.TaskOutputInfo = localOutput
+		resultsTaskID := tsk.Id
+		if archived {
+			tsk.OldTaskId = tsk.Id
+			tsk.Id = task.MakeOldID(tsk.Id, tsk.Execution)
+			tsk.Archived = true
+			require.NoError(t, db.Insert(ctx, task.OldCollection, tsk))
+		} else {
+			require.NoError(t, tsk.Insert(ctx))
+		}
+
+		testResults := make([]testresult.TestResult, 0, len(results))
+		for name, status := range results {
+			testResults = append(testResults, testresult.TestResult{
+				TaskID:    resultsTaskID,
+				Execution: tsk.Execution,
+				TestName:  name,
+				Status:    status,
+			})
+		}
+		svc := task.NewLocalService(env)
+		require.NoError(t, svc.AppendTestResultMetadata(resultTestutil.MakeAppendTestResultMetadataReq(ctx, testResults, tsk.Id)))
+	}
+
+	insertRestartedTask := func(t *testing.T, finishTime time.Time) {
+		insertTaskWithResults(t, task.Task{Id: "restarted", Execution: 0, FinishTime: finishTime.Add(-2 * time.Hour)}, true, map[string]string{
+			"flaky":  evergreen.TestFailedStatus,
+			"stable": evergreen.TestSucceededStatus,
+			"manual": evergreen.TestFailedStatus,
+		})
+		insertTaskWithResults(t, task.Task{Id: "restarted", Execution: 1, FinishTime: finishTime.Add(-time.Hour)}, true, map[string]string{
+			"flaky":  evergreen.TestSucceededStatus,
+			"stable": evergreen.TestSucceededStatus,
+			"manual": evergreen.TestSucceededStatus,
+		})
+		insertTaskWithResults(t, task.Task{Id: "restarted", Execution: 2, FinishTime: finishTime}, false, map[string]string{
+			"flaky":  evergreen.TestFailedStatus,
+			"stable": evergreen.TestSucceededStatus,
+			"manual": evergreen.TestFailedStatus,
+		})
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T, tss *fakeTSS, pRef model.ProjectRef){
+		"QuarantinesFlakyTests": func(t *testing.T, tss *fakeTSS, pRef model.ProjectRef) {
+			insertRestartedTask(t, time.Now().Add(-time.Minute))
+
+			j := NewFlakyTestDetectionJob(projectID, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			require.Len(t, tss.transitions, 1)
+			assert.Equal(t, "unit", tss.transitions[0].TaskName)
+			assert.Equal(t, []string{"flaky"}, tss.transitions[0].TestNames)
+			assert.True(t, tss.transitions[0].IsManuallyQuarantined)
+
+			quarantined, err := flakytest.Find(ctx, flakytest.QuarantinedByProject(projectID))
+			require.NoError(t, err)
+			require.Len(t, quarantined, 1)
+			assert.Equal(t, flakytest.TestKey{BuildVariant: "ubuntu", TaskName: "unit", TestName: "flaky"}, quarantined[0].TestKey)
+			assert.InDelta(t, 1, quarantined[0].Flakiness, 0.001)
+
+			events, err := model.MostRecentProjectEvents(ctx, projectID, 5)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.EventTypeProjectTestAutoQuarantined, events[0].EventType)
+			data, ok := events[0].Data.(*model.ProjectChangeEvent)
+			require.True(t, ok)
+			assert.Equal(t, flakyTestDetectionUser, data.User)
+			require.NotNil(t, data.TestQuarantine)
+			assert.Equal(t, "flaky", data.TestQuarantine.TestName)
+			assert.True(t, data.TestQuarantine.Quarantined)
+			assert.Equal(t, 2, data.TestQuarantine.Flips)
+		},
+		"NoopsWhenAutoQuarantineIsDisabled": func(t *testing.T, tss *fakeTSS, pRef model.ProjectRef) {
+			pRef.FlakyTestPolicy.AutoQuarantineEnabled = utility.FalsePtr()
+			require.NoError(t, pRef.Replace(ctx))
+			insertRestartedTask(t, time.Now().Add(-time.Minute))
+
+			j := NewFlakyTestDetectionJob(projectID, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			assert.Empty(t, tss.transitions)
+			quarantined, err := flakytest.Find(ctx, flakytest.ByProject(projectID))
+			require.NoError(t, err)
+			assert.Empty(t, quarantined)
+		},
+		"IgnoresTasksOutsideOfLookbackPeriod": func(t *testing.T, tss *fakeTSS, pRef model.ProjectRef) {
+			insertRestartedTask(t, time.Now().Add(-2*utility.Day))
+
+			j := NewFlakyTestDetectionJob(projectID, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			assert.Empty(t, tss.transitions)
+		},
+		"UnquarantinesTestsAfterStableStreak": func(t *testing.T, tss *fakeTSS, pRef model.ProjectRef) {
+			quarantinedAt := time.Now().Add(-time.Hour)
+			insertRestartedTask(t, quarantinedAt.Add(-time.Minute))
+			quarantinedTest := flakytest.AutoQuarantinedTest{
+				Project:       projectID,
+				TestKey:       flakytest.TestKey{BuildVariant: "ubuntu", TaskName: "unit", TestName: "flaky"},
+				Quarantined:   true,
+				QuarantinedAt: quarantinedAt,
+				LastCheckedAt: quarantinedAt,
+			}
+			require.NoError(t, quarantinedTest.Upsert(ctx))
+
+			insertTaskWithResults(t, task.Task{Id: "passed0", FinishTime: quarantinedAt.Add(time.Minute)}, false, map[string]string{"flaky": evergreen.TestSucceededStatus})
+			insertTaskWithResults(t, task.Task{Id: "skipped", FinishTime: quarantinedAt.Add(2 * time.Minute)}, false, map[string]string{"flaky": evergreen.TestSkippedStatus})
+			insertTaskWithResults(t, task.Task{Id: "passed1", FinishTime: quarantinedAt.Add(3 * time.Minute)}, false, map[string]string{"flaky": evergreen.TestSucceededStatus})
+
+			j := NewFlakyTestDetectionJob(projectID, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			require.Len(t, tss.transitions, 1, "test should be unquarantined and not quarantined again based on flips from before it was quarantined")
+			assert.Equal(t, []string{"flaky"}, tss.transitions[0].TestNames)
+			assert.False(t, tss.transitions[0].IsManuallyQuarantined)
+
+			dbTest, err := flakytest.FindOne(ctx, flakytest.ByID(quarantinedTest.ID))
+			require.NoError(t, err)
+			require.NotNil(t, dbTest)
+			assert.False(t, dbTest.Quarantined)
+			assert.Equal(t, 2, dbTest.StableStreak)
+
+			events, err := model.MostRecentProjectEvents(ctx, projectID, 5)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.EventTypeProjectTestAutoUnquarantined, events[0].EventType)
+		},
+		"FailureResetsStableStreak": func(t *testing.T, tss *fakeTSS, pRef model.ProjectRef) {
+			quarantinedAt := time.Now().Add(-time.Hour)
+			quarantinedTest := flakytest.AutoQuarantinedTest{
+				Project:       projectID,
+				TestKey:       flakytest.TestKey{BuildVariant: "ubuntu", TaskName: "unit", TestName: "flaky"},
+				Quarantined:   true,
+				QuarantinedAt: quarantinedAt,
+				LastCheckedAt: quarantinedAt,
+			}
+			require.NoError(t, quarantinedTest.Upsert(ctx))
+
+			insertTaskWithResults(t, task.Task{Id: "passed", FinishTime: quarantinedAt.Add(time.Minute)}, false, map[string]string{"flaky": evergreen.TestSucceededStatus})
+			insertTaskWithResults(t, task.Task{Id: "failed", FinishTime: quarantinedAt.Add(2 * time.Minute)}, false, map[string]string{"flaky": evergreen.TestFailedStatus})
+
+			j := NewFlakyTestDetectionJob(projectID, "ts")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			assert.Empty(t, tss.transitions)
+			dbTest, err := flakytest.FindOne(ctx, flakytest.ByID(quarantinedTest.ID))
+			require.NoError(t, err)
+			require.NotNil(t, dbTest)
+			assert.True(t, dbTest.Quarantined)
+			assert.Zero(t, dbTest.StableStreak)
+			assert.True(t, dbTest.LastCheckedAt.After(quarantinedAt))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(model.ProjectRefCollection, task.Collection, task.OldCollection, flakytest.Collection, event.EventCollection))
+			require.NoError(t, task.ClearTestResults(ctx, env))
+
+			tss := &fakeTSS{manuallyQuarantined: map[string]bool{"manual": true}}
+			srv := httptest.NewServer(tss)
+			t.Cleanup(srv.Close)
+			originalURL := env.Settings().TestSelection.URL
+			env.Settings().TestSelection.URL = srv.URL
+			t.Cleanup(func() {
+				env.Settings().TestSelection.URL = originalURL
+			})
+
+			pRef := model.ProjectRef{
+				Id:            projectID,
+				TestSelection: model.TestSelectionSettings{Allowed: utility.TruePtr()},
+				FlakyTestPolicy: model.FlakyTestPolicy{
+					AutoQuarantineEnabled: utility.TruePtr(),
+					LookbackDays:          1,
+					StableStreak:          2,
+				},
+			}
+			require.NoError(t, pRef.Insert(ctx))
+
+			tCase(t, tss, pRef)
+		})
+	}
+}
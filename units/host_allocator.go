@@ -350,6 +350,7 @@ func (j *hostAllocatorJob) Run(ctx context.Context) {
 		"max_duration_threshold_secs":        distroQueueInfo.MaxDurationThreshold.Seconds(),
 		"overdue_tasks":                      distroQueueInfo.CountWaitOverThreshold,
 		"overdue_tasks_in_groups":            totalOverdueInTaskGroups,
+		"over_fair_share_tasks":              distroQueueInfo.CountOverFairShare,
 		"total_runtime":                      distroQueueInfo.ExpectedDuration.String(),
 		"runtime_secs":                       distroQueueInfo.ExpectedDuration.Seconds(),
 		"time_to_empty":                      timeToEmpty.String(),
@@ -382,6 +383,7 @@ func (j *hostAllocatorJob) Run(ctx context.Context) {
 		attribute.Int(fmt.Sprintf("%s.merge_queue_tasks", hostAllocatorAttributePrefix), distroQueueInfo.CountDepFilledMergeQueueTasks),
 		attribute.Float64(fmt.Sprintf("%s.max_duration_threshold_secs", hostAllocatorAttributePrefix), distroQueueInfo.MaxDurationThreshold.Seconds()),
 		attribute.Int(fmt.Sprintf("%s.overdue_tasks_in_groups", hostAllocatorAttributePrefix), totalOverdueInTaskGroups),
+		attribute.Int(fmt.Sprintf("%s.over_fair_share_tasks", hostAllocatorAttributePrefix), distroQueueInfo.CountOverFairShare),
 		attribute.Float64(fmt.Sprintf("%s.queue_ratio", hostAllocatorAttributePrefix), float64(noSpawnsRatio)),
 		attribute.Float64(fmt.Sprintf("%s.host_queue_ratio", hostAllocatorAttributePrefix), float64(hostQueueRatio)),
 		attribute.Float64(fmt.Sprintf("%s.runtime_secs", hostAllocatorAttributePrefix), distroQueueInfo.ExpectedDuration.Seconds()),
@@ -0,0 +1,132 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const notificationDigestJobName = "notification-digest"
+
+func init() {
+	registry.AddJobType(notificationDigestJobName, func() amboy.Job { return makeNotificationDigestJob() })
+}
+
+type notificationDigestJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+	env      evergreen.Environment
+}
+
+func makeNotificationDigestJob() *notificationDigestJob {
+	j := &notificationDigestJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    notificationDigestJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewNotificationDigestJob creates a job that rolls the held notifications
+// whose digests are due into one digest notification per subscriber and
+// sends them.
+func NewNotificationDigestJob(ts string) amboy.Job {
+	j := makeNotificationDigestJob()
+	j.SetID(fmt.Sprintf("%s.%s", notificationDigestJobName, ts))
+	return j
+}
+
+func (j *notificationDigestJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+	flags, err := evergreen.GetServiceFlags(ctx)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "getting service flags"))
+		return
+	}
+
+	now := time.Now()
+	due, err := notification.FindDueForDigest(ctx, now)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+	if len(due) == 0 {
+		return
+	}
+
+	bySubscriber := map[string][]notification.Notification{}
+	var subscribers []string
+	for _, n := range due {
+		key := fmt.Sprintf("%s-%s", n.Subscriber.Type, n.Subscriber.String())
+		if _, ok := bySubscriber[key]; !ok {
+			subscribers = append(subscribers, key)
+		}
+		bySubscriber[key] = append(bySubscriber[key], n)
+	}
+
+	var digests []notification.Notification
+	for _, key := range subscribers {
+		held := bySubscriber[key]
+		digest, err := j.makeDigest(ctx, held)
+		if err != nil {
+			j.AddError(errors.Wrapf(err, "making digest for subscriber '%s'", key))
+			continue
+		}
+		digests = append(digests, *digest)
+		grip.Info(ctx, message.Fields{
+			"message":          "rolled held notifications into digest",
+			"job_id":           j.ID(),
+			"digest_id":        digest.ID,
+			"subscriber_type":  digest.Subscriber.Type,
+			"num_held":         len(held),
+			"notification_ids": notificationIDs(held),
+		})
+	}
+
+	jobs, err := notificationJobs(ctx, digests, flags, now)
+	j.AddError(errors.Wrap(err, "creating digest send jobs"))
+	j.AddError(errors.Wrap(amboy.EnqueueManyUniqueJobs(ctx, j.env.RemoteQueue(), jobs), "enqueueing digest send jobs"))
+}
+
+// makeDigest inserts the digest notification for the held notifications and
+// marks them as sent in it. Making the digest again for the same notifications
+// finds the digest that was already inserted, so a job that fails partway
+// through doesn't send duplicate digests.
+func (j *notificationDigestJob) makeDigest(ctx context.Context, held []notification.Notification) (*notification.Notification, error) {
+	digest, err := notification.MakeDigest(held[0].Subscriber, held)
+	if err != nil {
+		return nil, errors.Wrap(err, "making digest notification")
+	}
+	if err = notification.InsertMany(ctx, *digest); err != nil && !db.IsDuplicateKey(err) {
+		return nil, errors.Wrap(err, "inserting digest notification")
+	}
+	if err = notification.MarkSentInDigest(ctx, notificationIDs(held), digest.ID); err != nil {
+		return nil, errors.Wrap(err, "marking held notifications as sent")
+	}
+
+	return digest, nil
+}
+
+func notificationIDs(notifications []notification.Notification) []string {
+	ids := make([]string, 0, len(notifications))
+	for _, n := range notifications {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
@@ -0,0 +1,84 @@
+package units
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationDigestJob(t *testing.T) {
+	require.NoError(t, db.Clear(notification.Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(notification.Collection))
+	}()
+
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(t.Context()))
+
+	slack := event.Subscriber{Type: event.SlackSubscriberType, Target: "#evergreen"}
+	email := event.Subscriber{Type: event.EmailSubscriberType, Target: "a@example.com"}
+	held := func(id string, subscriber event.Subscriber, sendAfter time.Time) notification.Notification {
+		return notification.Notification{
+			ID:         id,
+			Subscriber: subscriber,
+			Payload:    &notification.SlackPayload{Body: id},
+			Digest: &notification.DigestInfo{
+				SubscriptionID: "subscription",
+				SendAfter:      sendAfter,
+				Project:        "mci",
+				Version:        "v1",
+				Summary:        id,
+			},
+		}
+	}
+	now := time.Now()
+	emailHeld := held("email-due", email, now.Add(-time.Minute))
+	emailHeld.Payload = &message.Email{Subject: "email-due"}
+	require.NoError(t, notification.InsertMany(t.Context(),
+		held("slack-due-1", slack, now.Add(-time.Minute)),
+		held("slack-due-2", slack, now.Add(-time.Hour)),
+		held("slack-not-due", slack, now.Add(time.Hour)),
+		emailHeld,
+	))
+
+	j := makeNotificationDigestJob()
+	j.env = env
+	j.Run(t.Context())
+	require.NoError(t, j.Error())
+
+	for _, id := range []string{"slack-due-1", "slack-due-2", "email-due"} {
+		n, err := notification.Find(t.Context(), id)
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		assert.NotZero(t, n.SentAt, id)
+		assert.NotEmpty(t, n.Digest.DigestID, id)
+	}
+	notDue, err := notification.Find(t.Context(), "slack-not-due")
+	require.NoError(t, err)
+	require.NotNil(t, notDue)
+	assert.Zero(t, notDue.SentAt)
+
+	slackDue, err := notification.Find(t.Context(), "slack-due-1")
+	require.NoError(t, err)
+	digest, err := notification.Find(t.Context(), slackDue.Digest.DigestID)
+	require.NoError(t, err)
+	require.NotNil(t, digest)
+	assert.Nil(t, digest.Digest)
+	assert.Equal(t, slack.Type, digest.Subscriber.Type)
+	payload, ok := digest.Payload.(*notification.SlackPayload)
+	require.True(t, ok)
+	assert.Contains(t, payload.Body, "2 notification(s)")
+
+	emailDue, err := notification.Find(t.Context(), "email-due")
+	require.NoError(t, err)
+	assert.NotEqual(t, slackDue.Digest.DigestID, emailDue.Digest.DigestID)
+
+	assert.Equal(t, 2, env.RemoteQueue().Stats(t.Context()).Total)
+}
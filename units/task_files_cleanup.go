@@ -0,0 +1,140 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/taskfiles"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	taskFilesCleanupJobName = "task-files-cleanup"
+
+	// taskFilesVersionGracePeriod is how long a version's task files are
+	// kept after the version finishes, so that tasks restarted shortly
+	// afterwards can still fetch them.
+	taskFilesVersionGracePeriod = 7 * 24 * time.Hour
+	// taskFilesMaxAge is the longest that a version's task files are kept,
+	// even if the version never finishes.
+	taskFilesMaxAge = 30 * 24 * time.Hour
+)
+
+func init() {
+	registry.AddJobType(taskFilesCleanupJobName, func() amboy.Job {
+		return makeTaskFilesCleanupJob()
+	})
+}
+
+type taskFilesCleanupJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env evergreen.Environment
+}
+
+func makeTaskFilesCleanupJob() *taskFilesCleanupJob {
+	j := &taskFilesCleanupJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    taskFilesCleanupJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewTaskFilesCleanupJob creates a job that removes the files published by
+// tasks in versions that have expired, both from the task files bucket and
+// from the database.
+func NewTaskFilesCleanupJob(env evergreen.Environment, ts string) amboy.Job {
+	j := makeTaskFilesCleanupJob()
+	j.SetID(fmt.Sprintf("%s.%s", taskFilesCleanupJobName, ts))
+	j.SetScopes([]string{taskFilesCleanupJobName})
+	j.SetEnqueueAllScopes(true)
+	j.env = env
+	return j
+}
+
+func (j *taskFilesCleanupJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+	buckets := j.env.Settings().Buckets
+	if buckets.TaskFilesBucket.Name == "" {
+		return
+	}
+
+	now := time.Now()
+	versions, err := taskfiles.FindVersionsCreatedBefore(ctx, now.Add(-taskFilesVersionGracePeriod))
+	if err != nil {
+		j.AddError(errors.Wrap(err, "finding versions with task files"))
+		return
+	}
+
+	var numRemoved int
+	for _, v := range versions {
+		if ctx.Err() != nil {
+			j.AddError(ctx.Err())
+			break
+		}
+
+		expired, err := taskFilesExpired(ctx, v, now)
+		if err != nil {
+			j.AddError(errors.Wrapf(err, "checking whether task files for version '%s' have expired", v.Version))
+			continue
+		}
+		if !expired {
+			continue
+		}
+
+		// Remove the archives before the records so that if removing the
+		// archives fails, the version is retried on the next run.
+		if err := taskfiles.RemoveVersion(ctx, buckets, v.Version); err != nil {
+			j.AddError(err)
+			continue
+		}
+		if err := taskfiles.RemoveByVersion(ctx, v.Version); err != nil {
+			j.AddError(err)
+			continue
+		}
+		numRemoved++
+	}
+
+	grip.Info(ctx, message.Fields{
+		"job":                taskFilesCleanupJobName,
+		"message":            "finished removing expired task files",
+		"versions_checked":   len(versions),
+		"versions_removed":   numRemoved,
+		"grace_period_hours": taskFilesVersionGracePeriod.Hours(),
+		"max_age_hours":      taskFilesMaxAge.Hours(),
+	})
+}
+
+// taskFilesExpired returns whether the version's task files should be removed.
+// They expire once the version has been finished for the grace period, if the
+// version no longer exists, or once they reach the maximum age.
+func taskFilesExpired(ctx context.Context, v taskfiles.VersionFileSets, now time.Time) (bool, error) {
+	if v.OldestCreateTime.Before(now.Add(-taskFilesMaxAge)) {
+		return true, nil
+	}
+
+	version, err := model.VersionFindOne(ctx, model.VersionById(v.Version).WithFields(model.VersionStatusKey, model.VersionFinishTimeKey))
+	if err != nil {
+		return false, errors.Wrap(err, "finding version")
+	}
+	if version == nil {
+		return true, nil
+	}
+	return evergreen.IsFinishedVersionStatus(version.Status) && version.FinishTime.Before(now.Add(-taskFilesVersionGracePeriod)), nil
+}
@@ -0,0 +1,86 @@
+package units
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/taskfiles"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskFilesExpired(t *testing.T) {
+	ctx := testutil.TestSpan(t.Context(), t)
+	require.NoError(t, db.ClearCollections(model.VersionCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(model.VersionCollection))
+	}()
+
+	now := time.Now()
+	for _, v := range []model.Version{
+		{Id: "running", Status: evergreen.VersionStarted},
+		{Id: "recently_finished", Status: evergreen.VersionSucceeded, FinishTime: now.Add(-time.Hour)},
+		{Id: "finished", Status: evergreen.VersionFailed, FinishTime: now.Add(-taskFilesVersionGracePeriod - time.Hour)},
+	} {
+		require.NoError(t, v.Insert(ctx))
+	}
+	recent := now.Add(-taskFilesVersionGracePeriod - time.Hour)
+
+	for tName, tCase := range map[string]struct {
+		fileSets taskfiles.VersionFileSets
+		expired  bool
+	}{
+		"RunningVersionIsKept": {
+			fileSets: taskfiles.VersionFileSets{Version: "running", OldestCreateTime: recent},
+		},
+		"RecentlyFinishedVersionIsKept": {
+			fileSets: taskfiles.VersionFileSets{Version: "recently_finished", OldestCreateTime: recent},
+		},
+		"VersionFinishedBeforeGracePeriodExpires": {
+			fileSets: taskfiles.VersionFileSets{Version: "finished", OldestCreateTime: recent},
+			expired:  true,
+		},
+		"MissingVersionExpires": {
+			fileSets: taskfiles.VersionFileSets{Version: "nonexistent", OldestCreateTime: recent},
+			expired:  true,
+		},
+		"RunningVersionPastMaxAgeExpires": {
+			fileSets: taskfiles.VersionFileSets{Version: "running", OldestCreateTime: now.Add(-taskFilesMaxAge - time.Hour)},
+			expired:  true,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			expired, err := taskFilesExpired(ctx, tCase.fileSets, now)
+			require.NoError(t, err)
+			assert.Equal(t, tCase.expired, expired)
+		})
+	}
+}
+
+func TestTaskFilesCleanupJobSkipsWithoutBucket(t *testing.T) {
+	ctx := testutil.TestSpan(t.Context(), t)
+	require.NoError(t, db.ClearCollections(taskfiles.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(taskfiles.Collection))
+	}()
+
+	env := &mock.Environment{}
+	require.NoError(t, env.Configure(ctx))
+	env.Settings().Buckets.TaskFilesBucket = evergreen.BucketConfig{}
+
+	fs := taskfiles.TaskFileSet{ID: "fs", Version: "nonexistent", CreateTime: time.Now().Add(-taskFilesMaxAge - time.Hour)}
+	require.NoError(t, db.Insert(ctx, taskfiles.Collection, fs))
+
+	j := NewTaskFilesCleanupJob(env, "id")
+	j.Run(ctx)
+	require.NoError(t, j.Error())
+
+	found, err := taskfiles.FindOne(ctx, db.Query(map[string]any{taskfiles.IDKey: "fs"}))
+	require.NoError(t, err)
+	assert.NotNil(t, found, "file sets should not be removed if the bucket is not configured")
+}
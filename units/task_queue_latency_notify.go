@@ -0,0 +1,186 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/alertrecord"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const taskQueueLatencyNotifyJobName = "task-queue-latency-notify"
+
+func init() {
+	registry.AddJobType(taskQueueLatencyNotifyJobName, func() amboy.Job {
+		return makeTaskQueueLatencyNotifyJob()
+	})
+}
+
+type taskQueueLatencyNotifyJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+}
+
+func makeTaskQueueLatencyNotifyJob() *taskQueueLatencyNotifyJob {
+	j := &taskQueueLatencyNotifyJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    taskQueueLatencyNotifyJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewTaskQueueLatencyNotifyJob creates a job that logs an event for each
+// scheduled task that has waited in the queue for longer than the threshold
+// of a task queue latency subscription.
+func NewTaskQueueLatencyNotifyJob(id string) amboy.Job {
+	j := makeTaskQueueLatencyNotifyJob()
+	j.SetID(fmt.Sprintf("%s.%s", taskQueueLatencyNotifyJobName, id))
+	return j
+}
+
+func (j *taskQueueLatencyNotifyJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	filtersByThreshold, err := event.FindTaskQueueLatencyFilters(ctx)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "finding task queue latency subscriptions"))
+		return
+	}
+	if len(filtersByThreshold) == 0 {
+		return
+	}
+
+	thresholds := make([]int, 0, len(filtersByThreshold))
+	for threshold := range filtersByThreshold {
+		thresholds = append(thresholds, threshold)
+	}
+	sort.Ints(thresholds)
+
+	now := time.Now()
+	repoProjects := map[string][]string{}
+	numEvents := map[int]int{}
+	for _, threshold := range thresholds {
+		if ctx.Err() != nil {
+			j.AddError(ctx.Err())
+			return
+		}
+
+		taskFilters, err := scheduledTaskFilters(ctx, filtersByThreshold[threshold], repoProjects)
+		if err != nil {
+			j.AddError(errors.Wrapf(err, "getting task filters for the %d minute threshold", threshold))
+			continue
+		}
+		tasks, err := task.FindScheduledButNotStarted(ctx, now.Add(-time.Duration(threshold)*time.Minute), taskFilters)
+		if err != nil {
+			j.AddError(errors.Wrapf(err, "finding tasks waiting for more than %d minutes", threshold))
+			continue
+		}
+		for _, t := range tasks {
+			logged, err := tryTaskQueueLatencyNotification(ctx, &t, threshold)
+			if err != nil {
+				j.AddError(errors.Wrapf(err, "logging queue latency event for task '%s'", t.Id))
+				continue
+			}
+			if logged {
+				numEvents[threshold]++
+			}
+		}
+	}
+
+	grip.Info(ctx, message.Fields{
+		"job":             taskQueueLatencyNotifyJobName,
+		"message":         "finished running task queue latency notify job",
+		"thresholds_mins": thresholds,
+		"events_logged":   numEvents,
+	})
+}
+
+// scheduledTaskFilters returns the filters for the tasks that the subscription
+// filters can match. Subscriptions can only be narrowed down by the task
+// fields that the filters map to directly; the rest are checked when the
+// event is processed. A project filter can be a repo, in which case it
+// matches the repo's branch projects, which are cached in repoProjects.
+func scheduledTaskFilters(ctx context.Context, filters []event.Filter, repoProjects map[string][]string) ([]task.ScheduledTaskFilter, error) {
+	taskFilters := make([]task.ScheduledTaskFilter, 0, len(filters))
+	for _, f := range filters {
+		taskFilter := task.ScheduledTaskFilter{
+			TaskID:  f.ID,
+			Version: f.InVersion,
+			BuildID: f.InBuild,
+			Distro:  f.Distro,
+		}
+		if f.Project != "" {
+			projects, err := projectsForFilter(ctx, f.Project, repoProjects)
+			if err != nil {
+				return nil, err
+			}
+			taskFilter.Projects = projects
+		}
+		taskFilters = append(taskFilters, taskFilter)
+	}
+	return taskFilters, nil
+}
+
+// projectsForFilter returns the IDs of the projects that a subscription's
+// project filter matches.
+func projectsForFilter(ctx context.Context, projectOrRepo string, repoProjects map[string][]string) ([]string, error) {
+	if projects, ok := repoProjects[projectOrRepo]; ok {
+		return projects, nil
+	}
+
+	projects := []string{projectOrRepo}
+	repoRef, err := model.FindOneRepoRef(ctx, projectOrRepo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding repo ref '%s'", projectOrRepo)
+	}
+	if repoRef != nil {
+		pRefs, err := model.FindMergedProjectRefsForRepo(ctx, repoRef)
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding projects for repo '%s'", projectOrRepo)
+		}
+		for _, pRef := range pRefs {
+			projects = append(projects, pRef.Id)
+		}
+	}
+	repoProjects[projectOrRepo] = projects
+	return projects, nil
+}
+
+// tryTaskQueueLatencyNotification logs an event for the task exceeding the
+// threshold, unless one was already logged since the task started waiting. It
+// returns true if an event was logged.
+func tryTaskQueueLatencyNotification(ctx context.Context, t *task.Task, threshold int) (bool, error) {
+	waitStart := t.QueueWaitStart()
+	if waitStart.IsZero() || time.Since(waitStart) < time.Duration(threshold)*time.Minute {
+		return false, nil
+	}
+
+	rec, err := alertrecord.FindByTaskQueueLatency(ctx, t.Id, threshold, waitStart)
+	if err != nil {
+		return false, errors.Wrap(err, "finding queue latency alert record")
+	}
+	if rec != nil {
+		return false, nil
+	}
+
+	// Record the alert before logging the event so that if recording it
+	// fails, the next run doesn't log a duplicate event.
+	if err := alertrecord.InsertNewTaskQueueLatencyRecord(ctx, t.Id, t.Project, t.Version, threshold); err != nil {
+		return false, errors.Wrap(err, "inserting queue latency alert record")
+	}
+	event.LogTaskQueueLatencyExceeded(ctx, t.Id, t.Execution, threshold)
+	return true, nil
+}
@@ -0,0 +1,158 @@
+package units
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/alertrecord"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskQueueLatencyNotifyJob(t *testing.T) {
+	ctx := testutil.TestSpan(t.Context(), t)
+
+	queueLatencyEvents := func(t *testing.T) map[string][]int {
+		events, err := event.FindUnprocessedEvents(ctx, -1)
+		require.NoError(t, err)
+		thresholdsByTask := map[string][]int{}
+		for _, e := range events {
+			if e.EventType != event.TaskQueueLatencyExceeded {
+				continue
+			}
+			data, ok := e.Data.(*event.TaskEventData)
+			require.True(t, ok)
+			thresholdsByTask[e.ResourceId] = append(thresholdsByTask[e.ResourceId], data.QueueLatencyMins)
+		}
+		return thresholdsByTask
+	}
+	subscribeWithFilter := func(t *testing.T, id, threshold string, filter event.Filter) {
+		sub := event.Subscription{
+			ID:           id,
+			ResourceType: event.ResourceTypeTask,
+			Trigger:      event.TriggerTaskQueueLatency,
+			Filter:       filter,
+			Subscriber: event.Subscriber{
+				Type:   event.EmailSubscriberType,
+				Target: "release@example.com",
+			},
+			OwnerType:   event.OwnerTypeProject,
+			Owner:       "project",
+			TriggerData: map[string]string{event.TaskQueueLatencyKey: threshold},
+		}
+		require.NoError(t, sub.Upsert(ctx))
+	}
+	subscribe := func(t *testing.T, id, threshold string) {
+		subscribeWithFilter(t, id, threshold, event.Filter{Project: "project"})
+	}
+	insertTaskIn := func(t *testing.T, id, project, distroID string, waiting time.Duration, status string) {
+		waitStart := time.Now().Add(-waiting)
+		tsk := task.Task{
+			Id:                  id,
+			Project:             project,
+			Version:             "version",
+			DistroId:            distroID,
+			Status:              status,
+			Activated:           true,
+			ScheduledTime:       waitStart,
+			DependenciesMetTime: waitStart,
+		}
+		if status == evergreen.TaskStarted {
+			tsk.StartTime = time.Now()
+		}
+		require.NoError(t, tsk.Insert(ctx))
+	}
+	insertTask := func(t *testing.T, id string, waiting time.Duration, status string) {
+		insertTaskIn(t, id, "project", "distro", waiting, status)
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"DoesNothingWithoutSubscriptions": func(t *testing.T) {
+			insertTask(t, "t1", 2*time.Hour, evergreen.TaskUndispatched)
+
+			j := NewTaskQueueLatencyNotifyJob("id")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			assert.Empty(t, queueLatencyEvents(t))
+		},
+		"LogsEventForEachExceededThreshold": func(t *testing.T) {
+			subscribe(t, "sub30", "30")
+			subscribe(t, "sub90", "90")
+			insertTask(t, "t1", time.Hour, evergreen.TaskUndispatched)
+			insertTask(t, "t2", 2*time.Hour, evergreen.TaskDispatched)
+			insertTask(t, "t3", 10*time.Minute, evergreen.TaskUndispatched)
+			insertTask(t, "t4", 2*time.Hour, evergreen.TaskStarted)
+
+			j := NewTaskQueueLatencyNotifyJob("id")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			thresholdsByTask := queueLatencyEvents(t)
+			assert.ElementsMatch(t, []int{30}, thresholdsByTask["t1"])
+			assert.ElementsMatch(t, []int{30, 90}, thresholdsByTask["t2"])
+			assert.NotContains(t, thresholdsByTask, "t3")
+			assert.NotContains(t, thresholdsByTask, "t4")
+		},
+		"OnlyLogsEventsForTasksMatchingSubscriptions": func(t *testing.T) {
+			subscribe(t, "sub30", "30")
+			subscribeWithFilter(t, "sub90", "90", event.Filter{Distro: "other_distro"})
+			insertTask(t, "t1", 2*time.Hour, evergreen.TaskUndispatched)
+			insertTaskIn(t, "t2", "other_project", "distro", 2*time.Hour, evergreen.TaskUndispatched)
+			insertTaskIn(t, "t3", "other_project", "other_distro", 2*time.Hour, evergreen.TaskUndispatched)
+
+			j := NewTaskQueueLatencyNotifyJob("id")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			thresholdsByTask := queueLatencyEvents(t)
+			assert.ElementsMatch(t, []int{30}, thresholdsByTask["t1"])
+			assert.NotContains(t, thresholdsByTask, "t2")
+			assert.ElementsMatch(t, []int{90}, thresholdsByTask["t3"])
+
+			rec, err := alertrecord.FindByTaskQueueLatency(ctx, "t2", 30, time.Now().Add(-3*time.Hour))
+			require.NoError(t, err)
+			assert.Nil(t, rec, "should not record alerts for tasks without a matching subscription")
+		},
+		"MatchesBranchProjectsOfRepoSubscriptions": func(t *testing.T) {
+			repoRef := model.RepoRef{ProjectRef: model.ProjectRef{Id: "repo", Owner: "owner", Repo: "repo"}}
+			require.NoError(t, repoRef.Replace(ctx))
+			pRef := model.ProjectRef{Id: "branch_project", Owner: "owner", Repo: "repo", RepoRefId: "repo", Enabled: true}
+			require.NoError(t, pRef.Insert(ctx))
+			subscribeWithFilter(t, "sub30", "30", event.Filter{Project: "repo"})
+			insertTaskIn(t, "t1", "branch_project", "distro", time.Hour, evergreen.TaskUndispatched)
+			insertTaskIn(t, "t2", "other_project", "distro", time.Hour, evergreen.TaskUndispatched)
+
+			j := NewTaskQueueLatencyNotifyJob("id")
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			thresholdsByTask := queueLatencyEvents(t)
+			assert.ElementsMatch(t, []int{30}, thresholdsByTask["t1"])
+			assert.NotContains(t, thresholdsByTask, "t2")
+		},
+		"DoesNotLogEventAgainForSameWait": func(t *testing.T) {
+			subscribe(t, "sub30", "30")
+			insertTask(t, "t1", time.Hour, evergreen.TaskUndispatched)
+
+			for i := 0; i < 2; i++ {
+				j := NewTaskQueueLatencyNotifyJob("id")
+				j.Run(ctx)
+				require.NoError(t, j.Error())
+			}
+
+			assert.ElementsMatch(t, []int{30}, queueLatencyEvents(t)["t1"])
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(event.EventCollection, event.SubscriptionsCollection, task.Collection, alertrecord.Collection, model.ProjectRefCollection, model.RepoRefCollection))
+			tCase(t)
+		})
+	}
+}
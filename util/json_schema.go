@@ -0,0 +1,176 @@
+package util
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// JSONSchemaDialect is the JSON Schema draft that JSONSchemaFor generates.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	durationType      = reflect.TypeOf(time.Duration(0))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// JSONSchemaFor returns a JSON Schema for the JSON encoding of v. Named
+// structs are described once in defs and referenced from the schema as
+// "#/$defs/<name>", so the defs must be placed under "$defs" in the root of
+// the document that contains the schema. Types that marshal themselves,
+// other than time.Time, are described as accepting any value.
+func JSONSchemaFor(v any) (schema map[string]any, defs map[string]any) {
+	g := jsonSchemaGenerator{
+		defs:  map[string]any{},
+		names: map[reflect.Type]string{},
+	}
+	return g.schema(reflect.TypeOf(v)), g.defs
+}
+
+type jsonSchemaGenerator struct {
+	defs  map[string]any
+	names map[reflect.Type]string
+}
+
+func (g *jsonSchemaGenerator) schema(t reflect.Type) map[string]any {
+	if t == nil {
+		return map[string]any{}
+	}
+
+	switch {
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface &&
+		(t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType)):
+		return map[string]any{}
+	case t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface &&
+		(t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType)):
+		return map[string]any{"type": "string"}
+	case t == durationType:
+		return map[string]any{"type": "integer"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullable(g.schema(t.Elem()))
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json encodes byte slices as base64 strings.
+			return nullable(map[string]any{"type": "string", "contentEncoding": "base64"})
+		}
+		return nullable(map[string]any{"type": "array", "items": g.schema(t.Elem())})
+	case reflect.Array:
+		return map[string]any{"type": "array", "items": g.schema(t.Elem()), "minItems": t.Len(), "maxItems": t.Len()}
+	case reflect.Map:
+		return nullable(map[string]any{"type": "object", "additionalProperties": g.schema(t.Elem())})
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return map[string]any{"$ref": "#/$defs/" + g.define(t)}
+	default:
+		return map[string]any{}
+	}
+}
+
+// define adds the named struct to the defs if it isn't there already, and
+// returns its name in the defs.
+func (g *jsonSchemaGenerator) define(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := g.defs[name]; taken {
+		name = strings.ReplaceAll(t.PkgPath(), "/", ".") + "." + t.Name()
+	}
+	g.names[t] = name
+	// Reserve the name before describing the struct, so recursive types
+	// refer to it instead of being described forever.
+	g.defs[name] = map[string]any{}
+	g.defs[name] = g.structSchema(t)
+
+	return name
+}
+
+func (g *jsonSchemaGenerator) structSchema(t reflect.Type) map[string]any {
+	properties := map[string]any{}
+	var required []string
+	g.addFields(t, properties, &required)
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// addFields adds the JSON fields of the struct to the properties. The fields
+// of embedded structs without a JSON name are promoted, as they are by
+// encoding/json, unless the outer struct has a field with the same name.
+func (g *jsonSchemaGenerator) addFields(t reflect.Type, properties map[string]any, required *[]string) {
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldType)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, ok := properties[name]; ok {
+			continue
+		}
+
+		properties[name] = g.schema(field.Type)
+		if !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") {
+			*required = append(*required, name)
+		}
+	}
+
+	for _, fieldType := range embedded {
+		g.addFields(fieldType, properties, required)
+	}
+}
+
+// nullable allows the schema's value to also be null.
+func nullable(schema map[string]any) map[string]any {
+	if typ, ok := schema["type"].(string); ok {
+		schema["type"] = []string{typ, "null"}
+		return schema
+	}
+	return map[string]any{"anyOf": []any{schema, map[string]any{"type": "null"}}}
+}
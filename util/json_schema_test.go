@@ -0,0 +1,102 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaTestBase struct {
+	ID      string `json:"id"`
+	Private string `json:"-"`
+	Name    string `json:"name"`
+}
+
+type schemaTestChild struct {
+	Value int `json:"value"`
+}
+
+type schemaTestNode struct {
+	Next *schemaTestNode `json:"next"`
+}
+
+type schemaTestMarshaler struct{}
+
+func (schemaTestMarshaler) MarshalJSON() ([]byte, error) { return []byte(`"custom"`), nil }
+
+type schemaTestObject struct {
+	schemaTestBase
+	Name       *string             `json:"name"`
+	Count      int                 `json:"count,omitempty"`
+	Ratio      float64             `json:"ratio"`
+	Enabled    bool                `json:"enabled"`
+	CreatedAt  time.Time           `json:"created_at"`
+	Tags       []string            `json:"tags"`
+	Data       []byte              `json:"data"`
+	Children   []schemaTestChild   `json:"children"`
+	Labels     map[string]string   `json:"labels"`
+	Node       schemaTestNode      `json:"node"`
+	Custom     schemaTestMarshaler `json:"custom"`
+	Anything   any                 `json:"anything"`
+	NoTag      string              `json:""`
+	unexported string
+}
+
+func TestJSONSchemaFor(t *testing.T) {
+	schema, defs := JSONSchemaFor(schemaTestObject{})
+	assert.Equal(t, map[string]any{"$ref": "#/$defs/schemaTestObject"}, schema)
+
+	object, ok := defs["schemaTestObject"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "object", object["type"])
+	properties, ok := object["properties"].(map[string]any)
+	require.True(t, ok)
+
+	t.Run("PromotesEmbeddedFields", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"type": "string"}, properties["id"])
+		assert.NotContains(t, properties, "Private")
+		assert.NotContains(t, properties, "unexported")
+	})
+	t.Run("OuterFieldsTakePrecedence", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"type": []string{"string", "null"}}, properties["name"])
+	})
+	t.Run("DescribesBasicTypes", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"type": "integer"}, properties["count"])
+		assert.Equal(t, map[string]any{"type": "number"}, properties["ratio"])
+		assert.Equal(t, map[string]any{"type": "boolean"}, properties["enabled"])
+		assert.Equal(t, map[string]any{"type": "string", "format": "date-time"}, properties["created_at"])
+		assert.Equal(t, map[string]any{"type": "string"}, properties["NoTag"])
+		assert.Equal(t, map[string]any{}, properties["anything"])
+		assert.Equal(t, map[string]any{}, properties["custom"])
+	})
+	t.Run("DescribesCollections", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"type": []string{"array", "null"}, "items": map[string]any{"type": "string"}}, properties["tags"])
+		assert.Equal(t, map[string]any{"type": []string{"string", "null"}, "contentEncoding": "base64"}, properties["data"])
+		assert.Equal(t, map[string]any{"type": []string{"array", "null"}, "items": map[string]any{"$ref": "#/$defs/schemaTestChild"}}, properties["children"])
+		assert.Equal(t, map[string]any{"type": []string{"object", "null"}, "additionalProperties": map[string]any{"type": "string"}}, properties["labels"])
+		assert.Contains(t, defs, "schemaTestChild")
+	})
+	t.Run("DescribesRecursiveTypes", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"$ref": "#/$defs/schemaTestNode"}, properties["node"])
+		node, ok := defs["schemaTestNode"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, map[string]any{
+			"anyOf": []any{map[string]any{"$ref": "#/$defs/schemaTestNode"}, map[string]any{"type": "null"}},
+		}, node["properties"].(map[string]any)["next"])
+	})
+	t.Run("RequiresFieldsWithoutOmitempty", func(t *testing.T) {
+		required, ok := object["required"].([]string)
+		require.True(t, ok)
+		assert.Contains(t, required, "id")
+		assert.Contains(t, required, "name")
+		assert.Contains(t, required, "created_at")
+		assert.NotContains(t, required, "count")
+	})
+	t.Run("IsValidJSON", func(t *testing.T) {
+		_, err := json.Marshal(map[string]any{"$schema": JSONSchemaDialect, "$defs": defs, "$ref": schema["$ref"]})
+		assert.NoError(t, err)
+	})
+}
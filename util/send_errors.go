@@ -0,0 +1,50 @@
+package util
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mongodb/grip"
+)
+
+type sendErrorsKey struct{}
+
+// SendErrors collects the errors that senders report while sending a message.
+// grip senders don't return errors from Send, so callers that need to know
+// whether a message was delivered attach a SendErrors to the context passed
+// to Send, and the sender's error handler records into it with
+// RecordSendError.
+type SendErrors struct {
+	mu      sync.Mutex
+	catcher grip.Catcher
+}
+
+// ContextWithSendErrors returns a context that collects the errors reported
+// by senders that are passed the context.
+func ContextWithSendErrors(ctx context.Context) (context.Context, *SendErrors) {
+	errs := &SendErrors{catcher: grip.NewBasicCatcher()}
+	return context.WithValue(ctx, sendErrorsKey{}, errs), errs
+}
+
+// RecordSendError records a sender's error in the context's SendErrors. It
+// is a no-op if the context doesn't have one.
+func RecordSendError(ctx context.Context, err error) {
+	if err == nil || ctx == nil {
+		return
+	}
+	errs, ok := ctx.Value(sendErrorsKey{}).(*SendErrors)
+	if !ok {
+		return
+	}
+
+	errs.mu.Lock()
+	defer errs.mu.Unlock()
+	errs.catcher.Add(err)
+}
+
+// Resolve returns the recorded errors, or nil if there were none.
+func (e *SendErrors) Resolve() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.catcher.Resolve()
+}
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendErrors(t *testing.T) {
+	t.Run("RecordsErrors", func(t *testing.T) {
+		ctx, errs := ContextWithSendErrors(t.Context())
+		assert.NoError(t, errs.Resolve())
+
+		RecordSendError(ctx, errors.New("connection refused"))
+		RecordSendError(ctx, nil)
+		RecordSendError(ctx, errors.New("rate limited"))
+
+		err := errs.Resolve()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "connection refused")
+		assert.Contains(t, err.Error(), "rate limited")
+	})
+	t.Run("NoopWithoutSendErrors", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			RecordSendError(context.Background(), errors.New("error"))
+		})
+	})
+	t.Run("OnlyRecordsForDerivedContexts", func(t *testing.T) {
+		ctx, errs := ContextWithSendErrors(t.Context())
+		otherCtx, otherErrs := ContextWithSendErrors(t.Context())
+
+		RecordSendError(ctx, errors.New("error"))
+		assert.Error(t, errs.Resolve())
+		assert.NoError(t, otherErrs.Resolve())
+
+		RecordSendError(otherCtx, errors.New("error"))
+		assert.Error(t, otherErrs.Resolve())
+	})
+}
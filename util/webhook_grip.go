@@ -38,6 +38,8 @@ type EvergreenWebhook struct {
 
 type evergreenWebhookMessage struct {
 	raw EvergreenWebhook
+	// unsigned is whether the webhook is sent without a signature.
+	unsigned bool
 
 	message.Base
 }
@@ -48,11 +50,21 @@ func NewWebhookMessage(raw EvergreenWebhook) message.Composer {
 	}
 }
 
+// NewChatWebhookMessage returns a composer that posts the webhook's body to a
+// chat service's incoming webhook, such as Microsoft Teams. Chat services
+// don't check Evergreen's signature, so the webhook doesn't need a secret.
+func NewChatWebhookMessage(raw EvergreenWebhook) message.Composer {
+	return &evergreenWebhookMessage{
+		raw:      raw,
+		unsigned: true,
+	}
+}
+
 func (w *evergreenWebhookMessage) Loggable() bool {
 	if len(w.raw.NotificationID) == 0 {
 		return false
 	}
-	if len(w.raw.Secret) == 0 {
+	if len(w.raw.Secret) == 0 && !w.unsigned {
 		return false
 	}
 	if len(w.raw.Body) == 0 {
@@ -92,11 +104,6 @@ func (w *EvergreenWebhook) request() (*http.Request, error) {
 		return nil, errors.Wrap(err, "creating webhook HTTP request")
 	}
 
-	hash, err := CalculateHMACHash(w.Secret, w.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "calculating HMAC hash")
-	}
-
 	for k := range w.Headers {
 		for i := range w.Headers[k] {
 			req.Header.Add(k, w.Headers[k][i])
@@ -107,7 +114,14 @@ func (w *EvergreenWebhook) request() (*http.Request, error) {
 	req.Header.Del(evergreenHMACHeader)
 	req.Header.Del(evergreenNotificationIDHeader)
 
-	req.Header.Add(evergreenHMACHeader, hash)
+	// Chat webhooks are sent without a secret, so they aren't signed.
+	if len(w.Secret) > 0 {
+		hash, err := CalculateHMACHash(w.Secret, w.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "calculating HMAC hash")
+		}
+		req.Header.Add(evergreenHMACHeader, hash)
+	}
 	req.Header.Add(evergreenNotificationIDHeader, w.NotificationID)
 
 	return req, nil
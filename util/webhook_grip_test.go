@@ -67,6 +67,29 @@ func TestEvergreenWebhookComposer(t *testing.T) {
 	assert.True(m.Loggable())
 }
 
+func TestChatWebhookComposer(t *testing.T) {
+	m := NewChatWebhookMessage(EvergreenWebhook{})
+	assert.False(t, m.Loggable())
+
+	raw := EvergreenWebhook{
+		NotificationID: "evergreen",
+		URL:            "https://example.com",
+		Body:           []byte(`{"text": "something important"}`),
+		Headers: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+	}
+	m = NewChatWebhookMessage(raw)
+	assert.True(t, m.Loggable())
+	assert.False(t, NewWebhookMessage(raw).Loggable(), "signed webhooks should require a secret")
+
+	req, err := raw.request()
+	require.NoError(t, err)
+	assert.Empty(t, req.Header.Get(evergreenHMACHeader))
+	assert.Equal(t, "evergreen", req.Header.Get(evergreenNotificationIDHeader))
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+}
+
 func TestEvergreenWebhookSender(t *testing.T) {
 	sender, err := NewEvergreenWebhookLogger()
 	assert.NoError(t, err)
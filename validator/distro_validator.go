@@ -476,6 +476,54 @@ func ensureHasValidPlannerSettings(ctx context.Context, d *distro.Distro, s *eve
 			Level:   Error,
 		})
 	}
+	errs = append(errs, validateFairShareWeights(settings.FairShareWeights, d.Id)...)
+
+	return errs
+}
+
+// validateFairShareWeights checks that every fair-share weight is named,
+// positive and has projects, and that no project belongs to more than one
+// share.
+func validateFairShareWeights(weights []distro.FairShareWeight, distroID string) ValidationErrors {
+	errs := ValidationErrors{}
+	names := map[string]bool{}
+	shareForProject := map[string]string{}
+	for i, w := range weights {
+		if w.Name == "" {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("planner_settings.fair_share_weights[%d] for distro '%s' must have a name", i, distroID),
+				Level:   Error,
+			})
+		} else if names[w.Name] {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("planner_settings.fair_share_weights for distro '%s' has duplicate name '%s'", distroID, w.Name),
+				Level:   Error,
+			})
+		}
+		names[w.Name] = true
+		if w.Weight <= 0 {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("invalid planner_settings.fair_share_weights weight of %d for share '%s' in distro '%s' - its value must be a positive integer", w.Weight, w.Name, distroID),
+				Level:   Error,
+			})
+		}
+		if len(w.Projects) == 0 {
+			errs = append(errs, ValidationError{
+				Message: fmt.Sprintf("planner_settings.fair_share_weights share '%s' in distro '%s' must have at least one project", w.Name, distroID),
+				Level:   Error,
+			})
+		}
+		for _, project := range w.Projects {
+			if other, ok := shareForProject[project]; ok && other != w.Name {
+				errs = append(errs, ValidationError{
+					Message: fmt.Sprintf("project '%s' is in both planner_settings.fair_share_weights shares '%s' and '%s' in distro '%s'", project, other, w.Name, distroID),
+					Level:   Error,
+				})
+				continue
+			}
+			shareForProject[project] = w.Name
+		}
+	}
 
 	return errs
 }
@@ -794,3 +794,71 @@ func TestEnsureHasValidPlannerSettingsMergeQueueTargetTime(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureHasValidPlannerSettingsFairShareWeights(t *testing.T) {
+	ctx := t.Context()
+	settings := &evergreen.Settings{}
+
+	for _, tCase := range []struct {
+		name      string
+		weights   []distro.FairShareWeight
+		expectErr string
+	}{
+		{name: "UnsetShouldBeValid"},
+		{
+			name: "ValidShares",
+			weights: []distro.FairShareWeight{
+				{Name: "release", Projects: []string{"server", "tools"}, Weight: 3},
+				{Name: "docs", Projects: []string{"docs"}, Weight: 1},
+			},
+		},
+		{
+			name:      "MissingNameShouldError",
+			weights:   []distro.FairShareWeight{{Projects: []string{"server"}, Weight: 1}},
+			expectErr: "must have a name",
+		},
+		{
+			name: "DuplicateNameShouldError",
+			weights: []distro.FairShareWeight{
+				{Name: "release", Projects: []string{"server"}, Weight: 1},
+				{Name: "release", Projects: []string{"tools"}, Weight: 1},
+			},
+			expectErr: "duplicate name 'release'",
+		},
+		{
+			name:      "NonPositiveWeightShouldError",
+			weights:   []distro.FairShareWeight{{Name: "release", Projects: []string{"server"}}},
+			expectErr: "must be a positive integer",
+		},
+		{
+			name:      "NoProjectsShouldError",
+			weights:   []distro.FairShareWeight{{Name: "release", Weight: 1}},
+			expectErr: "must have at least one project",
+		},
+		{
+			name: "ProjectInMultipleSharesShouldError",
+			weights: []distro.FairShareWeight{
+				{Name: "release", Projects: []string{"server"}, Weight: 1},
+				{Name: "nightly", Projects: []string{"server"}, Weight: 1},
+			},
+			expectErr: "project 'server' is in both",
+		},
+	} {
+		t.Run(tCase.name, func(t *testing.T) {
+			d := &distro.Distro{
+				Id: "distro",
+				PlannerSettings: distro.PlannerSettings{
+					Version:          evergreen.PlannerVersionTunable,
+					FairShareWeights: tCase.weights,
+				},
+			}
+			errs := ensureHasValidPlannerSettings(ctx, d, settings)
+			if tCase.expectErr == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, 1)
+			assert.Contains(t, errs[0].Message, tCase.expectErr)
+		})
+	}
+}
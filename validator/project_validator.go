@@ -140,6 +140,7 @@ var projectErrorValidators = []projectValidator{
 	validateHostCreates,
 	validateDuplicateBVTasks,
 	validateGenerateTasks,
+	validateTaskFilesFetchDependencies,
 }
 
 // Functions used to validate the syntax of project configs representing properties found on the project page.
@@ -2179,6 +2180,84 @@ func validateGenerateTasks(p *model.Project) ValidationErrors {
 	return validateTimesCalledPerTask(p, ts, evergreen.GenerateTasksCommandName, 1, Error)
 }
 
+// validateTaskFilesFetchDependencies checks that every task that calls
+// task_files.fetch depends on the task it fetches files from, since files can
+// only be fetched from one of the task's dependencies. Fetches whose task or
+// build variant comes from an expansion can't be checked until the task runs.
+func validateTaskFilesFetchDependencies(p *model.Project) ValidationErrors {
+	errs := ValidationErrors{}
+	for _, bvtu := range p.FindAllBuildVariantTasks() {
+		pt := p.FindProjectTask(bvtu.Name)
+		if pt == nil {
+			continue
+		}
+		for _, fetched := range taskFilesFetchedByTask(p, pt) {
+			if strings.Contains(fetched.TaskName, "${") || strings.Contains(fetched.Variant, "${") {
+				continue
+			}
+			if fetched.Variant == "" {
+				fetched.Variant = bvtu.Variant
+			}
+			if dependsOnTVPair(bvtu, fetched) {
+				continue
+			}
+			errs = append(errs, ValidationError{
+				Level:   Error,
+				Message: fmt.Sprintf("task '%s' in build variant '%s' calls %s to fetch files from task '%s' in build variant '%s', but does not depend on it", bvtu.Name, bvtu.Variant, evergreen.TaskFilesFetchCommandName, fetched.TaskName, fetched.Variant),
+			})
+		}
+	}
+	return errs
+}
+
+// taskFilesFetchedByTask returns the tasks that the project task fetches
+// files from, including through functions. The variant is empty if the
+// fetch does not specify one.
+func taskFilesFetchedByTask(p *model.Project, pt *model.ProjectTask) []model.TVPair {
+	var cmds []model.PluginCommandConf
+	for _, c := range pt.Commands {
+		if c.Function == "" {
+			cmds = append(cmds, c)
+			continue
+		}
+		if fn := p.Functions[c.Function]; fn != nil {
+			cmds = append(cmds, fn.List()...)
+		}
+	}
+
+	var fetched []model.TVPair
+	for _, c := range cmds {
+		if c.Command != evergreen.TaskFilesFetchCommandName {
+			continue
+		}
+		taskName, _ := c.Params["task"].(string)
+		if taskName == "" {
+			continue
+		}
+		variant, _ := c.Params["variant"].(string)
+		fetched = append(fetched, model.TVPair{TaskName: taskName, Variant: variant})
+	}
+	return fetched
+}
+
+// dependsOnTVPair returns whether any of the build variant task unit's
+// dependencies, including ones that use the all dependencies wildcard, match
+// the task and build variant.
+func dependsOnTVPair(bvtu model.BuildVariantTaskUnit, tv model.TVPair) bool {
+	for _, d := range bvtu.DependsOn {
+		depVariant := d.Variant
+		if depVariant == "" {
+			depVariant = bvtu.Variant
+		}
+		nameMatches := d.Name == model.AllDependencies || d.Name == tv.TaskName
+		variantMatches := depVariant == model.AllVariants || depVariant == tv.Variant
+		if nameMatches && variantMatches {
+			return true
+		}
+	}
+	return false
+}
+
 // validateVersionControl checks if a project with defined project config fields has version control enabled on the project ref.
 func validateVersionControl(_ context.Context, _ *evergreen.Settings, _ *model.Project, ref *model.ProjectRef, isConfigDefined bool) ValidationErrors {
 	var errs ValidationErrors
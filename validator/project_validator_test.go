@@ -4848,6 +4848,152 @@ func TestValidateCreateHosts(t *testing.T) {
 	assert.Len(errs, 1)
 }
 
+func TestValidateTaskFilesFetchDependencies(t *testing.T) {
+	loadProject := func(t *testing.T, projYAML string) *model.Project {
+		var p model.Project
+		_, err := model.LoadProjectInto(t.Context(), []byte(projYAML), nil, "", &p)
+		require.NoError(t, err)
+		return &p
+	}
+
+	t.Run("AllowsFetchFromDependency", func(t *testing.T) {
+		p := loadProject(t, `
+tasks:
+- name: compile
+  commands:
+  - command: task_files.publish
+    params:
+      name: binaries
+      paths: [bin]
+- name: test
+  depends_on:
+  - name: compile
+  commands:
+  - command: task_files.fetch
+    params:
+      name: binaries
+      task: compile
+
+buildvariants:
+- name: bv1
+  tasks:
+  - name: compile
+  - name: test
+`)
+		assert.Empty(t, validateTaskFilesFetchDependencies(p))
+	})
+	t.Run("FailsWithFetchFromNonDependency", func(t *testing.T) {
+		p := loadProject(t, `
+tasks:
+- name: compile
+- name: test
+  commands:
+  - command: task_files.fetch
+    params:
+      name: binaries
+      task: compile
+
+buildvariants:
+- name: bv1
+  tasks:
+  - name: compile
+  - name: test
+`)
+		errs := validateTaskFilesFetchDependencies(p)
+		require.Len(t, errs, 1)
+		assert.Equal(t, Error, errs[0].Level)
+		assert.Contains(t, errs[0].Message, "task 'test' in build variant 'bv1' calls task_files.fetch to fetch files from task 'compile' in build variant 'bv1', but does not depend on it")
+	})
+	t.Run("FailsWithFetchFromDependencyInOtherVariant", func(t *testing.T) {
+		p := loadProject(t, `
+tasks:
+- name: compile
+- name: test
+  depends_on:
+  - name: compile
+  commands:
+  - command: task_files.fetch
+    params:
+      name: binaries
+      task: compile
+      variant: bv2
+
+buildvariants:
+- name: bv1
+  tasks:
+  - name: compile
+  - name: test
+- name: bv2
+  tasks:
+  - name: compile
+`)
+		errs := validateTaskFilesFetchDependencies(p)
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Message, "from task 'compile' in build variant 'bv2'")
+	})
+	t.Run("ChecksFetchInFunction", func(t *testing.T) {
+		p := loadProject(t, `
+functions:
+  fetch binaries:
+    command: task_files.fetch
+    params:
+      name: binaries
+      task: compile
+
+tasks:
+- name: compile
+- name: test
+  commands:
+  - func: fetch binaries
+
+buildvariants:
+- name: bv1
+  tasks:
+  - name: compile
+  - name: test
+`)
+		assert.Len(t, validateTaskFilesFetchDependencies(p), 1)
+	})
+	t.Run("AllowsWildcardDependency", func(t *testing.T) {
+		p := loadProject(t, `
+tasks:
+- name: compile
+- name: test
+  depends_on:
+  - name: "*"
+  commands:
+  - command: task_files.fetch
+    params:
+      name: binaries
+      task: compile
+
+buildvariants:
+- name: bv1
+  tasks:
+  - name: compile
+  - name: test
+`)
+		assert.Empty(t, validateTaskFilesFetchDependencies(p))
+	})
+	t.Run("IgnoresFetchFromExpansion", func(t *testing.T) {
+		p := loadProject(t, `
+tasks:
+- name: test
+  commands:
+  - command: task_files.fetch
+    params:
+      name: binaries
+      task: ${producer}
+
+buildvariants:
+- name: bv1
+  tasks:
+  - name: test
+`)
+		assert.Empty(t, validateTaskFilesFetchDependencies(p))
+	})
+}
+
 func TestValidateParameters(t *testing.T) {
 	p := &model.Project{
 		Parameters: []model.ParameterInfo{